@@ -78,6 +78,28 @@ const docTemplate = `{
                         "name": "limit",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of the previous page, switches the list to cursor pagination",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "description": "offset (default) or cursor",
+                        "name": "pagination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include an estimated total in cursor pagination",
+                        "name": "with_total",
+                        "in": "query"
+                    },
                     {
                         "enum": [
                             "must-revalidate",
@@ -215,6 +237,28 @@ const docTemplate = `{
                         "name": "limit",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of the previous page, switches the list to cursor pagination",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "description": "offset (default) or cursor",
+                        "name": "pagination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include an estimated total in cursor pagination",
+                        "name": "with_total",
+                        "in": "query"
+                    },
                     {
                         "enum": [
                             "must-revalidate",
@@ -565,14 +609,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/bank": {
+        "/account/{id}/limit": {
             "get": {
                 "security": [
                     {
-                        "APIKey": []
+                        "OAuth2Password": []
                     }
                 ],
-                "description": "Get banks data",
+                "description": "get the transfer limits of the account per currency with the usage of the current UTC day, is_default marks limits taken from the configuration",
                 "consumes": [
                     "application/json"
                 ],
@@ -580,105 +624,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bank"
+                    "account-limit"
                 ],
-                "summary": "Get Bank data",
+                "summary": "Get account transfer limits",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "search by bank id",
-                        "name": "bank_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "search by account name",
-                        "name": "account_name",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "search by account number",
-                        "name": "account_number",
-                        "in": "query",
+                        "type": "integer",
+                        "description": "account id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "enum": [
-                            "must-revalidate",
-                            "none"
-                        ],
-                        "type": "string",
-                        "description": "Request Cache Control",
-                        "name": "Cache-Control",
-                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.GetBankAccountResponse"
+                            "$ref": "#/definitions/response.AccountLimitsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.GetBankAccountResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/response.GetBankAccountResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/me": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Get current account data",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "account"
-                ],
-                "summary": "Get current account data",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.SingleAccountResponse"
+                            "$ref": "#/definitions/response.AccountLimitsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleAccountResponse"
+                            "$ref": "#/definitions/response.AccountLimitsResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleAccountResponse"
+                            "$ref": "#/definitions/response.AccountLimitsResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleAccountResponse"
+                            "$ref": "#/definitions/response.AccountLimitsResponse"
                         }
                     }
                 }
@@ -689,7 +675,7 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Update current account data",
+                "description": "set the transfer limits of the account in a currency, amounts are minor units and 0 is unlimited",
                 "consumes": [
                     "application/json"
                 ],
@@ -697,17 +683,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "account"
+                    "account-limit"
                 ],
-                "summary": "Update current account data",
+                "summary": "Override account transfer limit",
                 "parameters": [
                     {
-                        "description": "Update Account Data",
+                        "type": "integer",
+                        "description": "account id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Account Limit Data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UpdateAccountData"
+                            "$ref": "#/definitions/model.UpsertAccountLimit"
                         }
                     }
                 ],
@@ -715,38 +708,44 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleAccountResponse"
+                            "$ref": "#/definitions/response.SingleAccountLimitResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleAccountResponse"
+                            "$ref": "#/definitions/response.SingleAccountLimitResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleAccountResponse"
+                            "$ref": "#/definitions/response.SingleAccountLimitResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountLimitResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleAccountResponse"
+                            "$ref": "#/definitions/response.SingleAccountLimitResponse"
                         }
                     }
                 }
             }
         },
-        "/me/password": {
-            "put": {
+        "/account/{id}/limit/{currency}": {
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Update password account data",
+                "description": "remove the override of the currency, the account is limited by the configured default again",
                 "consumes": [
                     "application/json"
                 ],
@@ -754,122 +753,129 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "account"
+                    "account-limit"
                 ],
-                "summary": "Update password account data",
+                "summary": "Remove account transfer limit override",
                 "parameters": [
                     {
-                        "description": "Update Account Data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdatePasswordData"
-                        }
+                        "type": "integer",
+                        "description": "account id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 currency code",
+                        "name": "currency",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleAccountResponse"
+                            "$ref": "#/definitions/response.EmptyResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleAccountResponse"
+                            "$ref": "#/definitions/response.EmptyResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleAccountResponse"
+                            "$ref": "#/definitions/response.EmptyResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleAccountResponse"
+                            "$ref": "#/definitions/response.EmptyResponse"
                         }
                     }
                 }
             }
         },
-        "/oauth2": {
-            "post": {
-                "description": "OAUTH2 Authorization Code flow will show generated token to access apps",
+        "/bank": {
+            "get": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "Get banks data",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "account"
+                    "bank"
                 ],
-                "summary": "OAUTH2 Authorization",
+                "summary": "Get Bank data",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Client ID",
-                        "name": "client_id",
-                        "in": "header",
+                        "description": "search by bank id",
+                        "name": "bank_id",
+                        "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Client Secret",
-                        "name": "client_secret",
-                        "in": "header",
+                        "description": "search by account name",
+                        "name": "account_name",
+                        "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Account Email",
-                        "name": "username",
-                        "in": "formData",
+                        "description": "search by account number",
+                        "name": "account_number",
+                        "in": "query",
                         "required": true
                     },
                     {
+                        "enum": [
+                            "must-revalidate",
+                            "none"
+                        ],
                         "type": "string",
-                        "description": "Account Password",
-                        "name": "password",
-                        "in": "formData",
-                        "required": true
+                        "description": "Request Cache Control",
+                        "name": "Cache-Control",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.LoginResponse"
+                            "$ref": "#/definitions/response.GetBankAccountResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.LoginResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/response.LoginResponse"
+                            "$ref": "#/definitions/response.GetBankAccountResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/response.LoginResponse"
+                            "$ref": "#/definitions/response.GetBankAccountResponse"
                         }
                     }
                 }
             }
         },
-        "/register": {
+        "/callback/provider/{provider}": {
             "post": {
-                "description": "Register to create access from guest",
+                "description": "apply the transfer status pushed by the provider, the request is signed with X-Provider-Signature, sha256= followed by the hex HMAC-SHA256 of X-Provider-Timestamp, a dot and the raw body, using the provider callback secret",
                 "consumes": [
                     "application/json"
                 ],
@@ -877,17 +883,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "account"
+                    "callback"
                 ],
-                "summary": "Register account",
+                "summary": "Receive provider status callback",
                 "parameters": [
                     {
-                        "description": "Account Data",
+                        "type": "string",
+                        "description": "provider name",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "unix timestamp of the callback",
+                        "name": "X-Provider-Timestamp",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "signature of the callback",
+                        "name": "X-Provider-Signature",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Provider Transaction",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.Register"
+                            "$ref": "#/definitions/clientresponse.Transfer"
                         }
                     }
                 ],
@@ -895,32 +922,44 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.RegisterResponse"
+                            "$ref": "#/definitions/response.ProviderCallbackResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.RegisterResponse"
+                            "$ref": "#/definitions/response.ProviderCallbackResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProviderCallbackResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProviderCallbackResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/response.RegisterResponse"
+                            "$ref": "#/definitions/response.ProviderCallbackResponse"
                         }
                     }
                 }
             }
         },
-        "/role": {
+        "/dead-letter": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Get roles data",
+                "description": "get kafka messages that exhausted their retry topics",
                 "consumes": [
                     "application/json"
                 ],
@@ -928,26 +967,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "role"
+                    "dead-letter"
                 ],
-                "summary": "Get roles data",
+                "summary": "Get dead letter messages",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "search by id",
-                        "name": "id",
+                        "description": "search by the topic the message was consumed from",
+                        "name": "original_topic",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "pending",
+                            "replayed"
+                        ],
                         "type": "string",
-                        "description": "search by scope",
-                        "name": "scope",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "search by client_id",
-                        "name": "cid",
+                        "description": "search by status",
+                        "name": "status",
                         "in": "query"
                     },
                     {
@@ -967,97 +1004,44 @@ const docTemplate = `{
                         "description": " ",
                         "name": "limit",
                         "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "must-revalidate",
-                            "none"
-                        ],
-                        "type": "string",
-                        "description": "Request Cache Control",
-                        "name": "Cache-Control",
-                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.RolesResponse"
+                            "$ref": "#/definitions/response.DeadLetterMessagesResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.RolesResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/response.RolesResponse"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Create role data",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "role"
-                ],
-                "summary": "Create Role",
-                "parameters": [
-                    {
-                        "description": "Role Data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CreateRole"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.SingleRoleResponse"
+                            "$ref": "#/definitions/response.DeadLetterMessagesResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleRoleResponse"
+                            "$ref": "#/definitions/response.DeadLetterMessagesResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleRoleResponse"
+                            "$ref": "#/definitions/response.DeadLetterMessagesResponse"
                         }
                     }
                 }
             }
         },
-        "/role/{id}": {
+        "/dead-letter/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Get roles data",
+                "description": "get a dead letter message with the failure headers it was forwarded with",
                 "consumes": [
                     "application/json"
                 ],
@@ -1065,112 +1049,60 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "role"
+                    "dead-letter"
                 ],
-                "summary": "Get roles data",
+                "summary": "Get dead letter message by id",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "get by id",
+                        "type": "integer",
+                        "description": "dead letter message id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "enum": [
-                            "must-revalidate",
-                            "none"
-                        ],
-                        "type": "string",
-                        "description": "Request Cache Control",
-                        "name": "Cache-Control",
-                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleRoleResponse"
+                            "$ref": "#/definitions/response.SingleDeadLetterMessageResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleRoleResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/response.SingleRoleResponse"
+                            "$ref": "#/definitions/response.SingleDeadLetterMessageResponse"
                         }
-                    }
-                }
-            },
-            "put": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Update role data",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "role"
-                ],
-                "summary": "Update role data",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "update by id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
                     },
-                    {
-                        "description": "Role Data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateRole"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleRoleResponse"
+                            "$ref": "#/definitions/response.SingleDeadLetterMessageResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleRoleResponse"
+                            "$ref": "#/definitions/response.SingleDeadLetterMessageResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleRoleResponse"
+                            "$ref": "#/definitions/response.SingleDeadLetterMessageResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/dead-letter/{id}/replay": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Delete role data",
+                "description": "publish a dead letter message back onto its original topic, a message can only be replayed once",
                 "consumes": [
                     "application/json"
                 ],
@@ -1178,13 +1110,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "role"
+                    "dead-letter"
                 ],
-                "summary": "Delete role data",
+                "summary": "Replay dead letter message",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "delete by id",
+                        "type": "integer",
+                        "description": "dead letter message id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1194,32 +1126,44 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.EmptyResponse"
+                            "$ref": "#/definitions/response.SingleDeadLetterMessageResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.EmptyResponse"
+                            "$ref": "#/definitions/response.SingleDeadLetterMessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleDeadLetterMessageResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleDeadLetterMessageResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/response.EmptyResponse"
+                            "$ref": "#/definitions/response.SingleDeadLetterMessageResponse"
                         }
                     }
                 }
             }
         },
-        "/transfer": {
+        "/fee-rule": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "get transfer bank",
+                "description": "get the transfer fee rules",
                 "consumes": [
                     "application/json"
                 ],
@@ -1227,32 +1171,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transfer"
+                    "fee-rule"
                 ],
-                "summary": "get Transfer data",
+                "summary": "Get fee rules",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "search by id",
-                        "name": "id",
+                        "description": "search by source bank id",
+                        "name": "source_bank_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "search by destination bank id",
+                        "name": "destination_bank_id",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "search by job id",
-                        "name": "job_id",
+                        "description": "search by account plan",
+                        "name": "plan",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "search by api key",
-                        "name": "api_key",
+                        "description": "search by currency",
+                        "name": "currency",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "flat",
+                            "percentage",
+                            "capped"
+                        ],
                         "type": "string",
-                        "description": "search by status",
-                        "name": "status",
+                        "description": "search by fee type",
+                        "name": "fee_type",
                         "in": "query"
                     },
                     {
@@ -1272,35 +1227,31 @@ const docTemplate = `{
                         "description": " ",
                         "name": "limit",
                         "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "must-revalidate",
-                            "none"
-                        ],
-                        "type": "string",
-                        "description": "Request Cache Control",
-                        "name": "Cache-Control",
-                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.TransferJobsResponse"
+                            "$ref": "#/definitions/response.FeeRulesResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.TransferJobsResponse"
+                            "$ref": "#/definitions/response.FeeRulesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.FeeRulesResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/response.TransferJobsResponse"
+                            "$ref": "#/definitions/response.FeeRulesResponse"
                         }
                     }
                 }
@@ -1308,10 +1259,10 @@ const docTemplate = `{
             "post": {
                 "security": [
                     {
-                        "APIKey": []
+                        "OAuth2Password": []
                     }
                 ],
-                "description": "create transfer bank",
+                "description": "create a transfer fee rule, empty source_bank_id, destination_bank_id or plan match any value and the most specific matching rule prices the transfer",
                 "consumes": [
                     "application/json"
                 ],
@@ -1319,17 +1270,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transfer"
+                    "fee-rule"
                 ],
-                "summary": "Create Transfer data",
+                "summary": "Create fee rule",
                 "parameters": [
                     {
-                        "description": "Create Transfer Data",
+                        "description": "Fee Rule Data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateTransfer"
+                            "$ref": "#/definitions/model.CreateFeeRule"
                         }
                     }
                 ],
@@ -1337,38 +1288,38 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
                         }
                     }
                 }
             }
         },
-        "/transfer/{job_id}": {
+        "/fee-rule/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "get transfer data by job id",
+                "description": "get a transfer fee rule",
                 "consumes": [
                     "application/json"
                 ],
@@ -1376,331 +1327,5630 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transfer"
+                    "fee-rule"
                 ],
-                "summary": "Get Transfer Data By Job ID",
+                "summary": "Get fee rule by id",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "get by job id",
-                        "name": "job_id",
+                        "type": "integer",
+                        "description": "fee rule id",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "enum": [
-                            "must-revalidate",
-                            "none"
-                        ],
-                        "type": "string",
-                        "description": "Request Cache Control",
-                        "name": "Cache-Control",
-                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "clientresponse.BankAccount": {
-            "type": "object",
-            "properties": {
-                "account_amount": {
-                    "type": "number"
-                },
-                "account_name": {
-                    "type": "string"
-                },
-                "account_number": {
-                    "type": "string"
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "replace a transfer fee rule, transfers already created keep the fee they were charged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fee-rule"
+                ],
+                "summary": "Update fee rule by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "fee rule id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fee Rule Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateFeeRule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFeeRuleResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "delete a transfer fee rule, transfers matching no rule are free",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fee-rule"
+                ],
+                "summary": "Delete fee rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "fee rule id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.EmptyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.EmptyResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.EmptyResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/fx-rate": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "get the rates of currency pairs, loaded from the rates file or set through the api",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fx-rate"
+                ],
+                "summary": "Get fx rates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "search by base currency",
+                        "name": "base_currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by quote currency",
+                        "name": "quote_currency",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "file",
+                            "api"
+                        ],
+                        "type": "string",
+                        "description": "search by source",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "sort result by attributes",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.FXRatesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.FXRatesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.FXRatesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.FXRatesResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "create or replace the rate of a currency pair, one unit of base currency is worth rate units of quote currency and fee_bps is charged on converted transfers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fx-rate"
+                ],
+                "summary": "Set fx rate",
+                "parameters": [
+                    {
+                        "description": "FX Rate Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateFXRate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFXRateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFXRateResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFXRateResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFXRateResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/fx-rate/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "get the rate of a currency pair",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fx-rate"
+                ],
+                "summary": "Get fx rate by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "fx rate id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFXRateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFXRateResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFXRateResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFXRateResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleFXRateResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "delete the rate of a currency pair, quotes already issued keep their locked rate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fx-rate"
+                ],
+                "summary": "Delete fx rate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "fx rate id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.EmptyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.EmptyResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.EmptyResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/account": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "get the ledger accounts with their debit, credit and balance totals",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Get ledger accounts",
+                "parameters": [
+                    {
+                        "enum": [
+                            "merchant",
+                            "clearing",
+                            "settlement",
+                            "fee_revenue"
+                        ],
+                        "type": "string",
+                        "description": "search by account kind",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by merchant api key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by currency",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerAccountsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerAccountsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerAccountsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerAccountsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/account/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "get a ledger account with its debit, credit and balance totals",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Get ledger account by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ledger account id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleLedgerAccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleLedgerAccountResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleLedgerAccountResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleLedgerAccountResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleLedgerAccountResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/account/{id}/entry": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "get the entries booked on a ledger account, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Get ledger account entries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ledger account id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "transfer",
+                            "fee",
+                            "settlement",
+                            "reversal",
+                            "refund"
+                        ],
+                        "type": "string",
+                        "description": "search by transaction type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "search by transfer job id",
+                        "name": "transfer_job_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerEntriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerEntriesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerEntriesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerEntriesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerEntriesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/balance": {
+            "get": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "get the ledger balance of the api key per currency in minor units, transfers and fees are debited once submitted and credited back when they fail",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Get merchant ledger balance",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerBalanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerBalanceResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerBalanceResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerBalanceResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/invariant": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "sum the ledger entries per currency, balanced is false when debits and credits differ or a transaction does not balance on its own",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "Check ledger invariant",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerInvariantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerInvariantResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerInvariantResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.LedgerInvariantResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Get current account data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "Get current account data",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Update current account data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "Update current account data",
+                "parameters": [
+                    {
+                        "description": "Update Account Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateAccountData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/password": {
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Update password account data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "Update password account data",
+                "parameters": [
+                    {
+                        "description": "Update Account Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdatePasswordData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleAccountResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/oauth2": {
+            "post": {
+                "description": "OAUTH2 Authorization Code flow will show generated token to access apps",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "OAUTH2 Authorization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "client_id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client Secret",
+                        "name": "client_secret",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Account Email",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Account Password",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.LoginResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.LoginResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.LoginResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliation": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "get the reconciliation reports with their totals",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "Get reconciliation reports",
+                "parameters": [
+                    {
+                        "enum": [
+                            "running",
+                            "completed",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "search by report status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "sort result by attributes",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReconciliationReportsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReconciliationReportsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReconciliationReportsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReconciliationReportsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "start reconciling the transfer jobs of the period against the provider transaction list, the report is returned running and completes in the background",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "Reconcile transfers with the provider",
+                "parameters": [
+                    {
+                        "description": "Reconciliation Period",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateReconciliation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliation/{report_id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "get a reconciliation report with its totals",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "Get reconciliation report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "report id",
+                        "name": "report_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliation/{report_id}/discrepancy": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "get the records missing on either side, the amount mismatches and the status mismatches found by the report",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "Get reconciliation discrepancies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "report id",
+                        "name": "report_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "missing_on_provider",
+                            "missing_locally",
+                            "amount_mismatch",
+                            "status_mismatch"
+                        ],
+                        "type": "string",
+                        "description": "search by discrepancy type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReconciliationDiscrepanciesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReconciliationDiscrepanciesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReconciliationDiscrepanciesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReconciliationDiscrepanciesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReconciliationDiscrepanciesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliation/{report_id}/export": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "download the discrepancies of a completed report as CSV",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "Export reconciliation report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "report id",
+                        "name": "report_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "csv file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleReconciliationReportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/register": {
+            "post": {
+                "description": "Register to create access from guest",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "Register account",
+                "parameters": [
+                    {
+                        "description": "Account Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.Register"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RegisterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.RegisterResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.RegisterResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/role": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Get roles data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "role"
+                ],
+                "summary": "Get roles data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "search by id",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by scope",
+                        "name": "scope",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by client_id",
+                        "name": "cid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "sort result by attributes",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of the previous page, switches the list to cursor pagination",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "description": "offset (default) or cursor",
+                        "name": "pagination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include an estimated total in cursor pagination",
+                        "name": "with_total",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "must-revalidate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Request Cache Control",
+                        "name": "Cache-Control",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RolesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.RolesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.RolesResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Create role data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "role"
+                ],
+                "summary": "Create Role",
+                "parameters": [
+                    {
+                        "description": "Role Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateRole"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRoleResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRoleResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/role/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Get roles data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "role"
+                ],
+                "summary": "Get roles data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "get by id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "must-revalidate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Request Cache Control",
+                        "name": "Cache-Control",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRoleResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRoleResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Update role data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "role"
+                ],
+                "summary": "Update role data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update by id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateRole"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRoleResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRoleResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Delete role data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "role"
+                ],
+                "summary": "Delete role data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "delete by id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.EmptyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.EmptyResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.EmptyResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/settlement": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "download the transfer jobs totaled per api key, UTC day of creation, status and currency as CSV or NDJSON, amounts and fees are in minor units",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "settlement"
+                ],
+                "summary": "Get settlement report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "search by api key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "processing",
+                            "success",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "search by transfer status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "first day of the report, YYYY-MM-DD, default yesterday",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "last day of the report, YYYY-MM-DD, default from",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "report format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "report file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SettlementReportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SettlementReportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SettlementReportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/settlement/statement": {
+            "get": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "download the settlement report of the api key as CSV or NDJSON, amounts and fees are in minor units",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "settlement"
+                ],
+                "summary": "Get settlement statement",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pending",
+                            "processing",
+                            "success",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "search by transfer status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "first day of the report, YYYY-MM-DD, default yesterday",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "last day of the report, YYYY-MM-DD, default from",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "report format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "report file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SettlementReportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SettlementReportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SettlementReportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    },
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "get transfer bank",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "get Transfer data",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "search by id",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by job id",
+                        "name": "job_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by api key, only applied for a super admin",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by merchant reference",
+                        "name": "reference",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "search by minimum amount in minor units",
+                        "name": "amount_gte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "search by maximum amount in minor units",
+                        "name": "amount_lte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "search by source bank id",
+                        "name": "source_bank_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "search by destination bank id",
+                        "name": "destination_bank_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by source bank account",
+                        "name": "source_bank_account",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by destination bank account",
+                        "name": "destination_bank_account",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by source or destination bank account",
+                        "name": "bank_account",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search created after, RFC 3339",
+                        "name": "created_at_gt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search created before, RFC 3339",
+                        "name": "created_at_lt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search updated after, RFC 3339",
+                        "name": "updated_at_gt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search updated before, RFC 3339",
+                        "name": "updated_at_lt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "sort result by attributes",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of the previous page, switches the list to cursor pagination",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "description": "offset (default) or cursor",
+                        "name": "pagination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include an estimated total in cursor pagination",
+                        "name": "with_total",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "must-revalidate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Request Cache Control",
+                        "name": "Cache-Control",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferJobsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferJobsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferJobsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferJobsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "create transfer bank, amount is an integer of minor units of the ISO 4217 currency (1099 IDR is Rp 10,99), pass quote_id from /transfer/quote to send it converted at the locked rate, both accounts are checked with the bank first and the source balance must cover the amount",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Create Transfer data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique key to safely retry the same transfer request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Create Transfer Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateTransfer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/batch": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "create many transfers at once from a json array or a csv file with header source_bank_account,destination_bank_account,source_bank_id,destination_bank_id,amount,currency,transaction_time where amount is in minor units, invalid rows are reported without blocking valid ones",
+                "consumes": [
+                    "application/json",
+                    "text/csv",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Create Transfer batch",
+                "parameters": [
+                    {
+                        "description": "Create Transfer Batch Data",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CreateTransfer"
+                            }
+                        }
+                    },
+                    {
+                        "type": "file",
+                        "description": "Transfer batch csv",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferBatchResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferBatchResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferBatchResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/batch/{batch_id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    },
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "get transfer batch status, per row errors and child jobs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Get Transfer batch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "get by batch id",
+                        "name": "batch_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferBatchResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferBatchResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferBatchResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferBatchResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/export": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "stream the transfer jobs matching the filters of the transfer list as CSV or NDJSON in id order, amounts are in minor units",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Export transfer data",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "export format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "job_id,status,amount,currency",
+                        "description": "comma separated columns, default every column",
+                        "name": "columns",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by api key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by merchant reference",
+                        "name": "reference",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "search by minimum amount in minor units",
+                        "name": "amount_gte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "search by maximum amount in minor units",
+                        "name": "amount_lte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "search by source bank id",
+                        "name": "source_bank_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "search by destination bank id",
+                        "name": "destination_bank_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by source bank account",
+                        "name": "source_bank_account",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by destination bank account",
+                        "name": "destination_bank_account",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by source or destination bank account",
+                        "name": "bank_account",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search created after, RFC 3339",
+                        "name": "created_at_gt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search created before, RFC 3339",
+                        "name": "created_at_lt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search updated after, RFC 3339",
+                        "name": "updated_at_gt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search updated before, RFC 3339",
+                        "name": "updated_at_lt",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "export file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "write the export in the background, the job links its file in download_url once completed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Create transfer export job",
+                "parameters": [
+                    {
+                        "description": "Export format, columns and transfer filters",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.GetTransferExportByParam"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/export/{export_id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "get the status of an export job and the link to its file once completed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Get transfer export job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "export id",
+                        "name": "export_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/export/{export_id}/download": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "download the file of a completed export job",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Download transfer export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "export id",
+                        "name": "export_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "export file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferExportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/quote": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "lock the fx rate and fee for converting amount from source to destination currency, pass the quote_id to create transfer before expires_at, transfer_fee_amount previews the fee of the matching fee rule for the given banks and is priced again when the transfer is created",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Create Transfer quote",
+                "parameters": [
+                    {
+                        "description": "Create Transfer Quote Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateTransferQuote"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferQuoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferQuoteResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferQuoteResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferQuoteResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferQuoteResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/recurring": {
+            "get": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "get recurring transfer mandates of the api key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Get recurring Transfer mandates",
+                "parameters": [
+                    {
+                        "enum": [
+                            "active",
+                            "paused",
+                            "completed"
+                        ],
+                        "type": "string",
+                        "description": "mandate status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "sort result by attributes",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RecurringTransfersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.RecurringTransfersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.RecurringTransfersResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.RecurringTransfersResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "create a mandate that generates a transfer job on every occurrence of a cron schedule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Create recurring Transfer mandate",
+                "parameters": [
+                    {
+                        "description": "Recurring Transfer Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateRecurringTransfer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/recurring/{mandate_id}": {
+            "get": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "get a recurring transfer mandate with the jobs it generated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Get recurring Transfer mandate by mandate id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "mandate id",
+                        "name": "mandate_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/recurring/{mandate_id}/pause": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "stop an active mandate from generating transfer jobs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Pause recurring Transfer mandate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "mandate id",
+                        "name": "mandate_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/recurring/{mandate_id}/resume": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "resume a paused mandate from its next occurrence, missed occurrences are skipped",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Resume recurring Transfer mandate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "mandate id",
+                        "name": "mandate_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleRecurringTransferResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/scheduled": {
+            "get": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "get future dated transfers of the api key that are not released yet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Get scheduled Transfer data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "sort result by attributes",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of the previous page, switches the list to cursor pagination",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "description": "offset (default) or cursor",
+                        "name": "pagination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include an estimated total in cursor pagination",
+                        "name": "with_total",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferJobsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferJobsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferJobsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferJobsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/stream": {
+            "get": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "push the creation and status changes of the jobs of the api key as server-sent events, send the id of the last event received as Last-Event-ID to resume after it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Stream transfer status changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "only the changes of this job",
+                        "name": "job_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only the changes of the jobs of this batch",
+                        "name": "batch_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "resume after this event id",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "resume after this event id when the header cannot be set",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "stream of transfer.created and transfer.status_changed events, the data is the event",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferStreamEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferStreamEventResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferStreamEventResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferStreamEventResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferStreamEventResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/stream/ws": {
+            "get": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "push the creation and status changes of the jobs of the api key as json text messages, pass the id of the last event received as last_event_id to resume after it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Stream transfer status changes over websocket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "only the changes of this job",
+                        "name": "job_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only the changes of the jobs of this batch",
+                        "name": "batch_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "resume after this event id",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "switching protocols, every message is an event",
+                        "schema": {
+                            "$ref": "#/definitions/model.TransferStreamEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferStreamEventResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferStreamEventResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferStreamEventResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/{job_id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    },
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "get transfer data by job id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Get Transfer Data By Job ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "get by job id",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "must-revalidate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Request Cache Control",
+                        "name": "Cache-Control",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "update a scheduled transfer before it is released",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Amend scheduled Transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "job id",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Transfer Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateTransfer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/{job_id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "cancel a scheduled transfer, or a pending transfer that has not been submitted to the provider yet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Cancel Transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "job id",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleTransferJobResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfer/{job_id}/events": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    },
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "description": "get status history of a transfer job",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "Get Transfer Job Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "get by job id",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "must-revalidate",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Request Cache Control",
+                        "name": "Cache-Control",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferJobEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferJobEventsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferJobEventsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferJobEventsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "get registered webhook endpoints of current account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Get webhook endpoints",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "search by account id, super admin only",
+                        "name": "account_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "search by active flag",
+                        "name": "is_active",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.WebhookEndpointsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.WebhookEndpointsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.WebhookEndpointsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "register url to receive signed transfer status notifications, the signing secret is only returned once",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Register webhook endpoint",
+                "parameters": [
+                    {
+                        "description": "Create Webhook Endpoint Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateWebhookEndpoint"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleWebhookEndpointResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleWebhookEndpointResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleWebhookEndpointResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleWebhookEndpointResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/delivery/{id}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "queue a new delivery with the same payload as an earlier delivery",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Redeliver webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "webhook delivery id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleWebhookDeliveryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleWebhookDeliveryResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleWebhookDeliveryResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.SingleWebhookDeliveryResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "delete webhook endpoint, pending deliveries to it are abandoned",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Delete webhook endpoint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "webhook endpoint id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.EmptyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.EmptyResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.EmptyResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "get latest delivery log of a webhook endpoint",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Get webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "webhook endpoint id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": " ",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.WebhookDeliveriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.WebhookDeliveriesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.WebhookDeliveriesResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "clientresponse.BankAccount": {
+            "type": "object",
+            "properties": {
+                "account_amount": {
+                    "type": "number"
+                },
+                "account_name": {
+                    "type": "string"
+                },
+                "account_number": {
+                    "type": "string"
+                },
+                "bank_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "clientresponse.Transfer": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "destination_amount": {
+                    "type": "integer"
+                },
+                "destination_bank_account": {
+                    "type": "string"
+                },
+                "destination_bank_id": {
+                    "type": "integer"
+                },
+                "destination_currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "source_bank_account": {
+                    "type": "string"
+                },
+                "source_bank_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "transaction_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Account": {
+            "type": "object",
+            "properties": {
+                "api_key": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "plan": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AccountLimit": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "daily_amount": {
+                    "type": "integer"
+                },
+                "daily_count": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_default": {
+                    "type": "boolean"
+                },
+                "max_per_transaction": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                },
+                "usage": {
+                    "$ref": "#/definitions/model.TransferLimitUsage"
+                }
+            }
+        },
+        "model.AccountRole": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "role_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CreateAccountRole": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "integer"
+                },
+                "role_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CreateFXRate": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "fee_bps": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "quote_currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "rate": {
+                    "type": "string",
+                    "example": "15650.25"
+                }
+            }
+        },
+        "model.CreateFeeRule": {
+            "type": "object"
+        },
+        "model.CreateReconciliation": {
+            "type": "object",
+            "properties": {
+                "period_end": {
+                    "type": "string",
+                    "example": "2024-03-18T00:00:00Z"
+                },
+                "period_start": {
+                    "type": "string",
+                    "example": "2024-03-17T00:00:00Z"
+                }
+            }
+        },
+        "model.CreateRecurringTransfer": {
+            "type": "object"
+        },
+        "model.CreateRole": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "client_secret": {
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateTransfer": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 1099
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "destination_bank_account": {
+                    "type": "string"
+                },
+                "destination_bank_id": {
+                    "type": "integer"
+                },
+                "quote_id": {
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string"
+                },
+                "source_bank_account": {
+                    "type": "string"
+                },
+                "source_bank_id": {
+                    "type": "integer"
+                },
+                "transaction_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateTransferQuote": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 1099
+                },
+                "destination_bank_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "destination_currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "source_bank_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "source_currency": {
+                    "type": "string",
+                    "example": "USD"
+                }
+            }
+        },
+        "model.CreateWebhookEndpoint": {
+            "type": "object",
+            "properties": {
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeadLetterMessage": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "original_topic": {
+                    "type": "string"
+                },
+                "partition": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "replayed_at": {
+                    "type": "string"
+                },
+                "retry_attempts": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.FXRate": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "fee_bps": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "quote_currency": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.FeeRule": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "destination_bank_id": {
+                    "type": "integer"
+                },
+                "fee_type": {
+                    "type": "string"
+                },
+                "flat_amount": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "max_amount": {
+                    "type": "integer"
+                },
+                "max_fee": {
+                    "type": "integer"
+                },
+                "min_amount": {
+                    "type": "integer"
+                },
+                "min_fee": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "percentage_bps": {
+                    "type": "integer"
+                },
+                "plan": {
+                    "type": "string"
+                },
+                "source_bank_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetTransferExportByParam": {
+            "type": "object"
+        },
+        "model.LedgerAccount": {
+            "type": "object",
+            "properties": {
+                "api_key": {
+                    "type": "string"
+                },
+                "balance": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "credit": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "debit": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LedgerEntry": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "direction": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "transaction_id": {
+                    "type": "string"
+                },
+                "transfer_job_id": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LedgerInvariant": {
+            "type": "object",
+            "properties": {
+                "balanced": {
+                    "type": "boolean"
+                },
+                "credit": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "debit": {
+                    "type": "integer"
+                },
+                "transactions": {
+                    "type": "integer"
+                },
+                "unbalanced_transactions": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Pagination": {
+            "type": "object",
+            "properties": {
+                "current_elements": {
+                    "type": "integer"
+                },
+                "current_page": {
+                    "type": "integer"
+                },
+                "estimated_total": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "sort_by": {
+                    "type": "string"
+                },
+                "total_elements": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ProviderCallbackAck": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "job_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ReconciliationDiscrepancy": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "job_id": {
+                    "type": "string"
+                },
+                "local_amount": {
+                    "type": "integer"
+                },
+                "local_currency": {
+                    "type": "string"
+                },
+                "local_status": {
+                    "type": "string"
+                },
+                "provider_amount": {
+                    "type": "integer"
+                },
+                "provider_currency": {
+                    "type": "string"
+                },
+                "provider_id": {
+                    "type": "string"
+                },
+                "provider_status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ReconciliationReport": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "discrepancy_count": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "job_count": {
+                    "type": "integer"
+                },
+                "matched_count": {
+                    "type": "integer"
+                },
+                "period_end": {
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "provider_count": {
+                    "type": "integer"
+                },
+                "report_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RecurringTransfer": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "jobs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TransferJobSummary"
+                    }
+                },
+                "last_run_at": {
+                    "type": "string"
+                },
+                "mandate_id": {
+                    "type": "string"
+                },
+                "max_occurrences": {
+                    "type": "integer"
+                },
+                "next_run_at": {
+                    "type": "string"
+                },
+                "occurrences": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "type": "string"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Register": {
+            "type": "object",
+            "properties": {
+                "confirm_password": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Role": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "scope": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.SettlementRow": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "api_key": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "day": {
+                    "type": "string"
+                },
+                "fee": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.TransferBatch": {
+            "type": "object",
+            "properties": {
+                "accepted_rows": {
+                    "type": "integer"
+                },
+                "batch_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TransferBatchError"
+                    }
+                },
+                "jobs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TransferJobSummary"
+                    }
+                },
+                "rejected_rows": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_summary": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "total_rows": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.TransferBatchError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.TransferConversion": {
+            "type": "object",
+            "properties": {
+                "destination_amount": {
+                    "type": "integer"
+                },
+                "destination_currency": {
+                    "type": "string"
+                },
+                "fee_amount": {
+                    "type": "integer"
+                },
+                "quote_id": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "string"
+                },
+                "source_amount": {
+                    "type": "integer"
+                },
+                "source_currency": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TransferExport": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "download_url": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "export_id": {
+                    "type": "string"
+                },
+                "filter": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "format": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "row_count": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.TransferJob": {
+            "type": "object",
+            "properties": {
+                "api_key": {
+                    "type": "string"
+                },
+                "attempt_count": {
+                    "type": "integer"
+                },
+                "conversion": {
+                    "$ref": "#/definitions/model.TransferConversion"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "fee_amount": {
+                    "type": "integer"
+                },
+                "fee_rule_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "job_id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string"
+                },
+                "scheduled_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.TransferJobEvent": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "job_id": {
+                    "type": "string"
+                },
+                "provider_response": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TransferJobSummary": {
+            "type": "object",
+            "properties": {
+                "job_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TransferLimitUsage": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.TransferQuote": {
+            "type": "object",
+            "properties": {
+                "destination_amount": {
+                    "type": "integer"
+                },
+                "destination_currency": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "fee_amount": {
+                    "type": "integer"
+                },
+                "quote_id": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "string"
+                },
+                "source_amount": {
+                    "type": "integer"
+                },
+                "source_currency": {
+                    "type": "string"
+                },
+                "total_amount": {
+                    "type": "integer"
+                },
+                "transfer_fee_amount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.TransferStreamEvent": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "job_id": {
+                    "type": "string"
+                },
+                "occurred_at": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.UpdateAccountData": {
+            "type": "object"
+        },
+        "model.UpdatePasswordData": {
+            "type": "object",
+            "properties": {
+                "confirm_password": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.UpdateRole": {
+            "type": "object"
+        },
+        "model.UpdateTransfer": {
+            "type": "object"
+        },
+        "model.UpsertAccountLimit": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "daily_amount": {
+                    "type": "integer",
+                    "example": 20000000000
+                },
+                "daily_count": {
+                    "type": "integer",
+                    "example": 100
                 },
-                "bank_id": {
+                "max_per_transaction": {
+                    "type": "integer",
+                    "example": 5000000000
+                }
+            }
+        },
+        "model.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                },
+                "webhook_endpoint_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.WebhookEndpoint": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.AccountLimitsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AccountLimit"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.AccountRolesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AccountRole"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.AccountsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Account"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.DeadLetterMessagesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DeadLetterMessage"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.EmptyResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.FXRatesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FXRate"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.FeeRulesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FeeRule"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.GetBankAccountResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/clientresponse.BankAccount"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
                     "type": "integer"
                 },
-                "id": {
-                    "type": "string"
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
                 }
             }
         },
-        "model.Account": {
+        "response.LedgerAccountsResponse": {
             "type": "object",
             "properties": {
-                "api_key": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LedgerAccount"
+                    }
                 },
-                "created_at": {
+                "message": {
                     "type": "string"
                 },
-                "created_by": {
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
                     "type": "integer"
                 },
-                "deleted_at": {
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.LedgerBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LedgerAccount"
+                    }
+                },
+                "message": {
                     "type": "string"
                 },
-                "deleted_by": {
+                "status_code": {
                     "type": "integer"
                 },
-                "email": {
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.LedgerEntriesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LedgerEntry"
+                    }
+                },
+                "message": {
                     "type": "string"
                 },
-                "id": {
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
                 },
-                "updated_at": {
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.LedgerInvariantResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LedgerInvariant"
+                    }
+                },
+                "message": {
                     "type": "string"
                 },
-                "updated_by": {
+                "status_code": {
                     "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
                 }
             }
         },
-        "model.AccountRole": {
+        "response.LoginResponse": {
             "type": "object",
             "properties": {
-                "account_id": {
-                    "type": "integer"
+                "access_token": {
+                    "type": "string"
                 },
-                "created_at": {
+                "exp": {
                     "type": "string"
                 },
-                "created_by": {
-                    "type": "integer"
+                "message": {
+                    "type": "string"
                 },
-                "deleted_at": {
+                "scope": {
                     "type": "string"
                 },
-                "deleted_by": {
+                "status_code": {
                     "type": "integer"
                 },
-                "id": {
-                    "type": "integer"
+                "token_type": {
+                    "type": "string"
                 },
-                "role_id": {
-                    "type": "integer"
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
                 },
-                "updated_at": {
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.ProviderCallbackResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.ProviderCallbackAck"
+                },
+                "message": {
                     "type": "string"
                 },
-                "updated_by": {
+                "status_code": {
                     "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
                 }
             }
         },
-        "model.CreateAccountRole": {
+        "response.ReconciliationDiscrepanciesResponse": {
             "type": "object",
             "properties": {
-                "account_id": {
-                    "type": "integer"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ReconciliationDiscrepancy"
+                    }
                 },
-                "role_id": {
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
                     "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
                 }
             }
         },
-        "model.CreateRole": {
+        "response.ReconciliationReportsResponse": {
             "type": "object",
             "properties": {
-                "client_id": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ReconciliationReport"
+                    }
                 },
-                "client_secret": {
+                "message": {
                     "type": "string"
                 },
-                "scope": {
-                    "type": "string"
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
                 }
             }
         },
-        "model.CreateTransfer": {
+        "response.RecurringTransfersResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.RecurringTransfer"
+                    }
                 },
-                "destination_bank_account": {
+                "message": {
                     "type": "string"
                 },
-                "destination_bank_id": {
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
                     "type": "integer"
                 },
-                "source_bank_account": {
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.RegisterResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.Account"
+                },
+                "message": {
                     "type": "string"
                 },
-                "source_bank_id": {
+                "status_code": {
                     "type": "integer"
                 },
-                "transaction_time": {
-                    "type": "string"
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
                 }
             }
         },
-        "model.Pagination": {
+        "response.RolesResponse": {
             "type": "object",
             "properties": {
-                "current_elements": {
-                    "type": "integer"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Role"
+                    }
                 },
-                "current_page": {
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/model.Pagination"
+                },
+                "status_code": {
                     "type": "integer"
                 },
-                "sort_by": {
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.SettlementReportResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SettlementRow"
+                    }
+                },
+                "message": {
                     "type": "string"
                 },
-                "total_elements": {
+                "status_code": {
                     "type": "integer"
                 },
-                "total_pages": {
-                    "type": "integer"
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
                 }
             }
         },
-        "model.Register": {
+        "response.SingleAccountLimitResponse": {
             "type": "object",
             "properties": {
-                "confirm_password": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/model.AccountLimit"
                 },
-                "email": {
+                "message": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "status_code": {
+                    "type": "integer"
                 },
-                "password": {
-                    "type": "string"
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
                 }
             }
         },
-        "model.Role": {
+        "response.SingleAccountResponse": {
             "type": "object",
             "properties": {
-                "client_id": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "created_by": {
-                    "type": "integer"
+                "data": {
+                    "$ref": "#/definitions/model.Account"
                 },
-                "deleted_at": {
+                "message": {
                     "type": "string"
                 },
-                "deleted_by": {
-                    "type": "integer"
-                },
-                "id": {
+                "status_code": {
                     "type": "integer"
                 },
-                "scope": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
                 },
-                "updated_by": {
-                    "type": "integer"
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
                 }
             }
         },
-        "model.TransferJob": {
+        "response.SingleAccountRoleResponse": {
             "type": "object",
             "properties": {
-                "api_key": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "created_by": {
-                    "type": "integer"
+                "data": {
+                    "$ref": "#/definitions/model.AccountRole"
                 },
-                "deleted_at": {
+                "message": {
                     "type": "string"
                 },
-                "deleted_by": {
-                    "type": "integer"
-                },
-                "id": {
+                "status_code": {
                     "type": "integer"
                 },
-                "job_id": {
-                    "type": "string"
-                },
-                "payload": {
-                    "type": "string"
-                },
-                "status": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
                 },
-                "updated_by": {
-                    "type": "integer"
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
                 }
             }
         },
-        "model.UpdateAccountData": {
+        "response.SingleDeadLetterMessageResponse": {
             "type": "object",
             "properties": {
-                "name": {
+                "data": {
+                    "$ref": "#/definitions/model.DeadLetterMessage"
+                },
+                "message": {
                     "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
                 }
             }
         },
-        "model.UpdatePasswordData": {
+        "response.SingleFXRateResponse": {
             "type": "object",
             "properties": {
-                "confirm_password": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/model.FXRate"
                 },
-                "password": {
+                "message": {
                     "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
                 }
             }
         },
-        "model.UpdateRole": {
-            "type": "object"
-        },
-        "response.AccountRolesResponse": {
+        "response.SingleFeeRuleResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.AccountRole"
-                    }
+                    "$ref": "#/definitions/model.FeeRule"
                 },
                 "message": {
                     "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/model.Pagination"
-                },
                 "status_code": {
                     "type": "integer"
                 },
@@ -1712,21 +6962,15 @@ const docTemplate = `{
                 }
             }
         },
-        "response.AccountsResponse": {
+        "response.SingleLedgerAccountResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Account"
-                    }
+                    "$ref": "#/definitions/model.LedgerAccount"
                 },
                 "message": {
                     "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/model.Pagination"
-                },
                 "status_code": {
                     "type": "integer"
                 },
@@ -1738,9 +6982,12 @@ const docTemplate = `{
                 }
             }
         },
-        "response.EmptyResponse": {
+        "response.SingleReconciliationReportResponse": {
             "type": "object",
             "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.ReconciliationReport"
+                },
                 "message": {
                     "type": "string"
                 },
@@ -1755,18 +7002,15 @@ const docTemplate = `{
                 }
             }
         },
-        "response.GetBankAccountResponse": {
+        "response.SingleRecurringTransferResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/clientresponse.BankAccount"
+                    "$ref": "#/definitions/model.RecurringTransfer"
                 },
                 "message": {
                     "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/model.Pagination"
-                },
                 "status_code": {
                     "type": "integer"
                 },
@@ -1778,27 +7022,18 @@ const docTemplate = `{
                 }
             }
         },
-        "response.LoginResponse": {
+        "response.SingleRoleResponse": {
             "type": "object",
             "properties": {
-                "access_token": {
-                    "type": "string"
-                },
-                "exp": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/model.Role"
                 },
                 "message": {
                     "type": "string"
                 },
-                "scope": {
-                    "type": "string"
-                },
                 "status_code": {
                     "type": "integer"
                 },
-                "token_type": {
-                    "type": "string"
-                },
                 "transaction_info": {
                     "$ref": "#/definitions/response.TransactionInfo"
                 },
@@ -1807,11 +7042,11 @@ const docTemplate = `{
                 }
             }
         },
-        "response.RegisterResponse": {
+        "response.SingleTransferBatchResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/model.Account"
+                    "$ref": "#/definitions/model.TransferBatch"
                 },
                 "message": {
                     "type": "string"
@@ -1827,21 +7062,15 @@ const docTemplate = `{
                 }
             }
         },
-        "response.RolesResponse": {
+        "response.SingleTransferExportResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Role"
-                    }
+                    "$ref": "#/definitions/model.TransferExport"
                 },
                 "message": {
                     "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/model.Pagination"
-                },
                 "status_code": {
                     "type": "integer"
                 },
@@ -1853,11 +7082,11 @@ const docTemplate = `{
                 }
             }
         },
-        "response.SingleAccountResponse": {
+        "response.SingleTransferJobResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/model.Account"
+                    "$ref": "#/definitions/model.TransferJob"
                 },
                 "message": {
                     "type": "string"
@@ -1873,11 +7102,11 @@ const docTemplate = `{
                 }
             }
         },
-        "response.SingleAccountRoleResponse": {
+        "response.SingleTransferQuoteResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/model.AccountRole"
+                    "$ref": "#/definitions/model.TransferQuote"
                 },
                 "message": {
                     "type": "string"
@@ -1893,11 +7122,11 @@ const docTemplate = `{
                 }
             }
         },
-        "response.SingleRoleResponse": {
+        "response.SingleWebhookDeliveryResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/model.Role"
+                    "$ref": "#/definitions/model.WebhookDelivery"
                 },
                 "message": {
                     "type": "string"
@@ -1913,11 +7142,11 @@ const docTemplate = `{
                 }
             }
         },
-        "response.SingleTransferJobResponse": {
+        "response.SingleWebhookEndpointResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/model.TransferJob"
+                    "$ref": "#/definitions/model.WebhookEndpoint"
                 },
                 "message": {
                     "type": "string"
@@ -1956,6 +7185,29 @@ const docTemplate = `{
                 }
             }
         },
+        "response.TransferJobEventsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TransferJobEvent"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
         "response.TransferJobsResponse": {
             "type": "object",
             "properties": {
@@ -1982,6 +7234,26 @@ const docTemplate = `{
                 }
             }
         },
+        "response.TransferStreamEventResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.TransferStreamEvent"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
         "response.Translation": {
             "type": "object",
             "properties": {
@@ -1989,6 +7261,52 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "response.WebhookDeliveriesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WebhookDelivery"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
+        },
+        "response.WebhookEndpointsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WebhookEndpoint"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "transaction_info": {
+                    "$ref": "#/definitions/response.TransactionInfo"
+                },
+                "translation": {
+                    "$ref": "#/definitions/response.Translation"
+                }
+            }
         }
     },
     "securityDefinitions": {
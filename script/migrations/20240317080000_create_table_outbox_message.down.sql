@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS outbox_messages;
+DROP SEQUENCE IF EXISTS outbox_message_id_seq;
+DROP TYPE outboxstatus;
\ No newline at end of file
@@ -0,0 +1,23 @@
+CREATE SEQUENCE outbox_message_id_seq;
+CREATE TYPE outboxstatus AS ENUM ('pending', 'sent');
+
+CREATE TABLE IF NOT EXISTS outbox_messages (
+  id integer primary key DEFAULT nextval('outbox_message_id_seq'),
+  topic varchar(255) NOT NULL,
+  message_key text NOT NULL,
+  payload jsonb NOT NULL,
+  status outboxstatus NOT NULL DEFAULT 'pending',
+  attempts integer default 0 NOT NULL,
+  last_error text,
+  sent_at timestamp WITH TIME ZONE,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE outbox_message_id_seq OWNED BY outbox_messages.id;
+
+CREATE INDEX IF NOT EXISTS outbox_messages_status_idx ON outbox_messages (status, id);
@@ -0,0 +1,7 @@
+UPDATE transfer_jobs SET status = 'pending' WHERE status = 'processing';
+ALTER TYPE transferstatus RENAME TO transferstatus_old;
+CREATE TYPE transferstatus AS ENUM ('failed', 'pending', 'success');
+ALTER TABLE transfer_jobs ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE transfer_jobs ALTER COLUMN status TYPE transferstatus USING status::text::transferstatus;
+ALTER TABLE transfer_jobs ALTER COLUMN status SET DEFAULT 'pending';
+DROP TYPE transferstatus_old;
@@ -0,0 +1 @@
+ALTER TYPE transferstatus ADD VALUE IF NOT EXISTS 'processing' AFTER 'pending';
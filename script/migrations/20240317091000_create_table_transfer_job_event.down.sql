@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS transfer_job_events;
+DROP SEQUENCE IF EXISTS transfer_job_event_id_seq;
\ No newline at end of file
@@ -0,0 +1,23 @@
+CREATE SEQUENCE transfer_job_event_id_seq;
+
+CREATE TABLE IF NOT EXISTS transfer_job_events (
+  id integer primary key DEFAULT nextval('transfer_job_event_id_seq'),
+  transfer_job_id integer NOT NULL,
+  from_status transferstatus,
+  to_status transferstatus NOT NULL,
+  reason text NOT NULL,
+  actor varchar(100) NOT NULL,
+  provider_response jsonb,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE transfer_job_event_id_seq OWNED BY transfer_job_events.id;
+
+ALTER TABLE "transfer_job_events" ADD CONSTRAINT fk_transfer_job_events_tj_key FOREIGN KEY("transfer_job_id") REFERENCES "transfer_jobs" ("id") ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS transfer_job_events_transfer_job_id_idx ON transfer_job_events (transfer_job_id, id);
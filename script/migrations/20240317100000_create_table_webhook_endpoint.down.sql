@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS webhook_endpoints;
+DROP SEQUENCE IF EXISTS webhook_endpoint_id_seq;
\ No newline at end of file
@@ -0,0 +1,21 @@
+CREATE SEQUENCE webhook_endpoint_id_seq;
+
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+  id integer primary key DEFAULT nextval('webhook_endpoint_id_seq'),
+  account_id integer NOT NULL,
+  url text NOT NULL,
+  secret text NOT NULL,
+  is_active boolean NOT NULL DEFAULT true,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE webhook_endpoint_id_seq OWNED BY webhook_endpoints.id;
+
+ALTER TABLE "webhook_endpoints" ADD CONSTRAINT fk_webhook_endpoints_acc_key FOREIGN KEY("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS webhook_endpoints_account_id_idx ON webhook_endpoints (account_id);
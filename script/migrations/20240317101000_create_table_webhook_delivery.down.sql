@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP SEQUENCE IF EXISTS webhook_delivery_id_seq;
+DROP TYPE webhookdeliverystatus;
\ No newline at end of file
@@ -0,0 +1,30 @@
+CREATE SEQUENCE webhook_delivery_id_seq;
+CREATE TYPE webhookdeliverystatus AS ENUM ('failed', 'pending', 'success');
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id integer primary key DEFAULT nextval('webhook_delivery_id_seq'),
+  webhook_endpoint_id integer NOT NULL,
+  transfer_job_event_id integer NOT NULL,
+  event_type varchar(100) NOT NULL,
+  payload jsonb NOT NULL,
+  status webhookdeliverystatus NOT NULL DEFAULT 'pending',
+  attempts integer NOT NULL DEFAULT 0,
+  next_attempt_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  response_code integer,
+  last_error text,
+  delivered_at timestamp WITH TIME ZONE,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE webhook_delivery_id_seq OWNED BY webhook_deliveries.id;
+
+ALTER TABLE "webhook_deliveries" ADD CONSTRAINT fk_webhook_deliveries_we_key FOREIGN KEY("webhook_endpoint_id") REFERENCES "webhook_endpoints" ("id") ON DELETE CASCADE;
+ALTER TABLE "webhook_deliveries" ADD CONSTRAINT fk_webhook_deliveries_tje_key FOREIGN KEY("transfer_job_event_id") REFERENCES "transfer_job_events" ("id") ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_status_idx ON webhook_deliveries (status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_endpoint_id_idx ON webhook_deliveries (webhook_endpoint_id, id);
@@ -0,0 +1,3 @@
+ALTER TABLE transfer_jobs DROP COLUMN IF EXISTS transfer_batch_id;
+DROP TABLE IF EXISTS transfer_batches;
+DROP SEQUENCE IF EXISTS transfer_batch_id_seq;
\ No newline at end of file
@@ -0,0 +1,25 @@
+CREATE SEQUENCE transfer_batch_id_seq;
+
+CREATE TABLE IF NOT EXISTS transfer_batches (
+  id integer primary key DEFAULT nextval('transfer_batch_id_seq'),
+  batch_id varchar(30) NOT NULL UNIQUE,
+  api_key text NOT NULL,
+  total_rows integer NOT NULL DEFAULT 0,
+  accepted_rows integer NOT NULL DEFAULT 0,
+  rejected_rows integer NOT NULL DEFAULT 0,
+  errors jsonb NOT NULL DEFAULT '[]',
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE transfer_batch_id_seq OWNED BY transfer_batches.id;
+
+ALTER TABLE transfer_jobs ADD COLUMN transfer_batch_id integer;
+
+ALTER TABLE "transfer_jobs" ADD CONSTRAINT fk_transfer_jobs_tb_key FOREIGN KEY("transfer_batch_id") REFERENCES "transfer_batches" ("id") ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS transfer_jobs_transfer_batch_id_idx ON transfer_jobs (transfer_batch_id);
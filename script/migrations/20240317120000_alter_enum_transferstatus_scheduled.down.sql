@@ -0,0 +1,11 @@
+UPDATE transfer_jobs SET status = 'failed' WHERE status IN ('scheduled', 'cancelled');
+UPDATE transfer_job_events SET from_status = 'failed' WHERE from_status IN ('scheduled', 'cancelled');
+UPDATE transfer_job_events SET to_status = 'failed' WHERE to_status IN ('scheduled', 'cancelled');
+ALTER TYPE transferstatus RENAME TO transferstatus_old;
+CREATE TYPE transferstatus AS ENUM ('failed', 'pending', 'processing', 'success');
+ALTER TABLE transfer_jobs ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE transfer_jobs ALTER COLUMN status TYPE transferstatus USING status::text::transferstatus;
+ALTER TABLE transfer_jobs ALTER COLUMN status SET DEFAULT 'pending';
+ALTER TABLE transfer_job_events ALTER COLUMN from_status TYPE transferstatus USING from_status::text::transferstatus;
+ALTER TABLE transfer_job_events ALTER COLUMN to_status TYPE transferstatus USING to_status::text::transferstatus;
+DROP TYPE transferstatus_old;
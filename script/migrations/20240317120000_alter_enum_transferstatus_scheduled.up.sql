@@ -0,0 +1,2 @@
+ALTER TYPE transferstatus ADD VALUE IF NOT EXISTS 'scheduled' AFTER 'processing';
+ALTER TYPE transferstatus ADD VALUE IF NOT EXISTS 'cancelled' BEFORE 'failed';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS transfer_jobs_status_scheduled_at_idx;
+ALTER TABLE transfer_jobs DROP COLUMN IF EXISTS scheduled_at;
\ No newline at end of file
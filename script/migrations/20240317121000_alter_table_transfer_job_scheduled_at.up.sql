@@ -0,0 +1,3 @@
+ALTER TABLE transfer_jobs ADD COLUMN scheduled_at timestamp WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS transfer_jobs_status_scheduled_at_idx ON transfer_jobs (status, scheduled_at);
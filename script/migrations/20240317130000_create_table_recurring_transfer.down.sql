@@ -0,0 +1,4 @@
+ALTER TABLE transfer_jobs DROP COLUMN IF EXISTS recurring_transfer_id;
+DROP TABLE IF EXISTS recurring_transfers;
+DROP SEQUENCE IF EXISTS recurring_transfer_id_seq;
+DROP TYPE recurringstatus;
\ No newline at end of file
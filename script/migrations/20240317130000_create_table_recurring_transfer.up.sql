@@ -0,0 +1,33 @@
+CREATE SEQUENCE recurring_transfer_id_seq;
+CREATE TYPE recurringstatus AS ENUM ('active', 'completed', 'paused');
+
+CREATE TABLE IF NOT EXISTS recurring_transfers (
+  id integer primary key DEFAULT nextval('recurring_transfer_id_seq'),
+  mandate_id varchar(30) NOT NULL UNIQUE,
+  api_key text NOT NULL,
+  payload jsonb NOT NULL,
+  schedule varchar(100) NOT NULL,
+  start_at timestamp WITH TIME ZONE NOT NULL,
+  end_at timestamp WITH TIME ZONE,
+  max_occurrences integer,
+  occurrences integer NOT NULL DEFAULT 0,
+  next_run_at timestamp WITH TIME ZONE,
+  last_run_at timestamp WITH TIME ZONE,
+  status recurringstatus NOT NULL DEFAULT 'active',
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE recurring_transfer_id_seq OWNED BY recurring_transfers.id;
+
+CREATE INDEX IF NOT EXISTS recurring_transfers_status_next_run_at_idx ON recurring_transfers (status, next_run_at);
+
+ALTER TABLE transfer_jobs ADD COLUMN recurring_transfer_id integer;
+
+ALTER TABLE "transfer_jobs" ADD CONSTRAINT fk_transfer_jobs_rt_key FOREIGN KEY("recurring_transfer_id") REFERENCES "recurring_transfers" ("id") ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS transfer_jobs_recurring_transfer_id_idx ON transfer_jobs (recurring_transfer_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS transfer_jobs_status_next_attempt_at_idx;
+ALTER TABLE transfer_jobs DROP COLUMN IF EXISTS last_error;
+ALTER TABLE transfer_jobs DROP COLUMN IF EXISTS next_attempt_at;
+ALTER TABLE transfer_jobs DROP COLUMN IF EXISTS attempt_count;
@@ -0,0 +1,5 @@
+ALTER TABLE transfer_jobs ADD COLUMN attempt_count integer NOT NULL DEFAULT 0;
+ALTER TABLE transfer_jobs ADD COLUMN next_attempt_at timestamp WITH TIME ZONE;
+ALTER TABLE transfer_jobs ADD COLUMN last_error text;
+
+CREATE INDEX IF NOT EXISTS transfer_jobs_status_next_attempt_at_idx ON transfer_jobs (status, next_attempt_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS dead_letter_messages;
+DROP SEQUENCE IF EXISTS dead_letter_message_id_seq;
+DROP TYPE deadletterstatus;
@@ -0,0 +1,28 @@
+CREATE SEQUENCE dead_letter_message_id_seq;
+CREATE TYPE deadletterstatus AS ENUM ('pending', 'replayed');
+
+CREATE TABLE IF NOT EXISTS dead_letter_messages (
+  id integer primary key DEFAULT nextval('dead_letter_message_id_seq'),
+  topic varchar(255) NOT NULL,
+  partition integer NOT NULL,
+  message_offset bigint NOT NULL,
+  original_topic varchar(255) NOT NULL,
+  message_key text NOT NULL,
+  payload text NOT NULL,
+  headers jsonb NOT NULL DEFAULT '{}',
+  error text,
+  retry_attempts integer default 0 NOT NULL,
+  status deadletterstatus NOT NULL DEFAULT 'pending',
+  replayed_at timestamp WITH TIME ZONE,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE,
+  UNIQUE (topic, partition, message_offset)
+);
+
+ALTER SEQUENCE dead_letter_message_id_seq OWNED BY dead_letter_messages.id;
+
+CREATE INDEX IF NOT EXISTS dead_letter_messages_status_idx ON dead_letter_messages (status, id);
@@ -0,0 +1,11 @@
+UPDATE outbox_messages
+SET payload = jsonb_set(payload - 'currency', '{amount}', to_jsonb((payload->>'amount')::numeric / 100))
+WHERE status = 'pending' AND payload ? 'currency';
+
+UPDATE recurring_transfers
+SET payload = jsonb_set(payload - 'currency', '{amount}', to_jsonb((payload->>'amount')::numeric / 100))
+WHERE payload ? 'currency';
+
+UPDATE transfer_jobs
+SET payload = jsonb_set(payload - 'currency', '{amount}', to_jsonb((payload->>'amount')::numeric / 100))
+WHERE payload ? 'currency';
@@ -0,0 +1,12 @@
+-- amounts used to be stored as a decimal of major units, rewrite them as minor units of IDR
+UPDATE transfer_jobs
+SET payload = jsonb_set(payload, '{amount}', to_jsonb(round((payload->>'amount')::numeric * 100)::bigint)) || '{"currency": "IDR"}'::jsonb
+WHERE payload ? 'amount' AND NOT payload ? 'currency';
+
+UPDATE recurring_transfers
+SET payload = jsonb_set(payload, '{amount}', to_jsonb(round((payload->>'amount')::numeric * 100)::bigint)) || '{"currency": "IDR"}'::jsonb
+WHERE payload ? 'amount' AND NOT payload ? 'currency';
+
+UPDATE outbox_messages
+SET payload = jsonb_set(payload, '{amount}', to_jsonb(round((payload->>'amount')::numeric * 100)::bigint)) || '{"currency": "IDR"}'::jsonb
+WHERE status = 'pending' AND payload ? 'amount' AND NOT payload ? 'currency';
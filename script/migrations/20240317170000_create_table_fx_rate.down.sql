@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS fx_rates;
+DROP SEQUENCE IF EXISTS fx_rate_id_seq;
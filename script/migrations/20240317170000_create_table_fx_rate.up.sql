@@ -0,0 +1,19 @@
+CREATE SEQUENCE fx_rate_id_seq;
+
+CREATE TABLE IF NOT EXISTS fx_rates (
+  id integer primary key DEFAULT nextval('fx_rate_id_seq'),
+  base_currency varchar(3) NOT NULL,
+  quote_currency varchar(3) NOT NULL,
+  rate numeric(24,12) NOT NULL,
+  fee_bps integer NOT NULL DEFAULT 0,
+  source varchar(10) NOT NULL,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE,
+  UNIQUE (base_currency, quote_currency)
+);
+
+ALTER SEQUENCE fx_rate_id_seq OWNED BY fx_rates.id;
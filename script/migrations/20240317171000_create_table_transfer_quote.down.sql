@@ -0,0 +1,3 @@
+ALTER TABLE transfer_jobs DROP COLUMN IF EXISTS conversion;
+DROP TABLE IF EXISTS transfer_quotes;
+DROP SEQUENCE IF EXISTS transfer_quote_id_seq;
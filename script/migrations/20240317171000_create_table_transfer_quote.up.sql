@@ -0,0 +1,27 @@
+CREATE SEQUENCE transfer_quote_id_seq;
+
+CREATE TABLE IF NOT EXISTS transfer_quotes (
+  id integer primary key DEFAULT nextval('transfer_quote_id_seq'),
+  quote_id varchar(30) NOT NULL UNIQUE,
+  api_key text NOT NULL,
+  source_currency varchar(3) NOT NULL,
+  destination_currency varchar(3) NOT NULL,
+  source_amount bigint NOT NULL,
+  destination_amount bigint NOT NULL,
+  rate numeric(24,12) NOT NULL,
+  fee_amount bigint NOT NULL DEFAULT 0,
+  expires_at timestamp WITH TIME ZONE NOT NULL,
+  transfer_job_id integer,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE transfer_quote_id_seq OWNED BY transfer_quotes.id;
+
+ALTER TABLE "transfer_quotes" ADD CONSTRAINT fk_transfer_quotes_tj_key FOREIGN KEY("transfer_job_id") REFERENCES "transfer_jobs" ("id") ON DELETE SET NULL;
+
+ALTER TABLE transfer_jobs ADD COLUMN conversion jsonb;
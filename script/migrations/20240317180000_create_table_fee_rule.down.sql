@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS fee_rules;
+DROP SEQUENCE IF EXISTS fee_rule_id_seq;
+DROP TYPE feetype;
@@ -0,0 +1,28 @@
+CREATE SEQUENCE fee_rule_id_seq;
+CREATE TYPE feetype AS ENUM ('flat', 'percentage', 'capped');
+
+CREATE TABLE IF NOT EXISTS fee_rules (
+  id integer primary key DEFAULT nextval('fee_rule_id_seq'),
+  name varchar(255) NOT NULL,
+  source_bank_id integer,
+  destination_bank_id integer,
+  plan varchar(30),
+  currency varchar(3) NOT NULL,
+  min_amount bigint NOT NULL DEFAULT 0,
+  max_amount bigint,
+  fee_type feetype NOT NULL,
+  flat_amount bigint NOT NULL DEFAULT 0,
+  percentage_bps integer NOT NULL DEFAULT 0,
+  min_fee bigint,
+  max_fee bigint,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE fee_rule_id_seq OWNED BY fee_rules.id;
+
+CREATE INDEX IF NOT EXISTS fee_rules_currency_min_amount_idx ON fee_rules (currency, min_amount);
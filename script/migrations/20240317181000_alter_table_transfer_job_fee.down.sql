@@ -0,0 +1,5 @@
+ALTER TABLE transfer_jobs DROP CONSTRAINT IF EXISTS fk_transfer_jobs_fr_key;
+ALTER TABLE transfer_jobs DROP COLUMN IF EXISTS fee_rule_id;
+ALTER TABLE transfer_jobs DROP COLUMN IF EXISTS fee_amount;
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS plan;
@@ -0,0 +1,6 @@
+ALTER TABLE accounts ADD COLUMN plan varchar(30) NOT NULL DEFAULT 'standard';
+
+ALTER TABLE transfer_jobs ADD COLUMN fee_amount bigint NOT NULL DEFAULT 0;
+ALTER TABLE transfer_jobs ADD COLUMN fee_rule_id integer;
+
+ALTER TABLE "transfer_jobs" ADD CONSTRAINT fk_transfer_jobs_fr_key FOREIGN KEY("fee_rule_id") REFERENCES "fee_rules" ("id") ON DELETE SET NULL;
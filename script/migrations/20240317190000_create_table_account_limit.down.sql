@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS transfer_jobs_api_key_created_at_idx;
+DROP TABLE IF EXISTS account_limits;
+DROP SEQUENCE IF EXISTS account_limit_id_seq;
@@ -0,0 +1,24 @@
+CREATE SEQUENCE account_limit_id_seq;
+
+CREATE TABLE IF NOT EXISTS account_limits (
+  id integer primary key DEFAULT nextval('account_limit_id_seq'),
+  account_id integer NOT NULL,
+  currency varchar(3) NOT NULL,
+  max_per_transaction bigint NOT NULL DEFAULT 0,
+  daily_amount bigint NOT NULL DEFAULT 0,
+  daily_count integer NOT NULL DEFAULT 0,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE,
+  UNIQUE (account_id, currency)
+);
+
+ALTER SEQUENCE account_limit_id_seq OWNED BY account_limits.id;
+
+ALTER TABLE "account_limits" ADD CONSTRAINT fk_account_limits_acc_key FOREIGN KEY("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+-- the daily usage of an account is rebuilt from its jobs when the redis counter is missing
+CREATE INDEX IF NOT EXISTS transfer_jobs_api_key_created_at_idx ON transfer_jobs (api_key, created_at);
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS ledger_entries;
+DROP SEQUENCE IF EXISTS ledger_entry_id_seq;
+DROP TABLE IF EXISTS ledger_transactions;
+DROP SEQUENCE IF EXISTS ledger_transaction_id_seq;
+DROP TABLE IF EXISTS ledger_accounts;
+DROP SEQUENCE IF EXISTS ledger_account_id_seq;
+DROP TYPE IF EXISTS ledgerdirection;
+DROP TYPE IF EXISTS ledgertransactiontype;
+DROP TYPE IF EXISTS ledgeraccountkind;
@@ -0,0 +1,54 @@
+CREATE TYPE ledgeraccountkind AS ENUM ('merchant', 'clearing', 'settlement', 'fee_revenue');
+CREATE TYPE ledgertransactiontype AS ENUM ('transfer', 'fee', 'settlement', 'reversal', 'refund');
+CREATE TYPE ledgerdirection AS ENUM ('debit', 'credit');
+
+CREATE SEQUENCE ledger_account_id_seq;
+
+-- system accounts are kept per currency with an empty api_key, merchant accounts per api_key and currency
+CREATE TABLE IF NOT EXISTS ledger_accounts (
+  id integer primary key DEFAULT nextval('ledger_account_id_seq'),
+  kind ledgeraccountkind NOT NULL,
+  api_key text NOT NULL DEFAULT '',
+  currency varchar(3) NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  UNIQUE (kind, api_key, currency)
+);
+
+ALTER SEQUENCE ledger_account_id_seq OWNED BY ledger_accounts.id;
+
+CREATE SEQUENCE ledger_transaction_id_seq;
+
+CREATE TABLE IF NOT EXISTS ledger_transactions (
+  id integer primary key DEFAULT nextval('ledger_transaction_id_seq'),
+  transaction_id varchar(30) NOT NULL UNIQUE,
+  type ledgertransactiontype NOT NULL,
+  transfer_job_id integer,
+  currency varchar(3) NOT NULL,
+  description text NOT NULL DEFAULT '',
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  UNIQUE (transfer_job_id, type)
+);
+
+ALTER SEQUENCE ledger_transaction_id_seq OWNED BY ledger_transactions.id;
+
+ALTER TABLE "ledger_transactions" ADD CONSTRAINT fk_ledger_transactions_tj_key FOREIGN KEY("transfer_job_id") REFERENCES "transfer_jobs" ("id") ON DELETE RESTRICT;
+
+CREATE SEQUENCE ledger_entry_id_seq;
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+  id integer primary key DEFAULT nextval('ledger_entry_id_seq'),
+  ledger_transaction_id integer NOT NULL,
+  ledger_account_id integer NOT NULL,
+  direction ledgerdirection NOT NULL,
+  amount bigint NOT NULL CHECK (amount > 0),
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER SEQUENCE ledger_entry_id_seq OWNED BY ledger_entries.id;
+
+ALTER TABLE "ledger_entries" ADD CONSTRAINT fk_ledger_entries_lt_key FOREIGN KEY("ledger_transaction_id") REFERENCES "ledger_transactions" ("id") ON DELETE RESTRICT;
+ALTER TABLE "ledger_entries" ADD CONSTRAINT fk_ledger_entries_la_key FOREIGN KEY("ledger_account_id") REFERENCES "ledger_accounts" ("id") ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS ledger_entries_ledger_account_id_idx ON ledger_entries (ledger_account_id);
+CREATE INDEX IF NOT EXISTS ledger_entries_ledger_transaction_id_idx ON ledger_entries (ledger_transaction_id);
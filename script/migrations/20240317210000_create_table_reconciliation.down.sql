@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS transfer_jobs_provider_id_idx;
+DROP TABLE IF EXISTS reconciliation_discrepancies;
+DROP SEQUENCE IF EXISTS reconciliation_discrepancy_id_seq;
+DROP TABLE IF EXISTS reconciliation_reports;
+DROP SEQUENCE IF EXISTS reconciliation_report_id_seq;
+DROP TYPE IF EXISTS discrepancytype;
+DROP TYPE IF EXISTS reconciliationstatus;
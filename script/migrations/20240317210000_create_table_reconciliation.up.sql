@@ -0,0 +1,56 @@
+CREATE TYPE reconciliationstatus AS ENUM ('running', 'completed', 'failed');
+CREATE TYPE discrepancytype AS ENUM ('missing_on_provider', 'missing_locally', 'amount_mismatch', 'status_mismatch');
+
+CREATE SEQUENCE reconciliation_report_id_seq;
+
+CREATE TABLE IF NOT EXISTS reconciliation_reports (
+  id integer primary key DEFAULT nextval('reconciliation_report_id_seq'),
+  report_id varchar(30) NOT NULL UNIQUE,
+  period_start timestamp WITH TIME ZONE NOT NULL,
+  period_end timestamp WITH TIME ZONE NOT NULL,
+  status reconciliationstatus NOT NULL DEFAULT 'running',
+  provider_count integer NOT NULL DEFAULT 0,
+  job_count integer NOT NULL DEFAULT 0,
+  matched_count integer NOT NULL DEFAULT 0,
+  discrepancy_count integer NOT NULL DEFAULT 0,
+  error text,
+  finished_at timestamp WITH TIME ZONE,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE reconciliation_report_id_seq OWNED BY reconciliation_reports.id;
+
+CREATE INDEX IF NOT EXISTS reconciliation_reports_period_idx ON reconciliation_reports (period_start, period_end);
+
+CREATE SEQUENCE reconciliation_discrepancy_id_seq;
+
+CREATE TABLE IF NOT EXISTS reconciliation_discrepancies (
+  id integer primary key DEFAULT nextval('reconciliation_discrepancy_id_seq'),
+  reconciliation_report_id integer NOT NULL,
+  type discrepancytype NOT NULL,
+  transfer_job_id integer,
+  job_id varchar(30),
+  provider_id text NOT NULL DEFAULT '',
+  local_amount bigint,
+  provider_amount bigint,
+  local_currency varchar(3),
+  provider_currency varchar(3),
+  local_status varchar(30),
+  provider_status varchar(30),
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER SEQUENCE reconciliation_discrepancy_id_seq OWNED BY reconciliation_discrepancies.id;
+
+ALTER TABLE "reconciliation_discrepancies" ADD CONSTRAINT fk_reconciliation_discrepancies_rr_key FOREIGN KEY("reconciliation_report_id") REFERENCES "reconciliation_reports" ("id") ON DELETE CASCADE;
+ALTER TABLE "reconciliation_discrepancies" ADD CONSTRAINT fk_reconciliation_discrepancies_tj_key FOREIGN KEY("transfer_job_id") REFERENCES "transfer_jobs" ("id") ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS reconciliation_discrepancies_report_idx ON reconciliation_discrepancies (reconciliation_report_id);
+
+-- provider records are matched to jobs by the provider id stored in the payload once the job is submitted
+CREATE INDEX IF NOT EXISTS transfer_jobs_provider_id_idx ON transfer_jobs ((payload->>'id'));
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS transfer_jobs_status_lease_expires_at_idx;
+ALTER TABLE transfer_jobs DROP COLUMN IF EXISTS lease_expires_at;
+ALTER TABLE transfer_jobs DROP COLUMN IF EXISTS lease_owner;
@@ -0,0 +1,4 @@
+ALTER TABLE transfer_jobs ADD COLUMN lease_owner text;
+ALTER TABLE transfer_jobs ADD COLUMN lease_expires_at timestamp WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS transfer_jobs_status_lease_expires_at_idx ON transfer_jobs (status, lease_expires_at);
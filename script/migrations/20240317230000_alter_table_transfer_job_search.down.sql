@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS transfer_jobs_updated_at_idx;
+DROP INDEX IF EXISTS transfer_jobs_created_at_idx;
+DROP INDEX IF EXISTS transfer_jobs_amount_idx;
+DROP INDEX IF EXISTS transfer_jobs_payload_idx;
+DROP INDEX IF EXISTS transfer_jobs_api_key_reference_idx;
+ALTER TABLE transfer_jobs DROP COLUMN IF EXISTS reference;
@@ -0,0 +1,13 @@
+ALTER TABLE transfer_jobs ADD COLUMN reference text;
+
+-- merchants look their transfers up by the reference they gave
+CREATE INDEX IF NOT EXISTS transfer_jobs_api_key_reference_idx ON transfer_jobs (api_key, reference);
+
+-- bank id and bank account filters are containment queries on the payload
+CREATE INDEX IF NOT EXISTS transfer_jobs_payload_idx ON transfer_jobs USING GIN (payload jsonb_path_ops);
+
+-- amount ranges compare the payload amount as a number
+CREATE INDEX IF NOT EXISTS transfer_jobs_amount_idx ON transfer_jobs (((payload->>'amount')::bigint));
+
+CREATE INDEX IF NOT EXISTS transfer_jobs_created_at_idx ON transfer_jobs (created_at);
+CREATE INDEX IF NOT EXISTS transfer_jobs_updated_at_idx ON transfer_jobs (updated_at);
@@ -0,0 +1,6 @@
+CREATE INDEX IF NOT EXISTS transfer_jobs_created_at_idx ON transfer_jobs (created_at);
+
+DROP INDEX IF EXISTS account_roles_created_at_id_idx;
+DROP INDEX IF EXISTS roles_created_at_id_idx;
+DROP INDEX IF EXISTS accounts_created_at_id_idx;
+DROP INDEX IF EXISTS transfer_jobs_created_at_id_idx;
@@ -0,0 +1,7 @@
+-- lists are walked by (created_at, id), the composite indexes serve both directions
+CREATE INDEX IF NOT EXISTS transfer_jobs_created_at_id_idx ON transfer_jobs (created_at, id);
+CREATE INDEX IF NOT EXISTS accounts_created_at_id_idx ON accounts (created_at, id);
+CREATE INDEX IF NOT EXISTS roles_created_at_id_idx ON roles (created_at, id);
+CREATE INDEX IF NOT EXISTS account_roles_created_at_id_idx ON account_roles (created_at, id);
+
+DROP INDEX IF EXISTS transfer_jobs_created_at_idx;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS transfer_exports;
+DROP SEQUENCE IF EXISTS transfer_export_id_seq;
+DROP TYPE IF EXISTS transferexportstatus;
@@ -0,0 +1,24 @@
+CREATE TYPE transferexportstatus AS ENUM ('running', 'completed', 'failed');
+
+CREATE SEQUENCE transfer_export_id_seq;
+
+CREATE TABLE IF NOT EXISTS transfer_exports (
+  id integer primary key DEFAULT nextval('transfer_export_id_seq'),
+  export_id varchar(30) NOT NULL UNIQUE,
+  format varchar(10) NOT NULL,
+  columns text NOT NULL,
+  filter jsonb NOT NULL DEFAULT '{}',
+  status transferexportstatus NOT NULL DEFAULT 'running',
+  row_count integer NOT NULL DEFAULT 0,
+  file_name text NOT NULL DEFAULT '',
+  error text,
+  finished_at timestamp WITH TIME ZONE,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE transfer_export_id_seq OWNED BY transfer_exports.id;
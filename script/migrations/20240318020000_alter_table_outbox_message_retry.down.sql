@@ -0,0 +1,9 @@
+ALTER TABLE outbox_messages DROP COLUMN IF EXISTS next_attempt_at;
+
+UPDATE outbox_messages SET status = 'pending' WHERE status = 'parked';
+ALTER TYPE outboxstatus RENAME TO outboxstatus_old;
+CREATE TYPE outboxstatus AS ENUM ('pending', 'sent');
+ALTER TABLE outbox_messages ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE outbox_messages ALTER COLUMN status TYPE outboxstatus USING status::text::outboxstatus;
+ALTER TABLE outbox_messages ALTER COLUMN status SET DEFAULT 'pending';
+DROP TYPE outboxstatus_old;
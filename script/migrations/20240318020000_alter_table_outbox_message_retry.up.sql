@@ -0,0 +1,3 @@
+ALTER TYPE outboxstatus ADD VALUE IF NOT EXISTS 'parked' AFTER 'sent';
+
+ALTER TABLE outbox_messages ADD COLUMN IF NOT EXISTS next_attempt_at timestamp WITH TIME ZONE;
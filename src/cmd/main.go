@@ -173,6 +173,7 @@ func main() {
 			err := consumer.New(&consumer.Consumer{
 				Conf:     cfg.Consumer,
 				Consumer: kafkaConsumer,
+				Producer: kafkaProducer,
 				Usecase:  *uc,
 			})
 			if err != nil {
@@ -2,11 +2,14 @@ package clientresponse
 
 type Transfer struct {
 	ID                     string `json:"id"`
-	Amount                 int    `json:"amount"`
+	Amount                 int64  `json:"amount"`
+	Currency               string `json:"currency"`
 	Status                 string `json:"status"`
 	TransactionDate        string `json:"transaction_date"`
 	SourceBankAccount      string `json:"source_bank_account"`
 	DestinationBankAccount string `json:"destination_bank_account"`
 	SourceBankID           int    `json:"source_bank_id"`
 	DestinationBankID      int    `json:"destination_bank_id"`
+	DestinationAmount      int64  `json:"destination_amount,omitempty"`
+	DestinationCurrency    string `json:"destination_currency,omitempty"`
 }
@@ -0,0 +1,1508 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AccountLimit is an object representing the database table.
+type AccountLimit struct {
+	ID                int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	AccountID         int       `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	Currency          string    `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	MaxPerTransaction int64     `boil:"max_per_transaction" json:"max_per_transaction" toml:"max_per_transaction" yaml:"max_per_transaction"`
+	DailyAmount       int64     `boil:"daily_amount" json:"daily_amount" toml:"daily_amount" yaml:"daily_amount"`
+	DailyCount        int       `boil:"daily_count" json:"daily_count" toml:"daily_count" yaml:"daily_count"`
+	CreatedBy         int       `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt         time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy         int       `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt         time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy         null.Int  `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt         null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *accountLimitR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L accountLimitL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AccountLimitColumns = struct {
+	ID                string
+	AccountID         string
+	Currency          string
+	MaxPerTransaction string
+	DailyAmount       string
+	DailyCount        string
+	CreatedBy         string
+	CreatedAt         string
+	UpdatedBy         string
+	UpdatedAt         string
+	DeletedBy         string
+	DeletedAt         string
+}{
+	ID:                "id",
+	AccountID:         "account_id",
+	Currency:          "currency",
+	MaxPerTransaction: "max_per_transaction",
+	DailyAmount:       "daily_amount",
+	DailyCount:        "daily_count",
+	CreatedBy:         "created_by",
+	CreatedAt:         "created_at",
+	UpdatedBy:         "updated_by",
+	UpdatedAt:         "updated_at",
+	DeletedBy:         "deleted_by",
+	DeletedAt:         "deleted_at",
+}
+
+var AccountLimitTableColumns = struct {
+	ID                string
+	AccountID         string
+	Currency          string
+	MaxPerTransaction string
+	DailyAmount       string
+	DailyCount        string
+	CreatedBy         string
+	CreatedAt         string
+	UpdatedBy         string
+	UpdatedAt         string
+	DeletedBy         string
+	DeletedAt         string
+}{
+	ID:                "account_limits.id",
+	AccountID:         "account_limits.account_id",
+	Currency:          "account_limits.currency",
+	MaxPerTransaction: "account_limits.max_per_transaction",
+	DailyAmount:       "account_limits.daily_amount",
+	DailyCount:        "account_limits.daily_count",
+	CreatedBy:         "account_limits.created_by",
+	CreatedAt:         "account_limits.created_at",
+	UpdatedBy:         "account_limits.updated_by",
+	UpdatedAt:         "account_limits.updated_at",
+	DeletedBy:         "account_limits.deleted_by",
+	DeletedAt:         "account_limits.deleted_at",
+}
+
+// Generated where
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var AccountLimitWhere = struct {
+	ID                whereHelperint
+	AccountID         whereHelperint
+	Currency          whereHelperstring
+	MaxPerTransaction whereHelperint64
+	DailyAmount       whereHelperint64
+	DailyCount        whereHelperint
+	CreatedBy         whereHelperint
+	CreatedAt         whereHelpertime_Time
+	UpdatedBy         whereHelperint
+	UpdatedAt         whereHelpertime_Time
+	DeletedBy         whereHelpernull_Int
+	DeletedAt         whereHelpernull_Time
+}{
+	ID:                whereHelperint{field: "\"account_limits\".\"id\""},
+	AccountID:         whereHelperint{field: "\"account_limits\".\"account_id\""},
+	Currency:          whereHelperstring{field: "\"account_limits\".\"currency\""},
+	MaxPerTransaction: whereHelperint64{field: "\"account_limits\".\"max_per_transaction\""},
+	DailyAmount:       whereHelperint64{field: "\"account_limits\".\"daily_amount\""},
+	DailyCount:        whereHelperint{field: "\"account_limits\".\"daily_count\""},
+	CreatedBy:         whereHelperint{field: "\"account_limits\".\"created_by\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"account_limits\".\"created_at\""},
+	UpdatedBy:         whereHelperint{field: "\"account_limits\".\"updated_by\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"account_limits\".\"updated_at\""},
+	DeletedBy:         whereHelpernull_Int{field: "\"account_limits\".\"deleted_by\""},
+	DeletedAt:         whereHelpernull_Time{field: "\"account_limits\".\"deleted_at\""},
+}
+
+// AccountLimitRels is where relationship names are stored.
+var AccountLimitRels = struct {
+	Account string
+}{
+	Account: "Account",
+}
+
+// accountLimitR is where relationships are stored.
+type accountLimitR struct {
+	Account *Account `boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
+}
+
+// NewStruct creates a new relationship struct
+func (*accountLimitR) NewStruct() *accountLimitR {
+	return &accountLimitR{}
+}
+
+func (r *accountLimitR) GetAccount() *Account {
+	if r == nil {
+		return nil
+	}
+	return r.Account
+}
+
+// accountLimitL is where Load methods for each relationship are stored.
+type accountLimitL struct{}
+
+var (
+	accountLimitAllColumns            = []string{"id", "account_id", "currency", "max_per_transaction", "daily_amount", "daily_count", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	accountLimitColumnsWithoutDefault = []string{"account_id", "currency"}
+	accountLimitColumnsWithDefault    = []string{"id", "max_per_transaction", "daily_amount", "daily_count", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	accountLimitPrimaryKeyColumns     = []string{"id"}
+	accountLimitGeneratedColumns      = []string{}
+)
+
+type (
+	// AccountLimitSlice is an alias for a slice of pointers to AccountLimit.
+	// This should almost always be used instead of []AccountLimit.
+	AccountLimitSlice []*AccountLimit
+	// AccountLimitHook is the signature for custom AccountLimit hook methods
+	AccountLimitHook func(context.Context, boil.ContextExecutor, *AccountLimit) error
+
+	accountLimitQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	accountLimitType                 = reflect.TypeOf(&AccountLimit{})
+	accountLimitMapping              = queries.MakeStructMapping(accountLimitType)
+	accountLimitPrimaryKeyMapping, _ = queries.BindMapping(accountLimitType, accountLimitMapping, accountLimitPrimaryKeyColumns)
+	accountLimitInsertCacheMut       sync.RWMutex
+	accountLimitInsertCache          = make(map[string]insertCache)
+	accountLimitUpdateCacheMut       sync.RWMutex
+	accountLimitUpdateCache          = make(map[string]updateCache)
+	accountLimitUpsertCacheMut       sync.RWMutex
+	accountLimitUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var accountLimitAfterSelectMu sync.Mutex
+var accountLimitAfterSelectHooks []AccountLimitHook
+
+var accountLimitBeforeInsertMu sync.Mutex
+var accountLimitBeforeInsertHooks []AccountLimitHook
+var accountLimitAfterInsertMu sync.Mutex
+var accountLimitAfterInsertHooks []AccountLimitHook
+
+var accountLimitBeforeUpdateMu sync.Mutex
+var accountLimitBeforeUpdateHooks []AccountLimitHook
+var accountLimitAfterUpdateMu sync.Mutex
+var accountLimitAfterUpdateHooks []AccountLimitHook
+
+var accountLimitBeforeDeleteMu sync.Mutex
+var accountLimitBeforeDeleteHooks []AccountLimitHook
+var accountLimitAfterDeleteMu sync.Mutex
+var accountLimitAfterDeleteHooks []AccountLimitHook
+
+var accountLimitBeforeUpsertMu sync.Mutex
+var accountLimitBeforeUpsertHooks []AccountLimitHook
+var accountLimitAfterUpsertMu sync.Mutex
+var accountLimitAfterUpsertHooks []AccountLimitHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AccountLimit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountLimitAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AccountLimit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountLimitBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AccountLimit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountLimitAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AccountLimit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountLimitBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AccountLimit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountLimitAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AccountLimit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountLimitBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AccountLimit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountLimitAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AccountLimit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountLimitBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AccountLimit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountLimitAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAccountLimitHook registers your hook function for all future operations.
+func AddAccountLimitHook(hookPoint boil.HookPoint, accountLimitHook AccountLimitHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		accountLimitAfterSelectMu.Lock()
+		accountLimitAfterSelectHooks = append(accountLimitAfterSelectHooks, accountLimitHook)
+		accountLimitAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		accountLimitBeforeInsertMu.Lock()
+		accountLimitBeforeInsertHooks = append(accountLimitBeforeInsertHooks, accountLimitHook)
+		accountLimitBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		accountLimitAfterInsertMu.Lock()
+		accountLimitAfterInsertHooks = append(accountLimitAfterInsertHooks, accountLimitHook)
+		accountLimitAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		accountLimitBeforeUpdateMu.Lock()
+		accountLimitBeforeUpdateHooks = append(accountLimitBeforeUpdateHooks, accountLimitHook)
+		accountLimitBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		accountLimitAfterUpdateMu.Lock()
+		accountLimitAfterUpdateHooks = append(accountLimitAfterUpdateHooks, accountLimitHook)
+		accountLimitAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		accountLimitBeforeDeleteMu.Lock()
+		accountLimitBeforeDeleteHooks = append(accountLimitBeforeDeleteHooks, accountLimitHook)
+		accountLimitBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		accountLimitAfterDeleteMu.Lock()
+		accountLimitAfterDeleteHooks = append(accountLimitAfterDeleteHooks, accountLimitHook)
+		accountLimitAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		accountLimitBeforeUpsertMu.Lock()
+		accountLimitBeforeUpsertHooks = append(accountLimitBeforeUpsertHooks, accountLimitHook)
+		accountLimitBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		accountLimitAfterUpsertMu.Lock()
+		accountLimitAfterUpsertHooks = append(accountLimitAfterUpsertHooks, accountLimitHook)
+		accountLimitAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single accountLimit record from the query using the global executor.
+func (q accountLimitQuery) OneG(ctx context.Context) (*AccountLimit, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single accountLimit record from the query.
+func (q accountLimitQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AccountLimit, error) {
+	o := &AccountLimit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for account_limits")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all AccountLimit records from the query using the global executor.
+func (q accountLimitQuery) AllG(ctx context.Context) (AccountLimitSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all AccountLimit records from the query.
+func (q accountLimitQuery) All(ctx context.Context, exec boil.ContextExecutor) (AccountLimitSlice, error) {
+	var o []*AccountLimit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to AccountLimit slice")
+	}
+
+	if len(accountLimitAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AccountLimit records in the query using the global executor
+func (q accountLimitQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all AccountLimit records in the query.
+func (q accountLimitQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count account_limits rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q accountLimitQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q accountLimitQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if account_limits exists")
+	}
+
+	return count > 0, nil
+}
+
+// Account pointed to by the foreign key.
+func (o *AccountLimit) Account(mods ...qm.QueryMod) accountQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AccountID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Accounts(queryMods...)
+}
+
+// LoadAccount allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (accountLimitL) LoadAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccountLimit interface{}, mods queries.Applicator) error {
+	var slice []*AccountLimit
+	var object *AccountLimit
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccountLimit.(*AccountLimit)
+		if !ok {
+			object = new(AccountLimit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccountLimit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccountLimit))
+			}
+		}
+	} else {
+		s, ok := maybeAccountLimit.(*[]*AccountLimit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccountLimit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccountLimit))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &accountLimitR{}
+		}
+		args[object.AccountID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountLimitR{}
+			}
+
+			args[obj.AccountID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`accounts`),
+		qm.WhereIn(`accounts.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`accounts.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Account")
+	}
+
+	var resultSlice []*Account
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Account")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for accounts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for accounts")
+	}
+
+	if len(accountAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Account = foreign
+		if foreign.R == nil {
+			foreign.R = &accountR{}
+		}
+		foreign.R.AccountLimits = append(foreign.R.AccountLimits, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AccountID == foreign.ID {
+				local.R.Account = foreign
+				if foreign.R == nil {
+					foreign.R = &accountR{}
+				}
+				foreign.R.AccountLimits = append(foreign.R.AccountLimits, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAccountG of the accountLimit to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.AccountLimits.
+// Uses the global database handle.
+func (o *AccountLimit) SetAccountG(ctx context.Context, insert bool, related *Account) error {
+	return o.SetAccount(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetAccount of the accountLimit to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.AccountLimits.
+func (o *AccountLimit) SetAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Account) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"account_limits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+		strmangle.WhereClause("\"", "\"", 2, accountLimitPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AccountID = related.ID
+	if o.R == nil {
+		o.R = &accountLimitR{
+			Account: related,
+		}
+	} else {
+		o.R.Account = related
+	}
+
+	if related.R == nil {
+		related.R = &accountR{
+			AccountLimits: AccountLimitSlice{o},
+		}
+	} else {
+		related.R.AccountLimits = append(related.R.AccountLimits, o)
+	}
+
+	return nil
+}
+
+// AccountLimits retrieves all the records using an executor.
+func AccountLimits(mods ...qm.QueryMod) accountLimitQuery {
+	mods = append(mods, qm.From("\"account_limits\""), qmhelper.WhereIsNull("\"account_limits\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"account_limits\".*"})
+	}
+
+	return accountLimitQuery{q}
+}
+
+// FindAccountLimitG retrieves a single record by ID.
+func FindAccountLimitG(ctx context.Context, iD int, selectCols ...string) (*AccountLimit, error) {
+	return FindAccountLimit(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindAccountLimit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAccountLimit(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*AccountLimit, error) {
+	accountLimitObj := &AccountLimit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"account_limits\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, accountLimitObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from account_limits")
+	}
+
+	if err = accountLimitObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return accountLimitObj, err
+	}
+
+	return accountLimitObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AccountLimit) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AccountLimit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no account_limits provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(accountLimitColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	accountLimitInsertCacheMut.RLock()
+	cache, cached := accountLimitInsertCache[key]
+	accountLimitInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			accountLimitAllColumns,
+			accountLimitColumnsWithDefault,
+			accountLimitColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(accountLimitType, accountLimitMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(accountLimitType, accountLimitMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"account_limits\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"account_limits\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into account_limits")
+	}
+
+	if !cached {
+		accountLimitInsertCacheMut.Lock()
+		accountLimitInsertCache[key] = cache
+		accountLimitInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single AccountLimit record using the global executor.
+// See Update for more documentation.
+func (o *AccountLimit) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the AccountLimit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AccountLimit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	accountLimitUpdateCacheMut.RLock()
+	cache, cached := accountLimitUpdateCache[key]
+	accountLimitUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			accountLimitAllColumns,
+			accountLimitPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update account_limits, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"account_limits\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, accountLimitPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(accountLimitType, accountLimitMapping, append(wl, accountLimitPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update account_limits row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for account_limits")
+	}
+
+	if !cached {
+		accountLimitUpdateCacheMut.Lock()
+		accountLimitUpdateCache[key] = cache
+		accountLimitUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q accountLimitQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q accountLimitQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for account_limits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for account_limits")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AccountLimitSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AccountLimitSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"account_limits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, accountLimitPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in accountLimit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all accountLimit")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AccountLimit) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AccountLimit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no account_limits provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(accountLimitColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	accountLimitUpsertCacheMut.RLock()
+	cache, cached := accountLimitUpsertCache[key]
+	accountLimitUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			accountLimitAllColumns,
+			accountLimitColumnsWithDefault,
+			accountLimitColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			accountLimitAllColumns,
+			accountLimitPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert account_limits, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(accountLimitAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(accountLimitPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert account_limits, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(accountLimitPrimaryKeyColumns))
+			copy(conflict, accountLimitPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"account_limits\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(accountLimitType, accountLimitMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(accountLimitType, accountLimitMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert account_limits")
+	}
+
+	if !cached {
+		accountLimitUpsertCacheMut.Lock()
+		accountLimitUpsertCache[key] = cache
+		accountLimitUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single AccountLimit record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AccountLimit) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single AccountLimit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AccountLimit) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no AccountLimit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), accountLimitPrimaryKeyMapping)
+		sql = "DELETE FROM \"account_limits\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"account_limits\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(accountLimitType, accountLimitMapping, append(wl, accountLimitPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from account_limits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for account_limits")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q accountLimitQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q accountLimitQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no accountLimitQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from account_limits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for account_limits")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AccountLimitSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AccountLimitSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(accountLimitBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountLimitPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"account_limits\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, accountLimitPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountLimitPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"account_limits\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, accountLimitPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from accountLimit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for account_limits")
+	}
+
+	if len(accountLimitAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AccountLimit) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no AccountLimit provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AccountLimit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAccountLimit(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AccountLimitSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty AccountLimitSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AccountLimitSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AccountLimitSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"account_limits\".* FROM \"account_limits\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, accountLimitPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in AccountLimitSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AccountLimitExistsG checks if the AccountLimit row exists.
+func AccountLimitExistsG(ctx context.Context, iD int) (bool, error) {
+	return AccountLimitExists(ctx, boil.GetContextDB(), iD)
+}
+
+// AccountLimitExists checks if the AccountLimit row exists.
+func AccountLimitExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"account_limits\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if account_limits exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AccountLimit row exists.
+func (o *AccountLimit) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AccountLimitExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,952 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAccountLimits(t *testing.T) {
+	t.Parallel()
+
+	query := AccountLimits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAccountLimitsSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAccountLimitsQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AccountLimits().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAccountLimitsSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AccountLimitSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAccountLimitsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAccountLimitsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AccountLimits().DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAccountLimitsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AccountLimitSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAccountLimitsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AccountLimitExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if AccountLimit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AccountLimitExists to return true, but got false.")
+	}
+}
+
+func testAccountLimitsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	accountLimitFound, err := FindAccountLimit(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if accountLimitFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAccountLimitsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AccountLimits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAccountLimitsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AccountLimits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAccountLimitsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	accountLimitOne := &AccountLimit{}
+	accountLimitTwo := &AccountLimit{}
+	if err = randomize.Struct(seed, accountLimitOne, accountLimitDBTypes, false, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, accountLimitTwo, accountLimitDBTypes, false, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = accountLimitOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = accountLimitTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AccountLimits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAccountLimitsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	accountLimitOne := &AccountLimit{}
+	accountLimitTwo := &AccountLimit{}
+	if err = randomize.Struct(seed, accountLimitOne, accountLimitDBTypes, false, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, accountLimitTwo, accountLimitDBTypes, false, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = accountLimitOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = accountLimitTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func accountLimitBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *AccountLimit) error {
+	*o = AccountLimit{}
+	return nil
+}
+
+func accountLimitAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *AccountLimit) error {
+	*o = AccountLimit{}
+	return nil
+}
+
+func accountLimitAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *AccountLimit) error {
+	*o = AccountLimit{}
+	return nil
+}
+
+func accountLimitBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AccountLimit) error {
+	*o = AccountLimit{}
+	return nil
+}
+
+func accountLimitAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AccountLimit) error {
+	*o = AccountLimit{}
+	return nil
+}
+
+func accountLimitBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AccountLimit) error {
+	*o = AccountLimit{}
+	return nil
+}
+
+func accountLimitAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AccountLimit) error {
+	*o = AccountLimit{}
+	return nil
+}
+
+func accountLimitBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AccountLimit) error {
+	*o = AccountLimit{}
+	return nil
+}
+
+func accountLimitAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AccountLimit) error {
+	*o = AccountLimit{}
+	return nil
+}
+
+func testAccountLimitsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &AccountLimit{}
+	o := &AccountLimit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize AccountLimit object: %s", err)
+	}
+
+	AddAccountLimitHook(boil.BeforeInsertHook, accountLimitBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	accountLimitBeforeInsertHooks = []AccountLimitHook{}
+
+	AddAccountLimitHook(boil.AfterInsertHook, accountLimitAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	accountLimitAfterInsertHooks = []AccountLimitHook{}
+
+	AddAccountLimitHook(boil.AfterSelectHook, accountLimitAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	accountLimitAfterSelectHooks = []AccountLimitHook{}
+
+	AddAccountLimitHook(boil.BeforeUpdateHook, accountLimitBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	accountLimitBeforeUpdateHooks = []AccountLimitHook{}
+
+	AddAccountLimitHook(boil.AfterUpdateHook, accountLimitAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	accountLimitAfterUpdateHooks = []AccountLimitHook{}
+
+	AddAccountLimitHook(boil.BeforeDeleteHook, accountLimitBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	accountLimitBeforeDeleteHooks = []AccountLimitHook{}
+
+	AddAccountLimitHook(boil.AfterDeleteHook, accountLimitAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	accountLimitAfterDeleteHooks = []AccountLimitHook{}
+
+	AddAccountLimitHook(boil.BeforeUpsertHook, accountLimitBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	accountLimitBeforeUpsertHooks = []AccountLimitHook{}
+
+	AddAccountLimitHook(boil.AfterUpsertHook, accountLimitAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	accountLimitAfterUpsertHooks = []AccountLimitHook{}
+}
+
+func testAccountLimitsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAccountLimitsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(accountLimitColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAccountLimitToOneAccountUsingAccount(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AccountLimit
+	var foreign Account
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, accountLimitDBTypes, false, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, accountDBTypes, false, accountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Account struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.AccountID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Account().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddAccountHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Account) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := AccountLimitSlice{&local}
+	if err = local.L.LoadAccount(ctx, tx, false, (*[]*AccountLimit)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Account == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Account = nil
+	if err = local.L.LoadAccount(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Account == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testAccountLimitToOneSetOpAccountUsingAccount(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AccountLimit
+	var b, c Account
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, accountLimitDBTypes, false, strmangle.SetComplement(accountLimitPrimaryKeyColumns, accountLimitColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Account{&b, &c} {
+		err = a.SetAccount(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Account != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AccountLimits[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.AccountID != x.ID {
+			t.Error("foreign key was wrong value", a.AccountID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AccountID))
+		reflect.Indirect(reflect.ValueOf(&a.AccountID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.AccountID != x.ID {
+			t.Error("foreign key was wrong value", a.AccountID, x.ID)
+		}
+	}
+}
+
+func testAccountLimitsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAccountLimitsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AccountLimitSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAccountLimitsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AccountLimits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	accountLimitDBTypes = map[string]string{`ID`: `integer`, `AccountID`: `integer`, `Currency`: `character varying`, `MaxPerTransaction`: `bigint`, `DailyAmount`: `bigint`, `DailyCount`: `integer`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	_                   = bytes.MinRead
+)
+
+func testAccountLimitsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(accountLimitPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(accountLimitAllColumns) == len(accountLimitPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAccountLimitsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(accountLimitAllColumns) == len(accountLimitPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AccountLimit{}
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, accountLimitDBTypes, true, accountLimitPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(accountLimitAllColumns, accountLimitPrimaryKeyColumns) {
+		fields = accountLimitAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			accountLimitAllColumns,
+			accountLimitPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AccountLimitSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAccountLimitsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(accountLimitAllColumns) == len(accountLimitPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AccountLimit{}
+	if err = randomize.Struct(seed, &o, accountLimitDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AccountLimit: %s", err)
+	}
+
+	count, err := AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, accountLimitDBTypes, false, accountLimitPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AccountLimit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AccountLimit: %s", err)
+	}
+
+	count, err = AccountLimits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -35,6 +35,7 @@ type Account struct {
 	UpdatedAt time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	DeletedBy null.Int    `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
 	DeletedAt null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	Plan      string      `boil:"plan" json:"plan" toml:"plan" yaml:"plan"`
 
 	R *accountR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L accountL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -52,6 +53,7 @@ var AccountColumns = struct {
 	UpdatedAt string
 	DeletedBy string
 	DeletedAt string
+	Plan      string
 }{
 	ID:        "id",
 	APIKey:    "api_key",
@@ -64,6 +66,7 @@ var AccountColumns = struct {
 	UpdatedAt: "updated_at",
 	DeletedBy: "deleted_by",
 	DeletedAt: "deleted_at",
+	Plan:      "plan",
 }
 
 var AccountTableColumns = struct {
@@ -78,6 +81,7 @@ var AccountTableColumns = struct {
 	UpdatedAt string
 	DeletedBy string
 	DeletedAt string
+	Plan      string
 }{
 	ID:        "accounts.id",
 	APIKey:    "accounts.api_key",
@@ -90,6 +94,7 @@ var AccountTableColumns = struct {
 	UpdatedAt: "accounts.updated_at",
 	DeletedBy: "accounts.deleted_by",
 	DeletedAt: "accounts.deleted_at",
+	Plan:      "accounts.plan",
 }
 
 // Generated where
@@ -144,33 +149,6 @@ func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 var AccountWhere = struct {
 	ID        whereHelperint
 	APIKey    whereHelpernull_String
@@ -183,6 +161,7 @@ var AccountWhere = struct {
 	UpdatedAt whereHelpertime_Time
 	DeletedBy whereHelpernull_Int
 	DeletedAt whereHelpernull_Time
+	Plan      whereHelperstring
 }{
 	ID:        whereHelperint{field: "\"accounts\".\"id\""},
 	APIKey:    whereHelpernull_String{field: "\"accounts\".\"api_key\""},
@@ -195,18 +174,25 @@ var AccountWhere = struct {
 	UpdatedAt: whereHelpertime_Time{field: "\"accounts\".\"updated_at\""},
 	DeletedBy: whereHelpernull_Int{field: "\"accounts\".\"deleted_by\""},
 	DeletedAt: whereHelpernull_Time{field: "\"accounts\".\"deleted_at\""},
+	Plan:      whereHelperstring{field: "\"accounts\".\"plan\""},
 }
 
 // AccountRels is where relationship names are stored.
 var AccountRels = struct {
-	AccountRoles string
+	AccountLimits    string
+	AccountRoles     string
+	WebhookEndpoints string
 }{
-	AccountRoles: "AccountRoles",
+	AccountLimits:    "AccountLimits",
+	AccountRoles:     "AccountRoles",
+	WebhookEndpoints: "WebhookEndpoints",
 }
 
 // accountR is where relationships are stored.
 type accountR struct {
-	AccountRoles AccountRoleSlice `boil:"AccountRoles" json:"AccountRoles" toml:"AccountRoles" yaml:"AccountRoles"`
+	AccountLimits    AccountLimitSlice    `boil:"AccountLimits" json:"AccountLimits" toml:"AccountLimits" yaml:"AccountLimits"`
+	AccountRoles     AccountRoleSlice     `boil:"AccountRoles" json:"AccountRoles" toml:"AccountRoles" yaml:"AccountRoles"`
+	WebhookEndpoints WebhookEndpointSlice `boil:"WebhookEndpoints" json:"WebhookEndpoints" toml:"WebhookEndpoints" yaml:"WebhookEndpoints"`
 }
 
 // NewStruct creates a new relationship struct
@@ -214,6 +200,13 @@ func (*accountR) NewStruct() *accountR {
 	return &accountR{}
 }
 
+func (r *accountR) GetAccountLimits() AccountLimitSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AccountLimits
+}
+
 func (r *accountR) GetAccountRoles() AccountRoleSlice {
 	if r == nil {
 		return nil
@@ -221,13 +214,20 @@ func (r *accountR) GetAccountRoles() AccountRoleSlice {
 	return r.AccountRoles
 }
 
+func (r *accountR) GetWebhookEndpoints() WebhookEndpointSlice {
+	if r == nil {
+		return nil
+	}
+	return r.WebhookEndpoints
+}
+
 // accountL is where Load methods for each relationship are stored.
 type accountL struct{}
 
 var (
-	accountAllColumns            = []string{"id", "api_key", "email", "password", "name", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	accountAllColumns            = []string{"id", "api_key", "email", "password", "name", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "plan"}
 	accountColumnsWithoutDefault = []string{"email", "password", "name"}
-	accountColumnsWithDefault    = []string{"id", "api_key", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	accountColumnsWithDefault    = []string{"id", "api_key", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "plan"}
 	accountPrimaryKeyColumns     = []string{"id"}
 	accountGeneratedColumns      = []string{}
 )
@@ -557,6 +557,20 @@ func (q accountQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bo
 	return count > 0, nil
 }
 
+// AccountLimits retrieves all the account_limit's AccountLimits with an executor.
+func (o *Account) AccountLimits(mods ...qm.QueryMod) accountLimitQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"account_limits\".\"account_id\"=?", o.ID),
+	)
+
+	return AccountLimits(queryMods...)
+}
+
 // AccountRoles retrieves all the account_role's AccountRoles with an executor.
 func (o *Account) AccountRoles(mods ...qm.QueryMod) accountRoleQuery {
 	var queryMods []qm.QueryMod
@@ -571,6 +585,134 @@ func (o *Account) AccountRoles(mods ...qm.QueryMod) accountRoleQuery {
 	return AccountRoles(queryMods...)
 }
 
+// WebhookEndpoints retrieves all the webhook_endpoint's WebhookEndpoints with an executor.
+func (o *Account) WebhookEndpoints(mods ...qm.QueryMod) webhookEndpointQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhook_endpoints\".\"account_id\"=?", o.ID),
+	)
+
+	return WebhookEndpoints(queryMods...)
+}
+
+// LoadAccountLimits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadAccountLimits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccount.(*Account)
+		if !ok {
+			object = new(Account)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccount))
+			}
+		}
+	} else {
+		s, ok := maybeAccount.(*[]*Account)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`account_limits`),
+		qm.WhereIn(`account_limits.account_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`account_limits.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load account_limits")
+	}
+
+	var resultSlice []*AccountLimit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice account_limits")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on account_limits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account_limits")
+	}
+
+	if len(accountLimitAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AccountLimits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accountLimitR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.AccountLimits = append(local.R.AccountLimits, foreign)
+				if foreign.R == nil {
+					foreign.R = &accountLimitR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadAccountRoles allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (accountL) LoadAccountRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
@@ -685,6 +827,182 @@ func (accountL) LoadAccountRoles(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
+// LoadWebhookEndpoints allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadWebhookEndpoints(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccount.(*Account)
+		if !ok {
+			object = new(Account)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccount))
+			}
+		}
+	} else {
+		s, ok := maybeAccount.(*[]*Account)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webhook_endpoints`),
+		qm.WhereIn(`webhook_endpoints.account_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`webhook_endpoints.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhook_endpoints")
+	}
+
+	var resultSlice []*WebhookEndpoint
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhook_endpoints")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhook_endpoints")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook_endpoints")
+	}
+
+	if len(webhookEndpointAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebhookEndpoints = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookEndpointR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.WebhookEndpoints = append(local.R.WebhookEndpoints, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookEndpointR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddAccountLimitsG adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.AccountLimits.
+// Sets related.R.Account appropriately.
+// Uses the global database handle.
+func (o *Account) AddAccountLimitsG(ctx context.Context, insert bool, related ...*AccountLimit) error {
+	return o.AddAccountLimits(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAccountLimits adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.AccountLimits.
+// Sets related.R.Account appropriately.
+func (o *Account) AddAccountLimits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountLimit) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"account_limits\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accountLimitPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			AccountLimits: related,
+		}
+	} else {
+		o.R.AccountLimits = append(o.R.AccountLimits, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountLimitR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
 // AddAccountRolesG adds the given related objects to the existing relationships
 // of the account, optionally inserting them as new records.
 // Appends related to o.R.AccountRoles.
@@ -747,6 +1065,68 @@ func (o *Account) AddAccountRoles(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// AddWebhookEndpointsG adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.WebhookEndpoints.
+// Sets related.R.Account appropriately.
+// Uses the global database handle.
+func (o *Account) AddWebhookEndpointsG(ctx context.Context, insert bool, related ...*WebhookEndpoint) error {
+	return o.AddWebhookEndpoints(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddWebhookEndpoints adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.WebhookEndpoints.
+// Sets related.R.Account appropriately.
+func (o *Account) AddWebhookEndpoints(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebhookEndpoint) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webhook_endpoints\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webhookEndpointPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			WebhookEndpoints: related,
+		}
+	} else {
+		o.R.WebhookEndpoints = append(o.R.WebhookEndpoints, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookEndpointR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
 // Accounts retrieves all the records using an executor.
 func Accounts(mods ...qm.QueryMod) accountQuery {
 	mods = append(mods, qm.From("\"accounts\""), qmhelper.WhereIsNull("\"accounts\".\"deleted_at\""))
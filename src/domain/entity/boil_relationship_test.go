@@ -8,8 +8,22 @@ import "testing"
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("AccountLimitToAccountUsingAccount", testAccountLimitToOneAccountUsingAccount)
 	t.Run("AccountRoleToAccountUsingAccount", testAccountRoleToOneAccountUsingAccount)
 	t.Run("AccountRoleToRoleUsingRole", testAccountRoleToOneRoleUsingRole)
+	t.Run("LedgerEntryToLedgerAccountUsingLedgerAccount", testLedgerEntryToOneLedgerAccountUsingLedgerAccount)
+	t.Run("LedgerEntryToLedgerTransactionUsingLedgerTransaction", testLedgerEntryToOneLedgerTransactionUsingLedgerTransaction)
+	t.Run("LedgerTransactionToTransferJobUsingTransferJob", testLedgerTransactionToOneTransferJobUsingTransferJob)
+	t.Run("ReconciliationDiscrepancyToReconciliationReportUsingReconciliationReport", testReconciliationDiscrepancyToOneReconciliationReportUsingReconciliationReport)
+	t.Run("ReconciliationDiscrepancyToTransferJobUsingTransferJob", testReconciliationDiscrepancyToOneTransferJobUsingTransferJob)
+	t.Run("TransferJobEventToTransferJobUsingTransferJob", testTransferJobEventToOneTransferJobUsingTransferJob)
+	t.Run("TransferJobToFeeRuleUsingFeeRule", testTransferJobToOneFeeRuleUsingFeeRule)
+	t.Run("TransferJobToRecurringTransferUsingRecurringTransfer", testTransferJobToOneRecurringTransferUsingRecurringTransfer)
+	t.Run("TransferJobToTransferBatchUsingTransferBatch", testTransferJobToOneTransferBatchUsingTransferBatch)
+	t.Run("TransferQuoteToTransferJobUsingTransferJob", testTransferQuoteToOneTransferJobUsingTransferJob)
+	t.Run("WebhookDeliveryToTransferJobEventUsingTransferJobEvent", testWebhookDeliveryToOneTransferJobEventUsingTransferJobEvent)
+	t.Run("WebhookDeliveryToWebhookEndpointUsingWebhookEndpoint", testWebhookDeliveryToOneWebhookEndpointUsingWebhookEndpoint)
+	t.Run("WebhookEndpointToAccountUsingAccount", testWebhookEndpointToOneAccountUsingAccount)
 }
 
 // TestOneToOne tests cannot be run in parallel
@@ -19,20 +33,55 @@ func TestOneToOne(t *testing.T) {}
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("AccountToAccountLimits", testAccountToManyAccountLimits)
 	t.Run("AccountToAccountRoles", testAccountToManyAccountRoles)
+	t.Run("AccountToWebhookEndpoints", testAccountToManyWebhookEndpoints)
+	t.Run("FeeRuleToTransferJobs", testFeeRuleToManyTransferJobs)
+	t.Run("LedgerAccountToLedgerEntries", testLedgerAccountToManyLedgerEntries)
+	t.Run("LedgerTransactionToLedgerEntries", testLedgerTransactionToManyLedgerEntries)
+	t.Run("ReconciliationReportToReconciliationDiscrepancies", testReconciliationReportToManyReconciliationDiscrepancies)
+	t.Run("RecurringTransferToTransferJobs", testRecurringTransferToManyTransferJobs)
 	t.Run("RoleToAccountRoles", testRoleToManyAccountRoles)
+	t.Run("TransferBatchToTransferJobs", testTransferBatchToManyTransferJobs)
+	t.Run("TransferJobEventToWebhookDeliveries", testTransferJobEventToManyWebhookDeliveries)
+	t.Run("TransferJobToLedgerTransactions", testTransferJobToManyLedgerTransactions)
+	t.Run("TransferJobToReconciliationDiscrepancies", testTransferJobToManyReconciliationDiscrepancies)
+	t.Run("TransferJobToTransferJobEvents", testTransferJobToManyTransferJobEvents)
+	t.Run("TransferJobToTransferQuotes", testTransferJobToManyTransferQuotes)
+	t.Run("WebhookEndpointToWebhookDeliveries", testWebhookEndpointToManyWebhookDeliveries)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("AccountLimitToAccountUsingAccountLimits", testAccountLimitToOneSetOpAccountUsingAccount)
 	t.Run("AccountRoleToAccountUsingAccountRoles", testAccountRoleToOneSetOpAccountUsingAccount)
 	t.Run("AccountRoleToRoleUsingAccountRoles", testAccountRoleToOneSetOpRoleUsingRole)
+	t.Run("LedgerEntryToLedgerAccountUsingLedgerEntries", testLedgerEntryToOneSetOpLedgerAccountUsingLedgerAccount)
+	t.Run("LedgerEntryToLedgerTransactionUsingLedgerEntries", testLedgerEntryToOneSetOpLedgerTransactionUsingLedgerTransaction)
+	t.Run("LedgerTransactionToTransferJobUsingLedgerTransactions", testLedgerTransactionToOneSetOpTransferJobUsingTransferJob)
+	t.Run("ReconciliationDiscrepancyToReconciliationReportUsingReconciliationDiscrepancies", testReconciliationDiscrepancyToOneSetOpReconciliationReportUsingReconciliationReport)
+	t.Run("ReconciliationDiscrepancyToTransferJobUsingReconciliationDiscrepancies", testReconciliationDiscrepancyToOneSetOpTransferJobUsingTransferJob)
+	t.Run("TransferJobEventToTransferJobUsingTransferJobEvents", testTransferJobEventToOneSetOpTransferJobUsingTransferJob)
+	t.Run("TransferJobToFeeRuleUsingTransferJobs", testTransferJobToOneSetOpFeeRuleUsingFeeRule)
+	t.Run("TransferJobToRecurringTransferUsingTransferJobs", testTransferJobToOneSetOpRecurringTransferUsingRecurringTransfer)
+	t.Run("TransferJobToTransferBatchUsingTransferJobs", testTransferJobToOneSetOpTransferBatchUsingTransferBatch)
+	t.Run("TransferQuoteToTransferJobUsingTransferQuotes", testTransferQuoteToOneSetOpTransferJobUsingTransferJob)
+	t.Run("WebhookDeliveryToTransferJobEventUsingWebhookDeliveries", testWebhookDeliveryToOneSetOpTransferJobEventUsingTransferJobEvent)
+	t.Run("WebhookDeliveryToWebhookEndpointUsingWebhookDeliveries", testWebhookDeliveryToOneSetOpWebhookEndpointUsingWebhookEndpoint)
+	t.Run("WebhookEndpointToAccountUsingWebhookEndpoints", testWebhookEndpointToOneSetOpAccountUsingAccount)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("LedgerTransactionToTransferJobUsingLedgerTransactions", testLedgerTransactionToOneRemoveOpTransferJobUsingTransferJob)
+	t.Run("ReconciliationDiscrepancyToTransferJobUsingReconciliationDiscrepancies", testReconciliationDiscrepancyToOneRemoveOpTransferJobUsingTransferJob)
+	t.Run("TransferJobToFeeRuleUsingTransferJobs", testTransferJobToOneRemoveOpFeeRuleUsingFeeRule)
+	t.Run("TransferJobToRecurringTransferUsingTransferJobs", testTransferJobToOneRemoveOpRecurringTransferUsingRecurringTransfer)
+	t.Run("TransferJobToTransferBatchUsingTransferJobs", testTransferJobToOneRemoveOpTransferBatchUsingTransferBatch)
+	t.Run("TransferQuoteToTransferJobUsingTransferQuotes", testTransferQuoteToOneRemoveOpTransferJobUsingTransferJob)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
@@ -45,14 +94,42 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("AccountToAccountLimits", testAccountToManyAddOpAccountLimits)
 	t.Run("AccountToAccountRoles", testAccountToManyAddOpAccountRoles)
+	t.Run("AccountToWebhookEndpoints", testAccountToManyAddOpWebhookEndpoints)
+	t.Run("FeeRuleToTransferJobs", testFeeRuleToManyAddOpTransferJobs)
+	t.Run("LedgerAccountToLedgerEntries", testLedgerAccountToManyAddOpLedgerEntries)
+	t.Run("LedgerTransactionToLedgerEntries", testLedgerTransactionToManyAddOpLedgerEntries)
+	t.Run("ReconciliationReportToReconciliationDiscrepancies", testReconciliationReportToManyAddOpReconciliationDiscrepancies)
+	t.Run("RecurringTransferToTransferJobs", testRecurringTransferToManyAddOpTransferJobs)
 	t.Run("RoleToAccountRoles", testRoleToManyAddOpAccountRoles)
+	t.Run("TransferBatchToTransferJobs", testTransferBatchToManyAddOpTransferJobs)
+	t.Run("TransferJobEventToWebhookDeliveries", testTransferJobEventToManyAddOpWebhookDeliveries)
+	t.Run("TransferJobToLedgerTransactions", testTransferJobToManyAddOpLedgerTransactions)
+	t.Run("TransferJobToReconciliationDiscrepancies", testTransferJobToManyAddOpReconciliationDiscrepancies)
+	t.Run("TransferJobToTransferJobEvents", testTransferJobToManyAddOpTransferJobEvents)
+	t.Run("TransferJobToTransferQuotes", testTransferJobToManyAddOpTransferQuotes)
+	t.Run("WebhookEndpointToWebhookDeliveries", testWebhookEndpointToManyAddOpWebhookDeliveries)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("FeeRuleToTransferJobs", testFeeRuleToManySetOpTransferJobs)
+	t.Run("RecurringTransferToTransferJobs", testRecurringTransferToManySetOpTransferJobs)
+	t.Run("TransferBatchToTransferJobs", testTransferBatchToManySetOpTransferJobs)
+	t.Run("TransferJobToLedgerTransactions", testTransferJobToManySetOpLedgerTransactions)
+	t.Run("TransferJobToReconciliationDiscrepancies", testTransferJobToManySetOpReconciliationDiscrepancies)
+	t.Run("TransferJobToTransferQuotes", testTransferJobToManySetOpTransferQuotes)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("FeeRuleToTransferJobs", testFeeRuleToManyRemoveOpTransferJobs)
+	t.Run("RecurringTransferToTransferJobs", testRecurringTransferToManyRemoveOpTransferJobs)
+	t.Run("TransferBatchToTransferJobs", testTransferBatchToManyRemoveOpTransferJobs)
+	t.Run("TransferJobToLedgerTransactions", testTransferJobToManyRemoveOpLedgerTransactions)
+	t.Run("TransferJobToReconciliationDiscrepancies", testTransferJobToManyRemoveOpReconciliationDiscrepancies)
+	t.Run("TransferJobToTransferQuotes", testTransferJobToManyRemoveOpTransferQuotes)
+}
@@ -12,163 +12,508 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimits)
 	t.Run("AccountRoles", testAccountRoles)
 	t.Run("Accounts", testAccounts)
+	t.Run("DeadLetterMessages", testDeadLetterMessages)
+	t.Run("FeeRules", testFeeRules)
+	t.Run("FXRates", testFXRates)
+	t.Run("LedgerAccounts", testLedgerAccounts)
+	t.Run("LedgerEntries", testLedgerEntries)
+	t.Run("LedgerTransactions", testLedgerTransactions)
+	t.Run("OutboxMessages", testOutboxMessages)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepancies)
+	t.Run("ReconciliationReports", testReconciliationReports)
+	t.Run("RecurringTransfers", testRecurringTransfers)
 	t.Run("Roles", testRoles)
 	t.Run("SchemaMigrations", testSchemaMigrations)
+	t.Run("TransferBatches", testTransferBatches)
+	t.Run("TransferExports", testTransferExports)
+	t.Run("TransferJobEvents", testTransferJobEvents)
 	t.Run("TransferJobs", testTransferJobs)
+	t.Run("TransferQuotes", testTransferQuotes)
+	t.Run("WebhookDeliveries", testWebhookDeliveries)
+	t.Run("WebhookEndpoints", testWebhookEndpoints)
 }
 
 func TestSoftDelete(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsSoftDelete)
 	t.Run("AccountRoles", testAccountRolesSoftDelete)
 	t.Run("Accounts", testAccountsSoftDelete)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesSoftDelete)
+	t.Run("FeeRules", testFeeRulesSoftDelete)
+	t.Run("FXRates", testFXRatesSoftDelete)
+	t.Run("OutboxMessages", testOutboxMessagesSoftDelete)
+	t.Run("ReconciliationReports", testReconciliationReportsSoftDelete)
+	t.Run("RecurringTransfers", testRecurringTransfersSoftDelete)
 	t.Run("Roles", testRolesSoftDelete)
+	t.Run("TransferBatches", testTransferBatchesSoftDelete)
+	t.Run("TransferExports", testTransferExportsSoftDelete)
+	t.Run("TransferJobEvents", testTransferJobEventsSoftDelete)
 	t.Run("TransferJobs", testTransferJobsSoftDelete)
+	t.Run("TransferQuotes", testTransferQuotesSoftDelete)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSoftDelete)
+	t.Run("WebhookEndpoints", testWebhookEndpointsSoftDelete)
 }
 
 func TestQuerySoftDeleteAll(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsQuerySoftDeleteAll)
 	t.Run("AccountRoles", testAccountRolesQuerySoftDeleteAll)
 	t.Run("Accounts", testAccountsQuerySoftDeleteAll)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesQuerySoftDeleteAll)
+	t.Run("FeeRules", testFeeRulesQuerySoftDeleteAll)
+	t.Run("FXRates", testFXRatesQuerySoftDeleteAll)
+	t.Run("OutboxMessages", testOutboxMessagesQuerySoftDeleteAll)
+	t.Run("ReconciliationReports", testReconciliationReportsQuerySoftDeleteAll)
+	t.Run("RecurringTransfers", testRecurringTransfersQuerySoftDeleteAll)
 	t.Run("Roles", testRolesQuerySoftDeleteAll)
+	t.Run("TransferBatches", testTransferBatchesQuerySoftDeleteAll)
+	t.Run("TransferExports", testTransferExportsQuerySoftDeleteAll)
+	t.Run("TransferJobEvents", testTransferJobEventsQuerySoftDeleteAll)
 	t.Run("TransferJobs", testTransferJobsQuerySoftDeleteAll)
+	t.Run("TransferQuotes", testTransferQuotesQuerySoftDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesQuerySoftDeleteAll)
+	t.Run("WebhookEndpoints", testWebhookEndpointsQuerySoftDeleteAll)
 }
 
 func TestSliceSoftDeleteAll(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsSliceSoftDeleteAll)
 	t.Run("AccountRoles", testAccountRolesSliceSoftDeleteAll)
 	t.Run("Accounts", testAccountsSliceSoftDeleteAll)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesSliceSoftDeleteAll)
+	t.Run("FeeRules", testFeeRulesSliceSoftDeleteAll)
+	t.Run("FXRates", testFXRatesSliceSoftDeleteAll)
+	t.Run("OutboxMessages", testOutboxMessagesSliceSoftDeleteAll)
+	t.Run("ReconciliationReports", testReconciliationReportsSliceSoftDeleteAll)
+	t.Run("RecurringTransfers", testRecurringTransfersSliceSoftDeleteAll)
 	t.Run("Roles", testRolesSliceSoftDeleteAll)
+	t.Run("TransferBatches", testTransferBatchesSliceSoftDeleteAll)
+	t.Run("TransferExports", testTransferExportsSliceSoftDeleteAll)
+	t.Run("TransferJobEvents", testTransferJobEventsSliceSoftDeleteAll)
 	t.Run("TransferJobs", testTransferJobsSliceSoftDeleteAll)
+	t.Run("TransferQuotes", testTransferQuotesSliceSoftDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceSoftDeleteAll)
+	t.Run("WebhookEndpoints", testWebhookEndpointsSliceSoftDeleteAll)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsDelete)
 	t.Run("AccountRoles", testAccountRolesDelete)
 	t.Run("Accounts", testAccountsDelete)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesDelete)
+	t.Run("FeeRules", testFeeRulesDelete)
+	t.Run("FXRates", testFXRatesDelete)
+	t.Run("LedgerAccounts", testLedgerAccountsDelete)
+	t.Run("LedgerEntries", testLedgerEntriesDelete)
+	t.Run("LedgerTransactions", testLedgerTransactionsDelete)
+	t.Run("OutboxMessages", testOutboxMessagesDelete)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesDelete)
+	t.Run("ReconciliationReports", testReconciliationReportsDelete)
+	t.Run("RecurringTransfers", testRecurringTransfersDelete)
 	t.Run("Roles", testRolesDelete)
 	t.Run("SchemaMigrations", testSchemaMigrationsDelete)
+	t.Run("TransferBatches", testTransferBatchesDelete)
+	t.Run("TransferExports", testTransferExportsDelete)
+	t.Run("TransferJobEvents", testTransferJobEventsDelete)
 	t.Run("TransferJobs", testTransferJobsDelete)
+	t.Run("TransferQuotes", testTransferQuotesDelete)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesDelete)
+	t.Run("WebhookEndpoints", testWebhookEndpointsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsQueryDeleteAll)
 	t.Run("AccountRoles", testAccountRolesQueryDeleteAll)
 	t.Run("Accounts", testAccountsQueryDeleteAll)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesQueryDeleteAll)
+	t.Run("FeeRules", testFeeRulesQueryDeleteAll)
+	t.Run("FXRates", testFXRatesQueryDeleteAll)
+	t.Run("LedgerAccounts", testLedgerAccountsQueryDeleteAll)
+	t.Run("LedgerEntries", testLedgerEntriesQueryDeleteAll)
+	t.Run("LedgerTransactions", testLedgerTransactionsQueryDeleteAll)
+	t.Run("OutboxMessages", testOutboxMessagesQueryDeleteAll)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesQueryDeleteAll)
+	t.Run("ReconciliationReports", testReconciliationReportsQueryDeleteAll)
+	t.Run("RecurringTransfers", testRecurringTransfersQueryDeleteAll)
 	t.Run("Roles", testRolesQueryDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsQueryDeleteAll)
+	t.Run("TransferBatches", testTransferBatchesQueryDeleteAll)
+	t.Run("TransferExports", testTransferExportsQueryDeleteAll)
+	t.Run("TransferJobEvents", testTransferJobEventsQueryDeleteAll)
 	t.Run("TransferJobs", testTransferJobsQueryDeleteAll)
+	t.Run("TransferQuotes", testTransferQuotesQueryDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesQueryDeleteAll)
+	t.Run("WebhookEndpoints", testWebhookEndpointsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsSliceDeleteAll)
 	t.Run("AccountRoles", testAccountRolesSliceDeleteAll)
 	t.Run("Accounts", testAccountsSliceDeleteAll)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesSliceDeleteAll)
+	t.Run("FeeRules", testFeeRulesSliceDeleteAll)
+	t.Run("FXRates", testFXRatesSliceDeleteAll)
+	t.Run("LedgerAccounts", testLedgerAccountsSliceDeleteAll)
+	t.Run("LedgerEntries", testLedgerEntriesSliceDeleteAll)
+	t.Run("LedgerTransactions", testLedgerTransactionsSliceDeleteAll)
+	t.Run("OutboxMessages", testOutboxMessagesSliceDeleteAll)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesSliceDeleteAll)
+	t.Run("ReconciliationReports", testReconciliationReportsSliceDeleteAll)
+	t.Run("RecurringTransfers", testRecurringTransfersSliceDeleteAll)
 	t.Run("Roles", testRolesSliceDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceDeleteAll)
+	t.Run("TransferBatches", testTransferBatchesSliceDeleteAll)
+	t.Run("TransferExports", testTransferExportsSliceDeleteAll)
+	t.Run("TransferJobEvents", testTransferJobEventsSliceDeleteAll)
 	t.Run("TransferJobs", testTransferJobsSliceDeleteAll)
+	t.Run("TransferQuotes", testTransferQuotesSliceDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceDeleteAll)
+	t.Run("WebhookEndpoints", testWebhookEndpointsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsExists)
 	t.Run("AccountRoles", testAccountRolesExists)
 	t.Run("Accounts", testAccountsExists)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesExists)
+	t.Run("FeeRules", testFeeRulesExists)
+	t.Run("FXRates", testFXRatesExists)
+	t.Run("LedgerAccounts", testLedgerAccountsExists)
+	t.Run("LedgerEntries", testLedgerEntriesExists)
+	t.Run("LedgerTransactions", testLedgerTransactionsExists)
+	t.Run("OutboxMessages", testOutboxMessagesExists)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesExists)
+	t.Run("ReconciliationReports", testReconciliationReportsExists)
+	t.Run("RecurringTransfers", testRecurringTransfersExists)
 	t.Run("Roles", testRolesExists)
 	t.Run("SchemaMigrations", testSchemaMigrationsExists)
+	t.Run("TransferBatches", testTransferBatchesExists)
+	t.Run("TransferExports", testTransferExportsExists)
+	t.Run("TransferJobEvents", testTransferJobEventsExists)
 	t.Run("TransferJobs", testTransferJobsExists)
+	t.Run("TransferQuotes", testTransferQuotesExists)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesExists)
+	t.Run("WebhookEndpoints", testWebhookEndpointsExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsFind)
 	t.Run("AccountRoles", testAccountRolesFind)
 	t.Run("Accounts", testAccountsFind)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesFind)
+	t.Run("FeeRules", testFeeRulesFind)
+	t.Run("FXRates", testFXRatesFind)
+	t.Run("LedgerAccounts", testLedgerAccountsFind)
+	t.Run("LedgerEntries", testLedgerEntriesFind)
+	t.Run("LedgerTransactions", testLedgerTransactionsFind)
+	t.Run("OutboxMessages", testOutboxMessagesFind)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesFind)
+	t.Run("ReconciliationReports", testReconciliationReportsFind)
+	t.Run("RecurringTransfers", testRecurringTransfersFind)
 	t.Run("Roles", testRolesFind)
 	t.Run("SchemaMigrations", testSchemaMigrationsFind)
+	t.Run("TransferBatches", testTransferBatchesFind)
+	t.Run("TransferExports", testTransferExportsFind)
+	t.Run("TransferJobEvents", testTransferJobEventsFind)
 	t.Run("TransferJobs", testTransferJobsFind)
+	t.Run("TransferQuotes", testTransferQuotesFind)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesFind)
+	t.Run("WebhookEndpoints", testWebhookEndpointsFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsBind)
 	t.Run("AccountRoles", testAccountRolesBind)
 	t.Run("Accounts", testAccountsBind)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesBind)
+	t.Run("FeeRules", testFeeRulesBind)
+	t.Run("FXRates", testFXRatesBind)
+	t.Run("LedgerAccounts", testLedgerAccountsBind)
+	t.Run("LedgerEntries", testLedgerEntriesBind)
+	t.Run("LedgerTransactions", testLedgerTransactionsBind)
+	t.Run("OutboxMessages", testOutboxMessagesBind)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesBind)
+	t.Run("ReconciliationReports", testReconciliationReportsBind)
+	t.Run("RecurringTransfers", testRecurringTransfersBind)
 	t.Run("Roles", testRolesBind)
 	t.Run("SchemaMigrations", testSchemaMigrationsBind)
+	t.Run("TransferBatches", testTransferBatchesBind)
+	t.Run("TransferExports", testTransferExportsBind)
+	t.Run("TransferJobEvents", testTransferJobEventsBind)
 	t.Run("TransferJobs", testTransferJobsBind)
+	t.Run("TransferQuotes", testTransferQuotesBind)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesBind)
+	t.Run("WebhookEndpoints", testWebhookEndpointsBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsOne)
 	t.Run("AccountRoles", testAccountRolesOne)
 	t.Run("Accounts", testAccountsOne)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesOne)
+	t.Run("FeeRules", testFeeRulesOne)
+	t.Run("FXRates", testFXRatesOne)
+	t.Run("LedgerAccounts", testLedgerAccountsOne)
+	t.Run("LedgerEntries", testLedgerEntriesOne)
+	t.Run("LedgerTransactions", testLedgerTransactionsOne)
+	t.Run("OutboxMessages", testOutboxMessagesOne)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesOne)
+	t.Run("ReconciliationReports", testReconciliationReportsOne)
+	t.Run("RecurringTransfers", testRecurringTransfersOne)
 	t.Run("Roles", testRolesOne)
 	t.Run("SchemaMigrations", testSchemaMigrationsOne)
+	t.Run("TransferBatches", testTransferBatchesOne)
+	t.Run("TransferExports", testTransferExportsOne)
+	t.Run("TransferJobEvents", testTransferJobEventsOne)
 	t.Run("TransferJobs", testTransferJobsOne)
+	t.Run("TransferQuotes", testTransferQuotesOne)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesOne)
+	t.Run("WebhookEndpoints", testWebhookEndpointsOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsAll)
 	t.Run("AccountRoles", testAccountRolesAll)
 	t.Run("Accounts", testAccountsAll)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesAll)
+	t.Run("FeeRules", testFeeRulesAll)
+	t.Run("FXRates", testFXRatesAll)
+	t.Run("LedgerAccounts", testLedgerAccountsAll)
+	t.Run("LedgerEntries", testLedgerEntriesAll)
+	t.Run("LedgerTransactions", testLedgerTransactionsAll)
+	t.Run("OutboxMessages", testOutboxMessagesAll)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesAll)
+	t.Run("ReconciliationReports", testReconciliationReportsAll)
+	t.Run("RecurringTransfers", testRecurringTransfersAll)
 	t.Run("Roles", testRolesAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsAll)
+	t.Run("TransferBatches", testTransferBatchesAll)
+	t.Run("TransferExports", testTransferExportsAll)
+	t.Run("TransferJobEvents", testTransferJobEventsAll)
 	t.Run("TransferJobs", testTransferJobsAll)
+	t.Run("TransferQuotes", testTransferQuotesAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesAll)
+	t.Run("WebhookEndpoints", testWebhookEndpointsAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsCount)
 	t.Run("AccountRoles", testAccountRolesCount)
 	t.Run("Accounts", testAccountsCount)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesCount)
+	t.Run("FeeRules", testFeeRulesCount)
+	t.Run("FXRates", testFXRatesCount)
+	t.Run("LedgerAccounts", testLedgerAccountsCount)
+	t.Run("LedgerEntries", testLedgerEntriesCount)
+	t.Run("LedgerTransactions", testLedgerTransactionsCount)
+	t.Run("OutboxMessages", testOutboxMessagesCount)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesCount)
+	t.Run("ReconciliationReports", testReconciliationReportsCount)
+	t.Run("RecurringTransfers", testRecurringTransfersCount)
 	t.Run("Roles", testRolesCount)
 	t.Run("SchemaMigrations", testSchemaMigrationsCount)
+	t.Run("TransferBatches", testTransferBatchesCount)
+	t.Run("TransferExports", testTransferExportsCount)
+	t.Run("TransferJobEvents", testTransferJobEventsCount)
 	t.Run("TransferJobs", testTransferJobsCount)
+	t.Run("TransferQuotes", testTransferQuotesCount)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesCount)
+	t.Run("WebhookEndpoints", testWebhookEndpointsCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsHooks)
 	t.Run("AccountRoles", testAccountRolesHooks)
 	t.Run("Accounts", testAccountsHooks)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesHooks)
+	t.Run("FeeRules", testFeeRulesHooks)
+	t.Run("FXRates", testFXRatesHooks)
+	t.Run("LedgerAccounts", testLedgerAccountsHooks)
+	t.Run("LedgerEntries", testLedgerEntriesHooks)
+	t.Run("LedgerTransactions", testLedgerTransactionsHooks)
+	t.Run("OutboxMessages", testOutboxMessagesHooks)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesHooks)
+	t.Run("ReconciliationReports", testReconciliationReportsHooks)
+	t.Run("RecurringTransfers", testRecurringTransfersHooks)
 	t.Run("Roles", testRolesHooks)
 	t.Run("SchemaMigrations", testSchemaMigrationsHooks)
+	t.Run("TransferBatches", testTransferBatchesHooks)
+	t.Run("TransferExports", testTransferExportsHooks)
+	t.Run("TransferJobEvents", testTransferJobEventsHooks)
 	t.Run("TransferJobs", testTransferJobsHooks)
+	t.Run("TransferQuotes", testTransferQuotesHooks)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesHooks)
+	t.Run("WebhookEndpoints", testWebhookEndpointsHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsInsert)
+	t.Run("AccountLimits", testAccountLimitsInsertWhitelist)
 	t.Run("AccountRoles", testAccountRolesInsert)
 	t.Run("AccountRoles", testAccountRolesInsertWhitelist)
 	t.Run("Accounts", testAccountsInsert)
 	t.Run("Accounts", testAccountsInsertWhitelist)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesInsert)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesInsertWhitelist)
+	t.Run("FeeRules", testFeeRulesInsert)
+	t.Run("FeeRules", testFeeRulesInsertWhitelist)
+	t.Run("FXRates", testFXRatesInsert)
+	t.Run("FXRates", testFXRatesInsertWhitelist)
+	t.Run("LedgerAccounts", testLedgerAccountsInsert)
+	t.Run("LedgerAccounts", testLedgerAccountsInsertWhitelist)
+	t.Run("LedgerEntries", testLedgerEntriesInsert)
+	t.Run("LedgerEntries", testLedgerEntriesInsertWhitelist)
+	t.Run("LedgerTransactions", testLedgerTransactionsInsert)
+	t.Run("LedgerTransactions", testLedgerTransactionsInsertWhitelist)
+	t.Run("OutboxMessages", testOutboxMessagesInsert)
+	t.Run("OutboxMessages", testOutboxMessagesInsertWhitelist)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesInsert)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesInsertWhitelist)
+	t.Run("ReconciliationReports", testReconciliationReportsInsert)
+	t.Run("ReconciliationReports", testReconciliationReportsInsertWhitelist)
+	t.Run("RecurringTransfers", testRecurringTransfersInsert)
+	t.Run("RecurringTransfers", testRecurringTransfersInsertWhitelist)
 	t.Run("Roles", testRolesInsert)
 	t.Run("Roles", testRolesInsertWhitelist)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsert)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsertWhitelist)
+	t.Run("TransferBatches", testTransferBatchesInsert)
+	t.Run("TransferBatches", testTransferBatchesInsertWhitelist)
+	t.Run("TransferExports", testTransferExportsInsert)
+	t.Run("TransferExports", testTransferExportsInsertWhitelist)
+	t.Run("TransferJobEvents", testTransferJobEventsInsert)
+	t.Run("TransferJobEvents", testTransferJobEventsInsertWhitelist)
 	t.Run("TransferJobs", testTransferJobsInsert)
 	t.Run("TransferJobs", testTransferJobsInsertWhitelist)
+	t.Run("TransferQuotes", testTransferQuotesInsert)
+	t.Run("TransferQuotes", testTransferQuotesInsertWhitelist)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesInsert)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesInsertWhitelist)
+	t.Run("WebhookEndpoints", testWebhookEndpointsInsert)
+	t.Run("WebhookEndpoints", testWebhookEndpointsInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsReload)
 	t.Run("AccountRoles", testAccountRolesReload)
 	t.Run("Accounts", testAccountsReload)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesReload)
+	t.Run("FeeRules", testFeeRulesReload)
+	t.Run("FXRates", testFXRatesReload)
+	t.Run("LedgerAccounts", testLedgerAccountsReload)
+	t.Run("LedgerEntries", testLedgerEntriesReload)
+	t.Run("LedgerTransactions", testLedgerTransactionsReload)
+	t.Run("OutboxMessages", testOutboxMessagesReload)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesReload)
+	t.Run("ReconciliationReports", testReconciliationReportsReload)
+	t.Run("RecurringTransfers", testRecurringTransfersReload)
 	t.Run("Roles", testRolesReload)
 	t.Run("SchemaMigrations", testSchemaMigrationsReload)
+	t.Run("TransferBatches", testTransferBatchesReload)
+	t.Run("TransferExports", testTransferExportsReload)
+	t.Run("TransferJobEvents", testTransferJobEventsReload)
 	t.Run("TransferJobs", testTransferJobsReload)
+	t.Run("TransferQuotes", testTransferQuotesReload)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesReload)
+	t.Run("WebhookEndpoints", testWebhookEndpointsReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsReloadAll)
 	t.Run("AccountRoles", testAccountRolesReloadAll)
 	t.Run("Accounts", testAccountsReloadAll)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesReloadAll)
+	t.Run("FeeRules", testFeeRulesReloadAll)
+	t.Run("FXRates", testFXRatesReloadAll)
+	t.Run("LedgerAccounts", testLedgerAccountsReloadAll)
+	t.Run("LedgerEntries", testLedgerEntriesReloadAll)
+	t.Run("LedgerTransactions", testLedgerTransactionsReloadAll)
+	t.Run("OutboxMessages", testOutboxMessagesReloadAll)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesReloadAll)
+	t.Run("ReconciliationReports", testReconciliationReportsReloadAll)
+	t.Run("RecurringTransfers", testRecurringTransfersReloadAll)
 	t.Run("Roles", testRolesReloadAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsReloadAll)
+	t.Run("TransferBatches", testTransferBatchesReloadAll)
+	t.Run("TransferExports", testTransferExportsReloadAll)
+	t.Run("TransferJobEvents", testTransferJobEventsReloadAll)
 	t.Run("TransferJobs", testTransferJobsReloadAll)
+	t.Run("TransferQuotes", testTransferQuotesReloadAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesReloadAll)
+	t.Run("WebhookEndpoints", testWebhookEndpointsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsSelect)
 	t.Run("AccountRoles", testAccountRolesSelect)
 	t.Run("Accounts", testAccountsSelect)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesSelect)
+	t.Run("FeeRules", testFeeRulesSelect)
+	t.Run("FXRates", testFXRatesSelect)
+	t.Run("LedgerAccounts", testLedgerAccountsSelect)
+	t.Run("LedgerEntries", testLedgerEntriesSelect)
+	t.Run("LedgerTransactions", testLedgerTransactionsSelect)
+	t.Run("OutboxMessages", testOutboxMessagesSelect)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesSelect)
+	t.Run("ReconciliationReports", testReconciliationReportsSelect)
+	t.Run("RecurringTransfers", testRecurringTransfersSelect)
 	t.Run("Roles", testRolesSelect)
 	t.Run("SchemaMigrations", testSchemaMigrationsSelect)
+	t.Run("TransferBatches", testTransferBatchesSelect)
+	t.Run("TransferExports", testTransferExportsSelect)
+	t.Run("TransferJobEvents", testTransferJobEventsSelect)
 	t.Run("TransferJobs", testTransferJobsSelect)
+	t.Run("TransferQuotes", testTransferQuotesSelect)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSelect)
+	t.Run("WebhookEndpoints", testWebhookEndpointsSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsUpdate)
 	t.Run("AccountRoles", testAccountRolesUpdate)
 	t.Run("Accounts", testAccountsUpdate)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesUpdate)
+	t.Run("FeeRules", testFeeRulesUpdate)
+	t.Run("FXRates", testFXRatesUpdate)
+	t.Run("LedgerAccounts", testLedgerAccountsUpdate)
+	t.Run("LedgerEntries", testLedgerEntriesUpdate)
+	t.Run("LedgerTransactions", testLedgerTransactionsUpdate)
+	t.Run("OutboxMessages", testOutboxMessagesUpdate)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesUpdate)
+	t.Run("ReconciliationReports", testReconciliationReportsUpdate)
+	t.Run("RecurringTransfers", testRecurringTransfersUpdate)
 	t.Run("Roles", testRolesUpdate)
 	t.Run("SchemaMigrations", testSchemaMigrationsUpdate)
+	t.Run("TransferBatches", testTransferBatchesUpdate)
+	t.Run("TransferExports", testTransferExportsUpdate)
+	t.Run("TransferJobEvents", testTransferJobEventsUpdate)
 	t.Run("TransferJobs", testTransferJobsUpdate)
+	t.Run("TransferQuotes", testTransferQuotesUpdate)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesUpdate)
+	t.Run("WebhookEndpoints", testWebhookEndpointsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsSliceUpdateAll)
 	t.Run("AccountRoles", testAccountRolesSliceUpdateAll)
 	t.Run("Accounts", testAccountsSliceUpdateAll)
+	t.Run("DeadLetterMessages", testDeadLetterMessagesSliceUpdateAll)
+	t.Run("FeeRules", testFeeRulesSliceUpdateAll)
+	t.Run("FXRates", testFXRatesSliceUpdateAll)
+	t.Run("LedgerAccounts", testLedgerAccountsSliceUpdateAll)
+	t.Run("LedgerEntries", testLedgerEntriesSliceUpdateAll)
+	t.Run("LedgerTransactions", testLedgerTransactionsSliceUpdateAll)
+	t.Run("OutboxMessages", testOutboxMessagesSliceUpdateAll)
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesSliceUpdateAll)
+	t.Run("ReconciliationReports", testReconciliationReportsSliceUpdateAll)
+	t.Run("RecurringTransfers", testRecurringTransfersSliceUpdateAll)
 	t.Run("Roles", testRolesSliceUpdateAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceUpdateAll)
+	t.Run("TransferBatches", testTransferBatchesSliceUpdateAll)
+	t.Run("TransferExports", testTransferExportsSliceUpdateAll)
+	t.Run("TransferJobEvents", testTransferJobEventsSliceUpdateAll)
 	t.Run("TransferJobs", testTransferJobsSliceUpdateAll)
+	t.Run("TransferQuotes", testTransferQuotesSliceUpdateAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceUpdateAll)
+	t.Run("WebhookEndpoints", testWebhookEndpointsSliceUpdateAll)
 }
@@ -4,15 +4,49 @@
 package entity
 
 var TableNames = struct {
-	AccountRoles     string
-	Accounts         string
-	Roles            string
-	SchemaMigrations string
-	TransferJobs     string
+	AccountLimits               string
+	AccountRoles                string
+	Accounts                    string
+	DeadLetterMessages          string
+	FeeRules                    string
+	FXRates                     string
+	LedgerAccounts              string
+	LedgerEntries               string
+	LedgerTransactions          string
+	OutboxMessages              string
+	ReconciliationDiscrepancies string
+	ReconciliationReports       string
+	RecurringTransfers          string
+	Roles                       string
+	SchemaMigrations            string
+	TransferBatches             string
+	TransferExports             string
+	TransferJobEvents           string
+	TransferJobs                string
+	TransferQuotes              string
+	WebhookDeliveries           string
+	WebhookEndpoints            string
 }{
-	AccountRoles:     "account_roles",
-	Accounts:         "accounts",
-	Roles:            "roles",
-	SchemaMigrations: "schema_migrations",
-	TransferJobs:     "transfer_jobs",
+	AccountLimits:               "account_limits",
+	AccountRoles:                "account_roles",
+	Accounts:                    "accounts",
+	DeadLetterMessages:          "dead_letter_messages",
+	FeeRules:                    "fee_rules",
+	FXRates:                     "fx_rates",
+	LedgerAccounts:              "ledger_accounts",
+	LedgerEntries:               "ledger_entries",
+	LedgerTransactions:          "ledger_transactions",
+	OutboxMessages:              "outbox_messages",
+	ReconciliationDiscrepancies: "reconciliation_discrepancies",
+	ReconciliationReports:       "reconciliation_reports",
+	RecurringTransfers:          "recurring_transfers",
+	Roles:                       "roles",
+	SchemaMigrations:            "schema_migrations",
+	TransferBatches:             "transfer_batches",
+	TransferExports:             "transfer_exports",
+	TransferJobEvents:           "transfer_job_events",
+	TransferJobs:                "transfer_jobs",
+	TransferQuotes:              "transfer_quotes",
+	WebhookDeliveries:           "webhook_deliveries",
+	WebhookEndpoints:            "webhook_endpoints",
 }
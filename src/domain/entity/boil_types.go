@@ -4,9 +4,14 @@
 package entity
 
 import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
 	"strconv"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/null/v8/convert"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/strmangle"
 )
@@ -51,26 +56,480 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	return str
 }
 
+type Deadletterstatus string
+
+// Enum values for Deadletterstatus
+const (
+	DeadletterstatusPending  Deadletterstatus = "pending"
+	DeadletterstatusReplayed Deadletterstatus = "replayed"
+)
+
+func AllDeadletterstatus() []Deadletterstatus {
+	return []Deadletterstatus{
+		DeadletterstatusPending,
+		DeadletterstatusReplayed,
+	}
+}
+
+func (e Deadletterstatus) IsValid() error {
+	switch e {
+	case DeadletterstatusPending, DeadletterstatusReplayed:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e Deadletterstatus) String() string {
+	return string(e)
+}
+
+func (e Deadletterstatus) Ordinal() int {
+	switch e {
+	case DeadletterstatusPending:
+		return 0
+	case DeadletterstatusReplayed:
+		return 1
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type Feetype string
+
+// Enum values for Feetype
+const (
+	FeetypeFlat       Feetype = "flat"
+	FeetypePercentage Feetype = "percentage"
+	FeetypeCapped     Feetype = "capped"
+)
+
+func AllFeetype() []Feetype {
+	return []Feetype{
+		FeetypeFlat,
+		FeetypePercentage,
+		FeetypeCapped,
+	}
+}
+
+func (e Feetype) IsValid() error {
+	switch e {
+	case FeetypeFlat, FeetypePercentage, FeetypeCapped:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e Feetype) String() string {
+	return string(e)
+}
+
+func (e Feetype) Ordinal() int {
+	switch e {
+	case FeetypeFlat:
+		return 0
+	case FeetypePercentage:
+		return 1
+	case FeetypeCapped:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type Ledgeraccountkind string
+
+// Enum values for Ledgeraccountkind
+const (
+	LedgeraccountkindMerchant   Ledgeraccountkind = "merchant"
+	LedgeraccountkindClearing   Ledgeraccountkind = "clearing"
+	LedgeraccountkindSettlement Ledgeraccountkind = "settlement"
+	LedgeraccountkindFeeRevenue Ledgeraccountkind = "fee_revenue"
+)
+
+func AllLedgeraccountkind() []Ledgeraccountkind {
+	return []Ledgeraccountkind{
+		LedgeraccountkindMerchant,
+		LedgeraccountkindClearing,
+		LedgeraccountkindSettlement,
+		LedgeraccountkindFeeRevenue,
+	}
+}
+
+func (e Ledgeraccountkind) IsValid() error {
+	switch e {
+	case LedgeraccountkindMerchant, LedgeraccountkindClearing, LedgeraccountkindSettlement, LedgeraccountkindFeeRevenue:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e Ledgeraccountkind) String() string {
+	return string(e)
+}
+
+func (e Ledgeraccountkind) Ordinal() int {
+	switch e {
+	case LedgeraccountkindMerchant:
+		return 0
+	case LedgeraccountkindClearing:
+		return 1
+	case LedgeraccountkindSettlement:
+		return 2
+	case LedgeraccountkindFeeRevenue:
+		return 3
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type Ledgerdirection string
+
+// Enum values for Ledgerdirection
+const (
+	LedgerdirectionDebit  Ledgerdirection = "debit"
+	LedgerdirectionCredit Ledgerdirection = "credit"
+)
+
+func AllLedgerdirection() []Ledgerdirection {
+	return []Ledgerdirection{
+		LedgerdirectionDebit,
+		LedgerdirectionCredit,
+	}
+}
+
+func (e Ledgerdirection) IsValid() error {
+	switch e {
+	case LedgerdirectionDebit, LedgerdirectionCredit:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e Ledgerdirection) String() string {
+	return string(e)
+}
+
+func (e Ledgerdirection) Ordinal() int {
+	switch e {
+	case LedgerdirectionDebit:
+		return 0
+	case LedgerdirectionCredit:
+		return 1
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type Ledgertransactiontype string
+
+// Enum values for Ledgertransactiontype
+const (
+	LedgertransactiontypeTransfer   Ledgertransactiontype = "transfer"
+	LedgertransactiontypeFee        Ledgertransactiontype = "fee"
+	LedgertransactiontypeSettlement Ledgertransactiontype = "settlement"
+	LedgertransactiontypeReversal   Ledgertransactiontype = "reversal"
+	LedgertransactiontypeRefund     Ledgertransactiontype = "refund"
+)
+
+func AllLedgertransactiontype() []Ledgertransactiontype {
+	return []Ledgertransactiontype{
+		LedgertransactiontypeTransfer,
+		LedgertransactiontypeFee,
+		LedgertransactiontypeSettlement,
+		LedgertransactiontypeReversal,
+		LedgertransactiontypeRefund,
+	}
+}
+
+func (e Ledgertransactiontype) IsValid() error {
+	switch e {
+	case LedgertransactiontypeTransfer, LedgertransactiontypeFee, LedgertransactiontypeSettlement, LedgertransactiontypeReversal, LedgertransactiontypeRefund:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e Ledgertransactiontype) String() string {
+	return string(e)
+}
+
+func (e Ledgertransactiontype) Ordinal() int {
+	switch e {
+	case LedgertransactiontypeTransfer:
+		return 0
+	case LedgertransactiontypeFee:
+		return 1
+	case LedgertransactiontypeSettlement:
+		return 2
+	case LedgertransactiontypeReversal:
+		return 3
+	case LedgertransactiontypeRefund:
+		return 4
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type Outboxstatus string
+
+// Enum values for Outboxstatus
+const (
+	OutboxstatusPending Outboxstatus = "pending"
+	OutboxstatusSent    Outboxstatus = "sent"
+	OutboxstatusParked  Outboxstatus = "parked"
+)
+
+func AllOutboxstatus() []Outboxstatus {
+	return []Outboxstatus{
+		OutboxstatusPending,
+		OutboxstatusSent,
+		OutboxstatusParked,
+	}
+}
+
+func (e Outboxstatus) IsValid() error {
+	switch e {
+	case OutboxstatusPending, OutboxstatusSent, OutboxstatusParked:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e Outboxstatus) String() string {
+	return string(e)
+}
+
+func (e Outboxstatus) Ordinal() int {
+	switch e {
+	case OutboxstatusPending:
+		return 0
+	case OutboxstatusSent:
+		return 1
+	case OutboxstatusParked:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type Discrepancytype string
+
+// Enum values for Discrepancytype
+const (
+	DiscrepancytypeMissingOnProvider Discrepancytype = "missing_on_provider"
+	DiscrepancytypeMissingLocally    Discrepancytype = "missing_locally"
+	DiscrepancytypeAmountMismatch    Discrepancytype = "amount_mismatch"
+	DiscrepancytypeStatusMismatch    Discrepancytype = "status_mismatch"
+)
+
+func AllDiscrepancytype() []Discrepancytype {
+	return []Discrepancytype{
+		DiscrepancytypeMissingOnProvider,
+		DiscrepancytypeMissingLocally,
+		DiscrepancytypeAmountMismatch,
+		DiscrepancytypeStatusMismatch,
+	}
+}
+
+func (e Discrepancytype) IsValid() error {
+	switch e {
+	case DiscrepancytypeMissingOnProvider, DiscrepancytypeMissingLocally, DiscrepancytypeAmountMismatch, DiscrepancytypeStatusMismatch:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e Discrepancytype) String() string {
+	return string(e)
+}
+
+func (e Discrepancytype) Ordinal() int {
+	switch e {
+	case DiscrepancytypeMissingOnProvider:
+		return 0
+	case DiscrepancytypeMissingLocally:
+		return 1
+	case DiscrepancytypeAmountMismatch:
+		return 2
+	case DiscrepancytypeStatusMismatch:
+		return 3
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type Reconciliationstatus string
+
+// Enum values for Reconciliationstatus
+const (
+	ReconciliationstatusRunning   Reconciliationstatus = "running"
+	ReconciliationstatusCompleted Reconciliationstatus = "completed"
+	ReconciliationstatusFailed    Reconciliationstatus = "failed"
+)
+
+func AllReconciliationstatus() []Reconciliationstatus {
+	return []Reconciliationstatus{
+		ReconciliationstatusRunning,
+		ReconciliationstatusCompleted,
+		ReconciliationstatusFailed,
+	}
+}
+
+func (e Reconciliationstatus) IsValid() error {
+	switch e {
+	case ReconciliationstatusRunning, ReconciliationstatusCompleted, ReconciliationstatusFailed:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e Reconciliationstatus) String() string {
+	return string(e)
+}
+
+func (e Reconciliationstatus) Ordinal() int {
+	switch e {
+	case ReconciliationstatusRunning:
+		return 0
+	case ReconciliationstatusCompleted:
+		return 1
+	case ReconciliationstatusFailed:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type Recurringstatus string
+
+// Enum values for Recurringstatus
+const (
+	RecurringstatusActive    Recurringstatus = "active"
+	RecurringstatusCompleted Recurringstatus = "completed"
+	RecurringstatusPaused    Recurringstatus = "paused"
+)
+
+func AllRecurringstatus() []Recurringstatus {
+	return []Recurringstatus{
+		RecurringstatusActive,
+		RecurringstatusCompleted,
+		RecurringstatusPaused,
+	}
+}
+
+func (e Recurringstatus) IsValid() error {
+	switch e {
+	case RecurringstatusActive, RecurringstatusCompleted, RecurringstatusPaused:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e Recurringstatus) String() string {
+	return string(e)
+}
+
+func (e Recurringstatus) Ordinal() int {
+	switch e {
+	case RecurringstatusActive:
+		return 0
+	case RecurringstatusCompleted:
+		return 1
+	case RecurringstatusPaused:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type Transferexportstatus string
+
+// Enum values for Transferexportstatus
+const (
+	TransferexportstatusRunning   Transferexportstatus = "running"
+	TransferexportstatusCompleted Transferexportstatus = "completed"
+	TransferexportstatusFailed    Transferexportstatus = "failed"
+)
+
+func AllTransferexportstatus() []Transferexportstatus {
+	return []Transferexportstatus{
+		TransferexportstatusRunning,
+		TransferexportstatusCompleted,
+		TransferexportstatusFailed,
+	}
+}
+
+func (e Transferexportstatus) IsValid() error {
+	switch e {
+	case TransferexportstatusRunning, TransferexportstatusCompleted, TransferexportstatusFailed:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e Transferexportstatus) String() string {
+	return string(e)
+}
+
+func (e Transferexportstatus) Ordinal() int {
+	switch e {
+	case TransferexportstatusRunning:
+		return 0
+	case TransferexportstatusCompleted:
+		return 1
+	case TransferexportstatusFailed:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
 type Transferstatus string
 
 // Enum values for Transferstatus
 const (
-	TransferstatusFailed  Transferstatus = "failed"
-	TransferstatusPending Transferstatus = "pending"
-	TransferstatusSuccess Transferstatus = "success"
+	TransferstatusCancelled  Transferstatus = "cancelled"
+	TransferstatusFailed     Transferstatus = "failed"
+	TransferstatusPending    Transferstatus = "pending"
+	TransferstatusProcessing Transferstatus = "processing"
+	TransferstatusScheduled  Transferstatus = "scheduled"
+	TransferstatusSuccess    Transferstatus = "success"
 )
 
 func AllTransferstatus() []Transferstatus {
 	return []Transferstatus{
+		TransferstatusCancelled,
 		TransferstatusFailed,
 		TransferstatusPending,
+		TransferstatusProcessing,
+		TransferstatusScheduled,
 		TransferstatusSuccess,
 	}
 }
 
 func (e Transferstatus) IsValid() error {
 	switch e {
-	case TransferstatusFailed, TransferstatusPending, TransferstatusSuccess:
+	case TransferstatusCancelled, TransferstatusFailed, TransferstatusPending, TransferstatusProcessing, TransferstatusScheduled, TransferstatusSuccess:
 		return nil
 	default:
 		return errors.New("enum is not valid")
@@ -83,11 +542,169 @@ func (e Transferstatus) String() string {
 
 func (e Transferstatus) Ordinal() int {
 	switch e {
-	case TransferstatusFailed:
+	case TransferstatusCancelled:
 		return 0
-	case TransferstatusPending:
+	case TransferstatusFailed:
 		return 1
+	case TransferstatusPending:
+		return 2
+	case TransferstatusProcessing:
+		return 3
+	case TransferstatusScheduled:
+		return 4
 	case TransferstatusSuccess:
+		return 5
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+// NullTransferstatus is a nullable Transferstatus enum type. It supports SQL and JSON serialization.
+type NullTransferstatus struct {
+	Val   Transferstatus
+	Valid bool
+}
+
+// NullTransferstatusFrom creates a new Transferstatus that will never be blank.
+func NullTransferstatusFrom(v Transferstatus) NullTransferstatus {
+	return NewNullTransferstatus(v, true)
+}
+
+// NullTransferstatusFromPtr creates a new NullTransferstatus that be null if s is nil.
+func NullTransferstatusFromPtr(v *Transferstatus) NullTransferstatus {
+	if v == nil {
+		return NewNullTransferstatus("", false)
+	}
+	return NewNullTransferstatus(*v, true)
+}
+
+// NewNullTransferstatus creates a new NullTransferstatus
+func NewNullTransferstatus(v Transferstatus, valid bool) NullTransferstatus {
+	return NullTransferstatus{
+		Val:   v,
+		Valid: valid,
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *NullTransferstatus) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, null.NullBytes) {
+		e.Val = ""
+		e.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &e.Val); err != nil {
+		return err
+	}
+
+	e.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e NullTransferstatus) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return null.NullBytes, nil
+	}
+	return json.Marshal(e.Val)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (e NullTransferstatus) MarshalText() ([]byte, error) {
+	if !e.Valid {
+		return []byte{}, nil
+	}
+	return []byte(e.Val), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *NullTransferstatus) UnmarshalText(text []byte) error {
+	if text == nil || len(text) == 0 {
+		e.Valid = false
+		return nil
+	}
+
+	e.Val = Transferstatus(text)
+	e.Valid = true
+	return nil
+}
+
+// SetValid changes this NullTransferstatus value and also sets it to be non-null.
+func (e *NullTransferstatus) SetValid(v Transferstatus) {
+	e.Val = v
+	e.Valid = true
+}
+
+// Ptr returns a pointer to this NullTransferstatus value, or a nil pointer if this NullTransferstatus is null.
+func (e NullTransferstatus) Ptr() *Transferstatus {
+	if !e.Valid {
+		return nil
+	}
+	return &e.Val
+}
+
+// IsZero returns true for null types.
+func (e NullTransferstatus) IsZero() bool {
+	return !e.Valid
+}
+
+// Scan implements the Scanner interface.
+func (e *NullTransferstatus) Scan(value interface{}) error {
+	if value == nil {
+		e.Val, e.Valid = "", false
+		return nil
+	}
+	e.Valid = true
+	return convert.ConvertAssign((*string)(&e.Val), value)
+}
+
+// Value implements the driver Valuer interface.
+func (e NullTransferstatus) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	return string(e.Val), nil
+}
+
+type Webhookdeliverystatus string
+
+// Enum values for Webhookdeliverystatus
+const (
+	WebhookdeliverystatusFailed  Webhookdeliverystatus = "failed"
+	WebhookdeliverystatusPending Webhookdeliverystatus = "pending"
+	WebhookdeliverystatusSuccess Webhookdeliverystatus = "success"
+)
+
+func AllWebhookdeliverystatus() []Webhookdeliverystatus {
+	return []Webhookdeliverystatus{
+		WebhookdeliverystatusFailed,
+		WebhookdeliverystatusPending,
+		WebhookdeliverystatusSuccess,
+	}
+}
+
+func (e Webhookdeliverystatus) IsValid() error {
+	switch e {
+	case WebhookdeliverystatusFailed, WebhookdeliverystatusPending, WebhookdeliverystatusSuccess:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e Webhookdeliverystatus) String() string {
+	return string(e)
+}
+
+func (e Webhookdeliverystatus) Ordinal() int {
+	switch e {
+	case WebhookdeliverystatusFailed:
+		return 0
+	case WebhookdeliverystatusPending:
+		return 1
+	case WebhookdeliverystatusSuccess:
 		return 2
 
 	default:
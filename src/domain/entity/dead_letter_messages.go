@@ -0,0 +1,1253 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// DeadLetterMessage is an object representing the database table.
+type DeadLetterMessage struct {
+	ID            int              `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Topic         string           `boil:"topic" json:"topic" toml:"topic" yaml:"topic"`
+	Partition     int              `boil:"partition" json:"partition" toml:"partition" yaml:"partition"`
+	MessageOffset int64            `boil:"message_offset" json:"message_offset" toml:"message_offset" yaml:"message_offset"`
+	OriginalTopic string           `boil:"original_topic" json:"original_topic" toml:"original_topic" yaml:"original_topic"`
+	MessageKey    string           `boil:"message_key" json:"message_key" toml:"message_key" yaml:"message_key"`
+	Payload       string           `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Headers       types.JSON       `boil:"headers" json:"headers" toml:"headers" yaml:"headers"`
+	Error         null.String      `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	RetryAttempts int              `boil:"retry_attempts" json:"retry_attempts" toml:"retry_attempts" yaml:"retry_attempts"`
+	Status        Deadletterstatus `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ReplayedAt    null.Time        `boil:"replayed_at" json:"replayed_at,omitempty" toml:"replayed_at" yaml:"replayed_at,omitempty"`
+	CreatedBy     int              `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt     time.Time        `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy     int              `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt     time.Time        `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy     null.Int         `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt     null.Time        `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *deadLetterMessageR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L deadLetterMessageL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DeadLetterMessageColumns = struct {
+	ID            string
+	Topic         string
+	Partition     string
+	MessageOffset string
+	OriginalTopic string
+	MessageKey    string
+	Payload       string
+	Headers       string
+	Error         string
+	RetryAttempts string
+	Status        string
+	ReplayedAt    string
+	CreatedBy     string
+	CreatedAt     string
+	UpdatedBy     string
+	UpdatedAt     string
+	DeletedBy     string
+	DeletedAt     string
+}{
+	ID:            "id",
+	Topic:         "topic",
+	Partition:     "partition",
+	MessageOffset: "message_offset",
+	OriginalTopic: "original_topic",
+	MessageKey:    "message_key",
+	Payload:       "payload",
+	Headers:       "headers",
+	Error:         "error",
+	RetryAttempts: "retry_attempts",
+	Status:        "status",
+	ReplayedAt:    "replayed_at",
+	CreatedBy:     "created_by",
+	CreatedAt:     "created_at",
+	UpdatedBy:     "updated_by",
+	UpdatedAt:     "updated_at",
+	DeletedBy:     "deleted_by",
+	DeletedAt:     "deleted_at",
+}
+
+var DeadLetterMessageTableColumns = struct {
+	ID            string
+	Topic         string
+	Partition     string
+	MessageOffset string
+	OriginalTopic string
+	MessageKey    string
+	Payload       string
+	Headers       string
+	Error         string
+	RetryAttempts string
+	Status        string
+	ReplayedAt    string
+	CreatedBy     string
+	CreatedAt     string
+	UpdatedBy     string
+	UpdatedAt     string
+	DeletedBy     string
+	DeletedAt     string
+}{
+	ID:            "dead_letter_messages.id",
+	Topic:         "dead_letter_messages.topic",
+	Partition:     "dead_letter_messages.partition",
+	MessageOffset: "dead_letter_messages.message_offset",
+	OriginalTopic: "dead_letter_messages.original_topic",
+	MessageKey:    "dead_letter_messages.message_key",
+	Payload:       "dead_letter_messages.payload",
+	Headers:       "dead_letter_messages.headers",
+	Error:         "dead_letter_messages.error",
+	RetryAttempts: "dead_letter_messages.retry_attempts",
+	Status:        "dead_letter_messages.status",
+	ReplayedAt:    "dead_letter_messages.replayed_at",
+	CreatedBy:     "dead_letter_messages.created_by",
+	CreatedAt:     "dead_letter_messages.created_at",
+	UpdatedBy:     "dead_letter_messages.updated_by",
+	UpdatedAt:     "dead_letter_messages.updated_at",
+	DeletedBy:     "dead_letter_messages.deleted_by",
+	DeletedAt:     "dead_letter_messages.deleted_at",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelperDeadletterstatus struct{ field string }
+
+func (w whereHelperDeadletterstatus) EQ(x Deadletterstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperDeadletterstatus) NEQ(x Deadletterstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperDeadletterstatus) LT(x Deadletterstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperDeadletterstatus) LTE(x Deadletterstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperDeadletterstatus) GT(x Deadletterstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperDeadletterstatus) GTE(x Deadletterstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperDeadletterstatus) IN(slice []Deadletterstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperDeadletterstatus) NIN(slice []Deadletterstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var DeadLetterMessageWhere = struct {
+	ID            whereHelperint
+	Topic         whereHelperstring
+	Partition     whereHelperint
+	MessageOffset whereHelperint64
+	OriginalTopic whereHelperstring
+	MessageKey    whereHelperstring
+	Payload       whereHelperstring
+	Headers       whereHelpertypes_JSON
+	Error         whereHelpernull_String
+	RetryAttempts whereHelperint
+	Status        whereHelperDeadletterstatus
+	ReplayedAt    whereHelpernull_Time
+	CreatedBy     whereHelperint
+	CreatedAt     whereHelpertime_Time
+	UpdatedBy     whereHelperint
+	UpdatedAt     whereHelpertime_Time
+	DeletedBy     whereHelpernull_Int
+	DeletedAt     whereHelpernull_Time
+}{
+	ID:            whereHelperint{field: "\"dead_letter_messages\".\"id\""},
+	Topic:         whereHelperstring{field: "\"dead_letter_messages\".\"topic\""},
+	Partition:     whereHelperint{field: "\"dead_letter_messages\".\"partition\""},
+	MessageOffset: whereHelperint64{field: "\"dead_letter_messages\".\"message_offset\""},
+	OriginalTopic: whereHelperstring{field: "\"dead_letter_messages\".\"original_topic\""},
+	MessageKey:    whereHelperstring{field: "\"dead_letter_messages\".\"message_key\""},
+	Payload:       whereHelperstring{field: "\"dead_letter_messages\".\"payload\""},
+	Headers:       whereHelpertypes_JSON{field: "\"dead_letter_messages\".\"headers\""},
+	Error:         whereHelpernull_String{field: "\"dead_letter_messages\".\"error\""},
+	RetryAttempts: whereHelperint{field: "\"dead_letter_messages\".\"retry_attempts\""},
+	Status:        whereHelperDeadletterstatus{field: "\"dead_letter_messages\".\"status\""},
+	ReplayedAt:    whereHelpernull_Time{field: "\"dead_letter_messages\".\"replayed_at\""},
+	CreatedBy:     whereHelperint{field: "\"dead_letter_messages\".\"created_by\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"dead_letter_messages\".\"created_at\""},
+	UpdatedBy:     whereHelperint{field: "\"dead_letter_messages\".\"updated_by\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"dead_letter_messages\".\"updated_at\""},
+	DeletedBy:     whereHelpernull_Int{field: "\"dead_letter_messages\".\"deleted_by\""},
+	DeletedAt:     whereHelpernull_Time{field: "\"dead_letter_messages\".\"deleted_at\""},
+}
+
+// DeadLetterMessageRels is where relationship names are stored.
+var DeadLetterMessageRels = struct {
+}{}
+
+// deadLetterMessageR is where relationships are stored.
+type deadLetterMessageR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*deadLetterMessageR) NewStruct() *deadLetterMessageR {
+	return &deadLetterMessageR{}
+}
+
+// deadLetterMessageL is where Load methods for each relationship are stored.
+type deadLetterMessageL struct{}
+
+var (
+	deadLetterMessageAllColumns            = []string{"id", "topic", "partition", "message_offset", "original_topic", "message_key", "payload", "headers", "error", "retry_attempts", "status", "replayed_at", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	deadLetterMessageColumnsWithoutDefault = []string{"topic", "partition", "message_offset", "original_topic", "message_key", "payload"}
+	deadLetterMessageColumnsWithDefault    = []string{"id", "headers", "error", "retry_attempts", "status", "replayed_at", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	deadLetterMessagePrimaryKeyColumns     = []string{"id"}
+	deadLetterMessageGeneratedColumns      = []string{}
+)
+
+type (
+	// DeadLetterMessageSlice is an alias for a slice of pointers to DeadLetterMessage.
+	// This should almost always be used instead of []DeadLetterMessage.
+	DeadLetterMessageSlice []*DeadLetterMessage
+	// DeadLetterMessageHook is the signature for custom DeadLetterMessage hook methods
+	DeadLetterMessageHook func(context.Context, boil.ContextExecutor, *DeadLetterMessage) error
+
+	deadLetterMessageQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	deadLetterMessageType                 = reflect.TypeOf(&DeadLetterMessage{})
+	deadLetterMessageMapping              = queries.MakeStructMapping(deadLetterMessageType)
+	deadLetterMessagePrimaryKeyMapping, _ = queries.BindMapping(deadLetterMessageType, deadLetterMessageMapping, deadLetterMessagePrimaryKeyColumns)
+	deadLetterMessageInsertCacheMut       sync.RWMutex
+	deadLetterMessageInsertCache          = make(map[string]insertCache)
+	deadLetterMessageUpdateCacheMut       sync.RWMutex
+	deadLetterMessageUpdateCache          = make(map[string]updateCache)
+	deadLetterMessageUpsertCacheMut       sync.RWMutex
+	deadLetterMessageUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var deadLetterMessageAfterSelectMu sync.Mutex
+var deadLetterMessageAfterSelectHooks []DeadLetterMessageHook
+
+var deadLetterMessageBeforeInsertMu sync.Mutex
+var deadLetterMessageBeforeInsertHooks []DeadLetterMessageHook
+var deadLetterMessageAfterInsertMu sync.Mutex
+var deadLetterMessageAfterInsertHooks []DeadLetterMessageHook
+
+var deadLetterMessageBeforeUpdateMu sync.Mutex
+var deadLetterMessageBeforeUpdateHooks []DeadLetterMessageHook
+var deadLetterMessageAfterUpdateMu sync.Mutex
+var deadLetterMessageAfterUpdateHooks []DeadLetterMessageHook
+
+var deadLetterMessageBeforeDeleteMu sync.Mutex
+var deadLetterMessageBeforeDeleteHooks []DeadLetterMessageHook
+var deadLetterMessageAfterDeleteMu sync.Mutex
+var deadLetterMessageAfterDeleteHooks []DeadLetterMessageHook
+
+var deadLetterMessageBeforeUpsertMu sync.Mutex
+var deadLetterMessageBeforeUpsertHooks []DeadLetterMessageHook
+var deadLetterMessageAfterUpsertMu sync.Mutex
+var deadLetterMessageAfterUpsertHooks []DeadLetterMessageHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DeadLetterMessage) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deadLetterMessageAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DeadLetterMessage) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deadLetterMessageBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DeadLetterMessage) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deadLetterMessageAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DeadLetterMessage) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deadLetterMessageBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DeadLetterMessage) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deadLetterMessageAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DeadLetterMessage) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deadLetterMessageBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DeadLetterMessage) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deadLetterMessageAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DeadLetterMessage) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deadLetterMessageBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DeadLetterMessage) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deadLetterMessageAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDeadLetterMessageHook registers your hook function for all future operations.
+func AddDeadLetterMessageHook(hookPoint boil.HookPoint, deadLetterMessageHook DeadLetterMessageHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		deadLetterMessageAfterSelectMu.Lock()
+		deadLetterMessageAfterSelectHooks = append(deadLetterMessageAfterSelectHooks, deadLetterMessageHook)
+		deadLetterMessageAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		deadLetterMessageBeforeInsertMu.Lock()
+		deadLetterMessageBeforeInsertHooks = append(deadLetterMessageBeforeInsertHooks, deadLetterMessageHook)
+		deadLetterMessageBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		deadLetterMessageAfterInsertMu.Lock()
+		deadLetterMessageAfterInsertHooks = append(deadLetterMessageAfterInsertHooks, deadLetterMessageHook)
+		deadLetterMessageAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		deadLetterMessageBeforeUpdateMu.Lock()
+		deadLetterMessageBeforeUpdateHooks = append(deadLetterMessageBeforeUpdateHooks, deadLetterMessageHook)
+		deadLetterMessageBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		deadLetterMessageAfterUpdateMu.Lock()
+		deadLetterMessageAfterUpdateHooks = append(deadLetterMessageAfterUpdateHooks, deadLetterMessageHook)
+		deadLetterMessageAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		deadLetterMessageBeforeDeleteMu.Lock()
+		deadLetterMessageBeforeDeleteHooks = append(deadLetterMessageBeforeDeleteHooks, deadLetterMessageHook)
+		deadLetterMessageBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		deadLetterMessageAfterDeleteMu.Lock()
+		deadLetterMessageAfterDeleteHooks = append(deadLetterMessageAfterDeleteHooks, deadLetterMessageHook)
+		deadLetterMessageAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		deadLetterMessageBeforeUpsertMu.Lock()
+		deadLetterMessageBeforeUpsertHooks = append(deadLetterMessageBeforeUpsertHooks, deadLetterMessageHook)
+		deadLetterMessageBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		deadLetterMessageAfterUpsertMu.Lock()
+		deadLetterMessageAfterUpsertHooks = append(deadLetterMessageAfterUpsertHooks, deadLetterMessageHook)
+		deadLetterMessageAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single deadLetterMessage record from the query using the global executor.
+func (q deadLetterMessageQuery) OneG(ctx context.Context) (*DeadLetterMessage, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single deadLetterMessage record from the query.
+func (q deadLetterMessageQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DeadLetterMessage, error) {
+	o := &DeadLetterMessage{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for dead_letter_messages")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all DeadLetterMessage records from the query using the global executor.
+func (q deadLetterMessageQuery) AllG(ctx context.Context) (DeadLetterMessageSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all DeadLetterMessage records from the query.
+func (q deadLetterMessageQuery) All(ctx context.Context, exec boil.ContextExecutor) (DeadLetterMessageSlice, error) {
+	var o []*DeadLetterMessage
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to DeadLetterMessage slice")
+	}
+
+	if len(deadLetterMessageAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all DeadLetterMessage records in the query using the global executor
+func (q deadLetterMessageQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all DeadLetterMessage records in the query.
+func (q deadLetterMessageQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count dead_letter_messages rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q deadLetterMessageQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q deadLetterMessageQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if dead_letter_messages exists")
+	}
+
+	return count > 0, nil
+}
+
+// DeadLetterMessages retrieves all the records using an executor.
+func DeadLetterMessages(mods ...qm.QueryMod) deadLetterMessageQuery {
+	mods = append(mods, qm.From("\"dead_letter_messages\""), qmhelper.WhereIsNull("\"dead_letter_messages\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"dead_letter_messages\".*"})
+	}
+
+	return deadLetterMessageQuery{q}
+}
+
+// FindDeadLetterMessageG retrieves a single record by ID.
+func FindDeadLetterMessageG(ctx context.Context, iD int, selectCols ...string) (*DeadLetterMessage, error) {
+	return FindDeadLetterMessage(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindDeadLetterMessage retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDeadLetterMessage(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DeadLetterMessage, error) {
+	deadLetterMessageObj := &DeadLetterMessage{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"dead_letter_messages\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, deadLetterMessageObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from dead_letter_messages")
+	}
+
+	if err = deadLetterMessageObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return deadLetterMessageObj, err
+	}
+
+	return deadLetterMessageObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *DeadLetterMessage) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DeadLetterMessage) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no dead_letter_messages provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(deadLetterMessageColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	deadLetterMessageInsertCacheMut.RLock()
+	cache, cached := deadLetterMessageInsertCache[key]
+	deadLetterMessageInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			deadLetterMessageAllColumns,
+			deadLetterMessageColumnsWithDefault,
+			deadLetterMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(deadLetterMessageType, deadLetterMessageMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(deadLetterMessageType, deadLetterMessageMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"dead_letter_messages\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"dead_letter_messages\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into dead_letter_messages")
+	}
+
+	if !cached {
+		deadLetterMessageInsertCacheMut.Lock()
+		deadLetterMessageInsertCache[key] = cache
+		deadLetterMessageInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single DeadLetterMessage record using the global executor.
+// See Update for more documentation.
+func (o *DeadLetterMessage) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the DeadLetterMessage.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DeadLetterMessage) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	deadLetterMessageUpdateCacheMut.RLock()
+	cache, cached := deadLetterMessageUpdateCache[key]
+	deadLetterMessageUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			deadLetterMessageAllColumns,
+			deadLetterMessagePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update dead_letter_messages, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"dead_letter_messages\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, deadLetterMessagePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(deadLetterMessageType, deadLetterMessageMapping, append(wl, deadLetterMessagePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update dead_letter_messages row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for dead_letter_messages")
+	}
+
+	if !cached {
+		deadLetterMessageUpdateCacheMut.Lock()
+		deadLetterMessageUpdateCache[key] = cache
+		deadLetterMessageUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q deadLetterMessageQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q deadLetterMessageQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for dead_letter_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for dead_letter_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o DeadLetterMessageSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DeadLetterMessageSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deadLetterMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"dead_letter_messages\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, deadLetterMessagePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in deadLetterMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all deadLetterMessage")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *DeadLetterMessage) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DeadLetterMessage) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no dead_letter_messages provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(deadLetterMessageColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	deadLetterMessageUpsertCacheMut.RLock()
+	cache, cached := deadLetterMessageUpsertCache[key]
+	deadLetterMessageUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			deadLetterMessageAllColumns,
+			deadLetterMessageColumnsWithDefault,
+			deadLetterMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			deadLetterMessageAllColumns,
+			deadLetterMessagePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert dead_letter_messages, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(deadLetterMessageAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(deadLetterMessagePrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert dead_letter_messages, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(deadLetterMessagePrimaryKeyColumns))
+			copy(conflict, deadLetterMessagePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"dead_letter_messages\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(deadLetterMessageType, deadLetterMessageMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(deadLetterMessageType, deadLetterMessageMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert dead_letter_messages")
+	}
+
+	if !cached {
+		deadLetterMessageUpsertCacheMut.Lock()
+		deadLetterMessageUpsertCache[key] = cache
+		deadLetterMessageUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single DeadLetterMessage record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *DeadLetterMessage) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single DeadLetterMessage record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DeadLetterMessage) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no DeadLetterMessage provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), deadLetterMessagePrimaryKeyMapping)
+		sql = "DELETE FROM \"dead_letter_messages\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"dead_letter_messages\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(deadLetterMessageType, deadLetterMessageMapping, append(wl, deadLetterMessagePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from dead_letter_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for dead_letter_messages")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q deadLetterMessageQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q deadLetterMessageQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no deadLetterMessageQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from dead_letter_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for dead_letter_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o DeadLetterMessageSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DeadLetterMessageSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(deadLetterMessageBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deadLetterMessagePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"dead_letter_messages\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, deadLetterMessagePrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deadLetterMessagePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"dead_letter_messages\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, deadLetterMessagePrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from deadLetterMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for dead_letter_messages")
+	}
+
+	if len(deadLetterMessageAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *DeadLetterMessage) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no DeadLetterMessage provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DeadLetterMessage) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDeadLetterMessage(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DeadLetterMessageSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty DeadLetterMessageSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DeadLetterMessageSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DeadLetterMessageSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deadLetterMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"dead_letter_messages\".* FROM \"dead_letter_messages\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, deadLetterMessagePrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in DeadLetterMessageSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DeadLetterMessageExistsG checks if the DeadLetterMessage row exists.
+func DeadLetterMessageExistsG(ctx context.Context, iD int) (bool, error) {
+	return DeadLetterMessageExists(ctx, boil.GetContextDB(), iD)
+}
+
+// DeadLetterMessageExists checks if the DeadLetterMessage row exists.
+func DeadLetterMessageExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"dead_letter_messages\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if dead_letter_messages exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DeadLetterMessage row exists.
+func (o *DeadLetterMessage) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DeadLetterMessageExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1564 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// FeeRule is an object representing the database table.
+type FeeRule struct {
+	ID                int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name              string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	SourceBankID      null.Int    `boil:"source_bank_id" json:"source_bank_id,omitempty" toml:"source_bank_id" yaml:"source_bank_id,omitempty"`
+	DestinationBankID null.Int    `boil:"destination_bank_id" json:"destination_bank_id,omitempty" toml:"destination_bank_id" yaml:"destination_bank_id,omitempty"`
+	Plan              null.String `boil:"plan" json:"plan,omitempty" toml:"plan" yaml:"plan,omitempty"`
+	Currency          string      `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	MinAmount         int64       `boil:"min_amount" json:"min_amount" toml:"min_amount" yaml:"min_amount"`
+	MaxAmount         null.Int64  `boil:"max_amount" json:"max_amount,omitempty" toml:"max_amount" yaml:"max_amount,omitempty"`
+	FeeType           Feetype     `boil:"fee_type" json:"fee_type" toml:"fee_type" yaml:"fee_type"`
+	FlatAmount        int64       `boil:"flat_amount" json:"flat_amount" toml:"flat_amount" yaml:"flat_amount"`
+	PercentageBPS     int         `boil:"percentage_bps" json:"percentage_bps" toml:"percentage_bps" yaml:"percentage_bps"`
+	MinFee            null.Int64  `boil:"min_fee" json:"min_fee,omitempty" toml:"min_fee" yaml:"min_fee,omitempty"`
+	MaxFee            null.Int64  `boil:"max_fee" json:"max_fee,omitempty" toml:"max_fee" yaml:"max_fee,omitempty"`
+	CreatedBy         int         `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy         int         `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy         null.Int    `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt         null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *feeRuleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L feeRuleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FeeRuleColumns = struct {
+	ID                string
+	Name              string
+	SourceBankID      string
+	DestinationBankID string
+	Plan              string
+	Currency          string
+	MinAmount         string
+	MaxAmount         string
+	FeeType           string
+	FlatAmount        string
+	PercentageBPS     string
+	MinFee            string
+	MaxFee            string
+	CreatedBy         string
+	CreatedAt         string
+	UpdatedBy         string
+	UpdatedAt         string
+	DeletedBy         string
+	DeletedAt         string
+}{
+	ID:                "id",
+	Name:              "name",
+	SourceBankID:      "source_bank_id",
+	DestinationBankID: "destination_bank_id",
+	Plan:              "plan",
+	Currency:          "currency",
+	MinAmount:         "min_amount",
+	MaxAmount:         "max_amount",
+	FeeType:           "fee_type",
+	FlatAmount:        "flat_amount",
+	PercentageBPS:     "percentage_bps",
+	MinFee:            "min_fee",
+	MaxFee:            "max_fee",
+	CreatedBy:         "created_by",
+	CreatedAt:         "created_at",
+	UpdatedBy:         "updated_by",
+	UpdatedAt:         "updated_at",
+	DeletedBy:         "deleted_by",
+	DeletedAt:         "deleted_at",
+}
+
+var FeeRuleTableColumns = struct {
+	ID                string
+	Name              string
+	SourceBankID      string
+	DestinationBankID string
+	Plan              string
+	Currency          string
+	MinAmount         string
+	MaxAmount         string
+	FeeType           string
+	FlatAmount        string
+	PercentageBPS     string
+	MinFee            string
+	MaxFee            string
+	CreatedBy         string
+	CreatedAt         string
+	UpdatedBy         string
+	UpdatedAt         string
+	DeletedBy         string
+	DeletedAt         string
+}{
+	ID:                "fee_rules.id",
+	Name:              "fee_rules.name",
+	SourceBankID:      "fee_rules.source_bank_id",
+	DestinationBankID: "fee_rules.destination_bank_id",
+	Plan:              "fee_rules.plan",
+	Currency:          "fee_rules.currency",
+	MinAmount:         "fee_rules.min_amount",
+	MaxAmount:         "fee_rules.max_amount",
+	FeeType:           "fee_rules.fee_type",
+	FlatAmount:        "fee_rules.flat_amount",
+	PercentageBPS:     "fee_rules.percentage_bps",
+	MinFee:            "fee_rules.min_fee",
+	MaxFee:            "fee_rules.max_fee",
+	CreatedBy:         "fee_rules.created_by",
+	CreatedAt:         "fee_rules.created_at",
+	UpdatedBy:         "fee_rules.updated_by",
+	UpdatedAt:         "fee_rules.updated_at",
+	DeletedBy:         "fee_rules.deleted_by",
+	DeletedAt:         "fee_rules.deleted_at",
+}
+
+// Generated where
+
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelperFeetype struct{ field string }
+
+func (w whereHelperFeetype) EQ(x Feetype) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperFeetype) NEQ(x Feetype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperFeetype) LT(x Feetype) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperFeetype) LTE(x Feetype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperFeetype) GT(x Feetype) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperFeetype) GTE(x Feetype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperFeetype) IN(slice []Feetype) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperFeetype) NIN(slice []Feetype) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var FeeRuleWhere = struct {
+	ID                whereHelperint
+	Name              whereHelperstring
+	SourceBankID      whereHelpernull_Int
+	DestinationBankID whereHelpernull_Int
+	Plan              whereHelpernull_String
+	Currency          whereHelperstring
+	MinAmount         whereHelperint64
+	MaxAmount         whereHelpernull_Int64
+	FeeType           whereHelperFeetype
+	FlatAmount        whereHelperint64
+	PercentageBPS     whereHelperint
+	MinFee            whereHelpernull_Int64
+	MaxFee            whereHelpernull_Int64
+	CreatedBy         whereHelperint
+	CreatedAt         whereHelpertime_Time
+	UpdatedBy         whereHelperint
+	UpdatedAt         whereHelpertime_Time
+	DeletedBy         whereHelpernull_Int
+	DeletedAt         whereHelpernull_Time
+}{
+	ID:                whereHelperint{field: "\"fee_rules\".\"id\""},
+	Name:              whereHelperstring{field: "\"fee_rules\".\"name\""},
+	SourceBankID:      whereHelpernull_Int{field: "\"fee_rules\".\"source_bank_id\""},
+	DestinationBankID: whereHelpernull_Int{field: "\"fee_rules\".\"destination_bank_id\""},
+	Plan:              whereHelpernull_String{field: "\"fee_rules\".\"plan\""},
+	Currency:          whereHelperstring{field: "\"fee_rules\".\"currency\""},
+	MinAmount:         whereHelperint64{field: "\"fee_rules\".\"min_amount\""},
+	MaxAmount:         whereHelpernull_Int64{field: "\"fee_rules\".\"max_amount\""},
+	FeeType:           whereHelperFeetype{field: "\"fee_rules\".\"fee_type\""},
+	FlatAmount:        whereHelperint64{field: "\"fee_rules\".\"flat_amount\""},
+	PercentageBPS:     whereHelperint{field: "\"fee_rules\".\"percentage_bps\""},
+	MinFee:            whereHelpernull_Int64{field: "\"fee_rules\".\"min_fee\""},
+	MaxFee:            whereHelpernull_Int64{field: "\"fee_rules\".\"max_fee\""},
+	CreatedBy:         whereHelperint{field: "\"fee_rules\".\"created_by\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"fee_rules\".\"created_at\""},
+	UpdatedBy:         whereHelperint{field: "\"fee_rules\".\"updated_by\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"fee_rules\".\"updated_at\""},
+	DeletedBy:         whereHelpernull_Int{field: "\"fee_rules\".\"deleted_by\""},
+	DeletedAt:         whereHelpernull_Time{field: "\"fee_rules\".\"deleted_at\""},
+}
+
+// FeeRuleRels is where relationship names are stored.
+var FeeRuleRels = struct {
+	TransferJobs string
+}{
+	TransferJobs: "TransferJobs",
+}
+
+// feeRuleR is where relationships are stored.
+type feeRuleR struct {
+	TransferJobs TransferJobSlice `boil:"TransferJobs" json:"TransferJobs" toml:"TransferJobs" yaml:"TransferJobs"`
+}
+
+// NewStruct creates a new relationship struct
+func (*feeRuleR) NewStruct() *feeRuleR {
+	return &feeRuleR{}
+}
+
+func (r *feeRuleR) GetTransferJobs() TransferJobSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TransferJobs
+}
+
+// feeRuleL is where Load methods for each relationship are stored.
+type feeRuleL struct{}
+
+var (
+	feeRuleAllColumns            = []string{"id", "name", "source_bank_id", "destination_bank_id", "plan", "currency", "min_amount", "max_amount", "fee_type", "flat_amount", "percentage_bps", "min_fee", "max_fee", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	feeRuleColumnsWithoutDefault = []string{"name", "currency", "fee_type"}
+	feeRuleColumnsWithDefault    = []string{"id", "source_bank_id", "destination_bank_id", "plan", "min_amount", "max_amount", "flat_amount", "percentage_bps", "min_fee", "max_fee", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	feeRulePrimaryKeyColumns     = []string{"id"}
+	feeRuleGeneratedColumns      = []string{}
+)
+
+type (
+	// FeeRuleSlice is an alias for a slice of pointers to FeeRule.
+	// This should almost always be used instead of []FeeRule.
+	FeeRuleSlice []*FeeRule
+	// FeeRuleHook is the signature for custom FeeRule hook methods
+	FeeRuleHook func(context.Context, boil.ContextExecutor, *FeeRule) error
+
+	feeRuleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	feeRuleType                 = reflect.TypeOf(&FeeRule{})
+	feeRuleMapping              = queries.MakeStructMapping(feeRuleType)
+	feeRulePrimaryKeyMapping, _ = queries.BindMapping(feeRuleType, feeRuleMapping, feeRulePrimaryKeyColumns)
+	feeRuleInsertCacheMut       sync.RWMutex
+	feeRuleInsertCache          = make(map[string]insertCache)
+	feeRuleUpdateCacheMut       sync.RWMutex
+	feeRuleUpdateCache          = make(map[string]updateCache)
+	feeRuleUpsertCacheMut       sync.RWMutex
+	feeRuleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var feeRuleAfterSelectMu sync.Mutex
+var feeRuleAfterSelectHooks []FeeRuleHook
+
+var feeRuleBeforeInsertMu sync.Mutex
+var feeRuleBeforeInsertHooks []FeeRuleHook
+var feeRuleAfterInsertMu sync.Mutex
+var feeRuleAfterInsertHooks []FeeRuleHook
+
+var feeRuleBeforeUpdateMu sync.Mutex
+var feeRuleBeforeUpdateHooks []FeeRuleHook
+var feeRuleAfterUpdateMu sync.Mutex
+var feeRuleAfterUpdateHooks []FeeRuleHook
+
+var feeRuleBeforeDeleteMu sync.Mutex
+var feeRuleBeforeDeleteHooks []FeeRuleHook
+var feeRuleAfterDeleteMu sync.Mutex
+var feeRuleAfterDeleteHooks []FeeRuleHook
+
+var feeRuleBeforeUpsertMu sync.Mutex
+var feeRuleBeforeUpsertHooks []FeeRuleHook
+var feeRuleAfterUpsertMu sync.Mutex
+var feeRuleAfterUpsertHooks []FeeRuleHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FeeRule) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range feeRuleAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FeeRule) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range feeRuleBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FeeRule) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range feeRuleAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FeeRule) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range feeRuleBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FeeRule) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range feeRuleAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FeeRule) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range feeRuleBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FeeRule) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range feeRuleAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FeeRule) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range feeRuleBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FeeRule) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range feeRuleAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFeeRuleHook registers your hook function for all future operations.
+func AddFeeRuleHook(hookPoint boil.HookPoint, feeRuleHook FeeRuleHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		feeRuleAfterSelectMu.Lock()
+		feeRuleAfterSelectHooks = append(feeRuleAfterSelectHooks, feeRuleHook)
+		feeRuleAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		feeRuleBeforeInsertMu.Lock()
+		feeRuleBeforeInsertHooks = append(feeRuleBeforeInsertHooks, feeRuleHook)
+		feeRuleBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		feeRuleAfterInsertMu.Lock()
+		feeRuleAfterInsertHooks = append(feeRuleAfterInsertHooks, feeRuleHook)
+		feeRuleAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		feeRuleBeforeUpdateMu.Lock()
+		feeRuleBeforeUpdateHooks = append(feeRuleBeforeUpdateHooks, feeRuleHook)
+		feeRuleBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		feeRuleAfterUpdateMu.Lock()
+		feeRuleAfterUpdateHooks = append(feeRuleAfterUpdateHooks, feeRuleHook)
+		feeRuleAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		feeRuleBeforeDeleteMu.Lock()
+		feeRuleBeforeDeleteHooks = append(feeRuleBeforeDeleteHooks, feeRuleHook)
+		feeRuleBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		feeRuleAfterDeleteMu.Lock()
+		feeRuleAfterDeleteHooks = append(feeRuleAfterDeleteHooks, feeRuleHook)
+		feeRuleAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		feeRuleBeforeUpsertMu.Lock()
+		feeRuleBeforeUpsertHooks = append(feeRuleBeforeUpsertHooks, feeRuleHook)
+		feeRuleBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		feeRuleAfterUpsertMu.Lock()
+		feeRuleAfterUpsertHooks = append(feeRuleAfterUpsertHooks, feeRuleHook)
+		feeRuleAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single feeRule record from the query using the global executor.
+func (q feeRuleQuery) OneG(ctx context.Context) (*FeeRule, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single feeRule record from the query.
+func (q feeRuleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FeeRule, error) {
+	o := &FeeRule{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for fee_rules")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all FeeRule records from the query using the global executor.
+func (q feeRuleQuery) AllG(ctx context.Context) (FeeRuleSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all FeeRule records from the query.
+func (q feeRuleQuery) All(ctx context.Context, exec boil.ContextExecutor) (FeeRuleSlice, error) {
+	var o []*FeeRule
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to FeeRule slice")
+	}
+
+	if len(feeRuleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all FeeRule records in the query using the global executor
+func (q feeRuleQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all FeeRule records in the query.
+func (q feeRuleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count fee_rules rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q feeRuleQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q feeRuleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if fee_rules exists")
+	}
+
+	return count > 0, nil
+}
+
+// TransferJobs retrieves all the transfer_job's TransferJobs with an executor.
+func (o *FeeRule) TransferJobs(mods ...qm.QueryMod) transferJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transfer_jobs\".\"fee_rule_id\"=?", o.ID),
+	)
+
+	return TransferJobs(queryMods...)
+}
+
+// LoadTransferJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (feeRuleL) LoadTransferJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFeeRule interface{}, mods queries.Applicator) error {
+	var slice []*FeeRule
+	var object *FeeRule
+
+	if singular {
+		var ok bool
+		object, ok = maybeFeeRule.(*FeeRule)
+		if !ok {
+			object = new(FeeRule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFeeRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFeeRule))
+			}
+		}
+	} else {
+		s, ok := maybeFeeRule.(*[]*FeeRule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFeeRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFeeRule))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &feeRuleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &feeRuleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transfer_jobs`),
+		qm.WhereIn(`transfer_jobs.fee_rule_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`transfer_jobs.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transfer_jobs")
+	}
+
+	var resultSlice []*TransferJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transfer_jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transfer_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer_jobs")
+	}
+
+	if len(transferJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TransferJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transferJobR{}
+			}
+			foreign.R.FeeRule = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.FeeRuleID) {
+				local.R.TransferJobs = append(local.R.TransferJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &transferJobR{}
+				}
+				foreign.R.FeeRule = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddTransferJobsG adds the given related objects to the existing relationships
+// of the fee_rule, optionally inserting them as new records.
+// Appends related to o.R.TransferJobs.
+// Sets related.R.FeeRule appropriately.
+// Uses the global database handle.
+func (o *FeeRule) AddTransferJobsG(ctx context.Context, insert bool, related ...*TransferJob) error {
+	return o.AddTransferJobs(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddTransferJobs adds the given related objects to the existing relationships
+// of the fee_rule, optionally inserting them as new records.
+// Appends related to o.R.TransferJobs.
+// Sets related.R.FeeRule appropriately.
+func (o *FeeRule) AddTransferJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TransferJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.FeeRuleID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transfer_jobs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"fee_rule_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transferJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.FeeRuleID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &feeRuleR{
+			TransferJobs: related,
+		}
+	} else {
+		o.R.TransferJobs = append(o.R.TransferJobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transferJobR{
+				FeeRule: o,
+			}
+		} else {
+			rel.R.FeeRule = o
+		}
+	}
+	return nil
+}
+
+// SetTransferJobsG removes all previously related items of the
+// fee_rule replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.FeeRule's TransferJobs accordingly.
+// Replaces o.R.TransferJobs with related.
+// Sets related.R.FeeRule's TransferJobs accordingly.
+// Uses the global database handle.
+func (o *FeeRule) SetTransferJobsG(ctx context.Context, insert bool, related ...*TransferJob) error {
+	return o.SetTransferJobs(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetTransferJobs removes all previously related items of the
+// fee_rule replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.FeeRule's TransferJobs accordingly.
+// Replaces o.R.TransferJobs with related.
+// Sets related.R.FeeRule's TransferJobs accordingly.
+func (o *FeeRule) SetTransferJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TransferJob) error {
+	query := "update \"transfer_jobs\" set \"fee_rule_id\" = null where \"fee_rule_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TransferJobs {
+			queries.SetScanner(&rel.FeeRuleID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.FeeRule = nil
+		}
+		o.R.TransferJobs = nil
+	}
+
+	return o.AddTransferJobs(ctx, exec, insert, related...)
+}
+
+// RemoveTransferJobsG relationships from objects passed in.
+// Removes related items from R.TransferJobs (uses pointer comparison, removal does not keep order)
+// Sets related.R.FeeRule.
+// Uses the global database handle.
+func (o *FeeRule) RemoveTransferJobsG(ctx context.Context, related ...*TransferJob) error {
+	return o.RemoveTransferJobs(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveTransferJobs relationships from objects passed in.
+// Removes related items from R.TransferJobs (uses pointer comparison, removal does not keep order)
+// Sets related.R.FeeRule.
+func (o *FeeRule) RemoveTransferJobs(ctx context.Context, exec boil.ContextExecutor, related ...*TransferJob) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FeeRuleID, nil)
+		if rel.R != nil {
+			rel.R.FeeRule = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("fee_rule_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TransferJobs {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TransferJobs)
+			if ln > 1 && i < ln-1 {
+				o.R.TransferJobs[i] = o.R.TransferJobs[ln-1]
+			}
+			o.R.TransferJobs = o.R.TransferJobs[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// FeeRules retrieves all the records using an executor.
+func FeeRules(mods ...qm.QueryMod) feeRuleQuery {
+	mods = append(mods, qm.From("\"fee_rules\""), qmhelper.WhereIsNull("\"fee_rules\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"fee_rules\".*"})
+	}
+
+	return feeRuleQuery{q}
+}
+
+// FindFeeRuleG retrieves a single record by ID.
+func FindFeeRuleG(ctx context.Context, iD int, selectCols ...string) (*FeeRule, error) {
+	return FindFeeRule(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindFeeRule retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFeeRule(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*FeeRule, error) {
+	feeRuleObj := &FeeRule{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"fee_rules\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, feeRuleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from fee_rules")
+	}
+
+	if err = feeRuleObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return feeRuleObj, err
+	}
+
+	return feeRuleObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *FeeRule) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FeeRule) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no fee_rules provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(feeRuleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	feeRuleInsertCacheMut.RLock()
+	cache, cached := feeRuleInsertCache[key]
+	feeRuleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			feeRuleAllColumns,
+			feeRuleColumnsWithDefault,
+			feeRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(feeRuleType, feeRuleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(feeRuleType, feeRuleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"fee_rules\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"fee_rules\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into fee_rules")
+	}
+
+	if !cached {
+		feeRuleInsertCacheMut.Lock()
+		feeRuleInsertCache[key] = cache
+		feeRuleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single FeeRule record using the global executor.
+// See Update for more documentation.
+func (o *FeeRule) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the FeeRule.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FeeRule) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	feeRuleUpdateCacheMut.RLock()
+	cache, cached := feeRuleUpdateCache[key]
+	feeRuleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			feeRuleAllColumns,
+			feeRulePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update fee_rules, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"fee_rules\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, feeRulePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(feeRuleType, feeRuleMapping, append(wl, feeRulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update fee_rules row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for fee_rules")
+	}
+
+	if !cached {
+		feeRuleUpdateCacheMut.Lock()
+		feeRuleUpdateCache[key] = cache
+		feeRuleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q feeRuleQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q feeRuleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for fee_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for fee_rules")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o FeeRuleSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FeeRuleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), feeRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"fee_rules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, feeRulePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in feeRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all feeRule")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *FeeRule) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FeeRule) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no fee_rules provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(feeRuleColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	feeRuleUpsertCacheMut.RLock()
+	cache, cached := feeRuleUpsertCache[key]
+	feeRuleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			feeRuleAllColumns,
+			feeRuleColumnsWithDefault,
+			feeRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			feeRuleAllColumns,
+			feeRulePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert fee_rules, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(feeRuleAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(feeRulePrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert fee_rules, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(feeRulePrimaryKeyColumns))
+			copy(conflict, feeRulePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"fee_rules\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(feeRuleType, feeRuleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(feeRuleType, feeRuleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert fee_rules")
+	}
+
+	if !cached {
+		feeRuleUpsertCacheMut.Lock()
+		feeRuleUpsertCache[key] = cache
+		feeRuleUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single FeeRule record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *FeeRule) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single FeeRule record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FeeRule) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no FeeRule provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), feeRulePrimaryKeyMapping)
+		sql = "DELETE FROM \"fee_rules\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"fee_rules\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(feeRuleType, feeRuleMapping, append(wl, feeRulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from fee_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for fee_rules")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q feeRuleQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q feeRuleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no feeRuleQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from fee_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for fee_rules")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o FeeRuleSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FeeRuleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(feeRuleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), feeRulePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"fee_rules\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, feeRulePrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), feeRulePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"fee_rules\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, feeRulePrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from feeRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for fee_rules")
+	}
+
+	if len(feeRuleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *FeeRule) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no FeeRule provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FeeRule) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFeeRule(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FeeRuleSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty FeeRuleSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FeeRuleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FeeRuleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), feeRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"fee_rules\".* FROM \"fee_rules\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, feeRulePrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in FeeRuleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FeeRuleExistsG checks if the FeeRule row exists.
+func FeeRuleExistsG(ctx context.Context, iD int) (bool, error) {
+	return FeeRuleExists(ctx, boil.GetContextDB(), iD)
+}
+
+// FeeRuleExists checks if the FeeRule row exists.
+func FeeRuleExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"fee_rules\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if fee_rules exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the FeeRule row exists.
+func (o *FeeRule) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return FeeRuleExists(ctx, exec, o.ID)
+}
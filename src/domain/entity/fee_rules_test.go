@@ -0,0 +1,1161 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFeeRules(t *testing.T) {
+	t.Parallel()
+
+	query := FeeRules()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFeeRulesSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFeeRulesQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FeeRules().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFeeRulesSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FeeRuleSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFeeRulesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFeeRulesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FeeRules().DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFeeRulesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FeeRuleSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFeeRulesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FeeRuleExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if FeeRule exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FeeRuleExists to return true, but got false.")
+	}
+}
+
+func testFeeRulesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	feeRuleFound, err := FindFeeRule(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if feeRuleFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFeeRulesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FeeRules().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFeeRulesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FeeRules().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFeeRulesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	feeRuleOne := &FeeRule{}
+	feeRuleTwo := &FeeRule{}
+	if err = randomize.Struct(seed, feeRuleOne, feeRuleDBTypes, false, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+	if err = randomize.Struct(seed, feeRuleTwo, feeRuleDBTypes, false, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = feeRuleOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = feeRuleTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FeeRules().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFeeRulesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	feeRuleOne := &FeeRule{}
+	feeRuleTwo := &FeeRule{}
+	if err = randomize.Struct(seed, feeRuleOne, feeRuleDBTypes, false, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+	if err = randomize.Struct(seed, feeRuleTwo, feeRuleDBTypes, false, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = feeRuleOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = feeRuleTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func feeRuleBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FeeRule) error {
+	*o = FeeRule{}
+	return nil
+}
+
+func feeRuleAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FeeRule) error {
+	*o = FeeRule{}
+	return nil
+}
+
+func feeRuleAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FeeRule) error {
+	*o = FeeRule{}
+	return nil
+}
+
+func feeRuleBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FeeRule) error {
+	*o = FeeRule{}
+	return nil
+}
+
+func feeRuleAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FeeRule) error {
+	*o = FeeRule{}
+	return nil
+}
+
+func feeRuleBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FeeRule) error {
+	*o = FeeRule{}
+	return nil
+}
+
+func feeRuleAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FeeRule) error {
+	*o = FeeRule{}
+	return nil
+}
+
+func feeRuleBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FeeRule) error {
+	*o = FeeRule{}
+	return nil
+}
+
+func feeRuleAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FeeRule) error {
+	*o = FeeRule{}
+	return nil
+}
+
+func testFeeRulesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FeeRule{}
+	o := &FeeRule{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FeeRule object: %s", err)
+	}
+
+	AddFeeRuleHook(boil.BeforeInsertHook, feeRuleBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	feeRuleBeforeInsertHooks = []FeeRuleHook{}
+
+	AddFeeRuleHook(boil.AfterInsertHook, feeRuleAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	feeRuleAfterInsertHooks = []FeeRuleHook{}
+
+	AddFeeRuleHook(boil.AfterSelectHook, feeRuleAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	feeRuleAfterSelectHooks = []FeeRuleHook{}
+
+	AddFeeRuleHook(boil.BeforeUpdateHook, feeRuleBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	feeRuleBeforeUpdateHooks = []FeeRuleHook{}
+
+	AddFeeRuleHook(boil.AfterUpdateHook, feeRuleAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	feeRuleAfterUpdateHooks = []FeeRuleHook{}
+
+	AddFeeRuleHook(boil.BeforeDeleteHook, feeRuleBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	feeRuleBeforeDeleteHooks = []FeeRuleHook{}
+
+	AddFeeRuleHook(boil.AfterDeleteHook, feeRuleAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	feeRuleAfterDeleteHooks = []FeeRuleHook{}
+
+	AddFeeRuleHook(boil.BeforeUpsertHook, feeRuleBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	feeRuleBeforeUpsertHooks = []FeeRuleHook{}
+
+	AddFeeRuleHook(boil.AfterUpsertHook, feeRuleAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	feeRuleAfterUpsertHooks = []FeeRuleHook{}
+}
+
+func testFeeRulesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFeeRulesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(feeRuleColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFeeRuleToManyTransferJobs(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FeeRule
+	var b, c TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transferJobDBTypes, false, transferJobColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferJobDBTypes, false, transferJobColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.FeeRuleID, a.ID)
+	queries.Assign(&c.FeeRuleID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.TransferJobs().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.FeeRuleID, b.FeeRuleID) {
+			bFound = true
+		}
+		if queries.Equal(v.FeeRuleID, c.FeeRuleID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FeeRuleSlice{&a}
+	if err = a.L.LoadTransferJobs(ctx, tx, false, (*[]*FeeRule)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TransferJobs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TransferJobs = nil
+	if err = a.L.LoadTransferJobs(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TransferJobs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFeeRuleToManyAddOpTransferJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FeeRule
+	var b, c, d, e TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, feeRuleDBTypes, false, strmangle.SetComplement(feeRulePrimaryKeyColumns, feeRuleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*TransferJob{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTransferJobs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.FeeRuleID) {
+			t.Error("foreign key was wrong value", a.ID, first.FeeRuleID)
+		}
+		if !queries.Equal(a.ID, second.FeeRuleID) {
+			t.Error("foreign key was wrong value", a.ID, second.FeeRuleID)
+		}
+
+		if first.R.FeeRule != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.FeeRule != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.TransferJobs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.TransferJobs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.TransferJobs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testFeeRuleToManySetOpTransferJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FeeRule
+	var b, c, d, e TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, feeRuleDBTypes, false, strmangle.SetComplement(feeRulePrimaryKeyColumns, feeRuleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetTransferJobs(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.TransferJobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetTransferJobs(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.TransferJobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FeeRuleID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FeeRuleID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.FeeRuleID) {
+		t.Error("foreign key was wrong value", a.ID, d.FeeRuleID)
+	}
+	if !queries.Equal(a.ID, e.FeeRuleID) {
+		t.Error("foreign key was wrong value", a.ID, e.FeeRuleID)
+	}
+
+	if b.R.FeeRule != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.FeeRule != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.FeeRule != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.FeeRule != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.TransferJobs[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.TransferJobs[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testFeeRuleToManyRemoveOpTransferJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FeeRule
+	var b, c, d, e TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, feeRuleDBTypes, false, strmangle.SetComplement(feeRulePrimaryKeyColumns, feeRuleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddTransferJobs(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.TransferJobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveTransferJobs(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.TransferJobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FeeRuleID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FeeRuleID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.FeeRule != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.FeeRule != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.FeeRule != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.FeeRule != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.TransferJobs) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.TransferJobs[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.TransferJobs[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testFeeRulesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFeeRulesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FeeRuleSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFeeRulesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FeeRules().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	feeRuleDBTypes = map[string]string{`ID`: `integer`, `Name`: `character varying`, `SourceBankID`: `integer`, `DestinationBankID`: `integer`, `Plan`: `character varying`, `Currency`: `character varying`, `MinAmount`: `bigint`, `MaxAmount`: `bigint`, `FeeType`: `enum.feetype('flat','percentage','capped')`, `FlatAmount`: `bigint`, `PercentageBPS`: `integer`, `MinFee`: `bigint`, `MaxFee`: `bigint`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	_              = bytes.MinRead
+)
+
+func testFeeRulesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(feeRulePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(feeRuleAllColumns) == len(feeRulePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRulePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFeeRulesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(feeRuleAllColumns) == len(feeRulePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FeeRule{}
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, feeRuleDBTypes, true, feeRulePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(feeRuleAllColumns, feeRulePrimaryKeyColumns) {
+		fields = feeRuleAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			feeRuleAllColumns,
+			feeRulePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FeeRuleSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFeeRulesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(feeRuleAllColumns) == len(feeRulePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FeeRule{}
+	if err = randomize.Struct(seed, &o, feeRuleDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FeeRule: %s", err)
+	}
+
+	count, err := FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, feeRuleDBTypes, false, feeRulePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FeeRule: %s", err)
+	}
+
+	count, err = FeeRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
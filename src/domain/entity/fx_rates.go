@@ -0,0 +1,1176 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// FXRate is an object representing the database table.
+type FXRate struct {
+	ID            int           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	BaseCurrency  string        `boil:"base_currency" json:"base_currency" toml:"base_currency" yaml:"base_currency"`
+	QuoteCurrency string        `boil:"quote_currency" json:"quote_currency" toml:"quote_currency" yaml:"quote_currency"`
+	Rate          types.Decimal `boil:"rate" json:"rate" toml:"rate" yaml:"rate"`
+	FeeBPS        int           `boil:"fee_bps" json:"fee_bps" toml:"fee_bps" yaml:"fee_bps"`
+	Source        string        `boil:"source" json:"source" toml:"source" yaml:"source"`
+	CreatedBy     int           `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt     time.Time     `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy     int           `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt     time.Time     `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy     null.Int      `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt     null.Time     `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *fxRateR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L fxRateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FXRateColumns = struct {
+	ID            string
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          string
+	FeeBPS        string
+	Source        string
+	CreatedBy     string
+	CreatedAt     string
+	UpdatedBy     string
+	UpdatedAt     string
+	DeletedBy     string
+	DeletedAt     string
+}{
+	ID:            "id",
+	BaseCurrency:  "base_currency",
+	QuoteCurrency: "quote_currency",
+	Rate:          "rate",
+	FeeBPS:        "fee_bps",
+	Source:        "source",
+	CreatedBy:     "created_by",
+	CreatedAt:     "created_at",
+	UpdatedBy:     "updated_by",
+	UpdatedAt:     "updated_at",
+	DeletedBy:     "deleted_by",
+	DeletedAt:     "deleted_at",
+}
+
+var FXRateTableColumns = struct {
+	ID            string
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          string
+	FeeBPS        string
+	Source        string
+	CreatedBy     string
+	CreatedAt     string
+	UpdatedBy     string
+	UpdatedAt     string
+	DeletedBy     string
+	DeletedAt     string
+}{
+	ID:            "fx_rates.id",
+	BaseCurrency:  "fx_rates.base_currency",
+	QuoteCurrency: "fx_rates.quote_currency",
+	Rate:          "fx_rates.rate",
+	FeeBPS:        "fx_rates.fee_bps",
+	Source:        "fx_rates.source",
+	CreatedBy:     "fx_rates.created_by",
+	CreatedAt:     "fx_rates.created_at",
+	UpdatedBy:     "fx_rates.updated_by",
+	UpdatedAt:     "fx_rates.updated_at",
+	DeletedBy:     "fx_rates.deleted_by",
+	DeletedAt:     "fx_rates.deleted_at",
+}
+
+// Generated where
+
+type whereHelpertypes_Decimal struct{ field string }
+
+func (w whereHelpertypes_Decimal) EQ(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_Decimal) NEQ(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_Decimal) LT(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Decimal) LTE(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Decimal) GT(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Decimal) GTE(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var FXRateWhere = struct {
+	ID            whereHelperint
+	BaseCurrency  whereHelperstring
+	QuoteCurrency whereHelperstring
+	Rate          whereHelpertypes_Decimal
+	FeeBPS        whereHelperint
+	Source        whereHelperstring
+	CreatedBy     whereHelperint
+	CreatedAt     whereHelpertime_Time
+	UpdatedBy     whereHelperint
+	UpdatedAt     whereHelpertime_Time
+	DeletedBy     whereHelpernull_Int
+	DeletedAt     whereHelpernull_Time
+}{
+	ID:            whereHelperint{field: "\"fx_rates\".\"id\""},
+	BaseCurrency:  whereHelperstring{field: "\"fx_rates\".\"base_currency\""},
+	QuoteCurrency: whereHelperstring{field: "\"fx_rates\".\"quote_currency\""},
+	Rate:          whereHelpertypes_Decimal{field: "\"fx_rates\".\"rate\""},
+	FeeBPS:        whereHelperint{field: "\"fx_rates\".\"fee_bps\""},
+	Source:        whereHelperstring{field: "\"fx_rates\".\"source\""},
+	CreatedBy:     whereHelperint{field: "\"fx_rates\".\"created_by\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"fx_rates\".\"created_at\""},
+	UpdatedBy:     whereHelperint{field: "\"fx_rates\".\"updated_by\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"fx_rates\".\"updated_at\""},
+	DeletedBy:     whereHelpernull_Int{field: "\"fx_rates\".\"deleted_by\""},
+	DeletedAt:     whereHelpernull_Time{field: "\"fx_rates\".\"deleted_at\""},
+}
+
+// FXRateRels is where relationship names are stored.
+var FXRateRels = struct {
+}{}
+
+// fxRateR is where relationships are stored.
+type fxRateR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*fxRateR) NewStruct() *fxRateR {
+	return &fxRateR{}
+}
+
+// fxRateL is where Load methods for each relationship are stored.
+type fxRateL struct{}
+
+var (
+	fxRateAllColumns            = []string{"id", "base_currency", "quote_currency", "rate", "fee_bps", "source", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	fxRateColumnsWithoutDefault = []string{"base_currency", "quote_currency", "rate", "source"}
+	fxRateColumnsWithDefault    = []string{"id", "fee_bps", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	fxRatePrimaryKeyColumns     = []string{"id"}
+	fxRateGeneratedColumns      = []string{}
+)
+
+type (
+	// FXRateSlice is an alias for a slice of pointers to FXRate.
+	// This should almost always be used instead of []FXRate.
+	FXRateSlice []*FXRate
+	// FXRateHook is the signature for custom FXRate hook methods
+	FXRateHook func(context.Context, boil.ContextExecutor, *FXRate) error
+
+	fxRateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	fxRateType                 = reflect.TypeOf(&FXRate{})
+	fxRateMapping              = queries.MakeStructMapping(fxRateType)
+	fxRatePrimaryKeyMapping, _ = queries.BindMapping(fxRateType, fxRateMapping, fxRatePrimaryKeyColumns)
+	fxRateInsertCacheMut       sync.RWMutex
+	fxRateInsertCache          = make(map[string]insertCache)
+	fxRateUpdateCacheMut       sync.RWMutex
+	fxRateUpdateCache          = make(map[string]updateCache)
+	fxRateUpsertCacheMut       sync.RWMutex
+	fxRateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var fxRateAfterSelectMu sync.Mutex
+var fxRateAfterSelectHooks []FXRateHook
+
+var fxRateBeforeInsertMu sync.Mutex
+var fxRateBeforeInsertHooks []FXRateHook
+var fxRateAfterInsertMu sync.Mutex
+var fxRateAfterInsertHooks []FXRateHook
+
+var fxRateBeforeUpdateMu sync.Mutex
+var fxRateBeforeUpdateHooks []FXRateHook
+var fxRateAfterUpdateMu sync.Mutex
+var fxRateAfterUpdateHooks []FXRateHook
+
+var fxRateBeforeDeleteMu sync.Mutex
+var fxRateBeforeDeleteHooks []FXRateHook
+var fxRateAfterDeleteMu sync.Mutex
+var fxRateAfterDeleteHooks []FXRateHook
+
+var fxRateBeforeUpsertMu sync.Mutex
+var fxRateBeforeUpsertHooks []FXRateHook
+var fxRateAfterUpsertMu sync.Mutex
+var fxRateAfterUpsertHooks []FXRateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FXRate) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FXRate) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FXRate) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FXRate) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FXRate) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FXRate) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FXRate) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FXRate) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FXRate) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fxRateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFXRateHook registers your hook function for all future operations.
+func AddFXRateHook(hookPoint boil.HookPoint, fxRateHook FXRateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		fxRateAfterSelectMu.Lock()
+		fxRateAfterSelectHooks = append(fxRateAfterSelectHooks, fxRateHook)
+		fxRateAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		fxRateBeforeInsertMu.Lock()
+		fxRateBeforeInsertHooks = append(fxRateBeforeInsertHooks, fxRateHook)
+		fxRateBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		fxRateAfterInsertMu.Lock()
+		fxRateAfterInsertHooks = append(fxRateAfterInsertHooks, fxRateHook)
+		fxRateAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		fxRateBeforeUpdateMu.Lock()
+		fxRateBeforeUpdateHooks = append(fxRateBeforeUpdateHooks, fxRateHook)
+		fxRateBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		fxRateAfterUpdateMu.Lock()
+		fxRateAfterUpdateHooks = append(fxRateAfterUpdateHooks, fxRateHook)
+		fxRateAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		fxRateBeforeDeleteMu.Lock()
+		fxRateBeforeDeleteHooks = append(fxRateBeforeDeleteHooks, fxRateHook)
+		fxRateBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		fxRateAfterDeleteMu.Lock()
+		fxRateAfterDeleteHooks = append(fxRateAfterDeleteHooks, fxRateHook)
+		fxRateAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		fxRateBeforeUpsertMu.Lock()
+		fxRateBeforeUpsertHooks = append(fxRateBeforeUpsertHooks, fxRateHook)
+		fxRateBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		fxRateAfterUpsertMu.Lock()
+		fxRateAfterUpsertHooks = append(fxRateAfterUpsertHooks, fxRateHook)
+		fxRateAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single fxRate record from the query using the global executor.
+func (q fxRateQuery) OneG(ctx context.Context) (*FXRate, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single fxRate record from the query.
+func (q fxRateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FXRate, error) {
+	o := &FXRate{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for fx_rates")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all FXRate records from the query using the global executor.
+func (q fxRateQuery) AllG(ctx context.Context) (FXRateSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all FXRate records from the query.
+func (q fxRateQuery) All(ctx context.Context, exec boil.ContextExecutor) (FXRateSlice, error) {
+	var o []*FXRate
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to FXRate slice")
+	}
+
+	if len(fxRateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all FXRate records in the query using the global executor
+func (q fxRateQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all FXRate records in the query.
+func (q fxRateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count fx_rates rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q fxRateQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q fxRateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if fx_rates exists")
+	}
+
+	return count > 0, nil
+}
+
+// FXRates retrieves all the records using an executor.
+func FXRates(mods ...qm.QueryMod) fxRateQuery {
+	mods = append(mods, qm.From("\"fx_rates\""), qmhelper.WhereIsNull("\"fx_rates\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"fx_rates\".*"})
+	}
+
+	return fxRateQuery{q}
+}
+
+// FindFXRateG retrieves a single record by ID.
+func FindFXRateG(ctx context.Context, iD int, selectCols ...string) (*FXRate, error) {
+	return FindFXRate(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindFXRate retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFXRate(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*FXRate, error) {
+	fxRateObj := &FXRate{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"fx_rates\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, fxRateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from fx_rates")
+	}
+
+	if err = fxRateObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return fxRateObj, err
+	}
+
+	return fxRateObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *FXRate) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FXRate) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no fx_rates provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(fxRateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	fxRateInsertCacheMut.RLock()
+	cache, cached := fxRateInsertCache[key]
+	fxRateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			fxRateAllColumns,
+			fxRateColumnsWithDefault,
+			fxRateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(fxRateType, fxRateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(fxRateType, fxRateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"fx_rates\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"fx_rates\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into fx_rates")
+	}
+
+	if !cached {
+		fxRateInsertCacheMut.Lock()
+		fxRateInsertCache[key] = cache
+		fxRateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single FXRate record using the global executor.
+// See Update for more documentation.
+func (o *FXRate) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the FXRate.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FXRate) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	fxRateUpdateCacheMut.RLock()
+	cache, cached := fxRateUpdateCache[key]
+	fxRateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			fxRateAllColumns,
+			fxRatePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update fx_rates, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"fx_rates\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, fxRatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(fxRateType, fxRateMapping, append(wl, fxRatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update fx_rates row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for fx_rates")
+	}
+
+	if !cached {
+		fxRateUpdateCacheMut.Lock()
+		fxRateUpdateCache[key] = cache
+		fxRateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q fxRateQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q fxRateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for fx_rates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for fx_rates")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o FXRateSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FXRateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fxRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"fx_rates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, fxRatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in fxRate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all fxRate")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *FXRate) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FXRate) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no fx_rates provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(fxRateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	fxRateUpsertCacheMut.RLock()
+	cache, cached := fxRateUpsertCache[key]
+	fxRateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			fxRateAllColumns,
+			fxRateColumnsWithDefault,
+			fxRateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			fxRateAllColumns,
+			fxRatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert fx_rates, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(fxRateAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(fxRatePrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert fx_rates, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(fxRatePrimaryKeyColumns))
+			copy(conflict, fxRatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"fx_rates\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(fxRateType, fxRateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(fxRateType, fxRateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert fx_rates")
+	}
+
+	if !cached {
+		fxRateUpsertCacheMut.Lock()
+		fxRateUpsertCache[key] = cache
+		fxRateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single FXRate record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *FXRate) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single FXRate record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FXRate) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no FXRate provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), fxRatePrimaryKeyMapping)
+		sql = "DELETE FROM \"fx_rates\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"fx_rates\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(fxRateType, fxRateMapping, append(wl, fxRatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from fx_rates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for fx_rates")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q fxRateQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q fxRateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no fxRateQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from fx_rates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for fx_rates")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o FXRateSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FXRateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(fxRateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fxRatePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"fx_rates\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, fxRatePrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fxRatePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"fx_rates\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, fxRatePrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from fxRate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for fx_rates")
+	}
+
+	if len(fxRateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *FXRate) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no FXRate provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FXRate) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFXRate(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FXRateSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty FXRateSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FXRateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FXRateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fxRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"fx_rates\".* FROM \"fx_rates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, fxRatePrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in FXRateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FXRateExistsG checks if the FXRate row exists.
+func FXRateExistsG(ctx context.Context, iD int) (bool, error) {
+	return FXRateExists(ctx, boil.GetContextDB(), iD)
+}
+
+// FXRateExists checks if the FXRate row exists.
+func FXRateExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"fx_rates\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if fx_rates exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the FXRate row exists.
+func (o *FXRate) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return FXRateExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1305 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LedgerAccount is an object representing the database table.
+type LedgerAccount struct {
+	ID        int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Kind      Ledgeraccountkind `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	APIKey    string            `boil:"api_key" json:"api_key" toml:"api_key" yaml:"api_key"`
+	Currency  string            `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	CreatedAt time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *ledgerAccountR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L ledgerAccountL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LedgerAccountColumns = struct {
+	ID        string
+	Kind      string
+	APIKey    string
+	Currency  string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	Kind:      "kind",
+	APIKey:    "api_key",
+	Currency:  "currency",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var LedgerAccountTableColumns = struct {
+	ID        string
+	Kind      string
+	APIKey    string
+	Currency  string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "ledger_accounts.id",
+	Kind:      "ledger_accounts.kind",
+	APIKey:    "ledger_accounts.api_key",
+	Currency:  "ledger_accounts.currency",
+	CreatedAt: "ledger_accounts.created_at",
+	UpdatedAt: "ledger_accounts.updated_at",
+}
+
+// Generated where
+
+type whereHelperLedgeraccountkind struct{ field string }
+
+func (w whereHelperLedgeraccountkind) EQ(x Ledgeraccountkind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperLedgeraccountkind) NEQ(x Ledgeraccountkind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperLedgeraccountkind) LT(x Ledgeraccountkind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperLedgeraccountkind) LTE(x Ledgeraccountkind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperLedgeraccountkind) GT(x Ledgeraccountkind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperLedgeraccountkind) GTE(x Ledgeraccountkind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperLedgeraccountkind) IN(slice []Ledgeraccountkind) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperLedgeraccountkind) NIN(slice []Ledgeraccountkind) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var LedgerAccountWhere = struct {
+	ID        whereHelperint
+	Kind      whereHelperLedgeraccountkind
+	APIKey    whereHelperstring
+	Currency  whereHelperstring
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "\"ledger_accounts\".\"id\""},
+	Kind:      whereHelperLedgeraccountkind{field: "\"ledger_accounts\".\"kind\""},
+	APIKey:    whereHelperstring{field: "\"ledger_accounts\".\"api_key\""},
+	Currency:  whereHelperstring{field: "\"ledger_accounts\".\"currency\""},
+	CreatedAt: whereHelpertime_Time{field: "\"ledger_accounts\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"ledger_accounts\".\"updated_at\""},
+}
+
+// LedgerAccountRels is where relationship names are stored.
+var LedgerAccountRels = struct {
+	LedgerEntries string
+}{
+	LedgerEntries: "LedgerEntries",
+}
+
+// ledgerAccountR is where relationships are stored.
+type ledgerAccountR struct {
+	LedgerEntries LedgerEntrySlice `boil:"LedgerEntries" json:"LedgerEntries" toml:"LedgerEntries" yaml:"LedgerEntries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*ledgerAccountR) NewStruct() *ledgerAccountR {
+	return &ledgerAccountR{}
+}
+
+func (r *ledgerAccountR) GetLedgerEntries() LedgerEntrySlice {
+	if r == nil {
+		return nil
+	}
+	return r.LedgerEntries
+}
+
+// ledgerAccountL is where Load methods for each relationship are stored.
+type ledgerAccountL struct{}
+
+var (
+	ledgerAccountAllColumns            = []string{"id", "kind", "api_key", "currency", "created_at", "updated_at"}
+	ledgerAccountColumnsWithoutDefault = []string{"kind", "currency"}
+	ledgerAccountColumnsWithDefault    = []string{"id", "api_key", "created_at", "updated_at"}
+	ledgerAccountPrimaryKeyColumns     = []string{"id"}
+	ledgerAccountGeneratedColumns      = []string{}
+)
+
+type (
+	// LedgerAccountSlice is an alias for a slice of pointers to LedgerAccount.
+	// This should almost always be used instead of []LedgerAccount.
+	LedgerAccountSlice []*LedgerAccount
+	// LedgerAccountHook is the signature for custom LedgerAccount hook methods
+	LedgerAccountHook func(context.Context, boil.ContextExecutor, *LedgerAccount) error
+
+	ledgerAccountQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	ledgerAccountType                 = reflect.TypeOf(&LedgerAccount{})
+	ledgerAccountMapping              = queries.MakeStructMapping(ledgerAccountType)
+	ledgerAccountPrimaryKeyMapping, _ = queries.BindMapping(ledgerAccountType, ledgerAccountMapping, ledgerAccountPrimaryKeyColumns)
+	ledgerAccountInsertCacheMut       sync.RWMutex
+	ledgerAccountInsertCache          = make(map[string]insertCache)
+	ledgerAccountUpdateCacheMut       sync.RWMutex
+	ledgerAccountUpdateCache          = make(map[string]updateCache)
+	ledgerAccountUpsertCacheMut       sync.RWMutex
+	ledgerAccountUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var ledgerAccountAfterSelectMu sync.Mutex
+var ledgerAccountAfterSelectHooks []LedgerAccountHook
+
+var ledgerAccountBeforeInsertMu sync.Mutex
+var ledgerAccountBeforeInsertHooks []LedgerAccountHook
+var ledgerAccountAfterInsertMu sync.Mutex
+var ledgerAccountAfterInsertHooks []LedgerAccountHook
+
+var ledgerAccountBeforeUpdateMu sync.Mutex
+var ledgerAccountBeforeUpdateHooks []LedgerAccountHook
+var ledgerAccountAfterUpdateMu sync.Mutex
+var ledgerAccountAfterUpdateHooks []LedgerAccountHook
+
+var ledgerAccountBeforeDeleteMu sync.Mutex
+var ledgerAccountBeforeDeleteHooks []LedgerAccountHook
+var ledgerAccountAfterDeleteMu sync.Mutex
+var ledgerAccountAfterDeleteHooks []LedgerAccountHook
+
+var ledgerAccountBeforeUpsertMu sync.Mutex
+var ledgerAccountBeforeUpsertHooks []LedgerAccountHook
+var ledgerAccountAfterUpsertMu sync.Mutex
+var ledgerAccountAfterUpsertHooks []LedgerAccountHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LedgerAccount) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAccountAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LedgerAccount) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAccountBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LedgerAccount) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAccountAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LedgerAccount) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAccountBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LedgerAccount) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAccountAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LedgerAccount) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAccountBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LedgerAccount) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAccountAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LedgerAccount) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAccountBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LedgerAccount) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAccountAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLedgerAccountHook registers your hook function for all future operations.
+func AddLedgerAccountHook(hookPoint boil.HookPoint, ledgerAccountHook LedgerAccountHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		ledgerAccountAfterSelectMu.Lock()
+		ledgerAccountAfterSelectHooks = append(ledgerAccountAfterSelectHooks, ledgerAccountHook)
+		ledgerAccountAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		ledgerAccountBeforeInsertMu.Lock()
+		ledgerAccountBeforeInsertHooks = append(ledgerAccountBeforeInsertHooks, ledgerAccountHook)
+		ledgerAccountBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		ledgerAccountAfterInsertMu.Lock()
+		ledgerAccountAfterInsertHooks = append(ledgerAccountAfterInsertHooks, ledgerAccountHook)
+		ledgerAccountAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		ledgerAccountBeforeUpdateMu.Lock()
+		ledgerAccountBeforeUpdateHooks = append(ledgerAccountBeforeUpdateHooks, ledgerAccountHook)
+		ledgerAccountBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		ledgerAccountAfterUpdateMu.Lock()
+		ledgerAccountAfterUpdateHooks = append(ledgerAccountAfterUpdateHooks, ledgerAccountHook)
+		ledgerAccountAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		ledgerAccountBeforeDeleteMu.Lock()
+		ledgerAccountBeforeDeleteHooks = append(ledgerAccountBeforeDeleteHooks, ledgerAccountHook)
+		ledgerAccountBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		ledgerAccountAfterDeleteMu.Lock()
+		ledgerAccountAfterDeleteHooks = append(ledgerAccountAfterDeleteHooks, ledgerAccountHook)
+		ledgerAccountAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		ledgerAccountBeforeUpsertMu.Lock()
+		ledgerAccountBeforeUpsertHooks = append(ledgerAccountBeforeUpsertHooks, ledgerAccountHook)
+		ledgerAccountBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		ledgerAccountAfterUpsertMu.Lock()
+		ledgerAccountAfterUpsertHooks = append(ledgerAccountAfterUpsertHooks, ledgerAccountHook)
+		ledgerAccountAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single ledgerAccount record from the query using the global executor.
+func (q ledgerAccountQuery) OneG(ctx context.Context) (*LedgerAccount, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single ledgerAccount record from the query.
+func (q ledgerAccountQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LedgerAccount, error) {
+	o := &LedgerAccount{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for ledger_accounts")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all LedgerAccount records from the query using the global executor.
+func (q ledgerAccountQuery) AllG(ctx context.Context) (LedgerAccountSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all LedgerAccount records from the query.
+func (q ledgerAccountQuery) All(ctx context.Context, exec boil.ContextExecutor) (LedgerAccountSlice, error) {
+	var o []*LedgerAccount
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to LedgerAccount slice")
+	}
+
+	if len(ledgerAccountAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all LedgerAccount records in the query using the global executor
+func (q ledgerAccountQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all LedgerAccount records in the query.
+func (q ledgerAccountQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count ledger_accounts rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q ledgerAccountQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q ledgerAccountQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if ledger_accounts exists")
+	}
+
+	return count > 0, nil
+}
+
+// LedgerEntries retrieves all the ledger_entry's LedgerEntries with an executor.
+func (o *LedgerAccount) LedgerEntries(mods ...qm.QueryMod) ledgerEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"ledger_entries\".\"ledger_account_id\"=?", o.ID),
+	)
+
+	return LedgerEntries(queryMods...)
+}
+
+// LoadLedgerEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (ledgerAccountL) LoadLedgerEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedgerAccount interface{}, mods queries.Applicator) error {
+	var slice []*LedgerAccount
+	var object *LedgerAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedgerAccount.(*LedgerAccount)
+		if !ok {
+			object = new(LedgerAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedgerAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedgerAccount))
+			}
+		}
+	} else {
+		s, ok := maybeLedgerAccount.(*[]*LedgerAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedgerAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedgerAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`ledger_entries`),
+		qm.WhereIn(`ledger_entries.ledger_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ledger_entries")
+	}
+
+	var resultSlice []*LedgerEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ledger_entries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on ledger_entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ledger_entries")
+	}
+
+	if len(ledgerEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.LedgerEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &ledgerEntryR{}
+			}
+			foreign.R.LedgerAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.LedgerAccountID {
+				local.R.LedgerEntries = append(local.R.LedgerEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &ledgerEntryR{}
+				}
+				foreign.R.LedgerAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddLedgerEntriesG adds the given related objects to the existing relationships
+// of the ledger_account, optionally inserting them as new records.
+// Appends related to o.R.LedgerEntries.
+// Sets related.R.LedgerAccount appropriately.
+// Uses the global database handle.
+func (o *LedgerAccount) AddLedgerEntriesG(ctx context.Context, insert bool, related ...*LedgerEntry) error {
+	return o.AddLedgerEntries(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddLedgerEntries adds the given related objects to the existing relationships
+// of the ledger_account, optionally inserting them as new records.
+// Appends related to o.R.LedgerEntries.
+// Sets related.R.LedgerAccount appropriately.
+func (o *LedgerAccount) AddLedgerEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LedgerEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.LedgerAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"ledger_entries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"ledger_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, ledgerEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.LedgerAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &ledgerAccountR{
+			LedgerEntries: related,
+		}
+	} else {
+		o.R.LedgerEntries = append(o.R.LedgerEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &ledgerEntryR{
+				LedgerAccount: o,
+			}
+		} else {
+			rel.R.LedgerAccount = o
+		}
+	}
+	return nil
+}
+
+// LedgerAccounts retrieves all the records using an executor.
+func LedgerAccounts(mods ...qm.QueryMod) ledgerAccountQuery {
+	mods = append(mods, qm.From("\"ledger_accounts\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"ledger_accounts\".*"})
+	}
+
+	return ledgerAccountQuery{q}
+}
+
+// FindLedgerAccountG retrieves a single record by ID.
+func FindLedgerAccountG(ctx context.Context, iD int, selectCols ...string) (*LedgerAccount, error) {
+	return FindLedgerAccount(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindLedgerAccount retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLedgerAccount(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*LedgerAccount, error) {
+	ledgerAccountObj := &LedgerAccount{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"ledger_accounts\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, ledgerAccountObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from ledger_accounts")
+	}
+
+	if err = ledgerAccountObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return ledgerAccountObj, err
+	}
+
+	return ledgerAccountObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *LedgerAccount) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LedgerAccount) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no ledger_accounts provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ledgerAccountColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	ledgerAccountInsertCacheMut.RLock()
+	cache, cached := ledgerAccountInsertCache[key]
+	ledgerAccountInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			ledgerAccountAllColumns,
+			ledgerAccountColumnsWithDefault,
+			ledgerAccountColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(ledgerAccountType, ledgerAccountMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(ledgerAccountType, ledgerAccountMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"ledger_accounts\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"ledger_accounts\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into ledger_accounts")
+	}
+
+	if !cached {
+		ledgerAccountInsertCacheMut.Lock()
+		ledgerAccountInsertCache[key] = cache
+		ledgerAccountInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single LedgerAccount record using the global executor.
+// See Update for more documentation.
+func (o *LedgerAccount) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the LedgerAccount.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LedgerAccount) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	ledgerAccountUpdateCacheMut.RLock()
+	cache, cached := ledgerAccountUpdateCache[key]
+	ledgerAccountUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			ledgerAccountAllColumns,
+			ledgerAccountPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update ledger_accounts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"ledger_accounts\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, ledgerAccountPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(ledgerAccountType, ledgerAccountMapping, append(wl, ledgerAccountPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update ledger_accounts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for ledger_accounts")
+	}
+
+	if !cached {
+		ledgerAccountUpdateCacheMut.Lock()
+		ledgerAccountUpdateCache[key] = cache
+		ledgerAccountUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q ledgerAccountQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q ledgerAccountQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for ledger_accounts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for ledger_accounts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o LedgerAccountSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LedgerAccountSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ledgerAccountPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"ledger_accounts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, ledgerAccountPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in ledgerAccount slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all ledgerAccount")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *LedgerAccount) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LedgerAccount) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no ledger_accounts provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ledgerAccountColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	ledgerAccountUpsertCacheMut.RLock()
+	cache, cached := ledgerAccountUpsertCache[key]
+	ledgerAccountUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			ledgerAccountAllColumns,
+			ledgerAccountColumnsWithDefault,
+			ledgerAccountColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			ledgerAccountAllColumns,
+			ledgerAccountPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert ledger_accounts, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(ledgerAccountAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(ledgerAccountPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert ledger_accounts, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(ledgerAccountPrimaryKeyColumns))
+			copy(conflict, ledgerAccountPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"ledger_accounts\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(ledgerAccountType, ledgerAccountMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(ledgerAccountType, ledgerAccountMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert ledger_accounts")
+	}
+
+	if !cached {
+		ledgerAccountUpsertCacheMut.Lock()
+		ledgerAccountUpsertCache[key] = cache
+		ledgerAccountUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single LedgerAccount record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *LedgerAccount) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single LedgerAccount record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LedgerAccount) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no LedgerAccount provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), ledgerAccountPrimaryKeyMapping)
+	sql := "DELETE FROM \"ledger_accounts\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from ledger_accounts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for ledger_accounts")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q ledgerAccountQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q ledgerAccountQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no ledgerAccountQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from ledger_accounts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for ledger_accounts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o LedgerAccountSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LedgerAccountSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(ledgerAccountBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ledgerAccountPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"ledger_accounts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, ledgerAccountPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from ledgerAccount slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for ledger_accounts")
+	}
+
+	if len(ledgerAccountAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *LedgerAccount) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no LedgerAccount provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LedgerAccount) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLedgerAccount(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LedgerAccountSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty LedgerAccountSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LedgerAccountSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LedgerAccountSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ledgerAccountPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"ledger_accounts\".* FROM \"ledger_accounts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, ledgerAccountPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in LedgerAccountSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LedgerAccountExistsG checks if the LedgerAccount row exists.
+func LedgerAccountExistsG(ctx context.Context, iD int) (bool, error) {
+	return LedgerAccountExists(ctx, boil.GetContextDB(), iD)
+}
+
+// LedgerAccountExists checks if the LedgerAccount row exists.
+func LedgerAccountExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"ledger_accounts\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if ledger_accounts exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the LedgerAccount row exists.
+func (o *LedgerAccount) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LedgerAccountExists(ctx, exec, o.ID)
+}
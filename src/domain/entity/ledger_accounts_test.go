@@ -0,0 +1,886 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testLedgerAccounts(t *testing.T) {
+	t.Parallel()
+
+	query := LedgerAccounts()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testLedgerAccountsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LedgerAccounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLedgerAccountsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := LedgerAccounts().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LedgerAccounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLedgerAccountsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LedgerAccountSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LedgerAccounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLedgerAccountsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := LedgerAccountExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if LedgerAccount exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected LedgerAccountExists to return true, but got false.")
+	}
+}
+
+func testLedgerAccountsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	ledgerAccountFound, err := FindLedgerAccount(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if ledgerAccountFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testLedgerAccountsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = LedgerAccounts().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLedgerAccountsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := LedgerAccounts().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testLedgerAccountsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	ledgerAccountOne := &LedgerAccount{}
+	ledgerAccountTwo := &LedgerAccount{}
+	if err = randomize.Struct(seed, ledgerAccountOne, ledgerAccountDBTypes, false, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+	if err = randomize.Struct(seed, ledgerAccountTwo, ledgerAccountDBTypes, false, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = ledgerAccountOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = ledgerAccountTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LedgerAccounts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testLedgerAccountsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	ledgerAccountOne := &LedgerAccount{}
+	ledgerAccountTwo := &LedgerAccount{}
+	if err = randomize.Struct(seed, ledgerAccountOne, ledgerAccountDBTypes, false, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+	if err = randomize.Struct(seed, ledgerAccountTwo, ledgerAccountDBTypes, false, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = ledgerAccountOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = ledgerAccountTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LedgerAccounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func ledgerAccountBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *LedgerAccount) error {
+	*o = LedgerAccount{}
+	return nil
+}
+
+func ledgerAccountAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *LedgerAccount) error {
+	*o = LedgerAccount{}
+	return nil
+}
+
+func ledgerAccountAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *LedgerAccount) error {
+	*o = LedgerAccount{}
+	return nil
+}
+
+func ledgerAccountBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LedgerAccount) error {
+	*o = LedgerAccount{}
+	return nil
+}
+
+func ledgerAccountAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LedgerAccount) error {
+	*o = LedgerAccount{}
+	return nil
+}
+
+func ledgerAccountBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LedgerAccount) error {
+	*o = LedgerAccount{}
+	return nil
+}
+
+func ledgerAccountAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LedgerAccount) error {
+	*o = LedgerAccount{}
+	return nil
+}
+
+func ledgerAccountBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LedgerAccount) error {
+	*o = LedgerAccount{}
+	return nil
+}
+
+func ledgerAccountAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LedgerAccount) error {
+	*o = LedgerAccount{}
+	return nil
+}
+
+func testLedgerAccountsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &LedgerAccount{}
+	o := &LedgerAccount{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount object: %s", err)
+	}
+
+	AddLedgerAccountHook(boil.BeforeInsertHook, ledgerAccountBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	ledgerAccountBeforeInsertHooks = []LedgerAccountHook{}
+
+	AddLedgerAccountHook(boil.AfterInsertHook, ledgerAccountAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	ledgerAccountAfterInsertHooks = []LedgerAccountHook{}
+
+	AddLedgerAccountHook(boil.AfterSelectHook, ledgerAccountAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	ledgerAccountAfterSelectHooks = []LedgerAccountHook{}
+
+	AddLedgerAccountHook(boil.BeforeUpdateHook, ledgerAccountBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	ledgerAccountBeforeUpdateHooks = []LedgerAccountHook{}
+
+	AddLedgerAccountHook(boil.AfterUpdateHook, ledgerAccountAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	ledgerAccountAfterUpdateHooks = []LedgerAccountHook{}
+
+	AddLedgerAccountHook(boil.BeforeDeleteHook, ledgerAccountBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	ledgerAccountBeforeDeleteHooks = []LedgerAccountHook{}
+
+	AddLedgerAccountHook(boil.AfterDeleteHook, ledgerAccountAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	ledgerAccountAfterDeleteHooks = []LedgerAccountHook{}
+
+	AddLedgerAccountHook(boil.BeforeUpsertHook, ledgerAccountBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	ledgerAccountBeforeUpsertHooks = []LedgerAccountHook{}
+
+	AddLedgerAccountHook(boil.AfterUpsertHook, ledgerAccountAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	ledgerAccountAfterUpsertHooks = []LedgerAccountHook{}
+}
+
+func testLedgerAccountsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LedgerAccounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLedgerAccountsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(ledgerAccountColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LedgerAccounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLedgerAccountToManyLedgerEntries(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LedgerAccount
+	var b, c LedgerEntry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, ledgerEntryDBTypes, false, ledgerEntryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, ledgerEntryDBTypes, false, ledgerEntryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.LedgerAccountID = a.ID
+	c.LedgerAccountID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.LedgerEntries().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.LedgerAccountID == b.LedgerAccountID {
+			bFound = true
+		}
+		if v.LedgerAccountID == c.LedgerAccountID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := LedgerAccountSlice{&a}
+	if err = a.L.LoadLedgerEntries(ctx, tx, false, (*[]*LedgerAccount)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.LedgerEntries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.LedgerEntries = nil
+	if err = a.L.LoadLedgerEntries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.LedgerEntries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testLedgerAccountToManyAddOpLedgerEntries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LedgerAccount
+	var b, c, d, e LedgerEntry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, ledgerAccountDBTypes, false, strmangle.SetComplement(ledgerAccountPrimaryKeyColumns, ledgerAccountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*LedgerEntry{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, ledgerEntryDBTypes, false, strmangle.SetComplement(ledgerEntryPrimaryKeyColumns, ledgerEntryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*LedgerEntry{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddLedgerEntries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.LedgerAccountID {
+			t.Error("foreign key was wrong value", a.ID, first.LedgerAccountID)
+		}
+		if a.ID != second.LedgerAccountID {
+			t.Error("foreign key was wrong value", a.ID, second.LedgerAccountID)
+		}
+
+		if first.R.LedgerAccount != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.LedgerAccount != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.LedgerEntries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.LedgerEntries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.LedgerEntries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testLedgerAccountsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLedgerAccountsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LedgerAccountSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLedgerAccountsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LedgerAccounts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	ledgerAccountDBTypes = map[string]string{`ID`: `integer`, `Kind`: `enum.ledgeraccountkind('merchant','clearing','settlement','fee_revenue')`, `APIKey`: `text`, `Currency`: `character varying`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                    = bytes.MinRead
+)
+
+func testLedgerAccountsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(ledgerAccountPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(ledgerAccountAllColumns) == len(ledgerAccountPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LedgerAccounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testLedgerAccountsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(ledgerAccountAllColumns) == len(ledgerAccountPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerAccount{}
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LedgerAccounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, ledgerAccountDBTypes, true, ledgerAccountPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(ledgerAccountAllColumns, ledgerAccountPrimaryKeyColumns) {
+		fields = ledgerAccountAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			ledgerAccountAllColumns,
+			ledgerAccountPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := LedgerAccountSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testLedgerAccountsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(ledgerAccountAllColumns) == len(ledgerAccountPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := LedgerAccount{}
+	if err = randomize.Struct(seed, &o, ledgerAccountDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LedgerAccount: %s", err)
+	}
+
+	count, err := LedgerAccounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, ledgerAccountDBTypes, false, ledgerAccountPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LedgerAccount struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LedgerAccount: %s", err)
+	}
+
+	count, err = LedgerAccounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
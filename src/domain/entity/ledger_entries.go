@@ -0,0 +1,1488 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LedgerEntry is an object representing the database table.
+type LedgerEntry struct {
+	ID                  int             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	LedgerTransactionID int             `boil:"ledger_transaction_id" json:"ledger_transaction_id" toml:"ledger_transaction_id" yaml:"ledger_transaction_id"`
+	LedgerAccountID     int             `boil:"ledger_account_id" json:"ledger_account_id" toml:"ledger_account_id" yaml:"ledger_account_id"`
+	Direction           Ledgerdirection `boil:"direction" json:"direction" toml:"direction" yaml:"direction"`
+	Amount              int64           `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	CreatedAt           time.Time       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *ledgerEntryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L ledgerEntryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LedgerEntryColumns = struct {
+	ID                  string
+	LedgerTransactionID string
+	LedgerAccountID     string
+	Direction           string
+	Amount              string
+	CreatedAt           string
+}{
+	ID:                  "id",
+	LedgerTransactionID: "ledger_transaction_id",
+	LedgerAccountID:     "ledger_account_id",
+	Direction:           "direction",
+	Amount:              "amount",
+	CreatedAt:           "created_at",
+}
+
+var LedgerEntryTableColumns = struct {
+	ID                  string
+	LedgerTransactionID string
+	LedgerAccountID     string
+	Direction           string
+	Amount              string
+	CreatedAt           string
+}{
+	ID:                  "ledger_entries.id",
+	LedgerTransactionID: "ledger_entries.ledger_transaction_id",
+	LedgerAccountID:     "ledger_entries.ledger_account_id",
+	Direction:           "ledger_entries.direction",
+	Amount:              "ledger_entries.amount",
+	CreatedAt:           "ledger_entries.created_at",
+}
+
+// Generated where
+
+type whereHelperLedgerdirection struct{ field string }
+
+func (w whereHelperLedgerdirection) EQ(x Ledgerdirection) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperLedgerdirection) NEQ(x Ledgerdirection) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperLedgerdirection) LT(x Ledgerdirection) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperLedgerdirection) LTE(x Ledgerdirection) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperLedgerdirection) GT(x Ledgerdirection) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperLedgerdirection) GTE(x Ledgerdirection) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperLedgerdirection) IN(slice []Ledgerdirection) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperLedgerdirection) NIN(slice []Ledgerdirection) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var LedgerEntryWhere = struct {
+	ID                  whereHelperint
+	LedgerTransactionID whereHelperint
+	LedgerAccountID     whereHelperint
+	Direction           whereHelperLedgerdirection
+	Amount              whereHelperint64
+	CreatedAt           whereHelpertime_Time
+}{
+	ID:                  whereHelperint{field: "\"ledger_entries\".\"id\""},
+	LedgerTransactionID: whereHelperint{field: "\"ledger_entries\".\"ledger_transaction_id\""},
+	LedgerAccountID:     whereHelperint{field: "\"ledger_entries\".\"ledger_account_id\""},
+	Direction:           whereHelperLedgerdirection{field: "\"ledger_entries\".\"direction\""},
+	Amount:              whereHelperint64{field: "\"ledger_entries\".\"amount\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"ledger_entries\".\"created_at\""},
+}
+
+// LedgerEntryRels is where relationship names are stored.
+var LedgerEntryRels = struct {
+	LedgerAccount     string
+	LedgerTransaction string
+}{
+	LedgerAccount:     "LedgerAccount",
+	LedgerTransaction: "LedgerTransaction",
+}
+
+// ledgerEntryR is where relationships are stored.
+type ledgerEntryR struct {
+	LedgerAccount     *LedgerAccount     `boil:"LedgerAccount" json:"LedgerAccount" toml:"LedgerAccount" yaml:"LedgerAccount"`
+	LedgerTransaction *LedgerTransaction `boil:"LedgerTransaction" json:"LedgerTransaction" toml:"LedgerTransaction" yaml:"LedgerTransaction"`
+}
+
+// NewStruct creates a new relationship struct
+func (*ledgerEntryR) NewStruct() *ledgerEntryR {
+	return &ledgerEntryR{}
+}
+
+func (r *ledgerEntryR) GetLedgerAccount() *LedgerAccount {
+	if r == nil {
+		return nil
+	}
+	return r.LedgerAccount
+}
+
+func (r *ledgerEntryR) GetLedgerTransaction() *LedgerTransaction {
+	if r == nil {
+		return nil
+	}
+	return r.LedgerTransaction
+}
+
+// ledgerEntryL is where Load methods for each relationship are stored.
+type ledgerEntryL struct{}
+
+var (
+	ledgerEntryAllColumns            = []string{"id", "ledger_transaction_id", "ledger_account_id", "direction", "amount", "created_at"}
+	ledgerEntryColumnsWithoutDefault = []string{"ledger_transaction_id", "ledger_account_id", "direction", "amount"}
+	ledgerEntryColumnsWithDefault    = []string{"id", "created_at"}
+	ledgerEntryPrimaryKeyColumns     = []string{"id"}
+	ledgerEntryGeneratedColumns      = []string{}
+)
+
+type (
+	// LedgerEntrySlice is an alias for a slice of pointers to LedgerEntry.
+	// This should almost always be used instead of []LedgerEntry.
+	LedgerEntrySlice []*LedgerEntry
+	// LedgerEntryHook is the signature for custom LedgerEntry hook methods
+	LedgerEntryHook func(context.Context, boil.ContextExecutor, *LedgerEntry) error
+
+	ledgerEntryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	ledgerEntryType                 = reflect.TypeOf(&LedgerEntry{})
+	ledgerEntryMapping              = queries.MakeStructMapping(ledgerEntryType)
+	ledgerEntryPrimaryKeyMapping, _ = queries.BindMapping(ledgerEntryType, ledgerEntryMapping, ledgerEntryPrimaryKeyColumns)
+	ledgerEntryInsertCacheMut       sync.RWMutex
+	ledgerEntryInsertCache          = make(map[string]insertCache)
+	ledgerEntryUpdateCacheMut       sync.RWMutex
+	ledgerEntryUpdateCache          = make(map[string]updateCache)
+	ledgerEntryUpsertCacheMut       sync.RWMutex
+	ledgerEntryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var ledgerEntryAfterSelectMu sync.Mutex
+var ledgerEntryAfterSelectHooks []LedgerEntryHook
+
+var ledgerEntryBeforeInsertMu sync.Mutex
+var ledgerEntryBeforeInsertHooks []LedgerEntryHook
+var ledgerEntryAfterInsertMu sync.Mutex
+var ledgerEntryAfterInsertHooks []LedgerEntryHook
+
+var ledgerEntryBeforeUpdateMu sync.Mutex
+var ledgerEntryBeforeUpdateHooks []LedgerEntryHook
+var ledgerEntryAfterUpdateMu sync.Mutex
+var ledgerEntryAfterUpdateHooks []LedgerEntryHook
+
+var ledgerEntryBeforeDeleteMu sync.Mutex
+var ledgerEntryBeforeDeleteHooks []LedgerEntryHook
+var ledgerEntryAfterDeleteMu sync.Mutex
+var ledgerEntryAfterDeleteHooks []LedgerEntryHook
+
+var ledgerEntryBeforeUpsertMu sync.Mutex
+var ledgerEntryBeforeUpsertHooks []LedgerEntryHook
+var ledgerEntryAfterUpsertMu sync.Mutex
+var ledgerEntryAfterUpsertHooks []LedgerEntryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LedgerEntry) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerEntryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LedgerEntry) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerEntryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LedgerEntry) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerEntryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LedgerEntry) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerEntryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LedgerEntry) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerEntryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LedgerEntry) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerEntryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LedgerEntry) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerEntryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LedgerEntry) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerEntryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LedgerEntry) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerEntryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLedgerEntryHook registers your hook function for all future operations.
+func AddLedgerEntryHook(hookPoint boil.HookPoint, ledgerEntryHook LedgerEntryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		ledgerEntryAfterSelectMu.Lock()
+		ledgerEntryAfterSelectHooks = append(ledgerEntryAfterSelectHooks, ledgerEntryHook)
+		ledgerEntryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		ledgerEntryBeforeInsertMu.Lock()
+		ledgerEntryBeforeInsertHooks = append(ledgerEntryBeforeInsertHooks, ledgerEntryHook)
+		ledgerEntryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		ledgerEntryAfterInsertMu.Lock()
+		ledgerEntryAfterInsertHooks = append(ledgerEntryAfterInsertHooks, ledgerEntryHook)
+		ledgerEntryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		ledgerEntryBeforeUpdateMu.Lock()
+		ledgerEntryBeforeUpdateHooks = append(ledgerEntryBeforeUpdateHooks, ledgerEntryHook)
+		ledgerEntryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		ledgerEntryAfterUpdateMu.Lock()
+		ledgerEntryAfterUpdateHooks = append(ledgerEntryAfterUpdateHooks, ledgerEntryHook)
+		ledgerEntryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		ledgerEntryBeforeDeleteMu.Lock()
+		ledgerEntryBeforeDeleteHooks = append(ledgerEntryBeforeDeleteHooks, ledgerEntryHook)
+		ledgerEntryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		ledgerEntryAfterDeleteMu.Lock()
+		ledgerEntryAfterDeleteHooks = append(ledgerEntryAfterDeleteHooks, ledgerEntryHook)
+		ledgerEntryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		ledgerEntryBeforeUpsertMu.Lock()
+		ledgerEntryBeforeUpsertHooks = append(ledgerEntryBeforeUpsertHooks, ledgerEntryHook)
+		ledgerEntryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		ledgerEntryAfterUpsertMu.Lock()
+		ledgerEntryAfterUpsertHooks = append(ledgerEntryAfterUpsertHooks, ledgerEntryHook)
+		ledgerEntryAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single ledgerEntry record from the query using the global executor.
+func (q ledgerEntryQuery) OneG(ctx context.Context) (*LedgerEntry, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single ledgerEntry record from the query.
+func (q ledgerEntryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LedgerEntry, error) {
+	o := &LedgerEntry{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for ledger_entries")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all LedgerEntry records from the query using the global executor.
+func (q ledgerEntryQuery) AllG(ctx context.Context) (LedgerEntrySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all LedgerEntry records from the query.
+func (q ledgerEntryQuery) All(ctx context.Context, exec boil.ContextExecutor) (LedgerEntrySlice, error) {
+	var o []*LedgerEntry
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to LedgerEntry slice")
+	}
+
+	if len(ledgerEntryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all LedgerEntry records in the query using the global executor
+func (q ledgerEntryQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all LedgerEntry records in the query.
+func (q ledgerEntryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count ledger_entries rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q ledgerEntryQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q ledgerEntryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if ledger_entries exists")
+	}
+
+	return count > 0, nil
+}
+
+// LedgerAccount pointed to by the foreign key.
+func (o *LedgerEntry) LedgerAccount(mods ...qm.QueryMod) ledgerAccountQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LedgerAccountID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return LedgerAccounts(queryMods...)
+}
+
+// LedgerTransaction pointed to by the foreign key.
+func (o *LedgerEntry) LedgerTransaction(mods ...qm.QueryMod) ledgerTransactionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LedgerTransactionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return LedgerTransactions(queryMods...)
+}
+
+// LoadLedgerAccount allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (ledgerEntryL) LoadLedgerAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedgerEntry interface{}, mods queries.Applicator) error {
+	var slice []*LedgerEntry
+	var object *LedgerEntry
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedgerEntry.(*LedgerEntry)
+		if !ok {
+			object = new(LedgerEntry)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedgerEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedgerEntry))
+			}
+		}
+	} else {
+		s, ok := maybeLedgerEntry.(*[]*LedgerEntry)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedgerEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedgerEntry))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerEntryR{}
+		}
+		args[object.LedgerAccountID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerEntryR{}
+			}
+
+			args[obj.LedgerAccountID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`ledger_accounts`),
+		qm.WhereIn(`ledger_accounts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load LedgerAccount")
+	}
+
+	var resultSlice []*LedgerAccount
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice LedgerAccount")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for ledger_accounts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ledger_accounts")
+	}
+
+	if len(ledgerAccountAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.LedgerAccount = foreign
+		if foreign.R == nil {
+			foreign.R = &ledgerAccountR{}
+		}
+		foreign.R.LedgerEntries = append(foreign.R.LedgerEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.LedgerAccountID == foreign.ID {
+				local.R.LedgerAccount = foreign
+				if foreign.R == nil {
+					foreign.R = &ledgerAccountR{}
+				}
+				foreign.R.LedgerEntries = append(foreign.R.LedgerEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLedgerTransaction allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (ledgerEntryL) LoadLedgerTransaction(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedgerEntry interface{}, mods queries.Applicator) error {
+	var slice []*LedgerEntry
+	var object *LedgerEntry
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedgerEntry.(*LedgerEntry)
+		if !ok {
+			object = new(LedgerEntry)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedgerEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedgerEntry))
+			}
+		}
+	} else {
+		s, ok := maybeLedgerEntry.(*[]*LedgerEntry)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedgerEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedgerEntry))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerEntryR{}
+		}
+		args[object.LedgerTransactionID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerEntryR{}
+			}
+
+			args[obj.LedgerTransactionID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`ledger_transactions`),
+		qm.WhereIn(`ledger_transactions.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load LedgerTransaction")
+	}
+
+	var resultSlice []*LedgerTransaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice LedgerTransaction")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for ledger_transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ledger_transactions")
+	}
+
+	if len(ledgerTransactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.LedgerTransaction = foreign
+		if foreign.R == nil {
+			foreign.R = &ledgerTransactionR{}
+		}
+		foreign.R.LedgerEntries = append(foreign.R.LedgerEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.LedgerTransactionID == foreign.ID {
+				local.R.LedgerTransaction = foreign
+				if foreign.R == nil {
+					foreign.R = &ledgerTransactionR{}
+				}
+				foreign.R.LedgerEntries = append(foreign.R.LedgerEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetLedgerAccountG of the ledgerEntry to the related item.
+// Sets o.R.LedgerAccount to related.
+// Adds o to related.R.LedgerEntries.
+// Uses the global database handle.
+func (o *LedgerEntry) SetLedgerAccountG(ctx context.Context, insert bool, related *LedgerAccount) error {
+	return o.SetLedgerAccount(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetLedgerAccount of the ledgerEntry to the related item.
+// Sets o.R.LedgerAccount to related.
+// Adds o to related.R.LedgerEntries.
+func (o *LedgerEntry) SetLedgerAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *LedgerAccount) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"ledger_entries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"ledger_account_id"}),
+		strmangle.WhereClause("\"", "\"", 2, ledgerEntryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.LedgerAccountID = related.ID
+	if o.R == nil {
+		o.R = &ledgerEntryR{
+			LedgerAccount: related,
+		}
+	} else {
+		o.R.LedgerAccount = related
+	}
+
+	if related.R == nil {
+		related.R = &ledgerAccountR{
+			LedgerEntries: LedgerEntrySlice{o},
+		}
+	} else {
+		related.R.LedgerEntries = append(related.R.LedgerEntries, o)
+	}
+
+	return nil
+}
+
+// SetLedgerTransactionG of the ledgerEntry to the related item.
+// Sets o.R.LedgerTransaction to related.
+// Adds o to related.R.LedgerEntries.
+// Uses the global database handle.
+func (o *LedgerEntry) SetLedgerTransactionG(ctx context.Context, insert bool, related *LedgerTransaction) error {
+	return o.SetLedgerTransaction(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetLedgerTransaction of the ledgerEntry to the related item.
+// Sets o.R.LedgerTransaction to related.
+// Adds o to related.R.LedgerEntries.
+func (o *LedgerEntry) SetLedgerTransaction(ctx context.Context, exec boil.ContextExecutor, insert bool, related *LedgerTransaction) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"ledger_entries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"ledger_transaction_id"}),
+		strmangle.WhereClause("\"", "\"", 2, ledgerEntryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.LedgerTransactionID = related.ID
+	if o.R == nil {
+		o.R = &ledgerEntryR{
+			LedgerTransaction: related,
+		}
+	} else {
+		o.R.LedgerTransaction = related
+	}
+
+	if related.R == nil {
+		related.R = &ledgerTransactionR{
+			LedgerEntries: LedgerEntrySlice{o},
+		}
+	} else {
+		related.R.LedgerEntries = append(related.R.LedgerEntries, o)
+	}
+
+	return nil
+}
+
+// LedgerEntries retrieves all the records using an executor.
+func LedgerEntries(mods ...qm.QueryMod) ledgerEntryQuery {
+	mods = append(mods, qm.From("\"ledger_entries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"ledger_entries\".*"})
+	}
+
+	return ledgerEntryQuery{q}
+}
+
+// FindLedgerEntryG retrieves a single record by ID.
+func FindLedgerEntryG(ctx context.Context, iD int, selectCols ...string) (*LedgerEntry, error) {
+	return FindLedgerEntry(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindLedgerEntry retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLedgerEntry(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*LedgerEntry, error) {
+	ledgerEntryObj := &LedgerEntry{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"ledger_entries\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, ledgerEntryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from ledger_entries")
+	}
+
+	if err = ledgerEntryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return ledgerEntryObj, err
+	}
+
+	return ledgerEntryObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *LedgerEntry) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LedgerEntry) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no ledger_entries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ledgerEntryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	ledgerEntryInsertCacheMut.RLock()
+	cache, cached := ledgerEntryInsertCache[key]
+	ledgerEntryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			ledgerEntryAllColumns,
+			ledgerEntryColumnsWithDefault,
+			ledgerEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(ledgerEntryType, ledgerEntryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(ledgerEntryType, ledgerEntryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"ledger_entries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"ledger_entries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into ledger_entries")
+	}
+
+	if !cached {
+		ledgerEntryInsertCacheMut.Lock()
+		ledgerEntryInsertCache[key] = cache
+		ledgerEntryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single LedgerEntry record using the global executor.
+// See Update for more documentation.
+func (o *LedgerEntry) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the LedgerEntry.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LedgerEntry) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	ledgerEntryUpdateCacheMut.RLock()
+	cache, cached := ledgerEntryUpdateCache[key]
+	ledgerEntryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			ledgerEntryAllColumns,
+			ledgerEntryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update ledger_entries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"ledger_entries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, ledgerEntryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(ledgerEntryType, ledgerEntryMapping, append(wl, ledgerEntryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update ledger_entries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for ledger_entries")
+	}
+
+	if !cached {
+		ledgerEntryUpdateCacheMut.Lock()
+		ledgerEntryUpdateCache[key] = cache
+		ledgerEntryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q ledgerEntryQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q ledgerEntryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for ledger_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for ledger_entries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o LedgerEntrySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LedgerEntrySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ledgerEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"ledger_entries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, ledgerEntryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in ledgerEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all ledgerEntry")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *LedgerEntry) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LedgerEntry) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no ledger_entries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ledgerEntryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	ledgerEntryUpsertCacheMut.RLock()
+	cache, cached := ledgerEntryUpsertCache[key]
+	ledgerEntryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			ledgerEntryAllColumns,
+			ledgerEntryColumnsWithDefault,
+			ledgerEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			ledgerEntryAllColumns,
+			ledgerEntryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert ledger_entries, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(ledgerEntryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(ledgerEntryPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert ledger_entries, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(ledgerEntryPrimaryKeyColumns))
+			copy(conflict, ledgerEntryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"ledger_entries\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(ledgerEntryType, ledgerEntryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(ledgerEntryType, ledgerEntryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert ledger_entries")
+	}
+
+	if !cached {
+		ledgerEntryUpsertCacheMut.Lock()
+		ledgerEntryUpsertCache[key] = cache
+		ledgerEntryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single LedgerEntry record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *LedgerEntry) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single LedgerEntry record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LedgerEntry) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no LedgerEntry provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), ledgerEntryPrimaryKeyMapping)
+	sql := "DELETE FROM \"ledger_entries\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from ledger_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for ledger_entries")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q ledgerEntryQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q ledgerEntryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no ledgerEntryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from ledger_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for ledger_entries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o LedgerEntrySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LedgerEntrySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(ledgerEntryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ledgerEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"ledger_entries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, ledgerEntryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from ledgerEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for ledger_entries")
+	}
+
+	if len(ledgerEntryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *LedgerEntry) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no LedgerEntry provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LedgerEntry) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLedgerEntry(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LedgerEntrySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty LedgerEntrySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LedgerEntrySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LedgerEntrySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ledgerEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"ledger_entries\".* FROM \"ledger_entries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, ledgerEntryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in LedgerEntrySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LedgerEntryExistsG checks if the LedgerEntry row exists.
+func LedgerEntryExistsG(ctx context.Context, iD int) (bool, error) {
+	return LedgerEntryExists(ctx, boil.GetContextDB(), iD)
+}
+
+// LedgerEntryExists checks if the LedgerEntry row exists.
+func LedgerEntryExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"ledger_entries\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if ledger_entries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the LedgerEntry row exists.
+func (o *LedgerEntry) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LedgerEntryExists(ctx, exec, o.ID)
+}
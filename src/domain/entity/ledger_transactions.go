@@ -0,0 +1,1545 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LedgerTransaction is an object representing the database table.
+type LedgerTransaction struct {
+	ID            int                   `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TransactionID string                `boil:"transaction_id" json:"transaction_id" toml:"transaction_id" yaml:"transaction_id"`
+	Type          Ledgertransactiontype `boil:"type" json:"type" toml:"type" yaml:"type"`
+	TransferJobID null.Int              `boil:"transfer_job_id" json:"transfer_job_id,omitempty" toml:"transfer_job_id" yaml:"transfer_job_id,omitempty"`
+	Currency      string                `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Description   string                `boil:"description" json:"description" toml:"description" yaml:"description"`
+	CreatedAt     time.Time             `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *ledgerTransactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L ledgerTransactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LedgerTransactionColumns = struct {
+	ID            string
+	TransactionID string
+	Type          string
+	TransferJobID string
+	Currency      string
+	Description   string
+	CreatedAt     string
+}{
+	ID:            "id",
+	TransactionID: "transaction_id",
+	Type:          "type",
+	TransferJobID: "transfer_job_id",
+	Currency:      "currency",
+	Description:   "description",
+	CreatedAt:     "created_at",
+}
+
+var LedgerTransactionTableColumns = struct {
+	ID            string
+	TransactionID string
+	Type          string
+	TransferJobID string
+	Currency      string
+	Description   string
+	CreatedAt     string
+}{
+	ID:            "ledger_transactions.id",
+	TransactionID: "ledger_transactions.transaction_id",
+	Type:          "ledger_transactions.type",
+	TransferJobID: "ledger_transactions.transfer_job_id",
+	Currency:      "ledger_transactions.currency",
+	Description:   "ledger_transactions.description",
+	CreatedAt:     "ledger_transactions.created_at",
+}
+
+// Generated where
+
+type whereHelperLedgertransactiontype struct{ field string }
+
+func (w whereHelperLedgertransactiontype) EQ(x Ledgertransactiontype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperLedgertransactiontype) NEQ(x Ledgertransactiontype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperLedgertransactiontype) LT(x Ledgertransactiontype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperLedgertransactiontype) LTE(x Ledgertransactiontype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperLedgertransactiontype) GT(x Ledgertransactiontype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperLedgertransactiontype) GTE(x Ledgertransactiontype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperLedgertransactiontype) IN(slice []Ledgertransactiontype) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperLedgertransactiontype) NIN(slice []Ledgertransactiontype) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var LedgerTransactionWhere = struct {
+	ID            whereHelperint
+	TransactionID whereHelperstring
+	Type          whereHelperLedgertransactiontype
+	TransferJobID whereHelpernull_Int
+	Currency      whereHelperstring
+	Description   whereHelperstring
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperint{field: "\"ledger_transactions\".\"id\""},
+	TransactionID: whereHelperstring{field: "\"ledger_transactions\".\"transaction_id\""},
+	Type:          whereHelperLedgertransactiontype{field: "\"ledger_transactions\".\"type\""},
+	TransferJobID: whereHelpernull_Int{field: "\"ledger_transactions\".\"transfer_job_id\""},
+	Currency:      whereHelperstring{field: "\"ledger_transactions\".\"currency\""},
+	Description:   whereHelperstring{field: "\"ledger_transactions\".\"description\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"ledger_transactions\".\"created_at\""},
+}
+
+// LedgerTransactionRels is where relationship names are stored.
+var LedgerTransactionRels = struct {
+	TransferJob   string
+	LedgerEntries string
+}{
+	TransferJob:   "TransferJob",
+	LedgerEntries: "LedgerEntries",
+}
+
+// ledgerTransactionR is where relationships are stored.
+type ledgerTransactionR struct {
+	TransferJob   *TransferJob     `boil:"TransferJob" json:"TransferJob" toml:"TransferJob" yaml:"TransferJob"`
+	LedgerEntries LedgerEntrySlice `boil:"LedgerEntries" json:"LedgerEntries" toml:"LedgerEntries" yaml:"LedgerEntries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*ledgerTransactionR) NewStruct() *ledgerTransactionR {
+	return &ledgerTransactionR{}
+}
+
+func (r *ledgerTransactionR) GetTransferJob() *TransferJob {
+	if r == nil {
+		return nil
+	}
+	return r.TransferJob
+}
+
+func (r *ledgerTransactionR) GetLedgerEntries() LedgerEntrySlice {
+	if r == nil {
+		return nil
+	}
+	return r.LedgerEntries
+}
+
+// ledgerTransactionL is where Load methods for each relationship are stored.
+type ledgerTransactionL struct{}
+
+var (
+	ledgerTransactionAllColumns            = []string{"id", "transaction_id", "type", "transfer_job_id", "currency", "description", "created_at"}
+	ledgerTransactionColumnsWithoutDefault = []string{"transaction_id", "type", "currency"}
+	ledgerTransactionColumnsWithDefault    = []string{"id", "transfer_job_id", "description", "created_at"}
+	ledgerTransactionPrimaryKeyColumns     = []string{"id"}
+	ledgerTransactionGeneratedColumns      = []string{}
+)
+
+type (
+	// LedgerTransactionSlice is an alias for a slice of pointers to LedgerTransaction.
+	// This should almost always be used instead of []LedgerTransaction.
+	LedgerTransactionSlice []*LedgerTransaction
+	// LedgerTransactionHook is the signature for custom LedgerTransaction hook methods
+	LedgerTransactionHook func(context.Context, boil.ContextExecutor, *LedgerTransaction) error
+
+	ledgerTransactionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	ledgerTransactionType                 = reflect.TypeOf(&LedgerTransaction{})
+	ledgerTransactionMapping              = queries.MakeStructMapping(ledgerTransactionType)
+	ledgerTransactionPrimaryKeyMapping, _ = queries.BindMapping(ledgerTransactionType, ledgerTransactionMapping, ledgerTransactionPrimaryKeyColumns)
+	ledgerTransactionInsertCacheMut       sync.RWMutex
+	ledgerTransactionInsertCache          = make(map[string]insertCache)
+	ledgerTransactionUpdateCacheMut       sync.RWMutex
+	ledgerTransactionUpdateCache          = make(map[string]updateCache)
+	ledgerTransactionUpsertCacheMut       sync.RWMutex
+	ledgerTransactionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var ledgerTransactionAfterSelectMu sync.Mutex
+var ledgerTransactionAfterSelectHooks []LedgerTransactionHook
+
+var ledgerTransactionBeforeInsertMu sync.Mutex
+var ledgerTransactionBeforeInsertHooks []LedgerTransactionHook
+var ledgerTransactionAfterInsertMu sync.Mutex
+var ledgerTransactionAfterInsertHooks []LedgerTransactionHook
+
+var ledgerTransactionBeforeUpdateMu sync.Mutex
+var ledgerTransactionBeforeUpdateHooks []LedgerTransactionHook
+var ledgerTransactionAfterUpdateMu sync.Mutex
+var ledgerTransactionAfterUpdateHooks []LedgerTransactionHook
+
+var ledgerTransactionBeforeDeleteMu sync.Mutex
+var ledgerTransactionBeforeDeleteHooks []LedgerTransactionHook
+var ledgerTransactionAfterDeleteMu sync.Mutex
+var ledgerTransactionAfterDeleteHooks []LedgerTransactionHook
+
+var ledgerTransactionBeforeUpsertMu sync.Mutex
+var ledgerTransactionBeforeUpsertHooks []LedgerTransactionHook
+var ledgerTransactionAfterUpsertMu sync.Mutex
+var ledgerTransactionAfterUpsertHooks []LedgerTransactionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LedgerTransaction) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerTransactionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LedgerTransaction) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerTransactionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LedgerTransaction) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerTransactionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LedgerTransaction) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerTransactionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LedgerTransaction) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerTransactionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LedgerTransaction) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerTransactionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LedgerTransaction) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerTransactionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LedgerTransaction) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerTransactionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LedgerTransaction) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerTransactionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLedgerTransactionHook registers your hook function for all future operations.
+func AddLedgerTransactionHook(hookPoint boil.HookPoint, ledgerTransactionHook LedgerTransactionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		ledgerTransactionAfterSelectMu.Lock()
+		ledgerTransactionAfterSelectHooks = append(ledgerTransactionAfterSelectHooks, ledgerTransactionHook)
+		ledgerTransactionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		ledgerTransactionBeforeInsertMu.Lock()
+		ledgerTransactionBeforeInsertHooks = append(ledgerTransactionBeforeInsertHooks, ledgerTransactionHook)
+		ledgerTransactionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		ledgerTransactionAfterInsertMu.Lock()
+		ledgerTransactionAfterInsertHooks = append(ledgerTransactionAfterInsertHooks, ledgerTransactionHook)
+		ledgerTransactionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		ledgerTransactionBeforeUpdateMu.Lock()
+		ledgerTransactionBeforeUpdateHooks = append(ledgerTransactionBeforeUpdateHooks, ledgerTransactionHook)
+		ledgerTransactionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		ledgerTransactionAfterUpdateMu.Lock()
+		ledgerTransactionAfterUpdateHooks = append(ledgerTransactionAfterUpdateHooks, ledgerTransactionHook)
+		ledgerTransactionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		ledgerTransactionBeforeDeleteMu.Lock()
+		ledgerTransactionBeforeDeleteHooks = append(ledgerTransactionBeforeDeleteHooks, ledgerTransactionHook)
+		ledgerTransactionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		ledgerTransactionAfterDeleteMu.Lock()
+		ledgerTransactionAfterDeleteHooks = append(ledgerTransactionAfterDeleteHooks, ledgerTransactionHook)
+		ledgerTransactionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		ledgerTransactionBeforeUpsertMu.Lock()
+		ledgerTransactionBeforeUpsertHooks = append(ledgerTransactionBeforeUpsertHooks, ledgerTransactionHook)
+		ledgerTransactionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		ledgerTransactionAfterUpsertMu.Lock()
+		ledgerTransactionAfterUpsertHooks = append(ledgerTransactionAfterUpsertHooks, ledgerTransactionHook)
+		ledgerTransactionAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single ledgerTransaction record from the query using the global executor.
+func (q ledgerTransactionQuery) OneG(ctx context.Context) (*LedgerTransaction, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single ledgerTransaction record from the query.
+func (q ledgerTransactionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LedgerTransaction, error) {
+	o := &LedgerTransaction{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for ledger_transactions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all LedgerTransaction records from the query using the global executor.
+func (q ledgerTransactionQuery) AllG(ctx context.Context) (LedgerTransactionSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all LedgerTransaction records from the query.
+func (q ledgerTransactionQuery) All(ctx context.Context, exec boil.ContextExecutor) (LedgerTransactionSlice, error) {
+	var o []*LedgerTransaction
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to LedgerTransaction slice")
+	}
+
+	if len(ledgerTransactionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all LedgerTransaction records in the query using the global executor
+func (q ledgerTransactionQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all LedgerTransaction records in the query.
+func (q ledgerTransactionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count ledger_transactions rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q ledgerTransactionQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q ledgerTransactionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if ledger_transactions exists")
+	}
+
+	return count > 0, nil
+}
+
+// TransferJob pointed to by the foreign key.
+func (o *LedgerTransaction) TransferJob(mods ...qm.QueryMod) transferJobQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TransferJobID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TransferJobs(queryMods...)
+}
+
+// LedgerEntries retrieves all the ledger_entry's LedgerEntries with an executor.
+func (o *LedgerTransaction) LedgerEntries(mods ...qm.QueryMod) ledgerEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"ledger_entries\".\"ledger_transaction_id\"=?", o.ID),
+	)
+
+	return LedgerEntries(queryMods...)
+}
+
+// LoadTransferJob allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (ledgerTransactionL) LoadTransferJob(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedgerTransaction interface{}, mods queries.Applicator) error {
+	var slice []*LedgerTransaction
+	var object *LedgerTransaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedgerTransaction.(*LedgerTransaction)
+		if !ok {
+			object = new(LedgerTransaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedgerTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedgerTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeLedgerTransaction.(*[]*LedgerTransaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedgerTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedgerTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerTransactionR{}
+		}
+		if !queries.IsNil(object.TransferJobID) {
+			args[object.TransferJobID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerTransactionR{}
+			}
+
+			if !queries.IsNil(obj.TransferJobID) {
+				args[obj.TransferJobID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transfer_jobs`),
+		qm.WhereIn(`transfer_jobs.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`transfer_jobs.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TransferJob")
+	}
+
+	var resultSlice []*TransferJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TransferJob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for transfer_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer_jobs")
+	}
+
+	if len(transferJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TransferJob = foreign
+		if foreign.R == nil {
+			foreign.R = &transferJobR{}
+		}
+		foreign.R.LedgerTransactions = append(foreign.R.LedgerTransactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TransferJobID, foreign.ID) {
+				local.R.TransferJob = foreign
+				if foreign.R == nil {
+					foreign.R = &transferJobR{}
+				}
+				foreign.R.LedgerTransactions = append(foreign.R.LedgerTransactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLedgerEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (ledgerTransactionL) LoadLedgerEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedgerTransaction interface{}, mods queries.Applicator) error {
+	var slice []*LedgerTransaction
+	var object *LedgerTransaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedgerTransaction.(*LedgerTransaction)
+		if !ok {
+			object = new(LedgerTransaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedgerTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedgerTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeLedgerTransaction.(*[]*LedgerTransaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedgerTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedgerTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerTransactionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerTransactionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`ledger_entries`),
+		qm.WhereIn(`ledger_entries.ledger_transaction_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ledger_entries")
+	}
+
+	var resultSlice []*LedgerEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ledger_entries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on ledger_entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ledger_entries")
+	}
+
+	if len(ledgerEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.LedgerEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &ledgerEntryR{}
+			}
+			foreign.R.LedgerTransaction = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.LedgerTransactionID {
+				local.R.LedgerEntries = append(local.R.LedgerEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &ledgerEntryR{}
+				}
+				foreign.R.LedgerTransaction = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTransferJobG of the ledgerTransaction to the related item.
+// Sets o.R.TransferJob to related.
+// Adds o to related.R.LedgerTransactions.
+// Uses the global database handle.
+func (o *LedgerTransaction) SetTransferJobG(ctx context.Context, insert bool, related *TransferJob) error {
+	return o.SetTransferJob(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetTransferJob of the ledgerTransaction to the related item.
+// Sets o.R.TransferJob to related.
+// Adds o to related.R.LedgerTransactions.
+func (o *LedgerTransaction) SetTransferJob(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TransferJob) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"ledger_transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_job_id"}),
+		strmangle.WhereClause("\"", "\"", 2, ledgerTransactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TransferJobID, related.ID)
+	if o.R == nil {
+		o.R = &ledgerTransactionR{
+			TransferJob: related,
+		}
+	} else {
+		o.R.TransferJob = related
+	}
+
+	if related.R == nil {
+		related.R = &transferJobR{
+			LedgerTransactions: LedgerTransactionSlice{o},
+		}
+	} else {
+		related.R.LedgerTransactions = append(related.R.LedgerTransactions, o)
+	}
+
+	return nil
+}
+
+// RemoveTransferJobG relationship.
+// Sets o.R.TransferJob to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *LedgerTransaction) RemoveTransferJobG(ctx context.Context, related *TransferJob) error {
+	return o.RemoveTransferJob(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveTransferJob relationship.
+// Sets o.R.TransferJob to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *LedgerTransaction) RemoveTransferJob(ctx context.Context, exec boil.ContextExecutor, related *TransferJob) error {
+	var err error
+
+	queries.SetScanner(&o.TransferJobID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("transfer_job_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.TransferJob = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.LedgerTransactions {
+		if queries.Equal(o.TransferJobID, ri.TransferJobID) {
+			continue
+		}
+
+		ln := len(related.R.LedgerTransactions)
+		if ln > 1 && i < ln-1 {
+			related.R.LedgerTransactions[i] = related.R.LedgerTransactions[ln-1]
+		}
+		related.R.LedgerTransactions = related.R.LedgerTransactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddLedgerEntriesG adds the given related objects to the existing relationships
+// of the ledger_transaction, optionally inserting them as new records.
+// Appends related to o.R.LedgerEntries.
+// Sets related.R.LedgerTransaction appropriately.
+// Uses the global database handle.
+func (o *LedgerTransaction) AddLedgerEntriesG(ctx context.Context, insert bool, related ...*LedgerEntry) error {
+	return o.AddLedgerEntries(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddLedgerEntries adds the given related objects to the existing relationships
+// of the ledger_transaction, optionally inserting them as new records.
+// Appends related to o.R.LedgerEntries.
+// Sets related.R.LedgerTransaction appropriately.
+func (o *LedgerTransaction) AddLedgerEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LedgerEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.LedgerTransactionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"ledger_entries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"ledger_transaction_id"}),
+				strmangle.WhereClause("\"", "\"", 2, ledgerEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.LedgerTransactionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &ledgerTransactionR{
+			LedgerEntries: related,
+		}
+	} else {
+		o.R.LedgerEntries = append(o.R.LedgerEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &ledgerEntryR{
+				LedgerTransaction: o,
+			}
+		} else {
+			rel.R.LedgerTransaction = o
+		}
+	}
+	return nil
+}
+
+// LedgerTransactions retrieves all the records using an executor.
+func LedgerTransactions(mods ...qm.QueryMod) ledgerTransactionQuery {
+	mods = append(mods, qm.From("\"ledger_transactions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"ledger_transactions\".*"})
+	}
+
+	return ledgerTransactionQuery{q}
+}
+
+// FindLedgerTransactionG retrieves a single record by ID.
+func FindLedgerTransactionG(ctx context.Context, iD int, selectCols ...string) (*LedgerTransaction, error) {
+	return FindLedgerTransaction(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindLedgerTransaction retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLedgerTransaction(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*LedgerTransaction, error) {
+	ledgerTransactionObj := &LedgerTransaction{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"ledger_transactions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, ledgerTransactionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from ledger_transactions")
+	}
+
+	if err = ledgerTransactionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return ledgerTransactionObj, err
+	}
+
+	return ledgerTransactionObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *LedgerTransaction) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LedgerTransaction) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no ledger_transactions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ledgerTransactionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	ledgerTransactionInsertCacheMut.RLock()
+	cache, cached := ledgerTransactionInsertCache[key]
+	ledgerTransactionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			ledgerTransactionAllColumns,
+			ledgerTransactionColumnsWithDefault,
+			ledgerTransactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(ledgerTransactionType, ledgerTransactionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(ledgerTransactionType, ledgerTransactionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"ledger_transactions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"ledger_transactions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into ledger_transactions")
+	}
+
+	if !cached {
+		ledgerTransactionInsertCacheMut.Lock()
+		ledgerTransactionInsertCache[key] = cache
+		ledgerTransactionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single LedgerTransaction record using the global executor.
+// See Update for more documentation.
+func (o *LedgerTransaction) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the LedgerTransaction.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LedgerTransaction) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	ledgerTransactionUpdateCacheMut.RLock()
+	cache, cached := ledgerTransactionUpdateCache[key]
+	ledgerTransactionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			ledgerTransactionAllColumns,
+			ledgerTransactionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update ledger_transactions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"ledger_transactions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, ledgerTransactionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(ledgerTransactionType, ledgerTransactionMapping, append(wl, ledgerTransactionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update ledger_transactions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for ledger_transactions")
+	}
+
+	if !cached {
+		ledgerTransactionUpdateCacheMut.Lock()
+		ledgerTransactionUpdateCache[key] = cache
+		ledgerTransactionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q ledgerTransactionQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q ledgerTransactionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for ledger_transactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for ledger_transactions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o LedgerTransactionSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LedgerTransactionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ledgerTransactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"ledger_transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, ledgerTransactionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in ledgerTransaction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all ledgerTransaction")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *LedgerTransaction) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LedgerTransaction) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no ledger_transactions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ledgerTransactionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	ledgerTransactionUpsertCacheMut.RLock()
+	cache, cached := ledgerTransactionUpsertCache[key]
+	ledgerTransactionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			ledgerTransactionAllColumns,
+			ledgerTransactionColumnsWithDefault,
+			ledgerTransactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			ledgerTransactionAllColumns,
+			ledgerTransactionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert ledger_transactions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(ledgerTransactionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(ledgerTransactionPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert ledger_transactions, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(ledgerTransactionPrimaryKeyColumns))
+			copy(conflict, ledgerTransactionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"ledger_transactions\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(ledgerTransactionType, ledgerTransactionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(ledgerTransactionType, ledgerTransactionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert ledger_transactions")
+	}
+
+	if !cached {
+		ledgerTransactionUpsertCacheMut.Lock()
+		ledgerTransactionUpsertCache[key] = cache
+		ledgerTransactionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single LedgerTransaction record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *LedgerTransaction) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single LedgerTransaction record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LedgerTransaction) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no LedgerTransaction provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), ledgerTransactionPrimaryKeyMapping)
+	sql := "DELETE FROM \"ledger_transactions\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from ledger_transactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for ledger_transactions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q ledgerTransactionQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q ledgerTransactionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no ledgerTransactionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from ledger_transactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for ledger_transactions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o LedgerTransactionSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LedgerTransactionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(ledgerTransactionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ledgerTransactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"ledger_transactions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, ledgerTransactionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from ledgerTransaction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for ledger_transactions")
+	}
+
+	if len(ledgerTransactionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *LedgerTransaction) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no LedgerTransaction provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LedgerTransaction) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLedgerTransaction(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LedgerTransactionSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty LedgerTransactionSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LedgerTransactionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LedgerTransactionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ledgerTransactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"ledger_transactions\".* FROM \"ledger_transactions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, ledgerTransactionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in LedgerTransactionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LedgerTransactionExistsG checks if the LedgerTransaction row exists.
+func LedgerTransactionExistsG(ctx context.Context, iD int) (bool, error) {
+	return LedgerTransactionExists(ctx, boil.GetContextDB(), iD)
+}
+
+// LedgerTransactionExists checks if the LedgerTransaction row exists.
+func LedgerTransactionExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"ledger_transactions\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if ledger_transactions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the LedgerTransaction row exists.
+func (o *LedgerTransaction) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LedgerTransactionExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1055 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testLedgerTransactions(t *testing.T) {
+	t.Parallel()
+
+	query := LedgerTransactions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testLedgerTransactionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLedgerTransactionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := LedgerTransactions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLedgerTransactionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LedgerTransactionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLedgerTransactionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := LedgerTransactionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if LedgerTransaction exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected LedgerTransactionExists to return true, but got false.")
+	}
+}
+
+func testLedgerTransactionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	ledgerTransactionFound, err := FindLedgerTransaction(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if ledgerTransactionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testLedgerTransactionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = LedgerTransactions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLedgerTransactionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := LedgerTransactions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testLedgerTransactionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	ledgerTransactionOne := &LedgerTransaction{}
+	ledgerTransactionTwo := &LedgerTransaction{}
+	if err = randomize.Struct(seed, ledgerTransactionOne, ledgerTransactionDBTypes, false, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+	if err = randomize.Struct(seed, ledgerTransactionTwo, ledgerTransactionDBTypes, false, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = ledgerTransactionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = ledgerTransactionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LedgerTransactions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testLedgerTransactionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	ledgerTransactionOne := &LedgerTransaction{}
+	ledgerTransactionTwo := &LedgerTransaction{}
+	if err = randomize.Struct(seed, ledgerTransactionOne, ledgerTransactionDBTypes, false, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+	if err = randomize.Struct(seed, ledgerTransactionTwo, ledgerTransactionDBTypes, false, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = ledgerTransactionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = ledgerTransactionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func ledgerTransactionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *LedgerTransaction) error {
+	*o = LedgerTransaction{}
+	return nil
+}
+
+func ledgerTransactionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *LedgerTransaction) error {
+	*o = LedgerTransaction{}
+	return nil
+}
+
+func ledgerTransactionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *LedgerTransaction) error {
+	*o = LedgerTransaction{}
+	return nil
+}
+
+func ledgerTransactionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LedgerTransaction) error {
+	*o = LedgerTransaction{}
+	return nil
+}
+
+func ledgerTransactionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LedgerTransaction) error {
+	*o = LedgerTransaction{}
+	return nil
+}
+
+func ledgerTransactionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LedgerTransaction) error {
+	*o = LedgerTransaction{}
+	return nil
+}
+
+func ledgerTransactionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LedgerTransaction) error {
+	*o = LedgerTransaction{}
+	return nil
+}
+
+func ledgerTransactionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LedgerTransaction) error {
+	*o = LedgerTransaction{}
+	return nil
+}
+
+func ledgerTransactionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LedgerTransaction) error {
+	*o = LedgerTransaction{}
+	return nil
+}
+
+func testLedgerTransactionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &LedgerTransaction{}
+	o := &LedgerTransaction{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction object: %s", err)
+	}
+
+	AddLedgerTransactionHook(boil.BeforeInsertHook, ledgerTransactionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	ledgerTransactionBeforeInsertHooks = []LedgerTransactionHook{}
+
+	AddLedgerTransactionHook(boil.AfterInsertHook, ledgerTransactionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	ledgerTransactionAfterInsertHooks = []LedgerTransactionHook{}
+
+	AddLedgerTransactionHook(boil.AfterSelectHook, ledgerTransactionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	ledgerTransactionAfterSelectHooks = []LedgerTransactionHook{}
+
+	AddLedgerTransactionHook(boil.BeforeUpdateHook, ledgerTransactionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	ledgerTransactionBeforeUpdateHooks = []LedgerTransactionHook{}
+
+	AddLedgerTransactionHook(boil.AfterUpdateHook, ledgerTransactionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	ledgerTransactionAfterUpdateHooks = []LedgerTransactionHook{}
+
+	AddLedgerTransactionHook(boil.BeforeDeleteHook, ledgerTransactionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	ledgerTransactionBeforeDeleteHooks = []LedgerTransactionHook{}
+
+	AddLedgerTransactionHook(boil.AfterDeleteHook, ledgerTransactionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	ledgerTransactionAfterDeleteHooks = []LedgerTransactionHook{}
+
+	AddLedgerTransactionHook(boil.BeforeUpsertHook, ledgerTransactionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	ledgerTransactionBeforeUpsertHooks = []LedgerTransactionHook{}
+
+	AddLedgerTransactionHook(boil.AfterUpsertHook, ledgerTransactionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	ledgerTransactionAfterUpsertHooks = []LedgerTransactionHook{}
+}
+
+func testLedgerTransactionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLedgerTransactionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(ledgerTransactionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLedgerTransactionToManyLedgerEntries(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LedgerTransaction
+	var b, c LedgerEntry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, ledgerEntryDBTypes, false, ledgerEntryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, ledgerEntryDBTypes, false, ledgerEntryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.LedgerTransactionID = a.ID
+	c.LedgerTransactionID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.LedgerEntries().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.LedgerTransactionID == b.LedgerTransactionID {
+			bFound = true
+		}
+		if v.LedgerTransactionID == c.LedgerTransactionID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := LedgerTransactionSlice{&a}
+	if err = a.L.LoadLedgerEntries(ctx, tx, false, (*[]*LedgerTransaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.LedgerEntries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.LedgerEntries = nil
+	if err = a.L.LoadLedgerEntries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.LedgerEntries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testLedgerTransactionToManyAddOpLedgerEntries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LedgerTransaction
+	var b, c, d, e LedgerEntry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, ledgerTransactionDBTypes, false, strmangle.SetComplement(ledgerTransactionPrimaryKeyColumns, ledgerTransactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*LedgerEntry{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, ledgerEntryDBTypes, false, strmangle.SetComplement(ledgerEntryPrimaryKeyColumns, ledgerEntryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*LedgerEntry{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddLedgerEntries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.LedgerTransactionID {
+			t.Error("foreign key was wrong value", a.ID, first.LedgerTransactionID)
+		}
+		if a.ID != second.LedgerTransactionID {
+			t.Error("foreign key was wrong value", a.ID, second.LedgerTransactionID)
+		}
+
+		if first.R.LedgerTransaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.LedgerTransaction != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.LedgerEntries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.LedgerEntries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.LedgerEntries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testLedgerTransactionToOneTransferJobUsingTransferJob(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local LedgerTransaction
+	var foreign TransferJob
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, transferJobDBTypes, false, transferJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJob struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.TransferJobID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.TransferJob().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddTransferJobHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *TransferJob) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := LedgerTransactionSlice{&local}
+	if err = local.L.LoadTransferJob(ctx, tx, false, (*[]*LedgerTransaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TransferJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.TransferJob = nil
+	if err = local.L.LoadTransferJob(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TransferJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testLedgerTransactionToOneSetOpTransferJobUsingTransferJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LedgerTransaction
+	var b, c TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, ledgerTransactionDBTypes, false, strmangle.SetComplement(ledgerTransactionPrimaryKeyColumns, ledgerTransactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*TransferJob{&b, &c} {
+		err = a.SetTransferJob(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.TransferJob != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.LedgerTransactions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.TransferJobID, x.ID) {
+			t.Error("foreign key was wrong value", a.TransferJobID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TransferJobID))
+		reflect.Indirect(reflect.ValueOf(&a.TransferJobID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.TransferJobID, x.ID) {
+			t.Error("foreign key was wrong value", a.TransferJobID, x.ID)
+		}
+	}
+}
+
+func testLedgerTransactionToOneRemoveOpTransferJobUsingTransferJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LedgerTransaction
+	var b TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, ledgerTransactionDBTypes, false, strmangle.SetComplement(ledgerTransactionPrimaryKeyColumns, ledgerTransactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetTransferJob(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveTransferJob(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.TransferJob().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.TransferJob != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.TransferJobID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.LedgerTransactions) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testLedgerTransactionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLedgerTransactionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LedgerTransactionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLedgerTransactionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LedgerTransactions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	ledgerTransactionDBTypes = map[string]string{`ID`: `integer`, `TransactionID`: `character varying`, `Type`: `enum.ledgertransactiontype('transfer','fee','settlement','reversal','refund')`, `TransferJobID`: `integer`, `Currency`: `character varying`, `Description`: `text`, `CreatedAt`: `timestamp with time zone`}
+	_                        = bytes.MinRead
+)
+
+func testLedgerTransactionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(ledgerTransactionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(ledgerTransactionAllColumns) == len(ledgerTransactionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testLedgerTransactionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(ledgerTransactionAllColumns) == len(ledgerTransactionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LedgerTransaction{}
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, ledgerTransactionDBTypes, true, ledgerTransactionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(ledgerTransactionAllColumns, ledgerTransactionPrimaryKeyColumns) {
+		fields = ledgerTransactionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			ledgerTransactionAllColumns,
+			ledgerTransactionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := LedgerTransactionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testLedgerTransactionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(ledgerTransactionAllColumns) == len(ledgerTransactionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := LedgerTransaction{}
+	if err = randomize.Struct(seed, &o, ledgerTransactionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LedgerTransaction: %s", err)
+	}
+
+	count, err := LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, ledgerTransactionDBTypes, false, ledgerTransactionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LedgerTransaction struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LedgerTransaction: %s", err)
+	}
+
+	count, err = LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1211 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// OutboxMessage is an object representing the database table.
+type OutboxMessage struct {
+	ID            int          `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Topic         string       `boil:"topic" json:"topic" toml:"topic" yaml:"topic"`
+	MessageKey    string       `boil:"message_key" json:"message_key" toml:"message_key" yaml:"message_key"`
+	Payload       types.JSON   `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Status        Outboxstatus `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts      int          `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	LastError     null.String  `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	SentAt        null.Time    `boil:"sent_at" json:"sent_at,omitempty" toml:"sent_at" yaml:"sent_at,omitempty"`
+	CreatedBy     int          `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt     time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy     int          `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt     time.Time    `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy     null.Int     `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt     null.Time    `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	NextAttemptAt null.Time    `boil:"next_attempt_at" json:"next_attempt_at,omitempty" toml:"next_attempt_at" yaml:"next_attempt_at,omitempty"`
+
+	R *outboxMessageR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L outboxMessageL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OutboxMessageColumns = struct {
+	ID            string
+	Topic         string
+	MessageKey    string
+	Payload       string
+	Status        string
+	Attempts      string
+	LastError     string
+	SentAt        string
+	CreatedBy     string
+	CreatedAt     string
+	UpdatedBy     string
+	UpdatedAt     string
+	DeletedBy     string
+	DeletedAt     string
+	NextAttemptAt string
+}{
+	ID:            "id",
+	Topic:         "topic",
+	MessageKey:    "message_key",
+	Payload:       "payload",
+	Status:        "status",
+	Attempts:      "attempts",
+	LastError:     "last_error",
+	SentAt:        "sent_at",
+	CreatedBy:     "created_by",
+	CreatedAt:     "created_at",
+	UpdatedBy:     "updated_by",
+	UpdatedAt:     "updated_at",
+	DeletedBy:     "deleted_by",
+	DeletedAt:     "deleted_at",
+	NextAttemptAt: "next_attempt_at",
+}
+
+var OutboxMessageTableColumns = struct {
+	ID            string
+	Topic         string
+	MessageKey    string
+	Payload       string
+	Status        string
+	Attempts      string
+	LastError     string
+	SentAt        string
+	CreatedBy     string
+	CreatedAt     string
+	UpdatedBy     string
+	UpdatedAt     string
+	DeletedBy     string
+	DeletedAt     string
+	NextAttemptAt string
+}{
+	ID:            "outbox_messages.id",
+	Topic:         "outbox_messages.topic",
+	MessageKey:    "outbox_messages.message_key",
+	Payload:       "outbox_messages.payload",
+	Status:        "outbox_messages.status",
+	Attempts:      "outbox_messages.attempts",
+	LastError:     "outbox_messages.last_error",
+	SentAt:        "outbox_messages.sent_at",
+	CreatedBy:     "outbox_messages.created_by",
+	CreatedAt:     "outbox_messages.created_at",
+	UpdatedBy:     "outbox_messages.updated_by",
+	UpdatedAt:     "outbox_messages.updated_at",
+	DeletedBy:     "outbox_messages.deleted_by",
+	DeletedAt:     "outbox_messages.deleted_at",
+	NextAttemptAt: "outbox_messages.next_attempt_at",
+}
+
+// Generated where
+
+type whereHelperOutboxstatus struct{ field string }
+
+func (w whereHelperOutboxstatus) EQ(x Outboxstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperOutboxstatus) NEQ(x Outboxstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperOutboxstatus) LT(x Outboxstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperOutboxstatus) LTE(x Outboxstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperOutboxstatus) GT(x Outboxstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperOutboxstatus) GTE(x Outboxstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperOutboxstatus) IN(slice []Outboxstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperOutboxstatus) NIN(slice []Outboxstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var OutboxMessageWhere = struct {
+	ID            whereHelperint
+	Topic         whereHelperstring
+	MessageKey    whereHelperstring
+	Payload       whereHelpertypes_JSON
+	Status        whereHelperOutboxstatus
+	Attempts      whereHelperint
+	LastError     whereHelpernull_String
+	SentAt        whereHelpernull_Time
+	CreatedBy     whereHelperint
+	CreatedAt     whereHelpertime_Time
+	UpdatedBy     whereHelperint
+	UpdatedAt     whereHelpertime_Time
+	DeletedBy     whereHelpernull_Int
+	DeletedAt     whereHelpernull_Time
+	NextAttemptAt whereHelpernull_Time
+}{
+	ID:            whereHelperint{field: "\"outbox_messages\".\"id\""},
+	Topic:         whereHelperstring{field: "\"outbox_messages\".\"topic\""},
+	MessageKey:    whereHelperstring{field: "\"outbox_messages\".\"message_key\""},
+	Payload:       whereHelpertypes_JSON{field: "\"outbox_messages\".\"payload\""},
+	Status:        whereHelperOutboxstatus{field: "\"outbox_messages\".\"status\""},
+	Attempts:      whereHelperint{field: "\"outbox_messages\".\"attempts\""},
+	LastError:     whereHelpernull_String{field: "\"outbox_messages\".\"last_error\""},
+	SentAt:        whereHelpernull_Time{field: "\"outbox_messages\".\"sent_at\""},
+	CreatedBy:     whereHelperint{field: "\"outbox_messages\".\"created_by\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"outbox_messages\".\"created_at\""},
+	UpdatedBy:     whereHelperint{field: "\"outbox_messages\".\"updated_by\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"outbox_messages\".\"updated_at\""},
+	DeletedBy:     whereHelpernull_Int{field: "\"outbox_messages\".\"deleted_by\""},
+	DeletedAt:     whereHelpernull_Time{field: "\"outbox_messages\".\"deleted_at\""},
+	NextAttemptAt: whereHelpernull_Time{field: "\"outbox_messages\".\"next_attempt_at\""},
+}
+
+// OutboxMessageRels is where relationship names are stored.
+var OutboxMessageRels = struct {
+}{}
+
+// outboxMessageR is where relationships are stored.
+type outboxMessageR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*outboxMessageR) NewStruct() *outboxMessageR {
+	return &outboxMessageR{}
+}
+
+// outboxMessageL is where Load methods for each relationship are stored.
+type outboxMessageL struct{}
+
+var (
+	outboxMessageAllColumns            = []string{"id", "topic", "message_key", "payload", "status", "attempts", "last_error", "sent_at", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "next_attempt_at"}
+	outboxMessageColumnsWithoutDefault = []string{"topic", "message_key", "payload"}
+	outboxMessageColumnsWithDefault    = []string{"id", "status", "attempts", "last_error", "sent_at", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "next_attempt_at"}
+	outboxMessagePrimaryKeyColumns     = []string{"id"}
+	outboxMessageGeneratedColumns      = []string{}
+)
+
+type (
+	// OutboxMessageSlice is an alias for a slice of pointers to OutboxMessage.
+	// This should almost always be used instead of []OutboxMessage.
+	OutboxMessageSlice []*OutboxMessage
+	// OutboxMessageHook is the signature for custom OutboxMessage hook methods
+	OutboxMessageHook func(context.Context, boil.ContextExecutor, *OutboxMessage) error
+
+	outboxMessageQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	outboxMessageType                 = reflect.TypeOf(&OutboxMessage{})
+	outboxMessageMapping              = queries.MakeStructMapping(outboxMessageType)
+	outboxMessagePrimaryKeyMapping, _ = queries.BindMapping(outboxMessageType, outboxMessageMapping, outboxMessagePrimaryKeyColumns)
+	outboxMessageInsertCacheMut       sync.RWMutex
+	outboxMessageInsertCache          = make(map[string]insertCache)
+	outboxMessageUpdateCacheMut       sync.RWMutex
+	outboxMessageUpdateCache          = make(map[string]updateCache)
+	outboxMessageUpsertCacheMut       sync.RWMutex
+	outboxMessageUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var outboxMessageAfterSelectMu sync.Mutex
+var outboxMessageAfterSelectHooks []OutboxMessageHook
+
+var outboxMessageBeforeInsertMu sync.Mutex
+var outboxMessageBeforeInsertHooks []OutboxMessageHook
+var outboxMessageAfterInsertMu sync.Mutex
+var outboxMessageAfterInsertHooks []OutboxMessageHook
+
+var outboxMessageBeforeUpdateMu sync.Mutex
+var outboxMessageBeforeUpdateHooks []OutboxMessageHook
+var outboxMessageAfterUpdateMu sync.Mutex
+var outboxMessageAfterUpdateHooks []OutboxMessageHook
+
+var outboxMessageBeforeDeleteMu sync.Mutex
+var outboxMessageBeforeDeleteHooks []OutboxMessageHook
+var outboxMessageAfterDeleteMu sync.Mutex
+var outboxMessageAfterDeleteHooks []OutboxMessageHook
+
+var outboxMessageBeforeUpsertMu sync.Mutex
+var outboxMessageBeforeUpsertHooks []OutboxMessageHook
+var outboxMessageAfterUpsertMu sync.Mutex
+var outboxMessageAfterUpsertHooks []OutboxMessageHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OutboxMessage) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxMessageAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OutboxMessage) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxMessageBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OutboxMessage) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxMessageAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OutboxMessage) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxMessageBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OutboxMessage) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxMessageAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OutboxMessage) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxMessageBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OutboxMessage) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxMessageAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OutboxMessage) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxMessageBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OutboxMessage) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxMessageAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOutboxMessageHook registers your hook function for all future operations.
+func AddOutboxMessageHook(hookPoint boil.HookPoint, outboxMessageHook OutboxMessageHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		outboxMessageAfterSelectMu.Lock()
+		outboxMessageAfterSelectHooks = append(outboxMessageAfterSelectHooks, outboxMessageHook)
+		outboxMessageAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		outboxMessageBeforeInsertMu.Lock()
+		outboxMessageBeforeInsertHooks = append(outboxMessageBeforeInsertHooks, outboxMessageHook)
+		outboxMessageBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		outboxMessageAfterInsertMu.Lock()
+		outboxMessageAfterInsertHooks = append(outboxMessageAfterInsertHooks, outboxMessageHook)
+		outboxMessageAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		outboxMessageBeforeUpdateMu.Lock()
+		outboxMessageBeforeUpdateHooks = append(outboxMessageBeforeUpdateHooks, outboxMessageHook)
+		outboxMessageBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		outboxMessageAfterUpdateMu.Lock()
+		outboxMessageAfterUpdateHooks = append(outboxMessageAfterUpdateHooks, outboxMessageHook)
+		outboxMessageAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		outboxMessageBeforeDeleteMu.Lock()
+		outboxMessageBeforeDeleteHooks = append(outboxMessageBeforeDeleteHooks, outboxMessageHook)
+		outboxMessageBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		outboxMessageAfterDeleteMu.Lock()
+		outboxMessageAfterDeleteHooks = append(outboxMessageAfterDeleteHooks, outboxMessageHook)
+		outboxMessageAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		outboxMessageBeforeUpsertMu.Lock()
+		outboxMessageBeforeUpsertHooks = append(outboxMessageBeforeUpsertHooks, outboxMessageHook)
+		outboxMessageBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		outboxMessageAfterUpsertMu.Lock()
+		outboxMessageAfterUpsertHooks = append(outboxMessageAfterUpsertHooks, outboxMessageHook)
+		outboxMessageAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single outboxMessage record from the query using the global executor.
+func (q outboxMessageQuery) OneG(ctx context.Context) (*OutboxMessage, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single outboxMessage record from the query.
+func (q outboxMessageQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OutboxMessage, error) {
+	o := &OutboxMessage{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for outbox_messages")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all OutboxMessage records from the query using the global executor.
+func (q outboxMessageQuery) AllG(ctx context.Context) (OutboxMessageSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all OutboxMessage records from the query.
+func (q outboxMessageQuery) All(ctx context.Context, exec boil.ContextExecutor) (OutboxMessageSlice, error) {
+	var o []*OutboxMessage
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to OutboxMessage slice")
+	}
+
+	if len(outboxMessageAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all OutboxMessage records in the query using the global executor
+func (q outboxMessageQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all OutboxMessage records in the query.
+func (q outboxMessageQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count outbox_messages rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q outboxMessageQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q outboxMessageQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if outbox_messages exists")
+	}
+
+	return count > 0, nil
+}
+
+// OutboxMessages retrieves all the records using an executor.
+func OutboxMessages(mods ...qm.QueryMod) outboxMessageQuery {
+	mods = append(mods, qm.From("\"outbox_messages\""), qmhelper.WhereIsNull("\"outbox_messages\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"outbox_messages\".*"})
+	}
+
+	return outboxMessageQuery{q}
+}
+
+// FindOutboxMessageG retrieves a single record by ID.
+func FindOutboxMessageG(ctx context.Context, iD int, selectCols ...string) (*OutboxMessage, error) {
+	return FindOutboxMessage(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindOutboxMessage retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOutboxMessage(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*OutboxMessage, error) {
+	outboxMessageObj := &OutboxMessage{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"outbox_messages\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, outboxMessageObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from outbox_messages")
+	}
+
+	if err = outboxMessageObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return outboxMessageObj, err
+	}
+
+	return outboxMessageObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *OutboxMessage) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OutboxMessage) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no outbox_messages provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(outboxMessageColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	outboxMessageInsertCacheMut.RLock()
+	cache, cached := outboxMessageInsertCache[key]
+	outboxMessageInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			outboxMessageAllColumns,
+			outboxMessageColumnsWithDefault,
+			outboxMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(outboxMessageType, outboxMessageMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(outboxMessageType, outboxMessageMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"outbox_messages\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"outbox_messages\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into outbox_messages")
+	}
+
+	if !cached {
+		outboxMessageInsertCacheMut.Lock()
+		outboxMessageInsertCache[key] = cache
+		outboxMessageInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single OutboxMessage record using the global executor.
+// See Update for more documentation.
+func (o *OutboxMessage) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the OutboxMessage.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OutboxMessage) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	outboxMessageUpdateCacheMut.RLock()
+	cache, cached := outboxMessageUpdateCache[key]
+	outboxMessageUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			outboxMessageAllColumns,
+			outboxMessagePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update outbox_messages, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"outbox_messages\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, outboxMessagePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(outboxMessageType, outboxMessageMapping, append(wl, outboxMessagePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update outbox_messages row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for outbox_messages")
+	}
+
+	if !cached {
+		outboxMessageUpdateCacheMut.Lock()
+		outboxMessageUpdateCache[key] = cache
+		outboxMessageUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q outboxMessageQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q outboxMessageQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for outbox_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for outbox_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o OutboxMessageSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OutboxMessageSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"outbox_messages\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, outboxMessagePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in outboxMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all outboxMessage")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *OutboxMessage) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OutboxMessage) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no outbox_messages provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(outboxMessageColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	outboxMessageUpsertCacheMut.RLock()
+	cache, cached := outboxMessageUpsertCache[key]
+	outboxMessageUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			outboxMessageAllColumns,
+			outboxMessageColumnsWithDefault,
+			outboxMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			outboxMessageAllColumns,
+			outboxMessagePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert outbox_messages, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(outboxMessageAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(outboxMessagePrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert outbox_messages, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(outboxMessagePrimaryKeyColumns))
+			copy(conflict, outboxMessagePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"outbox_messages\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(outboxMessageType, outboxMessageMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(outboxMessageType, outboxMessageMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert outbox_messages")
+	}
+
+	if !cached {
+		outboxMessageUpsertCacheMut.Lock()
+		outboxMessageUpsertCache[key] = cache
+		outboxMessageUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single OutboxMessage record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *OutboxMessage) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single OutboxMessage record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OutboxMessage) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no OutboxMessage provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), outboxMessagePrimaryKeyMapping)
+		sql = "DELETE FROM \"outbox_messages\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"outbox_messages\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(outboxMessageType, outboxMessageMapping, append(wl, outboxMessagePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from outbox_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for outbox_messages")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q outboxMessageQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q outboxMessageQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no outboxMessageQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from outbox_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for outbox_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o OutboxMessageSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OutboxMessageSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(outboxMessageBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxMessagePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"outbox_messages\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, outboxMessagePrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxMessagePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"outbox_messages\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, outboxMessagePrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from outboxMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for outbox_messages")
+	}
+
+	if len(outboxMessageAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *OutboxMessage) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no OutboxMessage provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OutboxMessage) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOutboxMessage(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OutboxMessageSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty OutboxMessageSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OutboxMessageSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OutboxMessageSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"outbox_messages\".* FROM \"outbox_messages\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, outboxMessagePrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in OutboxMessageSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OutboxMessageExistsG checks if the OutboxMessage row exists.
+func OutboxMessageExistsG(ctx context.Context, iD int) (bool, error) {
+	return OutboxMessageExists(ctx, boil.GetContextDB(), iD)
+}
+
+// OutboxMessageExists checks if the OutboxMessage row exists.
+func OutboxMessageExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"outbox_messages\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if outbox_messages exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OutboxMessage row exists.
+func (o *OutboxMessage) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OutboxMessageExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,833 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOutboxMessages(t *testing.T) {
+	t.Parallel()
+
+	query := OutboxMessages()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOutboxMessagesSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOutboxMessagesQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OutboxMessages().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOutboxMessagesSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OutboxMessageSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOutboxMessagesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOutboxMessagesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OutboxMessages().DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOutboxMessagesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OutboxMessageSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOutboxMessagesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OutboxMessageExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OutboxMessage exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OutboxMessageExists to return true, but got false.")
+	}
+}
+
+func testOutboxMessagesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	outboxMessageFound, err := FindOutboxMessage(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if outboxMessageFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOutboxMessagesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OutboxMessages().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOutboxMessagesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OutboxMessages().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOutboxMessagesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	outboxMessageOne := &OutboxMessage{}
+	outboxMessageTwo := &OutboxMessage{}
+	if err = randomize.Struct(seed, outboxMessageOne, outboxMessageDBTypes, false, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+	if err = randomize.Struct(seed, outboxMessageTwo, outboxMessageDBTypes, false, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = outboxMessageOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = outboxMessageTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OutboxMessages().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOutboxMessagesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	outboxMessageOne := &OutboxMessage{}
+	outboxMessageTwo := &OutboxMessage{}
+	if err = randomize.Struct(seed, outboxMessageOne, outboxMessageDBTypes, false, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+	if err = randomize.Struct(seed, outboxMessageTwo, outboxMessageDBTypes, false, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = outboxMessageOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = outboxMessageTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func outboxMessageBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OutboxMessage) error {
+	*o = OutboxMessage{}
+	return nil
+}
+
+func outboxMessageAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OutboxMessage) error {
+	*o = OutboxMessage{}
+	return nil
+}
+
+func outboxMessageAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OutboxMessage) error {
+	*o = OutboxMessage{}
+	return nil
+}
+
+func outboxMessageBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OutboxMessage) error {
+	*o = OutboxMessage{}
+	return nil
+}
+
+func outboxMessageAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OutboxMessage) error {
+	*o = OutboxMessage{}
+	return nil
+}
+
+func outboxMessageBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OutboxMessage) error {
+	*o = OutboxMessage{}
+	return nil
+}
+
+func outboxMessageAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OutboxMessage) error {
+	*o = OutboxMessage{}
+	return nil
+}
+
+func outboxMessageBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OutboxMessage) error {
+	*o = OutboxMessage{}
+	return nil
+}
+
+func outboxMessageAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OutboxMessage) error {
+	*o = OutboxMessage{}
+	return nil
+}
+
+func testOutboxMessagesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OutboxMessage{}
+	o := &OutboxMessage{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage object: %s", err)
+	}
+
+	AddOutboxMessageHook(boil.BeforeInsertHook, outboxMessageBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	outboxMessageBeforeInsertHooks = []OutboxMessageHook{}
+
+	AddOutboxMessageHook(boil.AfterInsertHook, outboxMessageAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	outboxMessageAfterInsertHooks = []OutboxMessageHook{}
+
+	AddOutboxMessageHook(boil.AfterSelectHook, outboxMessageAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	outboxMessageAfterSelectHooks = []OutboxMessageHook{}
+
+	AddOutboxMessageHook(boil.BeforeUpdateHook, outboxMessageBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	outboxMessageBeforeUpdateHooks = []OutboxMessageHook{}
+
+	AddOutboxMessageHook(boil.AfterUpdateHook, outboxMessageAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	outboxMessageAfterUpdateHooks = []OutboxMessageHook{}
+
+	AddOutboxMessageHook(boil.BeforeDeleteHook, outboxMessageBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	outboxMessageBeforeDeleteHooks = []OutboxMessageHook{}
+
+	AddOutboxMessageHook(boil.AfterDeleteHook, outboxMessageAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	outboxMessageAfterDeleteHooks = []OutboxMessageHook{}
+
+	AddOutboxMessageHook(boil.BeforeUpsertHook, outboxMessageBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	outboxMessageBeforeUpsertHooks = []OutboxMessageHook{}
+
+	AddOutboxMessageHook(boil.AfterUpsertHook, outboxMessageAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	outboxMessageAfterUpsertHooks = []OutboxMessageHook{}
+}
+
+func testOutboxMessagesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOutboxMessagesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(outboxMessageColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOutboxMessagesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOutboxMessagesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OutboxMessageSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOutboxMessagesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OutboxMessages().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	outboxMessageDBTypes = map[string]string{`ID`: `integer`, `Topic`: `character varying`, `MessageKey`: `text`, `Payload`: `jsonb`, `Status`: `enum.outboxstatus('pending','sent','parked')`, `Attempts`: `integer`, `LastError`: `text`, `SentAt`: `timestamp with time zone`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`, `NextAttemptAt`: `timestamp with time zone`}
+	_                    = bytes.MinRead
+)
+
+func testOutboxMessagesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(outboxMessagePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(outboxMessageAllColumns) == len(outboxMessagePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessagePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOutboxMessagesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(outboxMessageAllColumns) == len(outboxMessagePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OutboxMessage{}
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, outboxMessageDBTypes, true, outboxMessagePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(outboxMessageAllColumns, outboxMessagePrimaryKeyColumns) {
+		fields = outboxMessageAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			outboxMessageAllColumns,
+			outboxMessagePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OutboxMessageSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOutboxMessagesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(outboxMessageAllColumns) == len(outboxMessagePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OutboxMessage{}
+	if err = randomize.Struct(seed, &o, outboxMessageDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OutboxMessage: %s", err)
+	}
+
+	count, err := OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, outboxMessageDBTypes, false, outboxMessagePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OutboxMessage struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OutboxMessage: %s", err)
+	}
+
+	count, err = OutboxMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -6,13 +6,47 @@ package entity
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("AccountLimits", testAccountLimitsUpsert)
+
 	t.Run("AccountRoles", testAccountRolesUpsert)
 
 	t.Run("Accounts", testAccountsUpsert)
 
+	t.Run("DeadLetterMessages", testDeadLetterMessagesUpsert)
+
+	t.Run("FeeRules", testFeeRulesUpsert)
+
+	t.Run("FXRates", testFXRatesUpsert)
+
+	t.Run("LedgerAccounts", testLedgerAccountsUpsert)
+
+	t.Run("LedgerEntries", testLedgerEntriesUpsert)
+
+	t.Run("LedgerTransactions", testLedgerTransactionsUpsert)
+
+	t.Run("OutboxMessages", testOutboxMessagesUpsert)
+
+	t.Run("ReconciliationDiscrepancies", testReconciliationDiscrepanciesUpsert)
+
+	t.Run("ReconciliationReports", testReconciliationReportsUpsert)
+
+	t.Run("RecurringTransfers", testRecurringTransfersUpsert)
+
 	t.Run("Roles", testRolesUpsert)
 
 	t.Run("SchemaMigrations", testSchemaMigrationsUpsert)
 
+	t.Run("TransferBatches", testTransferBatchesUpsert)
+
+	t.Run("TransferExports", testTransferExportsUpsert)
+
+	t.Run("TransferJobEvents", testTransferJobEventsUpsert)
+
 	t.Run("TransferJobs", testTransferJobsUpsert)
+
+	t.Run("TransferQuotes", testTransferQuotesUpsert)
+
+	t.Run("WebhookDeliveries", testWebhookDeliveriesUpsert)
+
+	t.Run("WebhookEndpoints", testWebhookEndpointsUpsert)
 }
@@ -0,0 +1,1585 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReconciliationDiscrepancy is an object representing the database table.
+type ReconciliationDiscrepancy struct {
+	ID                     int             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ReconciliationReportID int             `boil:"reconciliation_report_id" json:"reconciliation_report_id" toml:"reconciliation_report_id" yaml:"reconciliation_report_id"`
+	Type                   Discrepancytype `boil:"type" json:"type" toml:"type" yaml:"type"`
+	TransferJobID          null.Int        `boil:"transfer_job_id" json:"transfer_job_id,omitempty" toml:"transfer_job_id" yaml:"transfer_job_id,omitempty"`
+	JobID                  null.String     `boil:"job_id" json:"job_id,omitempty" toml:"job_id" yaml:"job_id,omitempty"`
+	ProviderID             string          `boil:"provider_id" json:"provider_id" toml:"provider_id" yaml:"provider_id"`
+	LocalAmount            null.Int64      `boil:"local_amount" json:"local_amount,omitempty" toml:"local_amount" yaml:"local_amount,omitempty"`
+	ProviderAmount         null.Int64      `boil:"provider_amount" json:"provider_amount,omitempty" toml:"provider_amount" yaml:"provider_amount,omitempty"`
+	LocalCurrency          null.String     `boil:"local_currency" json:"local_currency,omitempty" toml:"local_currency" yaml:"local_currency,omitempty"`
+	ProviderCurrency       null.String     `boil:"provider_currency" json:"provider_currency,omitempty" toml:"provider_currency" yaml:"provider_currency,omitempty"`
+	LocalStatus            null.String     `boil:"local_status" json:"local_status,omitempty" toml:"local_status" yaml:"local_status,omitempty"`
+	ProviderStatus         null.String     `boil:"provider_status" json:"provider_status,omitempty" toml:"provider_status" yaml:"provider_status,omitempty"`
+	CreatedAt              time.Time       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *reconciliationDiscrepancyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reconciliationDiscrepancyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReconciliationDiscrepancyColumns = struct {
+	ID                     string
+	ReconciliationReportID string
+	Type                   string
+	TransferJobID          string
+	JobID                  string
+	ProviderID             string
+	LocalAmount            string
+	ProviderAmount         string
+	LocalCurrency          string
+	ProviderCurrency       string
+	LocalStatus            string
+	ProviderStatus         string
+	CreatedAt              string
+}{
+	ID:                     "id",
+	ReconciliationReportID: "reconciliation_report_id",
+	Type:                   "type",
+	TransferJobID:          "transfer_job_id",
+	JobID:                  "job_id",
+	ProviderID:             "provider_id",
+	LocalAmount:            "local_amount",
+	ProviderAmount:         "provider_amount",
+	LocalCurrency:          "local_currency",
+	ProviderCurrency:       "provider_currency",
+	LocalStatus:            "local_status",
+	ProviderStatus:         "provider_status",
+	CreatedAt:              "created_at",
+}
+
+var ReconciliationDiscrepancyTableColumns = struct {
+	ID                     string
+	ReconciliationReportID string
+	Type                   string
+	TransferJobID          string
+	JobID                  string
+	ProviderID             string
+	LocalAmount            string
+	ProviderAmount         string
+	LocalCurrency          string
+	ProviderCurrency       string
+	LocalStatus            string
+	ProviderStatus         string
+	CreatedAt              string
+}{
+	ID:                     "reconciliation_discrepancies.id",
+	ReconciliationReportID: "reconciliation_discrepancies.reconciliation_report_id",
+	Type:                   "reconciliation_discrepancies.type",
+	TransferJobID:          "reconciliation_discrepancies.transfer_job_id",
+	JobID:                  "reconciliation_discrepancies.job_id",
+	ProviderID:             "reconciliation_discrepancies.provider_id",
+	LocalAmount:            "reconciliation_discrepancies.local_amount",
+	ProviderAmount:         "reconciliation_discrepancies.provider_amount",
+	LocalCurrency:          "reconciliation_discrepancies.local_currency",
+	ProviderCurrency:       "reconciliation_discrepancies.provider_currency",
+	LocalStatus:            "reconciliation_discrepancies.local_status",
+	ProviderStatus:         "reconciliation_discrepancies.provider_status",
+	CreatedAt:              "reconciliation_discrepancies.created_at",
+}
+
+// Generated where
+
+type whereHelperDiscrepancytype struct{ field string }
+
+func (w whereHelperDiscrepancytype) EQ(x Discrepancytype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperDiscrepancytype) NEQ(x Discrepancytype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperDiscrepancytype) LT(x Discrepancytype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperDiscrepancytype) LTE(x Discrepancytype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperDiscrepancytype) GT(x Discrepancytype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperDiscrepancytype) GTE(x Discrepancytype) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperDiscrepancytype) IN(slice []Discrepancytype) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperDiscrepancytype) NIN(slice []Discrepancytype) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var ReconciliationDiscrepancyWhere = struct {
+	ID                     whereHelperint
+	ReconciliationReportID whereHelperint
+	Type                   whereHelperDiscrepancytype
+	TransferJobID          whereHelpernull_Int
+	JobID                  whereHelpernull_String
+	ProviderID             whereHelperstring
+	LocalAmount            whereHelpernull_Int64
+	ProviderAmount         whereHelpernull_Int64
+	LocalCurrency          whereHelpernull_String
+	ProviderCurrency       whereHelpernull_String
+	LocalStatus            whereHelpernull_String
+	ProviderStatus         whereHelpernull_String
+	CreatedAt              whereHelpertime_Time
+}{
+	ID:                     whereHelperint{field: "\"reconciliation_discrepancies\".\"id\""},
+	ReconciliationReportID: whereHelperint{field: "\"reconciliation_discrepancies\".\"reconciliation_report_id\""},
+	Type:                   whereHelperDiscrepancytype{field: "\"reconciliation_discrepancies\".\"type\""},
+	TransferJobID:          whereHelpernull_Int{field: "\"reconciliation_discrepancies\".\"transfer_job_id\""},
+	JobID:                  whereHelpernull_String{field: "\"reconciliation_discrepancies\".\"job_id\""},
+	ProviderID:             whereHelperstring{field: "\"reconciliation_discrepancies\".\"provider_id\""},
+	LocalAmount:            whereHelpernull_Int64{field: "\"reconciliation_discrepancies\".\"local_amount\""},
+	ProviderAmount:         whereHelpernull_Int64{field: "\"reconciliation_discrepancies\".\"provider_amount\""},
+	LocalCurrency:          whereHelpernull_String{field: "\"reconciliation_discrepancies\".\"local_currency\""},
+	ProviderCurrency:       whereHelpernull_String{field: "\"reconciliation_discrepancies\".\"provider_currency\""},
+	LocalStatus:            whereHelpernull_String{field: "\"reconciliation_discrepancies\".\"local_status\""},
+	ProviderStatus:         whereHelpernull_String{field: "\"reconciliation_discrepancies\".\"provider_status\""},
+	CreatedAt:              whereHelpertime_Time{field: "\"reconciliation_discrepancies\".\"created_at\""},
+}
+
+// ReconciliationDiscrepancyRels is where relationship names are stored.
+var ReconciliationDiscrepancyRels = struct {
+	ReconciliationReport string
+	TransferJob          string
+}{
+	ReconciliationReport: "ReconciliationReport",
+	TransferJob:          "TransferJob",
+}
+
+// reconciliationDiscrepancyR is where relationships are stored.
+type reconciliationDiscrepancyR struct {
+	ReconciliationReport *ReconciliationReport `boil:"ReconciliationReport" json:"ReconciliationReport" toml:"ReconciliationReport" yaml:"ReconciliationReport"`
+	TransferJob          *TransferJob          `boil:"TransferJob" json:"TransferJob" toml:"TransferJob" yaml:"TransferJob"`
+}
+
+// NewStruct creates a new relationship struct
+func (*reconciliationDiscrepancyR) NewStruct() *reconciliationDiscrepancyR {
+	return &reconciliationDiscrepancyR{}
+}
+
+func (r *reconciliationDiscrepancyR) GetReconciliationReport() *ReconciliationReport {
+	if r == nil {
+		return nil
+	}
+	return r.ReconciliationReport
+}
+
+func (r *reconciliationDiscrepancyR) GetTransferJob() *TransferJob {
+	if r == nil {
+		return nil
+	}
+	return r.TransferJob
+}
+
+// reconciliationDiscrepancyL is where Load methods for each relationship are stored.
+type reconciliationDiscrepancyL struct{}
+
+var (
+	reconciliationDiscrepancyAllColumns            = []string{"id", "reconciliation_report_id", "type", "transfer_job_id", "job_id", "provider_id", "local_amount", "provider_amount", "local_currency", "provider_currency", "local_status", "provider_status", "created_at"}
+	reconciliationDiscrepancyColumnsWithoutDefault = []string{"reconciliation_report_id", "type"}
+	reconciliationDiscrepancyColumnsWithDefault    = []string{"id", "transfer_job_id", "job_id", "provider_id", "local_amount", "provider_amount", "local_currency", "provider_currency", "local_status", "provider_status", "created_at"}
+	reconciliationDiscrepancyPrimaryKeyColumns     = []string{"id"}
+	reconciliationDiscrepancyGeneratedColumns      = []string{}
+)
+
+type (
+	// ReconciliationDiscrepancySlice is an alias for a slice of pointers to ReconciliationDiscrepancy.
+	// This should almost always be used instead of []ReconciliationDiscrepancy.
+	ReconciliationDiscrepancySlice []*ReconciliationDiscrepancy
+	// ReconciliationDiscrepancyHook is the signature for custom ReconciliationDiscrepancy hook methods
+	ReconciliationDiscrepancyHook func(context.Context, boil.ContextExecutor, *ReconciliationDiscrepancy) error
+
+	reconciliationDiscrepancyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reconciliationDiscrepancyType                 = reflect.TypeOf(&ReconciliationDiscrepancy{})
+	reconciliationDiscrepancyMapping              = queries.MakeStructMapping(reconciliationDiscrepancyType)
+	reconciliationDiscrepancyPrimaryKeyMapping, _ = queries.BindMapping(reconciliationDiscrepancyType, reconciliationDiscrepancyMapping, reconciliationDiscrepancyPrimaryKeyColumns)
+	reconciliationDiscrepancyInsertCacheMut       sync.RWMutex
+	reconciliationDiscrepancyInsertCache          = make(map[string]insertCache)
+	reconciliationDiscrepancyUpdateCacheMut       sync.RWMutex
+	reconciliationDiscrepancyUpdateCache          = make(map[string]updateCache)
+	reconciliationDiscrepancyUpsertCacheMut       sync.RWMutex
+	reconciliationDiscrepancyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var reconciliationDiscrepancyAfterSelectMu sync.Mutex
+var reconciliationDiscrepancyAfterSelectHooks []ReconciliationDiscrepancyHook
+
+var reconciliationDiscrepancyBeforeInsertMu sync.Mutex
+var reconciliationDiscrepancyBeforeInsertHooks []ReconciliationDiscrepancyHook
+var reconciliationDiscrepancyAfterInsertMu sync.Mutex
+var reconciliationDiscrepancyAfterInsertHooks []ReconciliationDiscrepancyHook
+
+var reconciliationDiscrepancyBeforeUpdateMu sync.Mutex
+var reconciliationDiscrepancyBeforeUpdateHooks []ReconciliationDiscrepancyHook
+var reconciliationDiscrepancyAfterUpdateMu sync.Mutex
+var reconciliationDiscrepancyAfterUpdateHooks []ReconciliationDiscrepancyHook
+
+var reconciliationDiscrepancyBeforeDeleteMu sync.Mutex
+var reconciliationDiscrepancyBeforeDeleteHooks []ReconciliationDiscrepancyHook
+var reconciliationDiscrepancyAfterDeleteMu sync.Mutex
+var reconciliationDiscrepancyAfterDeleteHooks []ReconciliationDiscrepancyHook
+
+var reconciliationDiscrepancyBeforeUpsertMu sync.Mutex
+var reconciliationDiscrepancyBeforeUpsertHooks []ReconciliationDiscrepancyHook
+var reconciliationDiscrepancyAfterUpsertMu sync.Mutex
+var reconciliationDiscrepancyAfterUpsertHooks []ReconciliationDiscrepancyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ReconciliationDiscrepancy) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationDiscrepancyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ReconciliationDiscrepancy) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationDiscrepancyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ReconciliationDiscrepancy) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationDiscrepancyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ReconciliationDiscrepancy) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationDiscrepancyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ReconciliationDiscrepancy) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationDiscrepancyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ReconciliationDiscrepancy) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationDiscrepancyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ReconciliationDiscrepancy) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationDiscrepancyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ReconciliationDiscrepancy) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationDiscrepancyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ReconciliationDiscrepancy) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationDiscrepancyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReconciliationDiscrepancyHook registers your hook function for all future operations.
+func AddReconciliationDiscrepancyHook(hookPoint boil.HookPoint, reconciliationDiscrepancyHook ReconciliationDiscrepancyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		reconciliationDiscrepancyAfterSelectMu.Lock()
+		reconciliationDiscrepancyAfterSelectHooks = append(reconciliationDiscrepancyAfterSelectHooks, reconciliationDiscrepancyHook)
+		reconciliationDiscrepancyAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		reconciliationDiscrepancyBeforeInsertMu.Lock()
+		reconciliationDiscrepancyBeforeInsertHooks = append(reconciliationDiscrepancyBeforeInsertHooks, reconciliationDiscrepancyHook)
+		reconciliationDiscrepancyBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		reconciliationDiscrepancyAfterInsertMu.Lock()
+		reconciliationDiscrepancyAfterInsertHooks = append(reconciliationDiscrepancyAfterInsertHooks, reconciliationDiscrepancyHook)
+		reconciliationDiscrepancyAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		reconciliationDiscrepancyBeforeUpdateMu.Lock()
+		reconciliationDiscrepancyBeforeUpdateHooks = append(reconciliationDiscrepancyBeforeUpdateHooks, reconciliationDiscrepancyHook)
+		reconciliationDiscrepancyBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		reconciliationDiscrepancyAfterUpdateMu.Lock()
+		reconciliationDiscrepancyAfterUpdateHooks = append(reconciliationDiscrepancyAfterUpdateHooks, reconciliationDiscrepancyHook)
+		reconciliationDiscrepancyAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		reconciliationDiscrepancyBeforeDeleteMu.Lock()
+		reconciliationDiscrepancyBeforeDeleteHooks = append(reconciliationDiscrepancyBeforeDeleteHooks, reconciliationDiscrepancyHook)
+		reconciliationDiscrepancyBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		reconciliationDiscrepancyAfterDeleteMu.Lock()
+		reconciliationDiscrepancyAfterDeleteHooks = append(reconciliationDiscrepancyAfterDeleteHooks, reconciliationDiscrepancyHook)
+		reconciliationDiscrepancyAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		reconciliationDiscrepancyBeforeUpsertMu.Lock()
+		reconciliationDiscrepancyBeforeUpsertHooks = append(reconciliationDiscrepancyBeforeUpsertHooks, reconciliationDiscrepancyHook)
+		reconciliationDiscrepancyBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		reconciliationDiscrepancyAfterUpsertMu.Lock()
+		reconciliationDiscrepancyAfterUpsertHooks = append(reconciliationDiscrepancyAfterUpsertHooks, reconciliationDiscrepancyHook)
+		reconciliationDiscrepancyAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single reconciliationDiscrepancy record from the query using the global executor.
+func (q reconciliationDiscrepancyQuery) OneG(ctx context.Context) (*ReconciliationDiscrepancy, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single reconciliationDiscrepancy record from the query.
+func (q reconciliationDiscrepancyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ReconciliationDiscrepancy, error) {
+	o := &ReconciliationDiscrepancy{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for reconciliation_discrepancies")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ReconciliationDiscrepancy records from the query using the global executor.
+func (q reconciliationDiscrepancyQuery) AllG(ctx context.Context) (ReconciliationDiscrepancySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ReconciliationDiscrepancy records from the query.
+func (q reconciliationDiscrepancyQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReconciliationDiscrepancySlice, error) {
+	var o []*ReconciliationDiscrepancy
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to ReconciliationDiscrepancy slice")
+	}
+
+	if len(reconciliationDiscrepancyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ReconciliationDiscrepancy records in the query using the global executor
+func (q reconciliationDiscrepancyQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ReconciliationDiscrepancy records in the query.
+func (q reconciliationDiscrepancyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count reconciliation_discrepancies rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q reconciliationDiscrepancyQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q reconciliationDiscrepancyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if reconciliation_discrepancies exists")
+	}
+
+	return count > 0, nil
+}
+
+// ReconciliationReport pointed to by the foreign key.
+func (o *ReconciliationDiscrepancy) ReconciliationReport(mods ...qm.QueryMod) reconciliationReportQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReconciliationReportID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ReconciliationReports(queryMods...)
+}
+
+// TransferJob pointed to by the foreign key.
+func (o *ReconciliationDiscrepancy) TransferJob(mods ...qm.QueryMod) transferJobQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TransferJobID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TransferJobs(queryMods...)
+}
+
+// LoadReconciliationReport allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reconciliationDiscrepancyL) LoadReconciliationReport(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReconciliationDiscrepancy interface{}, mods queries.Applicator) error {
+	var slice []*ReconciliationDiscrepancy
+	var object *ReconciliationDiscrepancy
+
+	if singular {
+		var ok bool
+		object, ok = maybeReconciliationDiscrepancy.(*ReconciliationDiscrepancy)
+		if !ok {
+			object = new(ReconciliationDiscrepancy)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReconciliationDiscrepancy)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReconciliationDiscrepancy))
+			}
+		}
+	} else {
+		s, ok := maybeReconciliationDiscrepancy.(*[]*ReconciliationDiscrepancy)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReconciliationDiscrepancy)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReconciliationDiscrepancy))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reconciliationDiscrepancyR{}
+		}
+		args[object.ReconciliationReportID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reconciliationDiscrepancyR{}
+			}
+
+			args[obj.ReconciliationReportID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`reconciliation_reports`),
+		qm.WhereIn(`reconciliation_reports.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`reconciliation_reports.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ReconciliationReport")
+	}
+
+	var resultSlice []*ReconciliationReport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ReconciliationReport")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for reconciliation_reports")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reconciliation_reports")
+	}
+
+	if len(reconciliationReportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ReconciliationReport = foreign
+		if foreign.R == nil {
+			foreign.R = &reconciliationReportR{}
+		}
+		foreign.R.ReconciliationDiscrepancies = append(foreign.R.ReconciliationDiscrepancies, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ReconciliationReportID == foreign.ID {
+				local.R.ReconciliationReport = foreign
+				if foreign.R == nil {
+					foreign.R = &reconciliationReportR{}
+				}
+				foreign.R.ReconciliationDiscrepancies = append(foreign.R.ReconciliationDiscrepancies, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransferJob allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reconciliationDiscrepancyL) LoadTransferJob(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReconciliationDiscrepancy interface{}, mods queries.Applicator) error {
+	var slice []*ReconciliationDiscrepancy
+	var object *ReconciliationDiscrepancy
+
+	if singular {
+		var ok bool
+		object, ok = maybeReconciliationDiscrepancy.(*ReconciliationDiscrepancy)
+		if !ok {
+			object = new(ReconciliationDiscrepancy)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReconciliationDiscrepancy)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReconciliationDiscrepancy))
+			}
+		}
+	} else {
+		s, ok := maybeReconciliationDiscrepancy.(*[]*ReconciliationDiscrepancy)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReconciliationDiscrepancy)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReconciliationDiscrepancy))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reconciliationDiscrepancyR{}
+		}
+		if !queries.IsNil(object.TransferJobID) {
+			args[object.TransferJobID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reconciliationDiscrepancyR{}
+			}
+
+			if !queries.IsNil(obj.TransferJobID) {
+				args[obj.TransferJobID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transfer_jobs`),
+		qm.WhereIn(`transfer_jobs.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`transfer_jobs.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TransferJob")
+	}
+
+	var resultSlice []*TransferJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TransferJob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for transfer_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer_jobs")
+	}
+
+	if len(transferJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TransferJob = foreign
+		if foreign.R == nil {
+			foreign.R = &transferJobR{}
+		}
+		foreign.R.ReconciliationDiscrepancies = append(foreign.R.ReconciliationDiscrepancies, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TransferJobID, foreign.ID) {
+				local.R.TransferJob = foreign
+				if foreign.R == nil {
+					foreign.R = &transferJobR{}
+				}
+				foreign.R.ReconciliationDiscrepancies = append(foreign.R.ReconciliationDiscrepancies, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetReconciliationReportG of the reconciliationDiscrepancy to the related item.
+// Sets o.R.ReconciliationReport to related.
+// Adds o to related.R.ReconciliationDiscrepancies.
+// Uses the global database handle.
+func (o *ReconciliationDiscrepancy) SetReconciliationReportG(ctx context.Context, insert bool, related *ReconciliationReport) error {
+	return o.SetReconciliationReport(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetReconciliationReport of the reconciliationDiscrepancy to the related item.
+// Sets o.R.ReconciliationReport to related.
+// Adds o to related.R.ReconciliationDiscrepancies.
+func (o *ReconciliationDiscrepancy) SetReconciliationReport(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ReconciliationReport) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"reconciliation_report_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reconciliationDiscrepancyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ReconciliationReportID = related.ID
+	if o.R == nil {
+		o.R = &reconciliationDiscrepancyR{
+			ReconciliationReport: related,
+		}
+	} else {
+		o.R.ReconciliationReport = related
+	}
+
+	if related.R == nil {
+		related.R = &reconciliationReportR{
+			ReconciliationDiscrepancies: ReconciliationDiscrepancySlice{o},
+		}
+	} else {
+		related.R.ReconciliationDiscrepancies = append(related.R.ReconciliationDiscrepancies, o)
+	}
+
+	return nil
+}
+
+// SetTransferJobG of the reconciliationDiscrepancy to the related item.
+// Sets o.R.TransferJob to related.
+// Adds o to related.R.ReconciliationDiscrepancies.
+// Uses the global database handle.
+func (o *ReconciliationDiscrepancy) SetTransferJobG(ctx context.Context, insert bool, related *TransferJob) error {
+	return o.SetTransferJob(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetTransferJob of the reconciliationDiscrepancy to the related item.
+// Sets o.R.TransferJob to related.
+// Adds o to related.R.ReconciliationDiscrepancies.
+func (o *ReconciliationDiscrepancy) SetTransferJob(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TransferJob) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_job_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reconciliationDiscrepancyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TransferJobID, related.ID)
+	if o.R == nil {
+		o.R = &reconciliationDiscrepancyR{
+			TransferJob: related,
+		}
+	} else {
+		o.R.TransferJob = related
+	}
+
+	if related.R == nil {
+		related.R = &transferJobR{
+			ReconciliationDiscrepancies: ReconciliationDiscrepancySlice{o},
+		}
+	} else {
+		related.R.ReconciliationDiscrepancies = append(related.R.ReconciliationDiscrepancies, o)
+	}
+
+	return nil
+}
+
+// RemoveTransferJobG relationship.
+// Sets o.R.TransferJob to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *ReconciliationDiscrepancy) RemoveTransferJobG(ctx context.Context, related *TransferJob) error {
+	return o.RemoveTransferJob(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveTransferJob relationship.
+// Sets o.R.TransferJob to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ReconciliationDiscrepancy) RemoveTransferJob(ctx context.Context, exec boil.ContextExecutor, related *TransferJob) error {
+	var err error
+
+	queries.SetScanner(&o.TransferJobID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("transfer_job_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.TransferJob = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ReconciliationDiscrepancies {
+		if queries.Equal(o.TransferJobID, ri.TransferJobID) {
+			continue
+		}
+
+		ln := len(related.R.ReconciliationDiscrepancies)
+		if ln > 1 && i < ln-1 {
+			related.R.ReconciliationDiscrepancies[i] = related.R.ReconciliationDiscrepancies[ln-1]
+		}
+		related.R.ReconciliationDiscrepancies = related.R.ReconciliationDiscrepancies[:ln-1]
+		break
+	}
+	return nil
+}
+
+// ReconciliationDiscrepancies retrieves all the records using an executor.
+func ReconciliationDiscrepancies(mods ...qm.QueryMod) reconciliationDiscrepancyQuery {
+	mods = append(mods, qm.From("\"reconciliation_discrepancies\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"reconciliation_discrepancies\".*"})
+	}
+
+	return reconciliationDiscrepancyQuery{q}
+}
+
+// FindReconciliationDiscrepancyG retrieves a single record by ID.
+func FindReconciliationDiscrepancyG(ctx context.Context, iD int, selectCols ...string) (*ReconciliationDiscrepancy, error) {
+	return FindReconciliationDiscrepancy(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindReconciliationDiscrepancy retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReconciliationDiscrepancy(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ReconciliationDiscrepancy, error) {
+	reconciliationDiscrepancyObj := &ReconciliationDiscrepancy{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"reconciliation_discrepancies\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, reconciliationDiscrepancyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from reconciliation_discrepancies")
+	}
+
+	if err = reconciliationDiscrepancyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return reconciliationDiscrepancyObj, err
+	}
+
+	return reconciliationDiscrepancyObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ReconciliationDiscrepancy) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReconciliationDiscrepancy) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no reconciliation_discrepancies provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reconciliationDiscrepancyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reconciliationDiscrepancyInsertCacheMut.RLock()
+	cache, cached := reconciliationDiscrepancyInsertCache[key]
+	reconciliationDiscrepancyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reconciliationDiscrepancyAllColumns,
+			reconciliationDiscrepancyColumnsWithDefault,
+			reconciliationDiscrepancyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(reconciliationDiscrepancyType, reconciliationDiscrepancyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reconciliationDiscrepancyType, reconciliationDiscrepancyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"reconciliation_discrepancies\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"reconciliation_discrepancies\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into reconciliation_discrepancies")
+	}
+
+	if !cached {
+		reconciliationDiscrepancyInsertCacheMut.Lock()
+		reconciliationDiscrepancyInsertCache[key] = cache
+		reconciliationDiscrepancyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ReconciliationDiscrepancy record using the global executor.
+// See Update for more documentation.
+func (o *ReconciliationDiscrepancy) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ReconciliationDiscrepancy.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReconciliationDiscrepancy) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	reconciliationDiscrepancyUpdateCacheMut.RLock()
+	cache, cached := reconciliationDiscrepancyUpdateCache[key]
+	reconciliationDiscrepancyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reconciliationDiscrepancyAllColumns,
+			reconciliationDiscrepancyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update reconciliation_discrepancies, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, reconciliationDiscrepancyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reconciliationDiscrepancyType, reconciliationDiscrepancyMapping, append(wl, reconciliationDiscrepancyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update reconciliation_discrepancies row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for reconciliation_discrepancies")
+	}
+
+	if !cached {
+		reconciliationDiscrepancyUpdateCacheMut.Lock()
+		reconciliationDiscrepancyUpdateCache[key] = cache
+		reconciliationDiscrepancyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q reconciliationDiscrepancyQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reconciliationDiscrepancyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for reconciliation_discrepancies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for reconciliation_discrepancies")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ReconciliationDiscrepancySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReconciliationDiscrepancySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationDiscrepancyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, reconciliationDiscrepancyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in reconciliationDiscrepancy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all reconciliationDiscrepancy")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ReconciliationDiscrepancy) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReconciliationDiscrepancy) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no reconciliation_discrepancies provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reconciliationDiscrepancyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	reconciliationDiscrepancyUpsertCacheMut.RLock()
+	cache, cached := reconciliationDiscrepancyUpsertCache[key]
+	reconciliationDiscrepancyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			reconciliationDiscrepancyAllColumns,
+			reconciliationDiscrepancyColumnsWithDefault,
+			reconciliationDiscrepancyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			reconciliationDiscrepancyAllColumns,
+			reconciliationDiscrepancyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert reconciliation_discrepancies, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(reconciliationDiscrepancyAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(reconciliationDiscrepancyPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert reconciliation_discrepancies, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(reconciliationDiscrepancyPrimaryKeyColumns))
+			copy(conflict, reconciliationDiscrepancyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"reconciliation_discrepancies\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(reconciliationDiscrepancyType, reconciliationDiscrepancyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(reconciliationDiscrepancyType, reconciliationDiscrepancyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert reconciliation_discrepancies")
+	}
+
+	if !cached {
+		reconciliationDiscrepancyUpsertCacheMut.Lock()
+		reconciliationDiscrepancyUpsertCache[key] = cache
+		reconciliationDiscrepancyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ReconciliationDiscrepancy record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ReconciliationDiscrepancy) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ReconciliationDiscrepancy record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReconciliationDiscrepancy) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no ReconciliationDiscrepancy provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reconciliationDiscrepancyPrimaryKeyMapping)
+	sql := "DELETE FROM \"reconciliation_discrepancies\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from reconciliation_discrepancies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for reconciliation_discrepancies")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q reconciliationDiscrepancyQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q reconciliationDiscrepancyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no reconciliationDiscrepancyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from reconciliation_discrepancies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for reconciliation_discrepancies")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ReconciliationDiscrepancySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReconciliationDiscrepancySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(reconciliationDiscrepancyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationDiscrepancyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"reconciliation_discrepancies\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reconciliationDiscrepancyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from reconciliationDiscrepancy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for reconciliation_discrepancies")
+	}
+
+	if len(reconciliationDiscrepancyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ReconciliationDiscrepancy) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no ReconciliationDiscrepancy provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReconciliationDiscrepancy) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReconciliationDiscrepancy(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReconciliationDiscrepancySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty ReconciliationDiscrepancySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReconciliationDiscrepancySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReconciliationDiscrepancySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationDiscrepancyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"reconciliation_discrepancies\".* FROM \"reconciliation_discrepancies\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reconciliationDiscrepancyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in ReconciliationDiscrepancySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReconciliationDiscrepancyExistsG checks if the ReconciliationDiscrepancy row exists.
+func ReconciliationDiscrepancyExistsG(ctx context.Context, iD int) (bool, error) {
+	return ReconciliationDiscrepancyExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ReconciliationDiscrepancyExists checks if the ReconciliationDiscrepancy row exists.
+func ReconciliationDiscrepancyExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"reconciliation_discrepancies\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if reconciliation_discrepancies exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ReconciliationDiscrepancy row exists.
+func (o *ReconciliationDiscrepancy) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ReconciliationDiscrepancyExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1020 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testReconciliationDiscrepancies(t *testing.T) {
+	t.Parallel()
+
+	query := ReconciliationDiscrepancies()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testReconciliationDiscrepanciesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReconciliationDiscrepanciesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ReconciliationDiscrepancies().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReconciliationDiscrepanciesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReconciliationDiscrepancySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReconciliationDiscrepanciesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ReconciliationDiscrepancyExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ReconciliationDiscrepancy exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ReconciliationDiscrepancyExists to return true, but got false.")
+	}
+}
+
+func testReconciliationDiscrepanciesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	reconciliationDiscrepancyFound, err := FindReconciliationDiscrepancy(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if reconciliationDiscrepancyFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testReconciliationDiscrepanciesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ReconciliationDiscrepancies().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReconciliationDiscrepanciesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ReconciliationDiscrepancies().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testReconciliationDiscrepanciesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	reconciliationDiscrepancyOne := &ReconciliationDiscrepancy{}
+	reconciliationDiscrepancyTwo := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, reconciliationDiscrepancyOne, reconciliationDiscrepancyDBTypes, false, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+	if err = randomize.Struct(seed, reconciliationDiscrepancyTwo, reconciliationDiscrepancyDBTypes, false, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = reconciliationDiscrepancyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = reconciliationDiscrepancyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReconciliationDiscrepancies().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testReconciliationDiscrepanciesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	reconciliationDiscrepancyOne := &ReconciliationDiscrepancy{}
+	reconciliationDiscrepancyTwo := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, reconciliationDiscrepancyOne, reconciliationDiscrepancyDBTypes, false, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+	if err = randomize.Struct(seed, reconciliationDiscrepancyTwo, reconciliationDiscrepancyDBTypes, false, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = reconciliationDiscrepancyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = reconciliationDiscrepancyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func reconciliationDiscrepancyBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationDiscrepancy) error {
+	*o = ReconciliationDiscrepancy{}
+	return nil
+}
+
+func reconciliationDiscrepancyAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationDiscrepancy) error {
+	*o = ReconciliationDiscrepancy{}
+	return nil
+}
+
+func reconciliationDiscrepancyAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationDiscrepancy) error {
+	*o = ReconciliationDiscrepancy{}
+	return nil
+}
+
+func reconciliationDiscrepancyBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationDiscrepancy) error {
+	*o = ReconciliationDiscrepancy{}
+	return nil
+}
+
+func reconciliationDiscrepancyAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationDiscrepancy) error {
+	*o = ReconciliationDiscrepancy{}
+	return nil
+}
+
+func reconciliationDiscrepancyBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationDiscrepancy) error {
+	*o = ReconciliationDiscrepancy{}
+	return nil
+}
+
+func reconciliationDiscrepancyAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationDiscrepancy) error {
+	*o = ReconciliationDiscrepancy{}
+	return nil
+}
+
+func reconciliationDiscrepancyBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationDiscrepancy) error {
+	*o = ReconciliationDiscrepancy{}
+	return nil
+}
+
+func reconciliationDiscrepancyAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationDiscrepancy) error {
+	*o = ReconciliationDiscrepancy{}
+	return nil
+}
+
+func testReconciliationDiscrepanciesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ReconciliationDiscrepancy{}
+	o := &ReconciliationDiscrepancy{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy object: %s", err)
+	}
+
+	AddReconciliationDiscrepancyHook(boil.BeforeInsertHook, reconciliationDiscrepancyBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	reconciliationDiscrepancyBeforeInsertHooks = []ReconciliationDiscrepancyHook{}
+
+	AddReconciliationDiscrepancyHook(boil.AfterInsertHook, reconciliationDiscrepancyAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	reconciliationDiscrepancyAfterInsertHooks = []ReconciliationDiscrepancyHook{}
+
+	AddReconciliationDiscrepancyHook(boil.AfterSelectHook, reconciliationDiscrepancyAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	reconciliationDiscrepancyAfterSelectHooks = []ReconciliationDiscrepancyHook{}
+
+	AddReconciliationDiscrepancyHook(boil.BeforeUpdateHook, reconciliationDiscrepancyBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	reconciliationDiscrepancyBeforeUpdateHooks = []ReconciliationDiscrepancyHook{}
+
+	AddReconciliationDiscrepancyHook(boil.AfterUpdateHook, reconciliationDiscrepancyAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	reconciliationDiscrepancyAfterUpdateHooks = []ReconciliationDiscrepancyHook{}
+
+	AddReconciliationDiscrepancyHook(boil.BeforeDeleteHook, reconciliationDiscrepancyBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	reconciliationDiscrepancyBeforeDeleteHooks = []ReconciliationDiscrepancyHook{}
+
+	AddReconciliationDiscrepancyHook(boil.AfterDeleteHook, reconciliationDiscrepancyAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	reconciliationDiscrepancyAfterDeleteHooks = []ReconciliationDiscrepancyHook{}
+
+	AddReconciliationDiscrepancyHook(boil.BeforeUpsertHook, reconciliationDiscrepancyBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	reconciliationDiscrepancyBeforeUpsertHooks = []ReconciliationDiscrepancyHook{}
+
+	AddReconciliationDiscrepancyHook(boil.AfterUpsertHook, reconciliationDiscrepancyAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	reconciliationDiscrepancyAfterUpsertHooks = []ReconciliationDiscrepancyHook{}
+}
+
+func testReconciliationDiscrepanciesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReconciliationDiscrepanciesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(reconciliationDiscrepancyColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReconciliationDiscrepancyToOneReconciliationReportUsingReconciliationReport(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ReconciliationDiscrepancy
+	var foreign ReconciliationReport
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, reconciliationDiscrepancyDBTypes, false, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, reconciliationReportDBTypes, false, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ReconciliationReportID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ReconciliationReport().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddReconciliationReportHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *ReconciliationReport) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ReconciliationDiscrepancySlice{&local}
+	if err = local.L.LoadReconciliationReport(ctx, tx, false, (*[]*ReconciliationDiscrepancy)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ReconciliationReport == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ReconciliationReport = nil
+	if err = local.L.LoadReconciliationReport(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ReconciliationReport == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testReconciliationDiscrepancyToOneTransferJobUsingTransferJob(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ReconciliationDiscrepancy
+	var foreign TransferJob
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, transferJobDBTypes, false, transferJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJob struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.TransferJobID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.TransferJob().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddTransferJobHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *TransferJob) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ReconciliationDiscrepancySlice{&local}
+	if err = local.L.LoadTransferJob(ctx, tx, false, (*[]*ReconciliationDiscrepancy)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TransferJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.TransferJob = nil
+	if err = local.L.LoadTransferJob(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TransferJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testReconciliationDiscrepancyToOneSetOpReconciliationReportUsingReconciliationReport(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ReconciliationDiscrepancy
+	var b, c ReconciliationReport
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, reconciliationDiscrepancyDBTypes, false, strmangle.SetComplement(reconciliationDiscrepancyPrimaryKeyColumns, reconciliationDiscrepancyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, reconciliationReportDBTypes, false, strmangle.SetComplement(reconciliationReportPrimaryKeyColumns, reconciliationReportColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, reconciliationReportDBTypes, false, strmangle.SetComplement(reconciliationReportPrimaryKeyColumns, reconciliationReportColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*ReconciliationReport{&b, &c} {
+		err = a.SetReconciliationReport(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ReconciliationReport != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ReconciliationDiscrepancies[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ReconciliationReportID != x.ID {
+			t.Error("foreign key was wrong value", a.ReconciliationReportID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ReconciliationReportID))
+		reflect.Indirect(reflect.ValueOf(&a.ReconciliationReportID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ReconciliationReportID != x.ID {
+			t.Error("foreign key was wrong value", a.ReconciliationReportID, x.ID)
+		}
+	}
+}
+func testReconciliationDiscrepancyToOneSetOpTransferJobUsingTransferJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ReconciliationDiscrepancy
+	var b, c TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, reconciliationDiscrepancyDBTypes, false, strmangle.SetComplement(reconciliationDiscrepancyPrimaryKeyColumns, reconciliationDiscrepancyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*TransferJob{&b, &c} {
+		err = a.SetTransferJob(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.TransferJob != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ReconciliationDiscrepancies[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.TransferJobID, x.ID) {
+			t.Error("foreign key was wrong value", a.TransferJobID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TransferJobID))
+		reflect.Indirect(reflect.ValueOf(&a.TransferJobID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.TransferJobID, x.ID) {
+			t.Error("foreign key was wrong value", a.TransferJobID, x.ID)
+		}
+	}
+}
+
+func testReconciliationDiscrepancyToOneRemoveOpTransferJobUsingTransferJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ReconciliationDiscrepancy
+	var b TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, reconciliationDiscrepancyDBTypes, false, strmangle.SetComplement(reconciliationDiscrepancyPrimaryKeyColumns, reconciliationDiscrepancyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetTransferJob(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveTransferJob(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.TransferJob().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.TransferJob != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.TransferJobID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ReconciliationDiscrepancies) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testReconciliationDiscrepanciesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReconciliationDiscrepanciesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReconciliationDiscrepancySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReconciliationDiscrepanciesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReconciliationDiscrepancies().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	reconciliationDiscrepancyDBTypes = map[string]string{`ID`: `integer`, `ReconciliationReportID`: `integer`, `Type`: `enum.discrepancytype('missing_on_provider','missing_locally','amount_mismatch','status_mismatch')`, `TransferJobID`: `integer`, `JobID`: `character varying`, `ProviderID`: `text`, `LocalAmount`: `bigint`, `ProviderAmount`: `bigint`, `LocalCurrency`: `character varying`, `ProviderCurrency`: `character varying`, `LocalStatus`: `character varying`, `ProviderStatus`: `character varying`, `CreatedAt`: `timestamp with time zone`}
+	_                                = bytes.MinRead
+)
+
+func testReconciliationDiscrepanciesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(reconciliationDiscrepancyPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(reconciliationDiscrepancyAllColumns) == len(reconciliationDiscrepancyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testReconciliationDiscrepanciesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(reconciliationDiscrepancyAllColumns) == len(reconciliationDiscrepancyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, reconciliationDiscrepancyDBTypes, true, reconciliationDiscrepancyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(reconciliationDiscrepancyAllColumns, reconciliationDiscrepancyPrimaryKeyColumns) {
+		fields = reconciliationDiscrepancyAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			reconciliationDiscrepancyAllColumns,
+			reconciliationDiscrepancyPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ReconciliationDiscrepancySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testReconciliationDiscrepanciesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(reconciliationDiscrepancyAllColumns) == len(reconciliationDiscrepancyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ReconciliationDiscrepancy{}
+	if err = randomize.Struct(seed, &o, reconciliationDiscrepancyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReconciliationDiscrepancy: %s", err)
+	}
+
+	count, err := ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, reconciliationDiscrepancyDBTypes, false, reconciliationDiscrepancyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationDiscrepancy struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReconciliationDiscrepancy: %s", err)
+	}
+
+	count, err = ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
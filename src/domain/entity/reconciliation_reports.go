@@ -0,0 +1,1424 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReconciliationReport is an object representing the database table.
+type ReconciliationReport struct {
+	ID               int                  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ReportID         string               `boil:"report_id" json:"report_id" toml:"report_id" yaml:"report_id"`
+	PeriodStart      time.Time            `boil:"period_start" json:"period_start" toml:"period_start" yaml:"period_start"`
+	PeriodEnd        time.Time            `boil:"period_end" json:"period_end" toml:"period_end" yaml:"period_end"`
+	Status           Reconciliationstatus `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ProviderCount    int                  `boil:"provider_count" json:"provider_count" toml:"provider_count" yaml:"provider_count"`
+	JobCount         int                  `boil:"job_count" json:"job_count" toml:"job_count" yaml:"job_count"`
+	MatchedCount     int                  `boil:"matched_count" json:"matched_count" toml:"matched_count" yaml:"matched_count"`
+	DiscrepancyCount int                  `boil:"discrepancy_count" json:"discrepancy_count" toml:"discrepancy_count" yaml:"discrepancy_count"`
+	Error            null.String          `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	FinishedAt       null.Time            `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+	CreatedBy        int                  `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt        time.Time            `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy        int                  `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt        time.Time            `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy        null.Int             `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt        null.Time            `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *reconciliationReportR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reconciliationReportL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReconciliationReportColumns = struct {
+	ID               string
+	ReportID         string
+	PeriodStart      string
+	PeriodEnd        string
+	Status           string
+	ProviderCount    string
+	JobCount         string
+	MatchedCount     string
+	DiscrepancyCount string
+	Error            string
+	FinishedAt       string
+	CreatedBy        string
+	CreatedAt        string
+	UpdatedBy        string
+	UpdatedAt        string
+	DeletedBy        string
+	DeletedAt        string
+}{
+	ID:               "id",
+	ReportID:         "report_id",
+	PeriodStart:      "period_start",
+	PeriodEnd:        "period_end",
+	Status:           "status",
+	ProviderCount:    "provider_count",
+	JobCount:         "job_count",
+	MatchedCount:     "matched_count",
+	DiscrepancyCount: "discrepancy_count",
+	Error:            "error",
+	FinishedAt:       "finished_at",
+	CreatedBy:        "created_by",
+	CreatedAt:        "created_at",
+	UpdatedBy:        "updated_by",
+	UpdatedAt:        "updated_at",
+	DeletedBy:        "deleted_by",
+	DeletedAt:        "deleted_at",
+}
+
+var ReconciliationReportTableColumns = struct {
+	ID               string
+	ReportID         string
+	PeriodStart      string
+	PeriodEnd        string
+	Status           string
+	ProviderCount    string
+	JobCount         string
+	MatchedCount     string
+	DiscrepancyCount string
+	Error            string
+	FinishedAt       string
+	CreatedBy        string
+	CreatedAt        string
+	UpdatedBy        string
+	UpdatedAt        string
+	DeletedBy        string
+	DeletedAt        string
+}{
+	ID:               "reconciliation_reports.id",
+	ReportID:         "reconciliation_reports.report_id",
+	PeriodStart:      "reconciliation_reports.period_start",
+	PeriodEnd:        "reconciliation_reports.period_end",
+	Status:           "reconciliation_reports.status",
+	ProviderCount:    "reconciliation_reports.provider_count",
+	JobCount:         "reconciliation_reports.job_count",
+	MatchedCount:     "reconciliation_reports.matched_count",
+	DiscrepancyCount: "reconciliation_reports.discrepancy_count",
+	Error:            "reconciliation_reports.error",
+	FinishedAt:       "reconciliation_reports.finished_at",
+	CreatedBy:        "reconciliation_reports.created_by",
+	CreatedAt:        "reconciliation_reports.created_at",
+	UpdatedBy:        "reconciliation_reports.updated_by",
+	UpdatedAt:        "reconciliation_reports.updated_at",
+	DeletedBy:        "reconciliation_reports.deleted_by",
+	DeletedAt:        "reconciliation_reports.deleted_at",
+}
+
+// Generated where
+
+type whereHelperReconciliationstatus struct{ field string }
+
+func (w whereHelperReconciliationstatus) EQ(x Reconciliationstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperReconciliationstatus) NEQ(x Reconciliationstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperReconciliationstatus) LT(x Reconciliationstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperReconciliationstatus) LTE(x Reconciliationstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperReconciliationstatus) GT(x Reconciliationstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperReconciliationstatus) GTE(x Reconciliationstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperReconciliationstatus) IN(slice []Reconciliationstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperReconciliationstatus) NIN(slice []Reconciliationstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var ReconciliationReportWhere = struct {
+	ID               whereHelperint
+	ReportID         whereHelperstring
+	PeriodStart      whereHelpertime_Time
+	PeriodEnd        whereHelpertime_Time
+	Status           whereHelperReconciliationstatus
+	ProviderCount    whereHelperint
+	JobCount         whereHelperint
+	MatchedCount     whereHelperint
+	DiscrepancyCount whereHelperint
+	Error            whereHelpernull_String
+	FinishedAt       whereHelpernull_Time
+	CreatedBy        whereHelperint
+	CreatedAt        whereHelpertime_Time
+	UpdatedBy        whereHelperint
+	UpdatedAt        whereHelpertime_Time
+	DeletedBy        whereHelpernull_Int
+	DeletedAt        whereHelpernull_Time
+}{
+	ID:               whereHelperint{field: "\"reconciliation_reports\".\"id\""},
+	ReportID:         whereHelperstring{field: "\"reconciliation_reports\".\"report_id\""},
+	PeriodStart:      whereHelpertime_Time{field: "\"reconciliation_reports\".\"period_start\""},
+	PeriodEnd:        whereHelpertime_Time{field: "\"reconciliation_reports\".\"period_end\""},
+	Status:           whereHelperReconciliationstatus{field: "\"reconciliation_reports\".\"status\""},
+	ProviderCount:    whereHelperint{field: "\"reconciliation_reports\".\"provider_count\""},
+	JobCount:         whereHelperint{field: "\"reconciliation_reports\".\"job_count\""},
+	MatchedCount:     whereHelperint{field: "\"reconciliation_reports\".\"matched_count\""},
+	DiscrepancyCount: whereHelperint{field: "\"reconciliation_reports\".\"discrepancy_count\""},
+	Error:            whereHelpernull_String{field: "\"reconciliation_reports\".\"error\""},
+	FinishedAt:       whereHelpernull_Time{field: "\"reconciliation_reports\".\"finished_at\""},
+	CreatedBy:        whereHelperint{field: "\"reconciliation_reports\".\"created_by\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"reconciliation_reports\".\"created_at\""},
+	UpdatedBy:        whereHelperint{field: "\"reconciliation_reports\".\"updated_by\""},
+	UpdatedAt:        whereHelpertime_Time{field: "\"reconciliation_reports\".\"updated_at\""},
+	DeletedBy:        whereHelpernull_Int{field: "\"reconciliation_reports\".\"deleted_by\""},
+	DeletedAt:        whereHelpernull_Time{field: "\"reconciliation_reports\".\"deleted_at\""},
+}
+
+// ReconciliationReportRels is where relationship names are stored.
+var ReconciliationReportRels = struct {
+	ReconciliationDiscrepancies string
+}{
+	ReconciliationDiscrepancies: "ReconciliationDiscrepancies",
+}
+
+// reconciliationReportR is where relationships are stored.
+type reconciliationReportR struct {
+	ReconciliationDiscrepancies ReconciliationDiscrepancySlice `boil:"ReconciliationDiscrepancies" json:"ReconciliationDiscrepancies" toml:"ReconciliationDiscrepancies" yaml:"ReconciliationDiscrepancies"`
+}
+
+// NewStruct creates a new relationship struct
+func (*reconciliationReportR) NewStruct() *reconciliationReportR {
+	return &reconciliationReportR{}
+}
+
+func (r *reconciliationReportR) GetReconciliationDiscrepancies() ReconciliationDiscrepancySlice {
+	if r == nil {
+		return nil
+	}
+	return r.ReconciliationDiscrepancies
+}
+
+// reconciliationReportL is where Load methods for each relationship are stored.
+type reconciliationReportL struct{}
+
+var (
+	reconciliationReportAllColumns            = []string{"id", "report_id", "period_start", "period_end", "status", "provider_count", "job_count", "matched_count", "discrepancy_count", "error", "finished_at", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	reconciliationReportColumnsWithoutDefault = []string{"report_id", "period_start", "period_end"}
+	reconciliationReportColumnsWithDefault    = []string{"id", "status", "provider_count", "job_count", "matched_count", "discrepancy_count", "error", "finished_at", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	reconciliationReportPrimaryKeyColumns     = []string{"id"}
+	reconciliationReportGeneratedColumns      = []string{}
+)
+
+type (
+	// ReconciliationReportSlice is an alias for a slice of pointers to ReconciliationReport.
+	// This should almost always be used instead of []ReconciliationReport.
+	ReconciliationReportSlice []*ReconciliationReport
+	// ReconciliationReportHook is the signature for custom ReconciliationReport hook methods
+	ReconciliationReportHook func(context.Context, boil.ContextExecutor, *ReconciliationReport) error
+
+	reconciliationReportQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reconciliationReportType                 = reflect.TypeOf(&ReconciliationReport{})
+	reconciliationReportMapping              = queries.MakeStructMapping(reconciliationReportType)
+	reconciliationReportPrimaryKeyMapping, _ = queries.BindMapping(reconciliationReportType, reconciliationReportMapping, reconciliationReportPrimaryKeyColumns)
+	reconciliationReportInsertCacheMut       sync.RWMutex
+	reconciliationReportInsertCache          = make(map[string]insertCache)
+	reconciliationReportUpdateCacheMut       sync.RWMutex
+	reconciliationReportUpdateCache          = make(map[string]updateCache)
+	reconciliationReportUpsertCacheMut       sync.RWMutex
+	reconciliationReportUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var reconciliationReportAfterSelectMu sync.Mutex
+var reconciliationReportAfterSelectHooks []ReconciliationReportHook
+
+var reconciliationReportBeforeInsertMu sync.Mutex
+var reconciliationReportBeforeInsertHooks []ReconciliationReportHook
+var reconciliationReportAfterInsertMu sync.Mutex
+var reconciliationReportAfterInsertHooks []ReconciliationReportHook
+
+var reconciliationReportBeforeUpdateMu sync.Mutex
+var reconciliationReportBeforeUpdateHooks []ReconciliationReportHook
+var reconciliationReportAfterUpdateMu sync.Mutex
+var reconciliationReportAfterUpdateHooks []ReconciliationReportHook
+
+var reconciliationReportBeforeDeleteMu sync.Mutex
+var reconciliationReportBeforeDeleteHooks []ReconciliationReportHook
+var reconciliationReportAfterDeleteMu sync.Mutex
+var reconciliationReportAfterDeleteHooks []ReconciliationReportHook
+
+var reconciliationReportBeforeUpsertMu sync.Mutex
+var reconciliationReportBeforeUpsertHooks []ReconciliationReportHook
+var reconciliationReportAfterUpsertMu sync.Mutex
+var reconciliationReportAfterUpsertHooks []ReconciliationReportHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ReconciliationReport) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationReportAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ReconciliationReport) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationReportBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ReconciliationReport) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationReportAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ReconciliationReport) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationReportBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ReconciliationReport) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationReportAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ReconciliationReport) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationReportBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ReconciliationReport) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationReportAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ReconciliationReport) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationReportBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ReconciliationReport) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reconciliationReportAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReconciliationReportHook registers your hook function for all future operations.
+func AddReconciliationReportHook(hookPoint boil.HookPoint, reconciliationReportHook ReconciliationReportHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		reconciliationReportAfterSelectMu.Lock()
+		reconciliationReportAfterSelectHooks = append(reconciliationReportAfterSelectHooks, reconciliationReportHook)
+		reconciliationReportAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		reconciliationReportBeforeInsertMu.Lock()
+		reconciliationReportBeforeInsertHooks = append(reconciliationReportBeforeInsertHooks, reconciliationReportHook)
+		reconciliationReportBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		reconciliationReportAfterInsertMu.Lock()
+		reconciliationReportAfterInsertHooks = append(reconciliationReportAfterInsertHooks, reconciliationReportHook)
+		reconciliationReportAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		reconciliationReportBeforeUpdateMu.Lock()
+		reconciliationReportBeforeUpdateHooks = append(reconciliationReportBeforeUpdateHooks, reconciliationReportHook)
+		reconciliationReportBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		reconciliationReportAfterUpdateMu.Lock()
+		reconciliationReportAfterUpdateHooks = append(reconciliationReportAfterUpdateHooks, reconciliationReportHook)
+		reconciliationReportAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		reconciliationReportBeforeDeleteMu.Lock()
+		reconciliationReportBeforeDeleteHooks = append(reconciliationReportBeforeDeleteHooks, reconciliationReportHook)
+		reconciliationReportBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		reconciliationReportAfterDeleteMu.Lock()
+		reconciliationReportAfterDeleteHooks = append(reconciliationReportAfterDeleteHooks, reconciliationReportHook)
+		reconciliationReportAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		reconciliationReportBeforeUpsertMu.Lock()
+		reconciliationReportBeforeUpsertHooks = append(reconciliationReportBeforeUpsertHooks, reconciliationReportHook)
+		reconciliationReportBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		reconciliationReportAfterUpsertMu.Lock()
+		reconciliationReportAfterUpsertHooks = append(reconciliationReportAfterUpsertHooks, reconciliationReportHook)
+		reconciliationReportAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single reconciliationReport record from the query using the global executor.
+func (q reconciliationReportQuery) OneG(ctx context.Context) (*ReconciliationReport, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single reconciliationReport record from the query.
+func (q reconciliationReportQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ReconciliationReport, error) {
+	o := &ReconciliationReport{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for reconciliation_reports")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ReconciliationReport records from the query using the global executor.
+func (q reconciliationReportQuery) AllG(ctx context.Context) (ReconciliationReportSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ReconciliationReport records from the query.
+func (q reconciliationReportQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReconciliationReportSlice, error) {
+	var o []*ReconciliationReport
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to ReconciliationReport slice")
+	}
+
+	if len(reconciliationReportAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ReconciliationReport records in the query using the global executor
+func (q reconciliationReportQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ReconciliationReport records in the query.
+func (q reconciliationReportQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count reconciliation_reports rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q reconciliationReportQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q reconciliationReportQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if reconciliation_reports exists")
+	}
+
+	return count > 0, nil
+}
+
+// ReconciliationDiscrepancies retrieves all the reconciliation_discrepancy's ReconciliationDiscrepancies with an executor.
+func (o *ReconciliationReport) ReconciliationDiscrepancies(mods ...qm.QueryMod) reconciliationDiscrepancyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reconciliation_discrepancies\".\"reconciliation_report_id\"=?", o.ID),
+	)
+
+	return ReconciliationDiscrepancies(queryMods...)
+}
+
+// LoadReconciliationDiscrepancies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (reconciliationReportL) LoadReconciliationDiscrepancies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReconciliationReport interface{}, mods queries.Applicator) error {
+	var slice []*ReconciliationReport
+	var object *ReconciliationReport
+
+	if singular {
+		var ok bool
+		object, ok = maybeReconciliationReport.(*ReconciliationReport)
+		if !ok {
+			object = new(ReconciliationReport)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReconciliationReport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReconciliationReport))
+			}
+		}
+	} else {
+		s, ok := maybeReconciliationReport.(*[]*ReconciliationReport)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReconciliationReport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReconciliationReport))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reconciliationReportR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reconciliationReportR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`reconciliation_discrepancies`),
+		qm.WhereIn(`reconciliation_discrepancies.reconciliation_report_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reconciliation_discrepancies")
+	}
+
+	var resultSlice []*ReconciliationDiscrepancy
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reconciliation_discrepancies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reconciliation_discrepancies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reconciliation_discrepancies")
+	}
+
+	if len(reconciliationDiscrepancyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReconciliationDiscrepancies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reconciliationDiscrepancyR{}
+			}
+			foreign.R.ReconciliationReport = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ReconciliationReportID {
+				local.R.ReconciliationDiscrepancies = append(local.R.ReconciliationDiscrepancies, foreign)
+				if foreign.R == nil {
+					foreign.R = &reconciliationDiscrepancyR{}
+				}
+				foreign.R.ReconciliationReport = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddReconciliationDiscrepanciesG adds the given related objects to the existing relationships
+// of the reconciliation_report, optionally inserting them as new records.
+// Appends related to o.R.ReconciliationDiscrepancies.
+// Sets related.R.ReconciliationReport appropriately.
+// Uses the global database handle.
+func (o *ReconciliationReport) AddReconciliationDiscrepanciesG(ctx context.Context, insert bool, related ...*ReconciliationDiscrepancy) error {
+	return o.AddReconciliationDiscrepancies(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddReconciliationDiscrepancies adds the given related objects to the existing relationships
+// of the reconciliation_report, optionally inserting them as new records.
+// Appends related to o.R.ReconciliationDiscrepancies.
+// Sets related.R.ReconciliationReport appropriately.
+func (o *ReconciliationReport) AddReconciliationDiscrepancies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReconciliationDiscrepancy) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ReconciliationReportID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"reconciliation_report_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reconciliationDiscrepancyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ReconciliationReportID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &reconciliationReportR{
+			ReconciliationDiscrepancies: related,
+		}
+	} else {
+		o.R.ReconciliationDiscrepancies = append(o.R.ReconciliationDiscrepancies, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reconciliationDiscrepancyR{
+				ReconciliationReport: o,
+			}
+		} else {
+			rel.R.ReconciliationReport = o
+		}
+	}
+	return nil
+}
+
+// ReconciliationReports retrieves all the records using an executor.
+func ReconciliationReports(mods ...qm.QueryMod) reconciliationReportQuery {
+	mods = append(mods, qm.From("\"reconciliation_reports\""), qmhelper.WhereIsNull("\"reconciliation_reports\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"reconciliation_reports\".*"})
+	}
+
+	return reconciliationReportQuery{q}
+}
+
+// FindReconciliationReportG retrieves a single record by ID.
+func FindReconciliationReportG(ctx context.Context, iD int, selectCols ...string) (*ReconciliationReport, error) {
+	return FindReconciliationReport(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindReconciliationReport retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReconciliationReport(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ReconciliationReport, error) {
+	reconciliationReportObj := &ReconciliationReport{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"reconciliation_reports\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, reconciliationReportObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from reconciliation_reports")
+	}
+
+	if err = reconciliationReportObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return reconciliationReportObj, err
+	}
+
+	return reconciliationReportObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ReconciliationReport) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReconciliationReport) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no reconciliation_reports provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reconciliationReportColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reconciliationReportInsertCacheMut.RLock()
+	cache, cached := reconciliationReportInsertCache[key]
+	reconciliationReportInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reconciliationReportAllColumns,
+			reconciliationReportColumnsWithDefault,
+			reconciliationReportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(reconciliationReportType, reconciliationReportMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reconciliationReportType, reconciliationReportMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"reconciliation_reports\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"reconciliation_reports\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into reconciliation_reports")
+	}
+
+	if !cached {
+		reconciliationReportInsertCacheMut.Lock()
+		reconciliationReportInsertCache[key] = cache
+		reconciliationReportInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ReconciliationReport record using the global executor.
+// See Update for more documentation.
+func (o *ReconciliationReport) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ReconciliationReport.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReconciliationReport) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	reconciliationReportUpdateCacheMut.RLock()
+	cache, cached := reconciliationReportUpdateCache[key]
+	reconciliationReportUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reconciliationReportAllColumns,
+			reconciliationReportPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update reconciliation_reports, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"reconciliation_reports\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, reconciliationReportPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reconciliationReportType, reconciliationReportMapping, append(wl, reconciliationReportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update reconciliation_reports row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for reconciliation_reports")
+	}
+
+	if !cached {
+		reconciliationReportUpdateCacheMut.Lock()
+		reconciliationReportUpdateCache[key] = cache
+		reconciliationReportUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q reconciliationReportQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reconciliationReportQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for reconciliation_reports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for reconciliation_reports")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ReconciliationReportSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReconciliationReportSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationReportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"reconciliation_reports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, reconciliationReportPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in reconciliationReport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all reconciliationReport")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ReconciliationReport) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReconciliationReport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no reconciliation_reports provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reconciliationReportColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	reconciliationReportUpsertCacheMut.RLock()
+	cache, cached := reconciliationReportUpsertCache[key]
+	reconciliationReportUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			reconciliationReportAllColumns,
+			reconciliationReportColumnsWithDefault,
+			reconciliationReportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			reconciliationReportAllColumns,
+			reconciliationReportPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert reconciliation_reports, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(reconciliationReportAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(reconciliationReportPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert reconciliation_reports, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(reconciliationReportPrimaryKeyColumns))
+			copy(conflict, reconciliationReportPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"reconciliation_reports\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(reconciliationReportType, reconciliationReportMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(reconciliationReportType, reconciliationReportMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert reconciliation_reports")
+	}
+
+	if !cached {
+		reconciliationReportUpsertCacheMut.Lock()
+		reconciliationReportUpsertCache[key] = cache
+		reconciliationReportUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ReconciliationReport record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ReconciliationReport) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single ReconciliationReport record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReconciliationReport) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no ReconciliationReport provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reconciliationReportPrimaryKeyMapping)
+		sql = "DELETE FROM \"reconciliation_reports\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"reconciliation_reports\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(reconciliationReportType, reconciliationReportMapping, append(wl, reconciliationReportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from reconciliation_reports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for reconciliation_reports")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q reconciliationReportQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q reconciliationReportQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no reconciliationReportQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from reconciliation_reports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for reconciliation_reports")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ReconciliationReportSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReconciliationReportSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(reconciliationReportBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationReportPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"reconciliation_reports\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reconciliationReportPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationReportPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"reconciliation_reports\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, reconciliationReportPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from reconciliationReport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for reconciliation_reports")
+	}
+
+	if len(reconciliationReportAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ReconciliationReport) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no ReconciliationReport provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReconciliationReport) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReconciliationReport(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReconciliationReportSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty ReconciliationReportSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReconciliationReportSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReconciliationReportSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationReportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"reconciliation_reports\".* FROM \"reconciliation_reports\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reconciliationReportPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in ReconciliationReportSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReconciliationReportExistsG checks if the ReconciliationReport row exists.
+func ReconciliationReportExistsG(ctx context.Context, iD int) (bool, error) {
+	return ReconciliationReportExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ReconciliationReportExists checks if the ReconciliationReport row exists.
+func ReconciliationReportExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"reconciliation_reports\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if reconciliation_reports exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ReconciliationReport row exists.
+func (o *ReconciliationReport) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ReconciliationReportExists(ctx, exec, o.ID)
+}
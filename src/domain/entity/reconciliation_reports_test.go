@@ -0,0 +1,987 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testReconciliationReports(t *testing.T) {
+	t.Parallel()
+
+	query := ReconciliationReports()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testReconciliationReportsSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReconciliationReportsQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ReconciliationReports().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReconciliationReportsSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReconciliationReportSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReconciliationReportsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReconciliationReportsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ReconciliationReports().DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReconciliationReportsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReconciliationReportSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReconciliationReportsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ReconciliationReportExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ReconciliationReport exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ReconciliationReportExists to return true, but got false.")
+	}
+}
+
+func testReconciliationReportsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	reconciliationReportFound, err := FindReconciliationReport(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if reconciliationReportFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testReconciliationReportsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ReconciliationReports().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReconciliationReportsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ReconciliationReports().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testReconciliationReportsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	reconciliationReportOne := &ReconciliationReport{}
+	reconciliationReportTwo := &ReconciliationReport{}
+	if err = randomize.Struct(seed, reconciliationReportOne, reconciliationReportDBTypes, false, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+	if err = randomize.Struct(seed, reconciliationReportTwo, reconciliationReportDBTypes, false, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = reconciliationReportOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = reconciliationReportTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReconciliationReports().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testReconciliationReportsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	reconciliationReportOne := &ReconciliationReport{}
+	reconciliationReportTwo := &ReconciliationReport{}
+	if err = randomize.Struct(seed, reconciliationReportOne, reconciliationReportDBTypes, false, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+	if err = randomize.Struct(seed, reconciliationReportTwo, reconciliationReportDBTypes, false, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = reconciliationReportOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = reconciliationReportTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func reconciliationReportBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationReport) error {
+	*o = ReconciliationReport{}
+	return nil
+}
+
+func reconciliationReportAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationReport) error {
+	*o = ReconciliationReport{}
+	return nil
+}
+
+func reconciliationReportAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationReport) error {
+	*o = ReconciliationReport{}
+	return nil
+}
+
+func reconciliationReportBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationReport) error {
+	*o = ReconciliationReport{}
+	return nil
+}
+
+func reconciliationReportAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationReport) error {
+	*o = ReconciliationReport{}
+	return nil
+}
+
+func reconciliationReportBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationReport) error {
+	*o = ReconciliationReport{}
+	return nil
+}
+
+func reconciliationReportAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationReport) error {
+	*o = ReconciliationReport{}
+	return nil
+}
+
+func reconciliationReportBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationReport) error {
+	*o = ReconciliationReport{}
+	return nil
+}
+
+func reconciliationReportAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReconciliationReport) error {
+	*o = ReconciliationReport{}
+	return nil
+}
+
+func testReconciliationReportsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ReconciliationReport{}
+	o := &ReconciliationReport{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport object: %s", err)
+	}
+
+	AddReconciliationReportHook(boil.BeforeInsertHook, reconciliationReportBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	reconciliationReportBeforeInsertHooks = []ReconciliationReportHook{}
+
+	AddReconciliationReportHook(boil.AfterInsertHook, reconciliationReportAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	reconciliationReportAfterInsertHooks = []ReconciliationReportHook{}
+
+	AddReconciliationReportHook(boil.AfterSelectHook, reconciliationReportAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	reconciliationReportAfterSelectHooks = []ReconciliationReportHook{}
+
+	AddReconciliationReportHook(boil.BeforeUpdateHook, reconciliationReportBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	reconciliationReportBeforeUpdateHooks = []ReconciliationReportHook{}
+
+	AddReconciliationReportHook(boil.AfterUpdateHook, reconciliationReportAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	reconciliationReportAfterUpdateHooks = []ReconciliationReportHook{}
+
+	AddReconciliationReportHook(boil.BeforeDeleteHook, reconciliationReportBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	reconciliationReportBeforeDeleteHooks = []ReconciliationReportHook{}
+
+	AddReconciliationReportHook(boil.AfterDeleteHook, reconciliationReportAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	reconciliationReportAfterDeleteHooks = []ReconciliationReportHook{}
+
+	AddReconciliationReportHook(boil.BeforeUpsertHook, reconciliationReportBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	reconciliationReportBeforeUpsertHooks = []ReconciliationReportHook{}
+
+	AddReconciliationReportHook(boil.AfterUpsertHook, reconciliationReportAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	reconciliationReportAfterUpsertHooks = []ReconciliationReportHook{}
+}
+
+func testReconciliationReportsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReconciliationReportsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(reconciliationReportColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReconciliationReportToManyReconciliationDiscrepancies(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ReconciliationReport
+	var b, c ReconciliationDiscrepancy
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, reconciliationDiscrepancyDBTypes, false, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, reconciliationDiscrepancyDBTypes, false, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ReconciliationReportID = a.ID
+	c.ReconciliationReportID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ReconciliationDiscrepancies().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ReconciliationReportID == b.ReconciliationReportID {
+			bFound = true
+		}
+		if v.ReconciliationReportID == c.ReconciliationReportID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ReconciliationReportSlice{&a}
+	if err = a.L.LoadReconciliationDiscrepancies(ctx, tx, false, (*[]*ReconciliationReport)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ReconciliationDiscrepancies); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ReconciliationDiscrepancies = nil
+	if err = a.L.LoadReconciliationDiscrepancies(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ReconciliationDiscrepancies); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testReconciliationReportToManyAddOpReconciliationDiscrepancies(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ReconciliationReport
+	var b, c, d, e ReconciliationDiscrepancy
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, reconciliationReportDBTypes, false, strmangle.SetComplement(reconciliationReportPrimaryKeyColumns, reconciliationReportColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ReconciliationDiscrepancy{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, reconciliationDiscrepancyDBTypes, false, strmangle.SetComplement(reconciliationDiscrepancyPrimaryKeyColumns, reconciliationDiscrepancyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ReconciliationDiscrepancy{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddReconciliationDiscrepancies(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ReconciliationReportID {
+			t.Error("foreign key was wrong value", a.ID, first.ReconciliationReportID)
+		}
+		if a.ID != second.ReconciliationReportID {
+			t.Error("foreign key was wrong value", a.ID, second.ReconciliationReportID)
+		}
+
+		if first.R.ReconciliationReport != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ReconciliationReport != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ReconciliationDiscrepancies[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ReconciliationDiscrepancies[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ReconciliationDiscrepancies().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testReconciliationReportsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReconciliationReportsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReconciliationReportSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReconciliationReportsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReconciliationReports().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	reconciliationReportDBTypes = map[string]string{`ID`: `integer`, `ReportID`: `character varying`, `PeriodStart`: `timestamp with time zone`, `PeriodEnd`: `timestamp with time zone`, `Status`: `enum.reconciliationstatus('running','completed','failed')`, `ProviderCount`: `integer`, `JobCount`: `integer`, `MatchedCount`: `integer`, `DiscrepancyCount`: `integer`, `Error`: `text`, `FinishedAt`: `timestamp with time zone`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	_                           = bytes.MinRead
+)
+
+func testReconciliationReportsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(reconciliationReportPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(reconciliationReportAllColumns) == len(reconciliationReportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testReconciliationReportsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(reconciliationReportAllColumns) == len(reconciliationReportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReconciliationReport{}
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, reconciliationReportDBTypes, true, reconciliationReportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(reconciliationReportAllColumns, reconciliationReportPrimaryKeyColumns) {
+		fields = reconciliationReportAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			reconciliationReportAllColumns,
+			reconciliationReportPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ReconciliationReportSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testReconciliationReportsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(reconciliationReportAllColumns) == len(reconciliationReportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ReconciliationReport{}
+	if err = randomize.Struct(seed, &o, reconciliationReportDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReconciliationReport: %s", err)
+	}
+
+	count, err := ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, reconciliationReportDBTypes, false, reconciliationReportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReconciliationReport struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReconciliationReport: %s", err)
+	}
+
+	count, err = ReconciliationReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
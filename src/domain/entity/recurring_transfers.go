@@ -0,0 +1,1526 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// RecurringTransfer is an object representing the database table.
+type RecurringTransfer struct {
+	ID             int             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	MandateID      string          `boil:"mandate_id" json:"mandate_id" toml:"mandate_id" yaml:"mandate_id"`
+	APIKey         string          `boil:"api_key" json:"api_key" toml:"api_key" yaml:"api_key"`
+	Payload        types.JSON      `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Schedule       string          `boil:"schedule" json:"schedule" toml:"schedule" yaml:"schedule"`
+	StartAt        time.Time       `boil:"start_at" json:"start_at" toml:"start_at" yaml:"start_at"`
+	EndAt          null.Time       `boil:"end_at" json:"end_at,omitempty" toml:"end_at" yaml:"end_at,omitempty"`
+	MaxOccurrences null.Int        `boil:"max_occurrences" json:"max_occurrences,omitempty" toml:"max_occurrences" yaml:"max_occurrences,omitempty"`
+	Occurrences    int             `boil:"occurrences" json:"occurrences" toml:"occurrences" yaml:"occurrences"`
+	NextRunAt      null.Time       `boil:"next_run_at" json:"next_run_at,omitempty" toml:"next_run_at" yaml:"next_run_at,omitempty"`
+	LastRunAt      null.Time       `boil:"last_run_at" json:"last_run_at,omitempty" toml:"last_run_at" yaml:"last_run_at,omitempty"`
+	Status         Recurringstatus `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedBy      int             `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt      time.Time       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy      int             `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt      time.Time       `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy      null.Int        `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt      null.Time       `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *recurringTransferR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L recurringTransferL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RecurringTransferColumns = struct {
+	ID             string
+	MandateID      string
+	APIKey         string
+	Payload        string
+	Schedule       string
+	StartAt        string
+	EndAt          string
+	MaxOccurrences string
+	Occurrences    string
+	NextRunAt      string
+	LastRunAt      string
+	Status         string
+	CreatedBy      string
+	CreatedAt      string
+	UpdatedBy      string
+	UpdatedAt      string
+	DeletedBy      string
+	DeletedAt      string
+}{
+	ID:             "id",
+	MandateID:      "mandate_id",
+	APIKey:         "api_key",
+	Payload:        "payload",
+	Schedule:       "schedule",
+	StartAt:        "start_at",
+	EndAt:          "end_at",
+	MaxOccurrences: "max_occurrences",
+	Occurrences:    "occurrences",
+	NextRunAt:      "next_run_at",
+	LastRunAt:      "last_run_at",
+	Status:         "status",
+	CreatedBy:      "created_by",
+	CreatedAt:      "created_at",
+	UpdatedBy:      "updated_by",
+	UpdatedAt:      "updated_at",
+	DeletedBy:      "deleted_by",
+	DeletedAt:      "deleted_at",
+}
+
+var RecurringTransferTableColumns = struct {
+	ID             string
+	MandateID      string
+	APIKey         string
+	Payload        string
+	Schedule       string
+	StartAt        string
+	EndAt          string
+	MaxOccurrences string
+	Occurrences    string
+	NextRunAt      string
+	LastRunAt      string
+	Status         string
+	CreatedBy      string
+	CreatedAt      string
+	UpdatedBy      string
+	UpdatedAt      string
+	DeletedBy      string
+	DeletedAt      string
+}{
+	ID:             "recurring_transfers.id",
+	MandateID:      "recurring_transfers.mandate_id",
+	APIKey:         "recurring_transfers.api_key",
+	Payload:        "recurring_transfers.payload",
+	Schedule:       "recurring_transfers.schedule",
+	StartAt:        "recurring_transfers.start_at",
+	EndAt:          "recurring_transfers.end_at",
+	MaxOccurrences: "recurring_transfers.max_occurrences",
+	Occurrences:    "recurring_transfers.occurrences",
+	NextRunAt:      "recurring_transfers.next_run_at",
+	LastRunAt:      "recurring_transfers.last_run_at",
+	Status:         "recurring_transfers.status",
+	CreatedBy:      "recurring_transfers.created_by",
+	CreatedAt:      "recurring_transfers.created_at",
+	UpdatedBy:      "recurring_transfers.updated_by",
+	UpdatedAt:      "recurring_transfers.updated_at",
+	DeletedBy:      "recurring_transfers.deleted_by",
+	DeletedAt:      "recurring_transfers.deleted_at",
+}
+
+// Generated where
+
+type whereHelperRecurringstatus struct{ field string }
+
+func (w whereHelperRecurringstatus) EQ(x Recurringstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperRecurringstatus) NEQ(x Recurringstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperRecurringstatus) LT(x Recurringstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperRecurringstatus) LTE(x Recurringstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperRecurringstatus) GT(x Recurringstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperRecurringstatus) GTE(x Recurringstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperRecurringstatus) IN(slice []Recurringstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperRecurringstatus) NIN(slice []Recurringstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var RecurringTransferWhere = struct {
+	ID             whereHelperint
+	MandateID      whereHelperstring
+	APIKey         whereHelperstring
+	Payload        whereHelpertypes_JSON
+	Schedule       whereHelperstring
+	StartAt        whereHelpertime_Time
+	EndAt          whereHelpernull_Time
+	MaxOccurrences whereHelpernull_Int
+	Occurrences    whereHelperint
+	NextRunAt      whereHelpernull_Time
+	LastRunAt      whereHelpernull_Time
+	Status         whereHelperRecurringstatus
+	CreatedBy      whereHelperint
+	CreatedAt      whereHelpertime_Time
+	UpdatedBy      whereHelperint
+	UpdatedAt      whereHelpertime_Time
+	DeletedBy      whereHelpernull_Int
+	DeletedAt      whereHelpernull_Time
+}{
+	ID:             whereHelperint{field: "\"recurring_transfers\".\"id\""},
+	MandateID:      whereHelperstring{field: "\"recurring_transfers\".\"mandate_id\""},
+	APIKey:         whereHelperstring{field: "\"recurring_transfers\".\"api_key\""},
+	Payload:        whereHelpertypes_JSON{field: "\"recurring_transfers\".\"payload\""},
+	Schedule:       whereHelperstring{field: "\"recurring_transfers\".\"schedule\""},
+	StartAt:        whereHelpertime_Time{field: "\"recurring_transfers\".\"start_at\""},
+	EndAt:          whereHelpernull_Time{field: "\"recurring_transfers\".\"end_at\""},
+	MaxOccurrences: whereHelpernull_Int{field: "\"recurring_transfers\".\"max_occurrences\""},
+	Occurrences:    whereHelperint{field: "\"recurring_transfers\".\"occurrences\""},
+	NextRunAt:      whereHelpernull_Time{field: "\"recurring_transfers\".\"next_run_at\""},
+	LastRunAt:      whereHelpernull_Time{field: "\"recurring_transfers\".\"last_run_at\""},
+	Status:         whereHelperRecurringstatus{field: "\"recurring_transfers\".\"status\""},
+	CreatedBy:      whereHelperint{field: "\"recurring_transfers\".\"created_by\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"recurring_transfers\".\"created_at\""},
+	UpdatedBy:      whereHelperint{field: "\"recurring_transfers\".\"updated_by\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"recurring_transfers\".\"updated_at\""},
+	DeletedBy:      whereHelpernull_Int{field: "\"recurring_transfers\".\"deleted_by\""},
+	DeletedAt:      whereHelpernull_Time{field: "\"recurring_transfers\".\"deleted_at\""},
+}
+
+// RecurringTransferRels is where relationship names are stored.
+var RecurringTransferRels = struct {
+	TransferJobs string
+}{
+	TransferJobs: "TransferJobs",
+}
+
+// recurringTransferR is where relationships are stored.
+type recurringTransferR struct {
+	TransferJobs TransferJobSlice `boil:"TransferJobs" json:"TransferJobs" toml:"TransferJobs" yaml:"TransferJobs"`
+}
+
+// NewStruct creates a new relationship struct
+func (*recurringTransferR) NewStruct() *recurringTransferR {
+	return &recurringTransferR{}
+}
+
+func (r *recurringTransferR) GetTransferJobs() TransferJobSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TransferJobs
+}
+
+// recurringTransferL is where Load methods for each relationship are stored.
+type recurringTransferL struct{}
+
+var (
+	recurringTransferAllColumns            = []string{"id", "mandate_id", "api_key", "payload", "schedule", "start_at", "end_at", "max_occurrences", "occurrences", "next_run_at", "last_run_at", "status", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	recurringTransferColumnsWithoutDefault = []string{"mandate_id", "api_key", "payload", "schedule", "start_at"}
+	recurringTransferColumnsWithDefault    = []string{"id", "end_at", "max_occurrences", "occurrences", "next_run_at", "last_run_at", "status", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	recurringTransferPrimaryKeyColumns     = []string{"id"}
+	recurringTransferGeneratedColumns      = []string{}
+)
+
+type (
+	// RecurringTransferSlice is an alias for a slice of pointers to RecurringTransfer.
+	// This should almost always be used instead of []RecurringTransfer.
+	RecurringTransferSlice []*RecurringTransfer
+	// RecurringTransferHook is the signature for custom RecurringTransfer hook methods
+	RecurringTransferHook func(context.Context, boil.ContextExecutor, *RecurringTransfer) error
+
+	recurringTransferQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	recurringTransferType                 = reflect.TypeOf(&RecurringTransfer{})
+	recurringTransferMapping              = queries.MakeStructMapping(recurringTransferType)
+	recurringTransferPrimaryKeyMapping, _ = queries.BindMapping(recurringTransferType, recurringTransferMapping, recurringTransferPrimaryKeyColumns)
+	recurringTransferInsertCacheMut       sync.RWMutex
+	recurringTransferInsertCache          = make(map[string]insertCache)
+	recurringTransferUpdateCacheMut       sync.RWMutex
+	recurringTransferUpdateCache          = make(map[string]updateCache)
+	recurringTransferUpsertCacheMut       sync.RWMutex
+	recurringTransferUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var recurringTransferAfterSelectMu sync.Mutex
+var recurringTransferAfterSelectHooks []RecurringTransferHook
+
+var recurringTransferBeforeInsertMu sync.Mutex
+var recurringTransferBeforeInsertHooks []RecurringTransferHook
+var recurringTransferAfterInsertMu sync.Mutex
+var recurringTransferAfterInsertHooks []RecurringTransferHook
+
+var recurringTransferBeforeUpdateMu sync.Mutex
+var recurringTransferBeforeUpdateHooks []RecurringTransferHook
+var recurringTransferAfterUpdateMu sync.Mutex
+var recurringTransferAfterUpdateHooks []RecurringTransferHook
+
+var recurringTransferBeforeDeleteMu sync.Mutex
+var recurringTransferBeforeDeleteHooks []RecurringTransferHook
+var recurringTransferAfterDeleteMu sync.Mutex
+var recurringTransferAfterDeleteHooks []RecurringTransferHook
+
+var recurringTransferBeforeUpsertMu sync.Mutex
+var recurringTransferBeforeUpsertHooks []RecurringTransferHook
+var recurringTransferAfterUpsertMu sync.Mutex
+var recurringTransferAfterUpsertHooks []RecurringTransferHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RecurringTransfer) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransferAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RecurringTransfer) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransferBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RecurringTransfer) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransferAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RecurringTransfer) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransferBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RecurringTransfer) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransferAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RecurringTransfer) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransferBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RecurringTransfer) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransferAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RecurringTransfer) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransferBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RecurringTransfer) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransferAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRecurringTransferHook registers your hook function for all future operations.
+func AddRecurringTransferHook(hookPoint boil.HookPoint, recurringTransferHook RecurringTransferHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		recurringTransferAfterSelectMu.Lock()
+		recurringTransferAfterSelectHooks = append(recurringTransferAfterSelectHooks, recurringTransferHook)
+		recurringTransferAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		recurringTransferBeforeInsertMu.Lock()
+		recurringTransferBeforeInsertHooks = append(recurringTransferBeforeInsertHooks, recurringTransferHook)
+		recurringTransferBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		recurringTransferAfterInsertMu.Lock()
+		recurringTransferAfterInsertHooks = append(recurringTransferAfterInsertHooks, recurringTransferHook)
+		recurringTransferAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		recurringTransferBeforeUpdateMu.Lock()
+		recurringTransferBeforeUpdateHooks = append(recurringTransferBeforeUpdateHooks, recurringTransferHook)
+		recurringTransferBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		recurringTransferAfterUpdateMu.Lock()
+		recurringTransferAfterUpdateHooks = append(recurringTransferAfterUpdateHooks, recurringTransferHook)
+		recurringTransferAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		recurringTransferBeforeDeleteMu.Lock()
+		recurringTransferBeforeDeleteHooks = append(recurringTransferBeforeDeleteHooks, recurringTransferHook)
+		recurringTransferBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		recurringTransferAfterDeleteMu.Lock()
+		recurringTransferAfterDeleteHooks = append(recurringTransferAfterDeleteHooks, recurringTransferHook)
+		recurringTransferAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		recurringTransferBeforeUpsertMu.Lock()
+		recurringTransferBeforeUpsertHooks = append(recurringTransferBeforeUpsertHooks, recurringTransferHook)
+		recurringTransferBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		recurringTransferAfterUpsertMu.Lock()
+		recurringTransferAfterUpsertHooks = append(recurringTransferAfterUpsertHooks, recurringTransferHook)
+		recurringTransferAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single recurringTransfer record from the query using the global executor.
+func (q recurringTransferQuery) OneG(ctx context.Context) (*RecurringTransfer, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single recurringTransfer record from the query.
+func (q recurringTransferQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RecurringTransfer, error) {
+	o := &RecurringTransfer{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for recurring_transfers")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all RecurringTransfer records from the query using the global executor.
+func (q recurringTransferQuery) AllG(ctx context.Context) (RecurringTransferSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all RecurringTransfer records from the query.
+func (q recurringTransferQuery) All(ctx context.Context, exec boil.ContextExecutor) (RecurringTransferSlice, error) {
+	var o []*RecurringTransfer
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to RecurringTransfer slice")
+	}
+
+	if len(recurringTransferAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all RecurringTransfer records in the query using the global executor
+func (q recurringTransferQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all RecurringTransfer records in the query.
+func (q recurringTransferQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count recurring_transfers rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q recurringTransferQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q recurringTransferQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if recurring_transfers exists")
+	}
+
+	return count > 0, nil
+}
+
+// TransferJobs retrieves all the transfer_job's TransferJobs with an executor.
+func (o *RecurringTransfer) TransferJobs(mods ...qm.QueryMod) transferJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transfer_jobs\".\"recurring_transfer_id\"=?", o.ID),
+	)
+
+	return TransferJobs(queryMods...)
+}
+
+// LoadTransferJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (recurringTransferL) LoadTransferJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRecurringTransfer interface{}, mods queries.Applicator) error {
+	var slice []*RecurringTransfer
+	var object *RecurringTransfer
+
+	if singular {
+		var ok bool
+		object, ok = maybeRecurringTransfer.(*RecurringTransfer)
+		if !ok {
+			object = new(RecurringTransfer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRecurringTransfer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRecurringTransfer))
+			}
+		}
+	} else {
+		s, ok := maybeRecurringTransfer.(*[]*RecurringTransfer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRecurringTransfer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRecurringTransfer))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &recurringTransferR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &recurringTransferR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transfer_jobs`),
+		qm.WhereIn(`transfer_jobs.recurring_transfer_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`transfer_jobs.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transfer_jobs")
+	}
+
+	var resultSlice []*TransferJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transfer_jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transfer_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer_jobs")
+	}
+
+	if len(transferJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TransferJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transferJobR{}
+			}
+			foreign.R.RecurringTransfer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.RecurringTransferID) {
+				local.R.TransferJobs = append(local.R.TransferJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &transferJobR{}
+				}
+				foreign.R.RecurringTransfer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddTransferJobsG adds the given related objects to the existing relationships
+// of the recurring_transfer, optionally inserting them as new records.
+// Appends related to o.R.TransferJobs.
+// Sets related.R.RecurringTransfer appropriately.
+// Uses the global database handle.
+func (o *RecurringTransfer) AddTransferJobsG(ctx context.Context, insert bool, related ...*TransferJob) error {
+	return o.AddTransferJobs(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddTransferJobs adds the given related objects to the existing relationships
+// of the recurring_transfer, optionally inserting them as new records.
+// Appends related to o.R.TransferJobs.
+// Sets related.R.RecurringTransfer appropriately.
+func (o *RecurringTransfer) AddTransferJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TransferJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.RecurringTransferID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transfer_jobs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"recurring_transfer_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transferJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.RecurringTransferID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &recurringTransferR{
+			TransferJobs: related,
+		}
+	} else {
+		o.R.TransferJobs = append(o.R.TransferJobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transferJobR{
+				RecurringTransfer: o,
+			}
+		} else {
+			rel.R.RecurringTransfer = o
+		}
+	}
+	return nil
+}
+
+// SetTransferJobsG removes all previously related items of the
+// recurring_transfer replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.RecurringTransfer's TransferJobs accordingly.
+// Replaces o.R.TransferJobs with related.
+// Sets related.R.RecurringTransfer's TransferJobs accordingly.
+// Uses the global database handle.
+func (o *RecurringTransfer) SetTransferJobsG(ctx context.Context, insert bool, related ...*TransferJob) error {
+	return o.SetTransferJobs(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetTransferJobs removes all previously related items of the
+// recurring_transfer replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.RecurringTransfer's TransferJobs accordingly.
+// Replaces o.R.TransferJobs with related.
+// Sets related.R.RecurringTransfer's TransferJobs accordingly.
+func (o *RecurringTransfer) SetTransferJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TransferJob) error {
+	query := "update \"transfer_jobs\" set \"recurring_transfer_id\" = null where \"recurring_transfer_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TransferJobs {
+			queries.SetScanner(&rel.RecurringTransferID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.RecurringTransfer = nil
+		}
+		o.R.TransferJobs = nil
+	}
+
+	return o.AddTransferJobs(ctx, exec, insert, related...)
+}
+
+// RemoveTransferJobsG relationships from objects passed in.
+// Removes related items from R.TransferJobs (uses pointer comparison, removal does not keep order)
+// Sets related.R.RecurringTransfer.
+// Uses the global database handle.
+func (o *RecurringTransfer) RemoveTransferJobsG(ctx context.Context, related ...*TransferJob) error {
+	return o.RemoveTransferJobs(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveTransferJobs relationships from objects passed in.
+// Removes related items from R.TransferJobs (uses pointer comparison, removal does not keep order)
+// Sets related.R.RecurringTransfer.
+func (o *RecurringTransfer) RemoveTransferJobs(ctx context.Context, exec boil.ContextExecutor, related ...*TransferJob) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.RecurringTransferID, nil)
+		if rel.R != nil {
+			rel.R.RecurringTransfer = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("recurring_transfer_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TransferJobs {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TransferJobs)
+			if ln > 1 && i < ln-1 {
+				o.R.TransferJobs[i] = o.R.TransferJobs[ln-1]
+			}
+			o.R.TransferJobs = o.R.TransferJobs[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// RecurringTransfers retrieves all the records using an executor.
+func RecurringTransfers(mods ...qm.QueryMod) recurringTransferQuery {
+	mods = append(mods, qm.From("\"recurring_transfers\""), qmhelper.WhereIsNull("\"recurring_transfers\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"recurring_transfers\".*"})
+	}
+
+	return recurringTransferQuery{q}
+}
+
+// FindRecurringTransferG retrieves a single record by ID.
+func FindRecurringTransferG(ctx context.Context, iD int, selectCols ...string) (*RecurringTransfer, error) {
+	return FindRecurringTransfer(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindRecurringTransfer retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRecurringTransfer(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*RecurringTransfer, error) {
+	recurringTransferObj := &RecurringTransfer{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"recurring_transfers\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, recurringTransferObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from recurring_transfers")
+	}
+
+	if err = recurringTransferObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return recurringTransferObj, err
+	}
+
+	return recurringTransferObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *RecurringTransfer) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RecurringTransfer) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no recurring_transfers provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(recurringTransferColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	recurringTransferInsertCacheMut.RLock()
+	cache, cached := recurringTransferInsertCache[key]
+	recurringTransferInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			recurringTransferAllColumns,
+			recurringTransferColumnsWithDefault,
+			recurringTransferColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(recurringTransferType, recurringTransferMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(recurringTransferType, recurringTransferMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"recurring_transfers\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"recurring_transfers\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into recurring_transfers")
+	}
+
+	if !cached {
+		recurringTransferInsertCacheMut.Lock()
+		recurringTransferInsertCache[key] = cache
+		recurringTransferInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single RecurringTransfer record using the global executor.
+// See Update for more documentation.
+func (o *RecurringTransfer) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the RecurringTransfer.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RecurringTransfer) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	recurringTransferUpdateCacheMut.RLock()
+	cache, cached := recurringTransferUpdateCache[key]
+	recurringTransferUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			recurringTransferAllColumns,
+			recurringTransferPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update recurring_transfers, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"recurring_transfers\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, recurringTransferPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(recurringTransferType, recurringTransferMapping, append(wl, recurringTransferPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update recurring_transfers row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for recurring_transfers")
+	}
+
+	if !cached {
+		recurringTransferUpdateCacheMut.Lock()
+		recurringTransferUpdateCache[key] = cache
+		recurringTransferUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q recurringTransferQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q recurringTransferQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for recurring_transfers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for recurring_transfers")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o RecurringTransferSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RecurringTransferSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), recurringTransferPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"recurring_transfers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, recurringTransferPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in recurringTransfer slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all recurringTransfer")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *RecurringTransfer) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RecurringTransfer) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no recurring_transfers provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(recurringTransferColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	recurringTransferUpsertCacheMut.RLock()
+	cache, cached := recurringTransferUpsertCache[key]
+	recurringTransferUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			recurringTransferAllColumns,
+			recurringTransferColumnsWithDefault,
+			recurringTransferColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			recurringTransferAllColumns,
+			recurringTransferPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert recurring_transfers, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(recurringTransferAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(recurringTransferPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert recurring_transfers, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(recurringTransferPrimaryKeyColumns))
+			copy(conflict, recurringTransferPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"recurring_transfers\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(recurringTransferType, recurringTransferMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(recurringTransferType, recurringTransferMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert recurring_transfers")
+	}
+
+	if !cached {
+		recurringTransferUpsertCacheMut.Lock()
+		recurringTransferUpsertCache[key] = cache
+		recurringTransferUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single RecurringTransfer record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *RecurringTransfer) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single RecurringTransfer record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RecurringTransfer) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no RecurringTransfer provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), recurringTransferPrimaryKeyMapping)
+		sql = "DELETE FROM \"recurring_transfers\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"recurring_transfers\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(recurringTransferType, recurringTransferMapping, append(wl, recurringTransferPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from recurring_transfers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for recurring_transfers")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q recurringTransferQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q recurringTransferQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no recurringTransferQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from recurring_transfers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for recurring_transfers")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o RecurringTransferSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RecurringTransferSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(recurringTransferBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), recurringTransferPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"recurring_transfers\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, recurringTransferPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), recurringTransferPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"recurring_transfers\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, recurringTransferPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from recurringTransfer slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for recurring_transfers")
+	}
+
+	if len(recurringTransferAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *RecurringTransfer) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no RecurringTransfer provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RecurringTransfer) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRecurringTransfer(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RecurringTransferSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty RecurringTransferSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RecurringTransferSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RecurringTransferSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), recurringTransferPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"recurring_transfers\".* FROM \"recurring_transfers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, recurringTransferPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in RecurringTransferSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RecurringTransferExistsG checks if the RecurringTransfer row exists.
+func RecurringTransferExistsG(ctx context.Context, iD int) (bool, error) {
+	return RecurringTransferExists(ctx, boil.GetContextDB(), iD)
+}
+
+// RecurringTransferExists checks if the RecurringTransfer row exists.
+func RecurringTransferExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"recurring_transfers\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if recurring_transfers exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RecurringTransfer row exists.
+func (o *RecurringTransfer) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RecurringTransferExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1161 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRecurringTransfers(t *testing.T) {
+	t.Parallel()
+
+	query := RecurringTransfers()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRecurringTransfersSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRecurringTransfersQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := RecurringTransfers().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRecurringTransfersSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RecurringTransferSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRecurringTransfersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRecurringTransfersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := RecurringTransfers().DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRecurringTransfersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RecurringTransferSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRecurringTransfersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RecurringTransferExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if RecurringTransfer exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RecurringTransferExists to return true, but got false.")
+	}
+}
+
+func testRecurringTransfersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	recurringTransferFound, err := FindRecurringTransfer(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if recurringTransferFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRecurringTransfersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = RecurringTransfers().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRecurringTransfersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := RecurringTransfers().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRecurringTransfersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	recurringTransferOne := &RecurringTransfer{}
+	recurringTransferTwo := &RecurringTransfer{}
+	if err = randomize.Struct(seed, recurringTransferOne, recurringTransferDBTypes, false, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+	if err = randomize.Struct(seed, recurringTransferTwo, recurringTransferDBTypes, false, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = recurringTransferOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = recurringTransferTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RecurringTransfers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRecurringTransfersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	recurringTransferOne := &RecurringTransfer{}
+	recurringTransferTwo := &RecurringTransfer{}
+	if err = randomize.Struct(seed, recurringTransferOne, recurringTransferDBTypes, false, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+	if err = randomize.Struct(seed, recurringTransferTwo, recurringTransferDBTypes, false, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = recurringTransferOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = recurringTransferTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func recurringTransferBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransfer) error {
+	*o = RecurringTransfer{}
+	return nil
+}
+
+func recurringTransferAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransfer) error {
+	*o = RecurringTransfer{}
+	return nil
+}
+
+func recurringTransferAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransfer) error {
+	*o = RecurringTransfer{}
+	return nil
+}
+
+func recurringTransferBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransfer) error {
+	*o = RecurringTransfer{}
+	return nil
+}
+
+func recurringTransferAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransfer) error {
+	*o = RecurringTransfer{}
+	return nil
+}
+
+func recurringTransferBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransfer) error {
+	*o = RecurringTransfer{}
+	return nil
+}
+
+func recurringTransferAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransfer) error {
+	*o = RecurringTransfer{}
+	return nil
+}
+
+func recurringTransferBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransfer) error {
+	*o = RecurringTransfer{}
+	return nil
+}
+
+func recurringTransferAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransfer) error {
+	*o = RecurringTransfer{}
+	return nil
+}
+
+func testRecurringTransfersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &RecurringTransfer{}
+	o := &RecurringTransfer{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer object: %s", err)
+	}
+
+	AddRecurringTransferHook(boil.BeforeInsertHook, recurringTransferBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	recurringTransferBeforeInsertHooks = []RecurringTransferHook{}
+
+	AddRecurringTransferHook(boil.AfterInsertHook, recurringTransferAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	recurringTransferAfterInsertHooks = []RecurringTransferHook{}
+
+	AddRecurringTransferHook(boil.AfterSelectHook, recurringTransferAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	recurringTransferAfterSelectHooks = []RecurringTransferHook{}
+
+	AddRecurringTransferHook(boil.BeforeUpdateHook, recurringTransferBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	recurringTransferBeforeUpdateHooks = []RecurringTransferHook{}
+
+	AddRecurringTransferHook(boil.AfterUpdateHook, recurringTransferAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	recurringTransferAfterUpdateHooks = []RecurringTransferHook{}
+
+	AddRecurringTransferHook(boil.BeforeDeleteHook, recurringTransferBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	recurringTransferBeforeDeleteHooks = []RecurringTransferHook{}
+
+	AddRecurringTransferHook(boil.AfterDeleteHook, recurringTransferAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	recurringTransferAfterDeleteHooks = []RecurringTransferHook{}
+
+	AddRecurringTransferHook(boil.BeforeUpsertHook, recurringTransferBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	recurringTransferBeforeUpsertHooks = []RecurringTransferHook{}
+
+	AddRecurringTransferHook(boil.AfterUpsertHook, recurringTransferAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	recurringTransferAfterUpsertHooks = []RecurringTransferHook{}
+}
+
+func testRecurringTransfersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRecurringTransfersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(recurringTransferColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRecurringTransferToManyTransferJobs(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RecurringTransfer
+	var b, c TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transferJobDBTypes, false, transferJobColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferJobDBTypes, false, transferJobColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.RecurringTransferID, a.ID)
+	queries.Assign(&c.RecurringTransferID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.TransferJobs().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.RecurringTransferID, b.RecurringTransferID) {
+			bFound = true
+		}
+		if queries.Equal(v.RecurringTransferID, c.RecurringTransferID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := RecurringTransferSlice{&a}
+	if err = a.L.LoadTransferJobs(ctx, tx, false, (*[]*RecurringTransfer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TransferJobs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TransferJobs = nil
+	if err = a.L.LoadTransferJobs(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TransferJobs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testRecurringTransferToManyAddOpTransferJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RecurringTransfer
+	var b, c, d, e TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, recurringTransferDBTypes, false, strmangle.SetComplement(recurringTransferPrimaryKeyColumns, recurringTransferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*TransferJob{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTransferJobs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.RecurringTransferID) {
+			t.Error("foreign key was wrong value", a.ID, first.RecurringTransferID)
+		}
+		if !queries.Equal(a.ID, second.RecurringTransferID) {
+			t.Error("foreign key was wrong value", a.ID, second.RecurringTransferID)
+		}
+
+		if first.R.RecurringTransfer != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.RecurringTransfer != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.TransferJobs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.TransferJobs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.TransferJobs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testRecurringTransferToManySetOpTransferJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RecurringTransfer
+	var b, c, d, e TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, recurringTransferDBTypes, false, strmangle.SetComplement(recurringTransferPrimaryKeyColumns, recurringTransferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetTransferJobs(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.TransferJobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetTransferJobs(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.TransferJobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.RecurringTransferID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.RecurringTransferID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.RecurringTransferID) {
+		t.Error("foreign key was wrong value", a.ID, d.RecurringTransferID)
+	}
+	if !queries.Equal(a.ID, e.RecurringTransferID) {
+		t.Error("foreign key was wrong value", a.ID, e.RecurringTransferID)
+	}
+
+	if b.R.RecurringTransfer != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.RecurringTransfer != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.RecurringTransfer != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.RecurringTransfer != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.TransferJobs[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.TransferJobs[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testRecurringTransferToManyRemoveOpTransferJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RecurringTransfer
+	var b, c, d, e TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, recurringTransferDBTypes, false, strmangle.SetComplement(recurringTransferPrimaryKeyColumns, recurringTransferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddTransferJobs(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.TransferJobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveTransferJobs(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.TransferJobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.RecurringTransferID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.RecurringTransferID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.RecurringTransfer != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.RecurringTransfer != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.RecurringTransfer != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.RecurringTransfer != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.TransferJobs) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.TransferJobs[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.TransferJobs[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testRecurringTransfersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRecurringTransfersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RecurringTransferSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRecurringTransfersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RecurringTransfers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	recurringTransferDBTypes = map[string]string{`ID`: `integer`, `MandateID`: `character varying`, `APIKey`: `text`, `Payload`: `jsonb`, `Schedule`: `character varying`, `StartAt`: `timestamp with time zone`, `EndAt`: `timestamp with time zone`, `MaxOccurrences`: `integer`, `Occurrences`: `integer`, `NextRunAt`: `timestamp with time zone`, `LastRunAt`: `timestamp with time zone`, `Status`: `enum.recurringstatus('active','completed','paused')`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	_                        = bytes.MinRead
+)
+
+func testRecurringTransfersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(recurringTransferPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(recurringTransferAllColumns) == len(recurringTransferPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRecurringTransfersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(recurringTransferAllColumns) == len(recurringTransferPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransfer{}
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, recurringTransferDBTypes, true, recurringTransferPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(recurringTransferAllColumns, recurringTransferPrimaryKeyColumns) {
+		fields = recurringTransferAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			recurringTransferAllColumns,
+			recurringTransferPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RecurringTransferSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testRecurringTransfersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(recurringTransferAllColumns) == len(recurringTransferPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := RecurringTransfer{}
+	if err = randomize.Struct(seed, &o, recurringTransferDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RecurringTransfer: %s", err)
+	}
+
+	count, err := RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, recurringTransferDBTypes, false, recurringTransferPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RecurringTransfer: %s", err)
+	}
+
+	count, err = RecurringTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1456 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// TransferBatch is an object representing the database table.
+type TransferBatch struct {
+	ID           int        `boil:"id" json:"id" toml:"id" yaml:"id"`
+	BatchID      string     `boil:"batch_id" json:"batch_id" toml:"batch_id" yaml:"batch_id"`
+	APIKey       string     `boil:"api_key" json:"api_key" toml:"api_key" yaml:"api_key"`
+	TotalRows    int        `boil:"total_rows" json:"total_rows" toml:"total_rows" yaml:"total_rows"`
+	AcceptedRows int        `boil:"accepted_rows" json:"accepted_rows" toml:"accepted_rows" yaml:"accepted_rows"`
+	RejectedRows int        `boil:"rejected_rows" json:"rejected_rows" toml:"rejected_rows" yaml:"rejected_rows"`
+	Errors       types.JSON `boil:"errors" json:"errors" toml:"errors" yaml:"errors"`
+	CreatedBy    int        `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt    time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy    int        `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt    time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy    null.Int   `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt    null.Time  `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *transferBatchR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L transferBatchL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TransferBatchColumns = struct {
+	ID           string
+	BatchID      string
+	APIKey       string
+	TotalRows    string
+	AcceptedRows string
+	RejectedRows string
+	Errors       string
+	CreatedBy    string
+	CreatedAt    string
+	UpdatedBy    string
+	UpdatedAt    string
+	DeletedBy    string
+	DeletedAt    string
+}{
+	ID:           "id",
+	BatchID:      "batch_id",
+	APIKey:       "api_key",
+	TotalRows:    "total_rows",
+	AcceptedRows: "accepted_rows",
+	RejectedRows: "rejected_rows",
+	Errors:       "errors",
+	CreatedBy:    "created_by",
+	CreatedAt:    "created_at",
+	UpdatedBy:    "updated_by",
+	UpdatedAt:    "updated_at",
+	DeletedBy:    "deleted_by",
+	DeletedAt:    "deleted_at",
+}
+
+var TransferBatchTableColumns = struct {
+	ID           string
+	BatchID      string
+	APIKey       string
+	TotalRows    string
+	AcceptedRows string
+	RejectedRows string
+	Errors       string
+	CreatedBy    string
+	CreatedAt    string
+	UpdatedBy    string
+	UpdatedAt    string
+	DeletedBy    string
+	DeletedAt    string
+}{
+	ID:           "transfer_batches.id",
+	BatchID:      "transfer_batches.batch_id",
+	APIKey:       "transfer_batches.api_key",
+	TotalRows:    "transfer_batches.total_rows",
+	AcceptedRows: "transfer_batches.accepted_rows",
+	RejectedRows: "transfer_batches.rejected_rows",
+	Errors:       "transfer_batches.errors",
+	CreatedBy:    "transfer_batches.created_by",
+	CreatedAt:    "transfer_batches.created_at",
+	UpdatedBy:    "transfer_batches.updated_by",
+	UpdatedAt:    "transfer_batches.updated_at",
+	DeletedBy:    "transfer_batches.deleted_by",
+	DeletedAt:    "transfer_batches.deleted_at",
+}
+
+// Generated where
+
+var TransferBatchWhere = struct {
+	ID           whereHelperint
+	BatchID      whereHelperstring
+	APIKey       whereHelperstring
+	TotalRows    whereHelperint
+	AcceptedRows whereHelperint
+	RejectedRows whereHelperint
+	Errors       whereHelpertypes_JSON
+	CreatedBy    whereHelperint
+	CreatedAt    whereHelpertime_Time
+	UpdatedBy    whereHelperint
+	UpdatedAt    whereHelpertime_Time
+	DeletedBy    whereHelpernull_Int
+	DeletedAt    whereHelpernull_Time
+}{
+	ID:           whereHelperint{field: "\"transfer_batches\".\"id\""},
+	BatchID:      whereHelperstring{field: "\"transfer_batches\".\"batch_id\""},
+	APIKey:       whereHelperstring{field: "\"transfer_batches\".\"api_key\""},
+	TotalRows:    whereHelperint{field: "\"transfer_batches\".\"total_rows\""},
+	AcceptedRows: whereHelperint{field: "\"transfer_batches\".\"accepted_rows\""},
+	RejectedRows: whereHelperint{field: "\"transfer_batches\".\"rejected_rows\""},
+	Errors:       whereHelpertypes_JSON{field: "\"transfer_batches\".\"errors\""},
+	CreatedBy:    whereHelperint{field: "\"transfer_batches\".\"created_by\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"transfer_batches\".\"created_at\""},
+	UpdatedBy:    whereHelperint{field: "\"transfer_batches\".\"updated_by\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"transfer_batches\".\"updated_at\""},
+	DeletedBy:    whereHelpernull_Int{field: "\"transfer_batches\".\"deleted_by\""},
+	DeletedAt:    whereHelpernull_Time{field: "\"transfer_batches\".\"deleted_at\""},
+}
+
+// TransferBatchRels is where relationship names are stored.
+var TransferBatchRels = struct {
+	TransferJobs string
+}{
+	TransferJobs: "TransferJobs",
+}
+
+// transferBatchR is where relationships are stored.
+type transferBatchR struct {
+	TransferJobs TransferJobSlice `boil:"TransferJobs" json:"TransferJobs" toml:"TransferJobs" yaml:"TransferJobs"`
+}
+
+// NewStruct creates a new relationship struct
+func (*transferBatchR) NewStruct() *transferBatchR {
+	return &transferBatchR{}
+}
+
+func (r *transferBatchR) GetTransferJobs() TransferJobSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TransferJobs
+}
+
+// transferBatchL is where Load methods for each relationship are stored.
+type transferBatchL struct{}
+
+var (
+	transferBatchAllColumns            = []string{"id", "batch_id", "api_key", "total_rows", "accepted_rows", "rejected_rows", "errors", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	transferBatchColumnsWithoutDefault = []string{"batch_id", "api_key"}
+	transferBatchColumnsWithDefault    = []string{"id", "total_rows", "accepted_rows", "rejected_rows", "errors", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	transferBatchPrimaryKeyColumns     = []string{"id"}
+	transferBatchGeneratedColumns      = []string{}
+)
+
+type (
+	// TransferBatchSlice is an alias for a slice of pointers to TransferBatch.
+	// This should almost always be used instead of []TransferBatch.
+	TransferBatchSlice []*TransferBatch
+	// TransferBatchHook is the signature for custom TransferBatch hook methods
+	TransferBatchHook func(context.Context, boil.ContextExecutor, *TransferBatch) error
+
+	transferBatchQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	transferBatchType                 = reflect.TypeOf(&TransferBatch{})
+	transferBatchMapping              = queries.MakeStructMapping(transferBatchType)
+	transferBatchPrimaryKeyMapping, _ = queries.BindMapping(transferBatchType, transferBatchMapping, transferBatchPrimaryKeyColumns)
+	transferBatchInsertCacheMut       sync.RWMutex
+	transferBatchInsertCache          = make(map[string]insertCache)
+	transferBatchUpdateCacheMut       sync.RWMutex
+	transferBatchUpdateCache          = make(map[string]updateCache)
+	transferBatchUpsertCacheMut       sync.RWMutex
+	transferBatchUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var transferBatchAfterSelectMu sync.Mutex
+var transferBatchAfterSelectHooks []TransferBatchHook
+
+var transferBatchBeforeInsertMu sync.Mutex
+var transferBatchBeforeInsertHooks []TransferBatchHook
+var transferBatchAfterInsertMu sync.Mutex
+var transferBatchAfterInsertHooks []TransferBatchHook
+
+var transferBatchBeforeUpdateMu sync.Mutex
+var transferBatchBeforeUpdateHooks []TransferBatchHook
+var transferBatchAfterUpdateMu sync.Mutex
+var transferBatchAfterUpdateHooks []TransferBatchHook
+
+var transferBatchBeforeDeleteMu sync.Mutex
+var transferBatchBeforeDeleteHooks []TransferBatchHook
+var transferBatchAfterDeleteMu sync.Mutex
+var transferBatchAfterDeleteHooks []TransferBatchHook
+
+var transferBatchBeforeUpsertMu sync.Mutex
+var transferBatchBeforeUpsertHooks []TransferBatchHook
+var transferBatchAfterUpsertMu sync.Mutex
+var transferBatchAfterUpsertHooks []TransferBatchHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TransferBatch) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferBatchAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TransferBatch) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferBatchBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TransferBatch) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferBatchAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TransferBatch) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferBatchBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TransferBatch) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferBatchAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TransferBatch) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferBatchBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TransferBatch) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferBatchAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TransferBatch) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferBatchBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TransferBatch) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferBatchAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTransferBatchHook registers your hook function for all future operations.
+func AddTransferBatchHook(hookPoint boil.HookPoint, transferBatchHook TransferBatchHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		transferBatchAfterSelectMu.Lock()
+		transferBatchAfterSelectHooks = append(transferBatchAfterSelectHooks, transferBatchHook)
+		transferBatchAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		transferBatchBeforeInsertMu.Lock()
+		transferBatchBeforeInsertHooks = append(transferBatchBeforeInsertHooks, transferBatchHook)
+		transferBatchBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		transferBatchAfterInsertMu.Lock()
+		transferBatchAfterInsertHooks = append(transferBatchAfterInsertHooks, transferBatchHook)
+		transferBatchAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		transferBatchBeforeUpdateMu.Lock()
+		transferBatchBeforeUpdateHooks = append(transferBatchBeforeUpdateHooks, transferBatchHook)
+		transferBatchBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		transferBatchAfterUpdateMu.Lock()
+		transferBatchAfterUpdateHooks = append(transferBatchAfterUpdateHooks, transferBatchHook)
+		transferBatchAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		transferBatchBeforeDeleteMu.Lock()
+		transferBatchBeforeDeleteHooks = append(transferBatchBeforeDeleteHooks, transferBatchHook)
+		transferBatchBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		transferBatchAfterDeleteMu.Lock()
+		transferBatchAfterDeleteHooks = append(transferBatchAfterDeleteHooks, transferBatchHook)
+		transferBatchAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		transferBatchBeforeUpsertMu.Lock()
+		transferBatchBeforeUpsertHooks = append(transferBatchBeforeUpsertHooks, transferBatchHook)
+		transferBatchBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		transferBatchAfterUpsertMu.Lock()
+		transferBatchAfterUpsertHooks = append(transferBatchAfterUpsertHooks, transferBatchHook)
+		transferBatchAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single transferBatch record from the query using the global executor.
+func (q transferBatchQuery) OneG(ctx context.Context) (*TransferBatch, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single transferBatch record from the query.
+func (q transferBatchQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TransferBatch, error) {
+	o := &TransferBatch{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for transfer_batches")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all TransferBatch records from the query using the global executor.
+func (q transferBatchQuery) AllG(ctx context.Context) (TransferBatchSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all TransferBatch records from the query.
+func (q transferBatchQuery) All(ctx context.Context, exec boil.ContextExecutor) (TransferBatchSlice, error) {
+	var o []*TransferBatch
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to TransferBatch slice")
+	}
+
+	if len(transferBatchAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all TransferBatch records in the query using the global executor
+func (q transferBatchQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all TransferBatch records in the query.
+func (q transferBatchQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count transfer_batches rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q transferBatchQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q transferBatchQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if transfer_batches exists")
+	}
+
+	return count > 0, nil
+}
+
+// TransferJobs retrieves all the transfer_job's TransferJobs with an executor.
+func (o *TransferBatch) TransferJobs(mods ...qm.QueryMod) transferJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transfer_jobs\".\"transfer_batch_id\"=?", o.ID),
+	)
+
+	return TransferJobs(queryMods...)
+}
+
+// LoadTransferJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transferBatchL) LoadTransferJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransferBatch interface{}, mods queries.Applicator) error {
+	var slice []*TransferBatch
+	var object *TransferBatch
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransferBatch.(*TransferBatch)
+		if !ok {
+			object = new(TransferBatch)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransferBatch)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransferBatch))
+			}
+		}
+	} else {
+		s, ok := maybeTransferBatch.(*[]*TransferBatch)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransferBatch)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransferBatch))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transferBatchR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transferBatchR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transfer_jobs`),
+		qm.WhereIn(`transfer_jobs.transfer_batch_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`transfer_jobs.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transfer_jobs")
+	}
+
+	var resultSlice []*TransferJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transfer_jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transfer_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer_jobs")
+	}
+
+	if len(transferJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TransferJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transferJobR{}
+			}
+			foreign.R.TransferBatch = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TransferBatchID) {
+				local.R.TransferJobs = append(local.R.TransferJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &transferJobR{}
+				}
+				foreign.R.TransferBatch = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddTransferJobsG adds the given related objects to the existing relationships
+// of the transfer_batch, optionally inserting them as new records.
+// Appends related to o.R.TransferJobs.
+// Sets related.R.TransferBatch appropriately.
+// Uses the global database handle.
+func (o *TransferBatch) AddTransferJobsG(ctx context.Context, insert bool, related ...*TransferJob) error {
+	return o.AddTransferJobs(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddTransferJobs adds the given related objects to the existing relationships
+// of the transfer_batch, optionally inserting them as new records.
+// Appends related to o.R.TransferJobs.
+// Sets related.R.TransferBatch appropriately.
+func (o *TransferBatch) AddTransferJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TransferJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TransferBatchID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transfer_jobs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_batch_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transferJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TransferBatchID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transferBatchR{
+			TransferJobs: related,
+		}
+	} else {
+		o.R.TransferJobs = append(o.R.TransferJobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transferJobR{
+				TransferBatch: o,
+			}
+		} else {
+			rel.R.TransferBatch = o
+		}
+	}
+	return nil
+}
+
+// SetTransferJobsG removes all previously related items of the
+// transfer_batch replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TransferBatch's TransferJobs accordingly.
+// Replaces o.R.TransferJobs with related.
+// Sets related.R.TransferBatch's TransferJobs accordingly.
+// Uses the global database handle.
+func (o *TransferBatch) SetTransferJobsG(ctx context.Context, insert bool, related ...*TransferJob) error {
+	return o.SetTransferJobs(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetTransferJobs removes all previously related items of the
+// transfer_batch replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TransferBatch's TransferJobs accordingly.
+// Replaces o.R.TransferJobs with related.
+// Sets related.R.TransferBatch's TransferJobs accordingly.
+func (o *TransferBatch) SetTransferJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TransferJob) error {
+	query := "update \"transfer_jobs\" set \"transfer_batch_id\" = null where \"transfer_batch_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TransferJobs {
+			queries.SetScanner(&rel.TransferBatchID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.TransferBatch = nil
+		}
+		o.R.TransferJobs = nil
+	}
+
+	return o.AddTransferJobs(ctx, exec, insert, related...)
+}
+
+// RemoveTransferJobsG relationships from objects passed in.
+// Removes related items from R.TransferJobs (uses pointer comparison, removal does not keep order)
+// Sets related.R.TransferBatch.
+// Uses the global database handle.
+func (o *TransferBatch) RemoveTransferJobsG(ctx context.Context, related ...*TransferJob) error {
+	return o.RemoveTransferJobs(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveTransferJobs relationships from objects passed in.
+// Removes related items from R.TransferJobs (uses pointer comparison, removal does not keep order)
+// Sets related.R.TransferBatch.
+func (o *TransferBatch) RemoveTransferJobs(ctx context.Context, exec boil.ContextExecutor, related ...*TransferJob) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TransferBatchID, nil)
+		if rel.R != nil {
+			rel.R.TransferBatch = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("transfer_batch_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TransferJobs {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TransferJobs)
+			if ln > 1 && i < ln-1 {
+				o.R.TransferJobs[i] = o.R.TransferJobs[ln-1]
+			}
+			o.R.TransferJobs = o.R.TransferJobs[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// TransferBatches retrieves all the records using an executor.
+func TransferBatches(mods ...qm.QueryMod) transferBatchQuery {
+	mods = append(mods, qm.From("\"transfer_batches\""), qmhelper.WhereIsNull("\"transfer_batches\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"transfer_batches\".*"})
+	}
+
+	return transferBatchQuery{q}
+}
+
+// FindTransferBatchG retrieves a single record by ID.
+func FindTransferBatchG(ctx context.Context, iD int, selectCols ...string) (*TransferBatch, error) {
+	return FindTransferBatch(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindTransferBatch retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTransferBatch(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TransferBatch, error) {
+	transferBatchObj := &TransferBatch{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"transfer_batches\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, transferBatchObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from transfer_batches")
+	}
+
+	if err = transferBatchObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return transferBatchObj, err
+	}
+
+	return transferBatchObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *TransferBatch) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TransferBatch) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no transfer_batches provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transferBatchColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	transferBatchInsertCacheMut.RLock()
+	cache, cached := transferBatchInsertCache[key]
+	transferBatchInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			transferBatchAllColumns,
+			transferBatchColumnsWithDefault,
+			transferBatchColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(transferBatchType, transferBatchMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(transferBatchType, transferBatchMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"transfer_batches\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"transfer_batches\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into transfer_batches")
+	}
+
+	if !cached {
+		transferBatchInsertCacheMut.Lock()
+		transferBatchInsertCache[key] = cache
+		transferBatchInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single TransferBatch record using the global executor.
+// See Update for more documentation.
+func (o *TransferBatch) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the TransferBatch.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TransferBatch) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	transferBatchUpdateCacheMut.RLock()
+	cache, cached := transferBatchUpdateCache[key]
+	transferBatchUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			transferBatchAllColumns,
+			transferBatchPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update transfer_batches, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"transfer_batches\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, transferBatchPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(transferBatchType, transferBatchMapping, append(wl, transferBatchPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update transfer_batches row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for transfer_batches")
+	}
+
+	if !cached {
+		transferBatchUpdateCacheMut.Lock()
+		transferBatchUpdateCache[key] = cache
+		transferBatchUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q transferBatchQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q transferBatchQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for transfer_batches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for transfer_batches")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o TransferBatchSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TransferBatchSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferBatchPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"transfer_batches\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, transferBatchPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in transferBatch slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all transferBatch")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *TransferBatch) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TransferBatch) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no transfer_batches provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transferBatchColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	transferBatchUpsertCacheMut.RLock()
+	cache, cached := transferBatchUpsertCache[key]
+	transferBatchUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			transferBatchAllColumns,
+			transferBatchColumnsWithDefault,
+			transferBatchColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			transferBatchAllColumns,
+			transferBatchPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert transfer_batches, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(transferBatchAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(transferBatchPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert transfer_batches, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(transferBatchPrimaryKeyColumns))
+			copy(conflict, transferBatchPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"transfer_batches\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(transferBatchType, transferBatchMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(transferBatchType, transferBatchMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert transfer_batches")
+	}
+
+	if !cached {
+		transferBatchUpsertCacheMut.Lock()
+		transferBatchUpsertCache[key] = cache
+		transferBatchUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single TransferBatch record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *TransferBatch) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single TransferBatch record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TransferBatch) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no TransferBatch provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), transferBatchPrimaryKeyMapping)
+		sql = "DELETE FROM \"transfer_batches\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"transfer_batches\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(transferBatchType, transferBatchMapping, append(wl, transferBatchPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from transfer_batches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for transfer_batches")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q transferBatchQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q transferBatchQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no transferBatchQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from transfer_batches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for transfer_batches")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o TransferBatchSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TransferBatchSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(transferBatchBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferBatchPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"transfer_batches\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, transferBatchPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferBatchPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"transfer_batches\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, transferBatchPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from transferBatch slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for transfer_batches")
+	}
+
+	if len(transferBatchAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *TransferBatch) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no TransferBatch provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TransferBatch) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTransferBatch(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TransferBatchSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty TransferBatchSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TransferBatchSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TransferBatchSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferBatchPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"transfer_batches\".* FROM \"transfer_batches\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, transferBatchPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in TransferBatchSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TransferBatchExistsG checks if the TransferBatch row exists.
+func TransferBatchExistsG(ctx context.Context, iD int) (bool, error) {
+	return TransferBatchExists(ctx, boil.GetContextDB(), iD)
+}
+
+// TransferBatchExists checks if the TransferBatch row exists.
+func TransferBatchExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"transfer_batches\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if transfer_batches exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TransferBatch row exists.
+func (o *TransferBatch) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TransferBatchExists(ctx, exec, o.ID)
+}
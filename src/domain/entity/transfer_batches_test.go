@@ -0,0 +1,1161 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTransferBatches(t *testing.T) {
+	t.Parallel()
+
+	query := TransferBatches()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTransferBatchesSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferBatchesQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TransferBatches().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferBatchesSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferBatchSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferBatchesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferBatchesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TransferBatches().DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferBatchesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferBatchSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferBatchesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TransferBatchExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if TransferBatch exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TransferBatchExists to return true, but got false.")
+	}
+}
+
+func testTransferBatchesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	transferBatchFound, err := FindTransferBatch(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if transferBatchFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTransferBatchesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TransferBatches().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferBatchesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TransferBatches().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTransferBatchesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	transferBatchOne := &TransferBatch{}
+	transferBatchTwo := &TransferBatch{}
+	if err = randomize.Struct(seed, transferBatchOne, transferBatchDBTypes, false, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+	if err = randomize.Struct(seed, transferBatchTwo, transferBatchDBTypes, false, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = transferBatchOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = transferBatchTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TransferBatches().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTransferBatchesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	transferBatchOne := &TransferBatch{}
+	transferBatchTwo := &TransferBatch{}
+	if err = randomize.Struct(seed, transferBatchOne, transferBatchDBTypes, false, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+	if err = randomize.Struct(seed, transferBatchTwo, transferBatchDBTypes, false, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = transferBatchOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = transferBatchTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func transferBatchBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferBatch) error {
+	*o = TransferBatch{}
+	return nil
+}
+
+func transferBatchAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferBatch) error {
+	*o = TransferBatch{}
+	return nil
+}
+
+func transferBatchAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *TransferBatch) error {
+	*o = TransferBatch{}
+	return nil
+}
+
+func transferBatchBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TransferBatch) error {
+	*o = TransferBatch{}
+	return nil
+}
+
+func transferBatchAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TransferBatch) error {
+	*o = TransferBatch{}
+	return nil
+}
+
+func transferBatchBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TransferBatch) error {
+	*o = TransferBatch{}
+	return nil
+}
+
+func transferBatchAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TransferBatch) error {
+	*o = TransferBatch{}
+	return nil
+}
+
+func transferBatchBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferBatch) error {
+	*o = TransferBatch{}
+	return nil
+}
+
+func transferBatchAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferBatch) error {
+	*o = TransferBatch{}
+	return nil
+}
+
+func testTransferBatchesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &TransferBatch{}
+	o := &TransferBatch{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TransferBatch object: %s", err)
+	}
+
+	AddTransferBatchHook(boil.BeforeInsertHook, transferBatchBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	transferBatchBeforeInsertHooks = []TransferBatchHook{}
+
+	AddTransferBatchHook(boil.AfterInsertHook, transferBatchAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	transferBatchAfterInsertHooks = []TransferBatchHook{}
+
+	AddTransferBatchHook(boil.AfterSelectHook, transferBatchAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	transferBatchAfterSelectHooks = []TransferBatchHook{}
+
+	AddTransferBatchHook(boil.BeforeUpdateHook, transferBatchBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	transferBatchBeforeUpdateHooks = []TransferBatchHook{}
+
+	AddTransferBatchHook(boil.AfterUpdateHook, transferBatchAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	transferBatchAfterUpdateHooks = []TransferBatchHook{}
+
+	AddTransferBatchHook(boil.BeforeDeleteHook, transferBatchBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	transferBatchBeforeDeleteHooks = []TransferBatchHook{}
+
+	AddTransferBatchHook(boil.AfterDeleteHook, transferBatchAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	transferBatchAfterDeleteHooks = []TransferBatchHook{}
+
+	AddTransferBatchHook(boil.BeforeUpsertHook, transferBatchBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	transferBatchBeforeUpsertHooks = []TransferBatchHook{}
+
+	AddTransferBatchHook(boil.AfterUpsertHook, transferBatchAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	transferBatchAfterUpsertHooks = []TransferBatchHook{}
+}
+
+func testTransferBatchesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTransferBatchesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(transferBatchColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTransferBatchToManyTransferJobs(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferBatch
+	var b, c TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transferJobDBTypes, false, transferJobColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferJobDBTypes, false, transferJobColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.TransferBatchID, a.ID)
+	queries.Assign(&c.TransferBatchID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.TransferJobs().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.TransferBatchID, b.TransferBatchID) {
+			bFound = true
+		}
+		if queries.Equal(v.TransferBatchID, c.TransferBatchID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransferBatchSlice{&a}
+	if err = a.L.LoadTransferJobs(ctx, tx, false, (*[]*TransferBatch)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TransferJobs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TransferJobs = nil
+	if err = a.L.LoadTransferJobs(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TransferJobs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransferBatchToManyAddOpTransferJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferBatch
+	var b, c, d, e TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferBatchDBTypes, false, strmangle.SetComplement(transferBatchPrimaryKeyColumns, transferBatchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*TransferJob{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTransferJobs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.TransferBatchID) {
+			t.Error("foreign key was wrong value", a.ID, first.TransferBatchID)
+		}
+		if !queries.Equal(a.ID, second.TransferBatchID) {
+			t.Error("foreign key was wrong value", a.ID, second.TransferBatchID)
+		}
+
+		if first.R.TransferBatch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.TransferBatch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.TransferJobs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.TransferJobs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.TransferJobs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTransferBatchToManySetOpTransferJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferBatch
+	var b, c, d, e TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferBatchDBTypes, false, strmangle.SetComplement(transferBatchPrimaryKeyColumns, transferBatchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetTransferJobs(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.TransferJobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetTransferJobs(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.TransferJobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransferBatchID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransferBatchID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.TransferBatchID) {
+		t.Error("foreign key was wrong value", a.ID, d.TransferBatchID)
+	}
+	if !queries.Equal(a.ID, e.TransferBatchID) {
+		t.Error("foreign key was wrong value", a.ID, e.TransferBatchID)
+	}
+
+	if b.R.TransferBatch != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.TransferBatch != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.TransferBatch != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.TransferBatch != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.TransferJobs[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.TransferJobs[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTransferBatchToManyRemoveOpTransferJobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferBatch
+	var b, c, d, e TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferBatchDBTypes, false, strmangle.SetComplement(transferBatchPrimaryKeyColumns, transferBatchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferJob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddTransferJobs(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.TransferJobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveTransferJobs(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.TransferJobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransferBatchID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransferBatchID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.TransferBatch != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.TransferBatch != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.TransferBatch != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.TransferBatch != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.TransferJobs) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.TransferJobs[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.TransferJobs[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testTransferBatchesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferBatchesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferBatchSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferBatchesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TransferBatches().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	transferBatchDBTypes = map[string]string{`ID`: `integer`, `BatchID`: `character varying`, `APIKey`: `text`, `TotalRows`: `integer`, `AcceptedRows`: `integer`, `RejectedRows`: `integer`, `Errors`: `jsonb`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	_                    = bytes.MinRead
+)
+
+func testTransferBatchesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(transferBatchPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(transferBatchAllColumns) == len(transferBatchPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTransferBatchesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(transferBatchAllColumns) == len(transferBatchPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferBatch{}
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, transferBatchDBTypes, true, transferBatchPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(transferBatchAllColumns, transferBatchPrimaryKeyColumns) {
+		fields = transferBatchAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			transferBatchAllColumns,
+			transferBatchPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TransferBatchSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTransferBatchesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(transferBatchAllColumns) == len(transferBatchPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TransferBatch{}
+	if err = randomize.Struct(seed, &o, transferBatchDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TransferBatch: %s", err)
+	}
+
+	count, err := TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, transferBatchDBTypes, false, transferBatchPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TransferBatch: %s", err)
+	}
+
+	count, err = TransferBatches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1218 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// TransferExport is an object representing the database table.
+type TransferExport struct {
+	ID         int                  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExportID   string               `boil:"export_id" json:"export_id" toml:"export_id" yaml:"export_id"`
+	Format     string               `boil:"format" json:"format" toml:"format" yaml:"format"`
+	Columns    string               `boil:"columns" json:"columns" toml:"columns" yaml:"columns"`
+	Filter     types.JSON           `boil:"filter" json:"filter" toml:"filter" yaml:"filter"`
+	Status     Transferexportstatus `boil:"status" json:"status" toml:"status" yaml:"status"`
+	RowCount   int                  `boil:"row_count" json:"row_count" toml:"row_count" yaml:"row_count"`
+	FileName   string               `boil:"file_name" json:"file_name" toml:"file_name" yaml:"file_name"`
+	Error      null.String          `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	FinishedAt null.Time            `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+	CreatedBy  int                  `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt  time.Time            `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy  int                  `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt  time.Time            `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy  null.Int             `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt  null.Time            `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *transferExportR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L transferExportL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TransferExportColumns = struct {
+	ID         string
+	ExportID   string
+	Format     string
+	Columns    string
+	Filter     string
+	Status     string
+	RowCount   string
+	FileName   string
+	Error      string
+	FinishedAt string
+	CreatedBy  string
+	CreatedAt  string
+	UpdatedBy  string
+	UpdatedAt  string
+	DeletedBy  string
+	DeletedAt  string
+}{
+	ID:         "id",
+	ExportID:   "export_id",
+	Format:     "format",
+	Columns:    "columns",
+	Filter:     "filter",
+	Status:     "status",
+	RowCount:   "row_count",
+	FileName:   "file_name",
+	Error:      "error",
+	FinishedAt: "finished_at",
+	CreatedBy:  "created_by",
+	CreatedAt:  "created_at",
+	UpdatedBy:  "updated_by",
+	UpdatedAt:  "updated_at",
+	DeletedBy:  "deleted_by",
+	DeletedAt:  "deleted_at",
+}
+
+var TransferExportTableColumns = struct {
+	ID         string
+	ExportID   string
+	Format     string
+	Columns    string
+	Filter     string
+	Status     string
+	RowCount   string
+	FileName   string
+	Error      string
+	FinishedAt string
+	CreatedBy  string
+	CreatedAt  string
+	UpdatedBy  string
+	UpdatedAt  string
+	DeletedBy  string
+	DeletedAt  string
+}{
+	ID:         "transfer_exports.id",
+	ExportID:   "transfer_exports.export_id",
+	Format:     "transfer_exports.format",
+	Columns:    "transfer_exports.columns",
+	Filter:     "transfer_exports.filter",
+	Status:     "transfer_exports.status",
+	RowCount:   "transfer_exports.row_count",
+	FileName:   "transfer_exports.file_name",
+	Error:      "transfer_exports.error",
+	FinishedAt: "transfer_exports.finished_at",
+	CreatedBy:  "transfer_exports.created_by",
+	CreatedAt:  "transfer_exports.created_at",
+	UpdatedBy:  "transfer_exports.updated_by",
+	UpdatedAt:  "transfer_exports.updated_at",
+	DeletedBy:  "transfer_exports.deleted_by",
+	DeletedAt:  "transfer_exports.deleted_at",
+}
+
+// Generated where
+
+type whereHelperTransferexportstatus struct{ field string }
+
+func (w whereHelperTransferexportstatus) EQ(x Transferexportstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperTransferexportstatus) NEQ(x Transferexportstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperTransferexportstatus) LT(x Transferexportstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperTransferexportstatus) LTE(x Transferexportstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperTransferexportstatus) GT(x Transferexportstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperTransferexportstatus) GTE(x Transferexportstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperTransferexportstatus) IN(slice []Transferexportstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperTransferexportstatus) NIN(slice []Transferexportstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var TransferExportWhere = struct {
+	ID         whereHelperint
+	ExportID   whereHelperstring
+	Format     whereHelperstring
+	Columns    whereHelperstring
+	Filter     whereHelpertypes_JSON
+	Status     whereHelperTransferexportstatus
+	RowCount   whereHelperint
+	FileName   whereHelperstring
+	Error      whereHelpernull_String
+	FinishedAt whereHelpernull_Time
+	CreatedBy  whereHelperint
+	CreatedAt  whereHelpertime_Time
+	UpdatedBy  whereHelperint
+	UpdatedAt  whereHelpertime_Time
+	DeletedBy  whereHelpernull_Int
+	DeletedAt  whereHelpernull_Time
+}{
+	ID:         whereHelperint{field: "\"transfer_exports\".\"id\""},
+	ExportID:   whereHelperstring{field: "\"transfer_exports\".\"export_id\""},
+	Format:     whereHelperstring{field: "\"transfer_exports\".\"format\""},
+	Columns:    whereHelperstring{field: "\"transfer_exports\".\"columns\""},
+	Filter:     whereHelpertypes_JSON{field: "\"transfer_exports\".\"filter\""},
+	Status:     whereHelperTransferexportstatus{field: "\"transfer_exports\".\"status\""},
+	RowCount:   whereHelperint{field: "\"transfer_exports\".\"row_count\""},
+	FileName:   whereHelperstring{field: "\"transfer_exports\".\"file_name\""},
+	Error:      whereHelpernull_String{field: "\"transfer_exports\".\"error\""},
+	FinishedAt: whereHelpernull_Time{field: "\"transfer_exports\".\"finished_at\""},
+	CreatedBy:  whereHelperint{field: "\"transfer_exports\".\"created_by\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"transfer_exports\".\"created_at\""},
+	UpdatedBy:  whereHelperint{field: "\"transfer_exports\".\"updated_by\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"transfer_exports\".\"updated_at\""},
+	DeletedBy:  whereHelpernull_Int{field: "\"transfer_exports\".\"deleted_by\""},
+	DeletedAt:  whereHelpernull_Time{field: "\"transfer_exports\".\"deleted_at\""},
+}
+
+// TransferExportRels is where relationship names are stored.
+var TransferExportRels = struct {
+}{}
+
+// transferExportR is where relationships are stored.
+type transferExportR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*transferExportR) NewStruct() *transferExportR {
+	return &transferExportR{}
+}
+
+// transferExportL is where Load methods for each relationship are stored.
+type transferExportL struct{}
+
+var (
+	transferExportAllColumns            = []string{"id", "export_id", "format", "columns", "filter", "status", "row_count", "file_name", "error", "finished_at", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	transferExportColumnsWithoutDefault = []string{"export_id", "format", "columns"}
+	transferExportColumnsWithDefault    = []string{"id", "filter", "status", "row_count", "file_name", "error", "finished_at", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	transferExportPrimaryKeyColumns     = []string{"id"}
+	transferExportGeneratedColumns      = []string{}
+)
+
+type (
+	// TransferExportSlice is an alias for a slice of pointers to TransferExport.
+	// This should almost always be used instead of []TransferExport.
+	TransferExportSlice []*TransferExport
+	// TransferExportHook is the signature for custom TransferExport hook methods
+	TransferExportHook func(context.Context, boil.ContextExecutor, *TransferExport) error
+
+	transferExportQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	transferExportType                 = reflect.TypeOf(&TransferExport{})
+	transferExportMapping              = queries.MakeStructMapping(transferExportType)
+	transferExportPrimaryKeyMapping, _ = queries.BindMapping(transferExportType, transferExportMapping, transferExportPrimaryKeyColumns)
+	transferExportInsertCacheMut       sync.RWMutex
+	transferExportInsertCache          = make(map[string]insertCache)
+	transferExportUpdateCacheMut       sync.RWMutex
+	transferExportUpdateCache          = make(map[string]updateCache)
+	transferExportUpsertCacheMut       sync.RWMutex
+	transferExportUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var transferExportAfterSelectMu sync.Mutex
+var transferExportAfterSelectHooks []TransferExportHook
+
+var transferExportBeforeInsertMu sync.Mutex
+var transferExportBeforeInsertHooks []TransferExportHook
+var transferExportAfterInsertMu sync.Mutex
+var transferExportAfterInsertHooks []TransferExportHook
+
+var transferExportBeforeUpdateMu sync.Mutex
+var transferExportBeforeUpdateHooks []TransferExportHook
+var transferExportAfterUpdateMu sync.Mutex
+var transferExportAfterUpdateHooks []TransferExportHook
+
+var transferExportBeforeDeleteMu sync.Mutex
+var transferExportBeforeDeleteHooks []TransferExportHook
+var transferExportAfterDeleteMu sync.Mutex
+var transferExportAfterDeleteHooks []TransferExportHook
+
+var transferExportBeforeUpsertMu sync.Mutex
+var transferExportBeforeUpsertHooks []TransferExportHook
+var transferExportAfterUpsertMu sync.Mutex
+var transferExportAfterUpsertHooks []TransferExportHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TransferExport) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferExportAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TransferExport) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferExportBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TransferExport) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferExportAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TransferExport) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferExportBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TransferExport) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferExportAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TransferExport) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferExportBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TransferExport) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferExportAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TransferExport) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferExportBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TransferExport) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferExportAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTransferExportHook registers your hook function for all future operations.
+func AddTransferExportHook(hookPoint boil.HookPoint, transferExportHook TransferExportHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		transferExportAfterSelectMu.Lock()
+		transferExportAfterSelectHooks = append(transferExportAfterSelectHooks, transferExportHook)
+		transferExportAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		transferExportBeforeInsertMu.Lock()
+		transferExportBeforeInsertHooks = append(transferExportBeforeInsertHooks, transferExportHook)
+		transferExportBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		transferExportAfterInsertMu.Lock()
+		transferExportAfterInsertHooks = append(transferExportAfterInsertHooks, transferExportHook)
+		transferExportAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		transferExportBeforeUpdateMu.Lock()
+		transferExportBeforeUpdateHooks = append(transferExportBeforeUpdateHooks, transferExportHook)
+		transferExportBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		transferExportAfterUpdateMu.Lock()
+		transferExportAfterUpdateHooks = append(transferExportAfterUpdateHooks, transferExportHook)
+		transferExportAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		transferExportBeforeDeleteMu.Lock()
+		transferExportBeforeDeleteHooks = append(transferExportBeforeDeleteHooks, transferExportHook)
+		transferExportBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		transferExportAfterDeleteMu.Lock()
+		transferExportAfterDeleteHooks = append(transferExportAfterDeleteHooks, transferExportHook)
+		transferExportAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		transferExportBeforeUpsertMu.Lock()
+		transferExportBeforeUpsertHooks = append(transferExportBeforeUpsertHooks, transferExportHook)
+		transferExportBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		transferExportAfterUpsertMu.Lock()
+		transferExportAfterUpsertHooks = append(transferExportAfterUpsertHooks, transferExportHook)
+		transferExportAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single transferExport record from the query using the global executor.
+func (q transferExportQuery) OneG(ctx context.Context) (*TransferExport, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single transferExport record from the query.
+func (q transferExportQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TransferExport, error) {
+	o := &TransferExport{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for transfer_exports")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all TransferExport records from the query using the global executor.
+func (q transferExportQuery) AllG(ctx context.Context) (TransferExportSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all TransferExport records from the query.
+func (q transferExportQuery) All(ctx context.Context, exec boil.ContextExecutor) (TransferExportSlice, error) {
+	var o []*TransferExport
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to TransferExport slice")
+	}
+
+	if len(transferExportAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all TransferExport records in the query using the global executor
+func (q transferExportQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all TransferExport records in the query.
+func (q transferExportQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count transfer_exports rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q transferExportQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q transferExportQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if transfer_exports exists")
+	}
+
+	return count > 0, nil
+}
+
+// TransferExports retrieves all the records using an executor.
+func TransferExports(mods ...qm.QueryMod) transferExportQuery {
+	mods = append(mods, qm.From("\"transfer_exports\""), qmhelper.WhereIsNull("\"transfer_exports\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"transfer_exports\".*"})
+	}
+
+	return transferExportQuery{q}
+}
+
+// FindTransferExportG retrieves a single record by ID.
+func FindTransferExportG(ctx context.Context, iD int, selectCols ...string) (*TransferExport, error) {
+	return FindTransferExport(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindTransferExport retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTransferExport(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TransferExport, error) {
+	transferExportObj := &TransferExport{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"transfer_exports\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, transferExportObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from transfer_exports")
+	}
+
+	if err = transferExportObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return transferExportObj, err
+	}
+
+	return transferExportObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *TransferExport) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TransferExport) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no transfer_exports provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transferExportColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	transferExportInsertCacheMut.RLock()
+	cache, cached := transferExportInsertCache[key]
+	transferExportInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			transferExportAllColumns,
+			transferExportColumnsWithDefault,
+			transferExportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(transferExportType, transferExportMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(transferExportType, transferExportMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"transfer_exports\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"transfer_exports\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into transfer_exports")
+	}
+
+	if !cached {
+		transferExportInsertCacheMut.Lock()
+		transferExportInsertCache[key] = cache
+		transferExportInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single TransferExport record using the global executor.
+// See Update for more documentation.
+func (o *TransferExport) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the TransferExport.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TransferExport) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	transferExportUpdateCacheMut.RLock()
+	cache, cached := transferExportUpdateCache[key]
+	transferExportUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			transferExportAllColumns,
+			transferExportPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update transfer_exports, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"transfer_exports\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, transferExportPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(transferExportType, transferExportMapping, append(wl, transferExportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update transfer_exports row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for transfer_exports")
+	}
+
+	if !cached {
+		transferExportUpdateCacheMut.Lock()
+		transferExportUpdateCache[key] = cache
+		transferExportUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q transferExportQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q transferExportQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for transfer_exports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for transfer_exports")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o TransferExportSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TransferExportSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferExportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"transfer_exports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, transferExportPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in transferExport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all transferExport")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *TransferExport) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TransferExport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no transfer_exports provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transferExportColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	transferExportUpsertCacheMut.RLock()
+	cache, cached := transferExportUpsertCache[key]
+	transferExportUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			transferExportAllColumns,
+			transferExportColumnsWithDefault,
+			transferExportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			transferExportAllColumns,
+			transferExportPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert transfer_exports, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(transferExportAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(transferExportPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert transfer_exports, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(transferExportPrimaryKeyColumns))
+			copy(conflict, transferExportPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"transfer_exports\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(transferExportType, transferExportMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(transferExportType, transferExportMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert transfer_exports")
+	}
+
+	if !cached {
+		transferExportUpsertCacheMut.Lock()
+		transferExportUpsertCache[key] = cache
+		transferExportUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single TransferExport record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *TransferExport) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single TransferExport record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TransferExport) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no TransferExport provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), transferExportPrimaryKeyMapping)
+		sql = "DELETE FROM \"transfer_exports\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"transfer_exports\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(transferExportType, transferExportMapping, append(wl, transferExportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from transfer_exports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for transfer_exports")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q transferExportQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q transferExportQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no transferExportQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from transfer_exports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for transfer_exports")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o TransferExportSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TransferExportSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(transferExportBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferExportPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"transfer_exports\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, transferExportPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferExportPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"transfer_exports\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, transferExportPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from transferExport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for transfer_exports")
+	}
+
+	if len(transferExportAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *TransferExport) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no TransferExport provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TransferExport) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTransferExport(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TransferExportSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty TransferExportSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TransferExportSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TransferExportSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferExportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"transfer_exports\".* FROM \"transfer_exports\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, transferExportPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in TransferExportSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TransferExportExistsG checks if the TransferExport row exists.
+func TransferExportExistsG(ctx context.Context, iD int) (bool, error) {
+	return TransferExportExists(ctx, boil.GetContextDB(), iD)
+}
+
+// TransferExportExists checks if the TransferExport row exists.
+func TransferExportExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"transfer_exports\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if transfer_exports exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TransferExport row exists.
+func (o *TransferExport) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TransferExportExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,833 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTransferExports(t *testing.T) {
+	t.Parallel()
+
+	query := TransferExports()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTransferExportsSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferExportsQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TransferExports().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferExportsSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferExportSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferExportsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferExportsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TransferExports().DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferExportsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferExportSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferExportsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TransferExportExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if TransferExport exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TransferExportExists to return true, but got false.")
+	}
+}
+
+func testTransferExportsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	transferExportFound, err := FindTransferExport(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if transferExportFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTransferExportsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TransferExports().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferExportsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TransferExports().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTransferExportsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	transferExportOne := &TransferExport{}
+	transferExportTwo := &TransferExport{}
+	if err = randomize.Struct(seed, transferExportOne, transferExportDBTypes, false, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+	if err = randomize.Struct(seed, transferExportTwo, transferExportDBTypes, false, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = transferExportOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = transferExportTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TransferExports().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTransferExportsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	transferExportOne := &TransferExport{}
+	transferExportTwo := &TransferExport{}
+	if err = randomize.Struct(seed, transferExportOne, transferExportDBTypes, false, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+	if err = randomize.Struct(seed, transferExportTwo, transferExportDBTypes, false, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = transferExportOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = transferExportTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func transferExportBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferExport) error {
+	*o = TransferExport{}
+	return nil
+}
+
+func transferExportAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferExport) error {
+	*o = TransferExport{}
+	return nil
+}
+
+func transferExportAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *TransferExport) error {
+	*o = TransferExport{}
+	return nil
+}
+
+func transferExportBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TransferExport) error {
+	*o = TransferExport{}
+	return nil
+}
+
+func transferExportAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TransferExport) error {
+	*o = TransferExport{}
+	return nil
+}
+
+func transferExportBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TransferExport) error {
+	*o = TransferExport{}
+	return nil
+}
+
+func transferExportAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TransferExport) error {
+	*o = TransferExport{}
+	return nil
+}
+
+func transferExportBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferExport) error {
+	*o = TransferExport{}
+	return nil
+}
+
+func transferExportAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferExport) error {
+	*o = TransferExport{}
+	return nil
+}
+
+func testTransferExportsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &TransferExport{}
+	o := &TransferExport{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, transferExportDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TransferExport object: %s", err)
+	}
+
+	AddTransferExportHook(boil.BeforeInsertHook, transferExportBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	transferExportBeforeInsertHooks = []TransferExportHook{}
+
+	AddTransferExportHook(boil.AfterInsertHook, transferExportAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	transferExportAfterInsertHooks = []TransferExportHook{}
+
+	AddTransferExportHook(boil.AfterSelectHook, transferExportAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	transferExportAfterSelectHooks = []TransferExportHook{}
+
+	AddTransferExportHook(boil.BeforeUpdateHook, transferExportBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	transferExportBeforeUpdateHooks = []TransferExportHook{}
+
+	AddTransferExportHook(boil.AfterUpdateHook, transferExportAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	transferExportAfterUpdateHooks = []TransferExportHook{}
+
+	AddTransferExportHook(boil.BeforeDeleteHook, transferExportBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	transferExportBeforeDeleteHooks = []TransferExportHook{}
+
+	AddTransferExportHook(boil.AfterDeleteHook, transferExportAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	transferExportAfterDeleteHooks = []TransferExportHook{}
+
+	AddTransferExportHook(boil.BeforeUpsertHook, transferExportBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	transferExportBeforeUpsertHooks = []TransferExportHook{}
+
+	AddTransferExportHook(boil.AfterUpsertHook, transferExportAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	transferExportAfterUpsertHooks = []TransferExportHook{}
+}
+
+func testTransferExportsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTransferExportsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(transferExportColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTransferExportsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferExportsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferExportSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferExportsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TransferExports().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	transferExportDBTypes = map[string]string{`ID`: `integer`, `ExportID`: `character varying`, `Format`: `character varying`, `Columns`: `text`, `Filter`: `jsonb`, `Status`: `enum.transferexportstatus('running','completed','failed')`, `RowCount`: `integer`, `FileName`: `text`, `Error`: `text`, `FinishedAt`: `timestamp with time zone`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	_                     = bytes.MinRead
+)
+
+func testTransferExportsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(transferExportPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(transferExportAllColumns) == len(transferExportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTransferExportsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(transferExportAllColumns) == len(transferExportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferExport{}
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, transferExportDBTypes, true, transferExportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(transferExportAllColumns, transferExportPrimaryKeyColumns) {
+		fields = transferExportAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			transferExportAllColumns,
+			transferExportPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TransferExportSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTransferExportsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(transferExportAllColumns) == len(transferExportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TransferExport{}
+	if err = randomize.Struct(seed, &o, transferExportDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TransferExport: %s", err)
+	}
+
+	count, err := TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, transferExportDBTypes, false, transferExportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferExport struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TransferExport: %s", err)
+	}
+
+	count, err = TransferExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
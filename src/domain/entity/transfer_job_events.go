@@ -0,0 +1,1658 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TransferJobEvent is an object representing the database table.
+type TransferJobEvent struct {
+	ID               int                `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TransferJobID    int                `boil:"transfer_job_id" json:"transfer_job_id" toml:"transfer_job_id" yaml:"transfer_job_id"`
+	FromStatus       NullTransferstatus `boil:"from_status" json:"from_status,omitempty" toml:"from_status" yaml:"from_status,omitempty"`
+	ToStatus         Transferstatus     `boil:"to_status" json:"to_status" toml:"to_status" yaml:"to_status"`
+	Reason           string             `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	Actor            string             `boil:"actor" json:"actor" toml:"actor" yaml:"actor"`
+	ProviderResponse null.JSON          `boil:"provider_response" json:"provider_response,omitempty" toml:"provider_response" yaml:"provider_response,omitempty"`
+	CreatedBy        int                `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt        time.Time          `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy        int                `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt        time.Time          `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy        null.Int           `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt        null.Time          `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *transferJobEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L transferJobEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TransferJobEventColumns = struct {
+	ID               string
+	TransferJobID    string
+	FromStatus       string
+	ToStatus         string
+	Reason           string
+	Actor            string
+	ProviderResponse string
+	CreatedBy        string
+	CreatedAt        string
+	UpdatedBy        string
+	UpdatedAt        string
+	DeletedBy        string
+	DeletedAt        string
+}{
+	ID:               "id",
+	TransferJobID:    "transfer_job_id",
+	FromStatus:       "from_status",
+	ToStatus:         "to_status",
+	Reason:           "reason",
+	Actor:            "actor",
+	ProviderResponse: "provider_response",
+	CreatedBy:        "created_by",
+	CreatedAt:        "created_at",
+	UpdatedBy:        "updated_by",
+	UpdatedAt:        "updated_at",
+	DeletedBy:        "deleted_by",
+	DeletedAt:        "deleted_at",
+}
+
+var TransferJobEventTableColumns = struct {
+	ID               string
+	TransferJobID    string
+	FromStatus       string
+	ToStatus         string
+	Reason           string
+	Actor            string
+	ProviderResponse string
+	CreatedBy        string
+	CreatedAt        string
+	UpdatedBy        string
+	UpdatedAt        string
+	DeletedBy        string
+	DeletedAt        string
+}{
+	ID:               "transfer_job_events.id",
+	TransferJobID:    "transfer_job_events.transfer_job_id",
+	FromStatus:       "transfer_job_events.from_status",
+	ToStatus:         "transfer_job_events.to_status",
+	Reason:           "transfer_job_events.reason",
+	Actor:            "transfer_job_events.actor",
+	ProviderResponse: "transfer_job_events.provider_response",
+	CreatedBy:        "transfer_job_events.created_by",
+	CreatedAt:        "transfer_job_events.created_at",
+	UpdatedBy:        "transfer_job_events.updated_by",
+	UpdatedAt:        "transfer_job_events.updated_at",
+	DeletedBy:        "transfer_job_events.deleted_by",
+	DeletedAt:        "transfer_job_events.deleted_at",
+}
+
+// Generated where
+
+type whereHelperNullTransferstatus struct{ field string }
+
+func (w whereHelperNullTransferstatus) EQ(x NullTransferstatus) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelperNullTransferstatus) NEQ(x NullTransferstatus) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelperNullTransferstatus) LT(x NullTransferstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperNullTransferstatus) LTE(x NullTransferstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperNullTransferstatus) GT(x NullTransferstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperNullTransferstatus) GTE(x NullTransferstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperNullTransferstatus) IN(slice []NullTransferstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperNullTransferstatus) NIN(slice []NullTransferstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelperNullTransferstatus) IsNull() qm.QueryMod { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelperNullTransferstatus) IsNotNull() qm.QueryMod {
+	return qmhelper.WhereIsNotNull(w.field)
+}
+
+type whereHelperTransferstatus struct{ field string }
+
+func (w whereHelperTransferstatus) EQ(x Transferstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperTransferstatus) NEQ(x Transferstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperTransferstatus) LT(x Transferstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperTransferstatus) LTE(x Transferstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperTransferstatus) GT(x Transferstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperTransferstatus) GTE(x Transferstatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperTransferstatus) IN(slice []Transferstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperTransferstatus) NIN(slice []Transferstatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpernull_JSON struct{ field string }
+
+func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var TransferJobEventWhere = struct {
+	ID               whereHelperint
+	TransferJobID    whereHelperint
+	FromStatus       whereHelperNullTransferstatus
+	ToStatus         whereHelperTransferstatus
+	Reason           whereHelperstring
+	Actor            whereHelperstring
+	ProviderResponse whereHelpernull_JSON
+	CreatedBy        whereHelperint
+	CreatedAt        whereHelpertime_Time
+	UpdatedBy        whereHelperint
+	UpdatedAt        whereHelpertime_Time
+	DeletedBy        whereHelpernull_Int
+	DeletedAt        whereHelpernull_Time
+}{
+	ID:               whereHelperint{field: "\"transfer_job_events\".\"id\""},
+	TransferJobID:    whereHelperint{field: "\"transfer_job_events\".\"transfer_job_id\""},
+	FromStatus:       whereHelperNullTransferstatus{field: "\"transfer_job_events\".\"from_status\""},
+	ToStatus:         whereHelperTransferstatus{field: "\"transfer_job_events\".\"to_status\""},
+	Reason:           whereHelperstring{field: "\"transfer_job_events\".\"reason\""},
+	Actor:            whereHelperstring{field: "\"transfer_job_events\".\"actor\""},
+	ProviderResponse: whereHelpernull_JSON{field: "\"transfer_job_events\".\"provider_response\""},
+	CreatedBy:        whereHelperint{field: "\"transfer_job_events\".\"created_by\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"transfer_job_events\".\"created_at\""},
+	UpdatedBy:        whereHelperint{field: "\"transfer_job_events\".\"updated_by\""},
+	UpdatedAt:        whereHelpertime_Time{field: "\"transfer_job_events\".\"updated_at\""},
+	DeletedBy:        whereHelpernull_Int{field: "\"transfer_job_events\".\"deleted_by\""},
+	DeletedAt:        whereHelpernull_Time{field: "\"transfer_job_events\".\"deleted_at\""},
+}
+
+// TransferJobEventRels is where relationship names are stored.
+var TransferJobEventRels = struct {
+	TransferJob       string
+	WebhookDeliveries string
+}{
+	TransferJob:       "TransferJob",
+	WebhookDeliveries: "WebhookDeliveries",
+}
+
+// transferJobEventR is where relationships are stored.
+type transferJobEventR struct {
+	TransferJob       *TransferJob         `boil:"TransferJob" json:"TransferJob" toml:"TransferJob" yaml:"TransferJob"`
+	WebhookDeliveries WebhookDeliverySlice `boil:"WebhookDeliveries" json:"WebhookDeliveries" toml:"WebhookDeliveries" yaml:"WebhookDeliveries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*transferJobEventR) NewStruct() *transferJobEventR {
+	return &transferJobEventR{}
+}
+
+func (r *transferJobEventR) GetTransferJob() *TransferJob {
+	if r == nil {
+		return nil
+	}
+	return r.TransferJob
+}
+
+func (r *transferJobEventR) GetWebhookDeliveries() WebhookDeliverySlice {
+	if r == nil {
+		return nil
+	}
+	return r.WebhookDeliveries
+}
+
+// transferJobEventL is where Load methods for each relationship are stored.
+type transferJobEventL struct{}
+
+var (
+	transferJobEventAllColumns            = []string{"id", "transfer_job_id", "from_status", "to_status", "reason", "actor", "provider_response", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	transferJobEventColumnsWithoutDefault = []string{"transfer_job_id", "to_status", "reason", "actor"}
+	transferJobEventColumnsWithDefault    = []string{"id", "from_status", "provider_response", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	transferJobEventPrimaryKeyColumns     = []string{"id"}
+	transferJobEventGeneratedColumns      = []string{}
+)
+
+type (
+	// TransferJobEventSlice is an alias for a slice of pointers to TransferJobEvent.
+	// This should almost always be used instead of []TransferJobEvent.
+	TransferJobEventSlice []*TransferJobEvent
+	// TransferJobEventHook is the signature for custom TransferJobEvent hook methods
+	TransferJobEventHook func(context.Context, boil.ContextExecutor, *TransferJobEvent) error
+
+	transferJobEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	transferJobEventType                 = reflect.TypeOf(&TransferJobEvent{})
+	transferJobEventMapping              = queries.MakeStructMapping(transferJobEventType)
+	transferJobEventPrimaryKeyMapping, _ = queries.BindMapping(transferJobEventType, transferJobEventMapping, transferJobEventPrimaryKeyColumns)
+	transferJobEventInsertCacheMut       sync.RWMutex
+	transferJobEventInsertCache          = make(map[string]insertCache)
+	transferJobEventUpdateCacheMut       sync.RWMutex
+	transferJobEventUpdateCache          = make(map[string]updateCache)
+	transferJobEventUpsertCacheMut       sync.RWMutex
+	transferJobEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var transferJobEventAfterSelectMu sync.Mutex
+var transferJobEventAfterSelectHooks []TransferJobEventHook
+
+var transferJobEventBeforeInsertMu sync.Mutex
+var transferJobEventBeforeInsertHooks []TransferJobEventHook
+var transferJobEventAfterInsertMu sync.Mutex
+var transferJobEventAfterInsertHooks []TransferJobEventHook
+
+var transferJobEventBeforeUpdateMu sync.Mutex
+var transferJobEventBeforeUpdateHooks []TransferJobEventHook
+var transferJobEventAfterUpdateMu sync.Mutex
+var transferJobEventAfterUpdateHooks []TransferJobEventHook
+
+var transferJobEventBeforeDeleteMu sync.Mutex
+var transferJobEventBeforeDeleteHooks []TransferJobEventHook
+var transferJobEventAfterDeleteMu sync.Mutex
+var transferJobEventAfterDeleteHooks []TransferJobEventHook
+
+var transferJobEventBeforeUpsertMu sync.Mutex
+var transferJobEventBeforeUpsertHooks []TransferJobEventHook
+var transferJobEventAfterUpsertMu sync.Mutex
+var transferJobEventAfterUpsertHooks []TransferJobEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TransferJobEvent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferJobEventAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TransferJobEvent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferJobEventBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TransferJobEvent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferJobEventAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TransferJobEvent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferJobEventBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TransferJobEvent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferJobEventAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TransferJobEvent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferJobEventBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TransferJobEvent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferJobEventAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TransferJobEvent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferJobEventBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TransferJobEvent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferJobEventAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTransferJobEventHook registers your hook function for all future operations.
+func AddTransferJobEventHook(hookPoint boil.HookPoint, transferJobEventHook TransferJobEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		transferJobEventAfterSelectMu.Lock()
+		transferJobEventAfterSelectHooks = append(transferJobEventAfterSelectHooks, transferJobEventHook)
+		transferJobEventAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		transferJobEventBeforeInsertMu.Lock()
+		transferJobEventBeforeInsertHooks = append(transferJobEventBeforeInsertHooks, transferJobEventHook)
+		transferJobEventBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		transferJobEventAfterInsertMu.Lock()
+		transferJobEventAfterInsertHooks = append(transferJobEventAfterInsertHooks, transferJobEventHook)
+		transferJobEventAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		transferJobEventBeforeUpdateMu.Lock()
+		transferJobEventBeforeUpdateHooks = append(transferJobEventBeforeUpdateHooks, transferJobEventHook)
+		transferJobEventBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		transferJobEventAfterUpdateMu.Lock()
+		transferJobEventAfterUpdateHooks = append(transferJobEventAfterUpdateHooks, transferJobEventHook)
+		transferJobEventAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		transferJobEventBeforeDeleteMu.Lock()
+		transferJobEventBeforeDeleteHooks = append(transferJobEventBeforeDeleteHooks, transferJobEventHook)
+		transferJobEventBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		transferJobEventAfterDeleteMu.Lock()
+		transferJobEventAfterDeleteHooks = append(transferJobEventAfterDeleteHooks, transferJobEventHook)
+		transferJobEventAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		transferJobEventBeforeUpsertMu.Lock()
+		transferJobEventBeforeUpsertHooks = append(transferJobEventBeforeUpsertHooks, transferJobEventHook)
+		transferJobEventBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		transferJobEventAfterUpsertMu.Lock()
+		transferJobEventAfterUpsertHooks = append(transferJobEventAfterUpsertHooks, transferJobEventHook)
+		transferJobEventAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single transferJobEvent record from the query using the global executor.
+func (q transferJobEventQuery) OneG(ctx context.Context) (*TransferJobEvent, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single transferJobEvent record from the query.
+func (q transferJobEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TransferJobEvent, error) {
+	o := &TransferJobEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for transfer_job_events")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all TransferJobEvent records from the query using the global executor.
+func (q transferJobEventQuery) AllG(ctx context.Context) (TransferJobEventSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all TransferJobEvent records from the query.
+func (q transferJobEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (TransferJobEventSlice, error) {
+	var o []*TransferJobEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to TransferJobEvent slice")
+	}
+
+	if len(transferJobEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all TransferJobEvent records in the query using the global executor
+func (q transferJobEventQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all TransferJobEvent records in the query.
+func (q transferJobEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count transfer_job_events rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q transferJobEventQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q transferJobEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if transfer_job_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// TransferJob pointed to by the foreign key.
+func (o *TransferJobEvent) TransferJob(mods ...qm.QueryMod) transferJobQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TransferJobID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TransferJobs(queryMods...)
+}
+
+// WebhookDeliveries retrieves all the webhook_delivery's WebhookDeliveries with an executor.
+func (o *TransferJobEvent) WebhookDeliveries(mods ...qm.QueryMod) webhookDeliveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhook_deliveries\".\"transfer_job_event_id\"=?", o.ID),
+	)
+
+	return WebhookDeliveries(queryMods...)
+}
+
+// LoadTransferJob allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transferJobEventL) LoadTransferJob(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransferJobEvent interface{}, mods queries.Applicator) error {
+	var slice []*TransferJobEvent
+	var object *TransferJobEvent
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransferJobEvent.(*TransferJobEvent)
+		if !ok {
+			object = new(TransferJobEvent)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransferJobEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransferJobEvent))
+			}
+		}
+	} else {
+		s, ok := maybeTransferJobEvent.(*[]*TransferJobEvent)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransferJobEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransferJobEvent))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transferJobEventR{}
+		}
+		args[object.TransferJobID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transferJobEventR{}
+			}
+
+			args[obj.TransferJobID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transfer_jobs`),
+		qm.WhereIn(`transfer_jobs.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`transfer_jobs.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TransferJob")
+	}
+
+	var resultSlice []*TransferJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TransferJob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for transfer_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer_jobs")
+	}
+
+	if len(transferJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TransferJob = foreign
+		if foreign.R == nil {
+			foreign.R = &transferJobR{}
+		}
+		foreign.R.TransferJobEvents = append(foreign.R.TransferJobEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TransferJobID == foreign.ID {
+				local.R.TransferJob = foreign
+				if foreign.R == nil {
+					foreign.R = &transferJobR{}
+				}
+				foreign.R.TransferJobEvents = append(foreign.R.TransferJobEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebhookDeliveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transferJobEventL) LoadWebhookDeliveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransferJobEvent interface{}, mods queries.Applicator) error {
+	var slice []*TransferJobEvent
+	var object *TransferJobEvent
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransferJobEvent.(*TransferJobEvent)
+		if !ok {
+			object = new(TransferJobEvent)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransferJobEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransferJobEvent))
+			}
+		}
+	} else {
+		s, ok := maybeTransferJobEvent.(*[]*TransferJobEvent)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransferJobEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransferJobEvent))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transferJobEventR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transferJobEventR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webhook_deliveries`),
+		qm.WhereIn(`webhook_deliveries.transfer_job_event_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`webhook_deliveries.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhook_deliveries")
+	}
+
+	var resultSlice []*WebhookDelivery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhook_deliveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhook_deliveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook_deliveries")
+	}
+
+	if len(webhookDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebhookDeliveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookDeliveryR{}
+			}
+			foreign.R.TransferJobEvent = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TransferJobEventID {
+				local.R.WebhookDeliveries = append(local.R.WebhookDeliveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookDeliveryR{}
+				}
+				foreign.R.TransferJobEvent = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTransferJobG of the transferJobEvent to the related item.
+// Sets o.R.TransferJob to related.
+// Adds o to related.R.TransferJobEvents.
+// Uses the global database handle.
+func (o *TransferJobEvent) SetTransferJobG(ctx context.Context, insert bool, related *TransferJob) error {
+	return o.SetTransferJob(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetTransferJob of the transferJobEvent to the related item.
+// Sets o.R.TransferJob to related.
+// Adds o to related.R.TransferJobEvents.
+func (o *TransferJobEvent) SetTransferJob(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TransferJob) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transfer_job_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_job_id"}),
+		strmangle.WhereClause("\"", "\"", 2, transferJobEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TransferJobID = related.ID
+	if o.R == nil {
+		o.R = &transferJobEventR{
+			TransferJob: related,
+		}
+	} else {
+		o.R.TransferJob = related
+	}
+
+	if related.R == nil {
+		related.R = &transferJobR{
+			TransferJobEvents: TransferJobEventSlice{o},
+		}
+	} else {
+		related.R.TransferJobEvents = append(related.R.TransferJobEvents, o)
+	}
+
+	return nil
+}
+
+// AddWebhookDeliveriesG adds the given related objects to the existing relationships
+// of the transfer_job_event, optionally inserting them as new records.
+// Appends related to o.R.WebhookDeliveries.
+// Sets related.R.TransferJobEvent appropriately.
+// Uses the global database handle.
+func (o *TransferJobEvent) AddWebhookDeliveriesG(ctx context.Context, insert bool, related ...*WebhookDelivery) error {
+	return o.AddWebhookDeliveries(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddWebhookDeliveries adds the given related objects to the existing relationships
+// of the transfer_job_event, optionally inserting them as new records.
+// Appends related to o.R.WebhookDeliveries.
+// Sets related.R.TransferJobEvent appropriately.
+func (o *TransferJobEvent) AddWebhookDeliveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebhookDelivery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TransferJobEventID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webhook_deliveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_job_event_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webhookDeliveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TransferJobEventID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transferJobEventR{
+			WebhookDeliveries: related,
+		}
+	} else {
+		o.R.WebhookDeliveries = append(o.R.WebhookDeliveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookDeliveryR{
+				TransferJobEvent: o,
+			}
+		} else {
+			rel.R.TransferJobEvent = o
+		}
+	}
+	return nil
+}
+
+// TransferJobEvents retrieves all the records using an executor.
+func TransferJobEvents(mods ...qm.QueryMod) transferJobEventQuery {
+	mods = append(mods, qm.From("\"transfer_job_events\""), qmhelper.WhereIsNull("\"transfer_job_events\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"transfer_job_events\".*"})
+	}
+
+	return transferJobEventQuery{q}
+}
+
+// FindTransferJobEventG retrieves a single record by ID.
+func FindTransferJobEventG(ctx context.Context, iD int, selectCols ...string) (*TransferJobEvent, error) {
+	return FindTransferJobEvent(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindTransferJobEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTransferJobEvent(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TransferJobEvent, error) {
+	transferJobEventObj := &TransferJobEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"transfer_job_events\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, transferJobEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from transfer_job_events")
+	}
+
+	if err = transferJobEventObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return transferJobEventObj, err
+	}
+
+	return transferJobEventObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *TransferJobEvent) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TransferJobEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no transfer_job_events provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transferJobEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	transferJobEventInsertCacheMut.RLock()
+	cache, cached := transferJobEventInsertCache[key]
+	transferJobEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			transferJobEventAllColumns,
+			transferJobEventColumnsWithDefault,
+			transferJobEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(transferJobEventType, transferJobEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(transferJobEventType, transferJobEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"transfer_job_events\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"transfer_job_events\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into transfer_job_events")
+	}
+
+	if !cached {
+		transferJobEventInsertCacheMut.Lock()
+		transferJobEventInsertCache[key] = cache
+		transferJobEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single TransferJobEvent record using the global executor.
+// See Update for more documentation.
+func (o *TransferJobEvent) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the TransferJobEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TransferJobEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	transferJobEventUpdateCacheMut.RLock()
+	cache, cached := transferJobEventUpdateCache[key]
+	transferJobEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			transferJobEventAllColumns,
+			transferJobEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update transfer_job_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"transfer_job_events\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, transferJobEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(transferJobEventType, transferJobEventMapping, append(wl, transferJobEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update transfer_job_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for transfer_job_events")
+	}
+
+	if !cached {
+		transferJobEventUpdateCacheMut.Lock()
+		transferJobEventUpdateCache[key] = cache
+		transferJobEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q transferJobEventQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q transferJobEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for transfer_job_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for transfer_job_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o TransferJobEventSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TransferJobEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferJobEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"transfer_job_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, transferJobEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in transferJobEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all transferJobEvent")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *TransferJobEvent) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TransferJobEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no transfer_job_events provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transferJobEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	transferJobEventUpsertCacheMut.RLock()
+	cache, cached := transferJobEventUpsertCache[key]
+	transferJobEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			transferJobEventAllColumns,
+			transferJobEventColumnsWithDefault,
+			transferJobEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			transferJobEventAllColumns,
+			transferJobEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert transfer_job_events, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(transferJobEventAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(transferJobEventPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert transfer_job_events, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(transferJobEventPrimaryKeyColumns))
+			copy(conflict, transferJobEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"transfer_job_events\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(transferJobEventType, transferJobEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(transferJobEventType, transferJobEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert transfer_job_events")
+	}
+
+	if !cached {
+		transferJobEventUpsertCacheMut.Lock()
+		transferJobEventUpsertCache[key] = cache
+		transferJobEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single TransferJobEvent record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *TransferJobEvent) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single TransferJobEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TransferJobEvent) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no TransferJobEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), transferJobEventPrimaryKeyMapping)
+		sql = "DELETE FROM \"transfer_job_events\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"transfer_job_events\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(transferJobEventType, transferJobEventMapping, append(wl, transferJobEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from transfer_job_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for transfer_job_events")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q transferJobEventQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q transferJobEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no transferJobEventQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from transfer_job_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for transfer_job_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o TransferJobEventSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TransferJobEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(transferJobEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferJobEventPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"transfer_job_events\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, transferJobEventPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferJobEventPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"transfer_job_events\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, transferJobEventPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from transferJobEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for transfer_job_events")
+	}
+
+	if len(transferJobEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *TransferJobEvent) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no TransferJobEvent provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TransferJobEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTransferJobEvent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TransferJobEventSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty TransferJobEventSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TransferJobEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TransferJobEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferJobEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"transfer_job_events\".* FROM \"transfer_job_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, transferJobEventPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in TransferJobEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TransferJobEventExistsG checks if the TransferJobEvent row exists.
+func TransferJobEventExistsG(ctx context.Context, iD int) (bool, error) {
+	return TransferJobEventExists(ctx, boil.GetContextDB(), iD)
+}
+
+// TransferJobEventExists checks if the TransferJobEvent row exists.
+func TransferJobEventExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"transfer_job_events\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if transfer_job_events exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TransferJobEvent row exists.
+func (o *TransferJobEvent) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TransferJobEventExists(ctx, exec, o.ID)
+}
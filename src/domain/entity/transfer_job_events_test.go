@@ -0,0 +1,1105 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTransferJobEvents(t *testing.T) {
+	t.Parallel()
+
+	query := TransferJobEvents()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTransferJobEventsSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferJobEventsQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TransferJobEvents().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferJobEventsSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferJobEventSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferJobEventsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferJobEventsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TransferJobEvents().DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferJobEventsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferJobEventSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferJobEventsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TransferJobEventExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if TransferJobEvent exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TransferJobEventExists to return true, but got false.")
+	}
+}
+
+func testTransferJobEventsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	transferJobEventFound, err := FindTransferJobEvent(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if transferJobEventFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTransferJobEventsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TransferJobEvents().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferJobEventsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TransferJobEvents().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTransferJobEventsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	transferJobEventOne := &TransferJobEvent{}
+	transferJobEventTwo := &TransferJobEvent{}
+	if err = randomize.Struct(seed, transferJobEventOne, transferJobEventDBTypes, false, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, transferJobEventTwo, transferJobEventDBTypes, false, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = transferJobEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = transferJobEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TransferJobEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTransferJobEventsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	transferJobEventOne := &TransferJobEvent{}
+	transferJobEventTwo := &TransferJobEvent{}
+	if err = randomize.Struct(seed, transferJobEventOne, transferJobEventDBTypes, false, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, transferJobEventTwo, transferJobEventDBTypes, false, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = transferJobEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = transferJobEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func transferJobEventBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferJobEvent) error {
+	*o = TransferJobEvent{}
+	return nil
+}
+
+func transferJobEventAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferJobEvent) error {
+	*o = TransferJobEvent{}
+	return nil
+}
+
+func transferJobEventAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *TransferJobEvent) error {
+	*o = TransferJobEvent{}
+	return nil
+}
+
+func transferJobEventBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TransferJobEvent) error {
+	*o = TransferJobEvent{}
+	return nil
+}
+
+func transferJobEventAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TransferJobEvent) error {
+	*o = TransferJobEvent{}
+	return nil
+}
+
+func transferJobEventBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TransferJobEvent) error {
+	*o = TransferJobEvent{}
+	return nil
+}
+
+func transferJobEventAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TransferJobEvent) error {
+	*o = TransferJobEvent{}
+	return nil
+}
+
+func transferJobEventBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferJobEvent) error {
+	*o = TransferJobEvent{}
+	return nil
+}
+
+func transferJobEventAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferJobEvent) error {
+	*o = TransferJobEvent{}
+	return nil
+}
+
+func testTransferJobEventsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &TransferJobEvent{}
+	o := &TransferJobEvent{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent object: %s", err)
+	}
+
+	AddTransferJobEventHook(boil.BeforeInsertHook, transferJobEventBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	transferJobEventBeforeInsertHooks = []TransferJobEventHook{}
+
+	AddTransferJobEventHook(boil.AfterInsertHook, transferJobEventAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	transferJobEventAfterInsertHooks = []TransferJobEventHook{}
+
+	AddTransferJobEventHook(boil.AfterSelectHook, transferJobEventAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	transferJobEventAfterSelectHooks = []TransferJobEventHook{}
+
+	AddTransferJobEventHook(boil.BeforeUpdateHook, transferJobEventBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	transferJobEventBeforeUpdateHooks = []TransferJobEventHook{}
+
+	AddTransferJobEventHook(boil.AfterUpdateHook, transferJobEventAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	transferJobEventAfterUpdateHooks = []TransferJobEventHook{}
+
+	AddTransferJobEventHook(boil.BeforeDeleteHook, transferJobEventBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	transferJobEventBeforeDeleteHooks = []TransferJobEventHook{}
+
+	AddTransferJobEventHook(boil.AfterDeleteHook, transferJobEventAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	transferJobEventAfterDeleteHooks = []TransferJobEventHook{}
+
+	AddTransferJobEventHook(boil.BeforeUpsertHook, transferJobEventBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	transferJobEventBeforeUpsertHooks = []TransferJobEventHook{}
+
+	AddTransferJobEventHook(boil.AfterUpsertHook, transferJobEventAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	transferJobEventAfterUpsertHooks = []TransferJobEventHook{}
+}
+
+func testTransferJobEventsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTransferJobEventsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(transferJobEventColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTransferJobEventToManyWebhookDeliveries(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJobEvent
+	var b, c WebhookDelivery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.TransferJobEventID = a.ID
+	c.TransferJobEventID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.WebhookDeliveries().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.TransferJobEventID == b.TransferJobEventID {
+			bFound = true
+		}
+		if v.TransferJobEventID == c.TransferJobEventID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransferJobEventSlice{&a}
+	if err = a.L.LoadWebhookDeliveries(ctx, tx, false, (*[]*TransferJobEvent)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WebhookDeliveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.WebhookDeliveries = nil
+	if err = a.L.LoadWebhookDeliveries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WebhookDeliveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransferJobEventToManyAddOpWebhookDeliveries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJobEvent
+	var b, c, d, e WebhookDelivery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobEventDBTypes, false, strmangle.SetComplement(transferJobEventPrimaryKeyColumns, transferJobEventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WebhookDelivery{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, webhookDeliveryDBTypes, false, strmangle.SetComplement(webhookDeliveryPrimaryKeyColumns, webhookDeliveryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*WebhookDelivery{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddWebhookDeliveries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.TransferJobEventID {
+			t.Error("foreign key was wrong value", a.ID, first.TransferJobEventID)
+		}
+		if a.ID != second.TransferJobEventID {
+			t.Error("foreign key was wrong value", a.ID, second.TransferJobEventID)
+		}
+
+		if first.R.TransferJobEvent != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.TransferJobEvent != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.WebhookDeliveries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.WebhookDeliveries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.WebhookDeliveries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testTransferJobEventToOneTransferJobUsingTransferJob(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local TransferJobEvent
+	var foreign TransferJob
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transferJobEventDBTypes, false, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, transferJobDBTypes, false, transferJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJob struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.TransferJobID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.TransferJob().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddTransferJobHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *TransferJob) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := TransferJobEventSlice{&local}
+	if err = local.L.LoadTransferJob(ctx, tx, false, (*[]*TransferJobEvent)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TransferJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.TransferJob = nil
+	if err = local.L.LoadTransferJob(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TransferJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testTransferJobEventToOneSetOpTransferJobUsingTransferJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJobEvent
+	var b, c TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobEventDBTypes, false, strmangle.SetComplement(transferJobEventPrimaryKeyColumns, transferJobEventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*TransferJob{&b, &c} {
+		err = a.SetTransferJob(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.TransferJob != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TransferJobEvents[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.TransferJobID != x.ID {
+			t.Error("foreign key was wrong value", a.TransferJobID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TransferJobID))
+		reflect.Indirect(reflect.ValueOf(&a.TransferJobID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.TransferJobID != x.ID {
+			t.Error("foreign key was wrong value", a.TransferJobID, x.ID)
+		}
+	}
+}
+
+func testTransferJobEventsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferJobEventsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferJobEventSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferJobEventsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TransferJobEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	transferJobEventDBTypes = map[string]string{`ID`: `integer`, `TransferJobID`: `integer`, `FromStatus`: `enum.transferstatus('cancelled','failed','pending','processing','scheduled','success')`, `ToStatus`: `enum.transferstatus('cancelled','failed','pending','processing','scheduled','success')`, `Reason`: `text`, `Actor`: `character varying`, `ProviderResponse`: `jsonb`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	_                       = bytes.MinRead
+)
+
+func testTransferJobEventsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(transferJobEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(transferJobEventAllColumns) == len(transferJobEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTransferJobEventsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(transferJobEventAllColumns) == len(transferJobEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferJobEvent{}
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, transferJobEventDBTypes, true, transferJobEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(transferJobEventAllColumns, transferJobEventPrimaryKeyColumns) {
+		fields = transferJobEventAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			transferJobEventAllColumns,
+			transferJobEventPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TransferJobEventSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTransferJobEventsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(transferJobEventAllColumns) == len(transferJobEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TransferJobEvent{}
+	if err = randomize.Struct(seed, &o, transferJobEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TransferJobEvent: %s", err)
+	}
+
+	count, err := TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, transferJobEventDBTypes, false, transferJobEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TransferJobEvent: %s", err)
+	}
+
+	count, err = TransferJobEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -25,178 +25,277 @@ import (
 
 // TransferJob is an object representing the database table.
 type TransferJob struct {
-	ID        int            `boil:"id" json:"id" toml:"id" yaml:"id"`
-	JobID     string         `boil:"job_id" json:"job_id" toml:"job_id" yaml:"job_id"`
-	APIKey    string         `boil:"api_key" json:"api_key" toml:"api_key" yaml:"api_key"`
-	Payload   types.JSON     `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
-	Status    Transferstatus `boil:"status" json:"status" toml:"status" yaml:"status"`
-	CreatedBy int            `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
-	CreatedAt time.Time      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedBy int            `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
-	UpdatedAt time.Time      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedBy null.Int       `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
-	DeletedAt null.Time      `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID                  int            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	JobID               string         `boil:"job_id" json:"job_id" toml:"job_id" yaml:"job_id"`
+	APIKey              string         `boil:"api_key" json:"api_key" toml:"api_key" yaml:"api_key"`
+	Payload             types.JSON     `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Status              Transferstatus `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedBy           int            `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt           time.Time      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy           int            `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt           time.Time      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy           null.Int       `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt           null.Time      `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	TransferBatchID     null.Int       `boil:"transfer_batch_id" json:"transfer_batch_id,omitempty" toml:"transfer_batch_id" yaml:"transfer_batch_id,omitempty"`
+	ScheduledAt         null.Time      `boil:"scheduled_at" json:"scheduled_at,omitempty" toml:"scheduled_at" yaml:"scheduled_at,omitempty"`
+	RecurringTransferID null.Int       `boil:"recurring_transfer_id" json:"recurring_transfer_id,omitempty" toml:"recurring_transfer_id" yaml:"recurring_transfer_id,omitempty"`
+	AttemptCount        int            `boil:"attempt_count" json:"attempt_count" toml:"attempt_count" yaml:"attempt_count"`
+	NextAttemptAt       null.Time      `boil:"next_attempt_at" json:"next_attempt_at,omitempty" toml:"next_attempt_at" yaml:"next_attempt_at,omitempty"`
+	LastError           null.String    `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	Conversion          null.JSON      `boil:"conversion" json:"conversion,omitempty" toml:"conversion" yaml:"conversion,omitempty"`
+	FeeAmount           int64          `boil:"fee_amount" json:"fee_amount" toml:"fee_amount" yaml:"fee_amount"`
+	FeeRuleID           null.Int       `boil:"fee_rule_id" json:"fee_rule_id,omitempty" toml:"fee_rule_id" yaml:"fee_rule_id,omitempty"`
+	LeaseOwner          null.String    `boil:"lease_owner" json:"lease_owner,omitempty" toml:"lease_owner" yaml:"lease_owner,omitempty"`
+	LeaseExpiresAt      null.Time      `boil:"lease_expires_at" json:"lease_expires_at,omitempty" toml:"lease_expires_at" yaml:"lease_expires_at,omitempty"`
+	Reference           null.String    `boil:"reference" json:"reference,omitempty" toml:"reference" yaml:"reference,omitempty"`
 
 	R *transferJobR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L transferJobL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var TransferJobColumns = struct {
-	ID        string
-	JobID     string
-	APIKey    string
-	Payload   string
-	Status    string
-	CreatedBy string
-	CreatedAt string
-	UpdatedBy string
-	UpdatedAt string
-	DeletedBy string
-	DeletedAt string
+	ID                  string
+	JobID               string
+	APIKey              string
+	Payload             string
+	Status              string
+	CreatedBy           string
+	CreatedAt           string
+	UpdatedBy           string
+	UpdatedAt           string
+	DeletedBy           string
+	DeletedAt           string
+	TransferBatchID     string
+	ScheduledAt         string
+	RecurringTransferID string
+	AttemptCount        string
+	NextAttemptAt       string
+	LastError           string
+	Conversion          string
+	FeeAmount           string
+	FeeRuleID           string
+	LeaseOwner          string
+	LeaseExpiresAt      string
+	Reference           string
 }{
-	ID:        "id",
-	JobID:     "job_id",
-	APIKey:    "api_key",
-	Payload:   "payload",
-	Status:    "status",
-	CreatedBy: "created_by",
-	CreatedAt: "created_at",
-	UpdatedBy: "updated_by",
-	UpdatedAt: "updated_at",
-	DeletedBy: "deleted_by",
-	DeletedAt: "deleted_at",
+	ID:                  "id",
+	JobID:               "job_id",
+	APIKey:              "api_key",
+	Payload:             "payload",
+	Status:              "status",
+	CreatedBy:           "created_by",
+	CreatedAt:           "created_at",
+	UpdatedBy:           "updated_by",
+	UpdatedAt:           "updated_at",
+	DeletedBy:           "deleted_by",
+	DeletedAt:           "deleted_at",
+	TransferBatchID:     "transfer_batch_id",
+	ScheduledAt:         "scheduled_at",
+	RecurringTransferID: "recurring_transfer_id",
+	AttemptCount:        "attempt_count",
+	NextAttemptAt:       "next_attempt_at",
+	LastError:           "last_error",
+	Conversion:          "conversion",
+	FeeAmount:           "fee_amount",
+	FeeRuleID:           "fee_rule_id",
+	LeaseOwner:          "lease_owner",
+	LeaseExpiresAt:      "lease_expires_at",
+	Reference:           "reference",
 }
 
 var TransferJobTableColumns = struct {
-	ID        string
-	JobID     string
-	APIKey    string
-	Payload   string
-	Status    string
-	CreatedBy string
-	CreatedAt string
-	UpdatedBy string
-	UpdatedAt string
-	DeletedBy string
-	DeletedAt string
+	ID                  string
+	JobID               string
+	APIKey              string
+	Payload             string
+	Status              string
+	CreatedBy           string
+	CreatedAt           string
+	UpdatedBy           string
+	UpdatedAt           string
+	DeletedBy           string
+	DeletedAt           string
+	TransferBatchID     string
+	ScheduledAt         string
+	RecurringTransferID string
+	AttemptCount        string
+	NextAttemptAt       string
+	LastError           string
+	Conversion          string
+	FeeAmount           string
+	FeeRuleID           string
+	LeaseOwner          string
+	LeaseExpiresAt      string
+	Reference           string
 }{
-	ID:        "transfer_jobs.id",
-	JobID:     "transfer_jobs.job_id",
-	APIKey:    "transfer_jobs.api_key",
-	Payload:   "transfer_jobs.payload",
-	Status:    "transfer_jobs.status",
-	CreatedBy: "transfer_jobs.created_by",
-	CreatedAt: "transfer_jobs.created_at",
-	UpdatedBy: "transfer_jobs.updated_by",
-	UpdatedAt: "transfer_jobs.updated_at",
-	DeletedBy: "transfer_jobs.deleted_by",
-	DeletedAt: "transfer_jobs.deleted_at",
+	ID:                  "transfer_jobs.id",
+	JobID:               "transfer_jobs.job_id",
+	APIKey:              "transfer_jobs.api_key",
+	Payload:             "transfer_jobs.payload",
+	Status:              "transfer_jobs.status",
+	CreatedBy:           "transfer_jobs.created_by",
+	CreatedAt:           "transfer_jobs.created_at",
+	UpdatedBy:           "transfer_jobs.updated_by",
+	UpdatedAt:           "transfer_jobs.updated_at",
+	DeletedBy:           "transfer_jobs.deleted_by",
+	DeletedAt:           "transfer_jobs.deleted_at",
+	TransferBatchID:     "transfer_jobs.transfer_batch_id",
+	ScheduledAt:         "transfer_jobs.scheduled_at",
+	RecurringTransferID: "transfer_jobs.recurring_transfer_id",
+	AttemptCount:        "transfer_jobs.attempt_count",
+	NextAttemptAt:       "transfer_jobs.next_attempt_at",
+	LastError:           "transfer_jobs.last_error",
+	Conversion:          "transfer_jobs.conversion",
+	FeeAmount:           "transfer_jobs.fee_amount",
+	FeeRuleID:           "transfer_jobs.fee_rule_id",
+	LeaseOwner:          "transfer_jobs.lease_owner",
+	LeaseExpiresAt:      "transfer_jobs.lease_expires_at",
+	Reference:           "transfer_jobs.reference",
 }
 
 // Generated where
 
-type whereHelpertypes_JSON struct{ field string }
-
-func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
+var TransferJobWhere = struct {
+	ID                  whereHelperint
+	JobID               whereHelperstring
+	APIKey              whereHelperstring
+	Payload             whereHelpertypes_JSON
+	Status              whereHelperTransferstatus
+	CreatedBy           whereHelperint
+	CreatedAt           whereHelpertime_Time
+	UpdatedBy           whereHelperint
+	UpdatedAt           whereHelpertime_Time
+	DeletedBy           whereHelpernull_Int
+	DeletedAt           whereHelpernull_Time
+	TransferBatchID     whereHelpernull_Int
+	ScheduledAt         whereHelpernull_Time
+	RecurringTransferID whereHelpernull_Int
+	AttemptCount        whereHelperint
+	NextAttemptAt       whereHelpernull_Time
+	LastError           whereHelpernull_String
+	Conversion          whereHelpernull_JSON
+	FeeAmount           whereHelperint64
+	FeeRuleID           whereHelpernull_Int
+	LeaseOwner          whereHelpernull_String
+	LeaseExpiresAt      whereHelpernull_Time
+	Reference           whereHelpernull_String
+}{
+	ID:                  whereHelperint{field: "\"transfer_jobs\".\"id\""},
+	JobID:               whereHelperstring{field: "\"transfer_jobs\".\"job_id\""},
+	APIKey:              whereHelperstring{field: "\"transfer_jobs\".\"api_key\""},
+	Payload:             whereHelpertypes_JSON{field: "\"transfer_jobs\".\"payload\""},
+	Status:              whereHelperTransferstatus{field: "\"transfer_jobs\".\"status\""},
+	CreatedBy:           whereHelperint{field: "\"transfer_jobs\".\"created_by\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"transfer_jobs\".\"created_at\""},
+	UpdatedBy:           whereHelperint{field: "\"transfer_jobs\".\"updated_by\""},
+	UpdatedAt:           whereHelpertime_Time{field: "\"transfer_jobs\".\"updated_at\""},
+	DeletedBy:           whereHelpernull_Int{field: "\"transfer_jobs\".\"deleted_by\""},
+	DeletedAt:           whereHelpernull_Time{field: "\"transfer_jobs\".\"deleted_at\""},
+	TransferBatchID:     whereHelpernull_Int{field: "\"transfer_jobs\".\"transfer_batch_id\""},
+	ScheduledAt:         whereHelpernull_Time{field: "\"transfer_jobs\".\"scheduled_at\""},
+	RecurringTransferID: whereHelpernull_Int{field: "\"transfer_jobs\".\"recurring_transfer_id\""},
+	AttemptCount:        whereHelperint{field: "\"transfer_jobs\".\"attempt_count\""},
+	NextAttemptAt:       whereHelpernull_Time{field: "\"transfer_jobs\".\"next_attempt_at\""},
+	LastError:           whereHelpernull_String{field: "\"transfer_jobs\".\"last_error\""},
+	Conversion:          whereHelpernull_JSON{field: "\"transfer_jobs\".\"conversion\""},
+	FeeAmount:           whereHelperint64{field: "\"transfer_jobs\".\"fee_amount\""},
+	FeeRuleID:           whereHelpernull_Int{field: "\"transfer_jobs\".\"fee_rule_id\""},
+	LeaseOwner:          whereHelpernull_String{field: "\"transfer_jobs\".\"lease_owner\""},
+	LeaseExpiresAt:      whereHelpernull_Time{field: "\"transfer_jobs\".\"lease_expires_at\""},
+	Reference:           whereHelpernull_String{field: "\"transfer_jobs\".\"reference\""},
 }
 
-type whereHelperTransferstatus struct{ field string }
-
-func (w whereHelperTransferstatus) EQ(x Transferstatus) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelperTransferstatus) NEQ(x Transferstatus) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelperTransferstatus) LT(x Transferstatus) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
+// TransferJobRels is where relationship names are stored.
+var TransferJobRels = struct {
+	FeeRule                     string
+	RecurringTransfer           string
+	TransferBatch               string
+	LedgerTransactions          string
+	ReconciliationDiscrepancies string
+	TransferJobEvents           string
+	TransferQuotes              string
+}{
+	FeeRule:                     "FeeRule",
+	RecurringTransfer:           "RecurringTransfer",
+	TransferBatch:               "TransferBatch",
+	LedgerTransactions:          "LedgerTransactions",
+	ReconciliationDiscrepancies: "ReconciliationDiscrepancies",
+	TransferJobEvents:           "TransferJobEvents",
+	TransferQuotes:              "TransferQuotes",
 }
-func (w whereHelperTransferstatus) LTE(x Transferstatus) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
+
+// transferJobR is where relationships are stored.
+type transferJobR struct {
+	FeeRule                     *FeeRule                       `boil:"FeeRule" json:"FeeRule" toml:"FeeRule" yaml:"FeeRule"`
+	RecurringTransfer           *RecurringTransfer             `boil:"RecurringTransfer" json:"RecurringTransfer" toml:"RecurringTransfer" yaml:"RecurringTransfer"`
+	TransferBatch               *TransferBatch                 `boil:"TransferBatch" json:"TransferBatch" toml:"TransferBatch" yaml:"TransferBatch"`
+	LedgerTransactions          LedgerTransactionSlice         `boil:"LedgerTransactions" json:"LedgerTransactions" toml:"LedgerTransactions" yaml:"LedgerTransactions"`
+	ReconciliationDiscrepancies ReconciliationDiscrepancySlice `boil:"ReconciliationDiscrepancies" json:"ReconciliationDiscrepancies" toml:"ReconciliationDiscrepancies" yaml:"ReconciliationDiscrepancies"`
+	TransferJobEvents           TransferJobEventSlice          `boil:"TransferJobEvents" json:"TransferJobEvents" toml:"TransferJobEvents" yaml:"TransferJobEvents"`
+	TransferQuotes              TransferQuoteSlice             `boil:"TransferQuotes" json:"TransferQuotes" toml:"TransferQuotes" yaml:"TransferQuotes"`
 }
-func (w whereHelperTransferstatus) GT(x Transferstatus) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
+
+// NewStruct creates a new relationship struct
+func (*transferJobR) NewStruct() *transferJobR {
+	return &transferJobR{}
 }
-func (w whereHelperTransferstatus) GTE(x Transferstatus) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
+
+func (r *transferJobR) GetFeeRule() *FeeRule {
+	if r == nil {
+		return nil
+	}
+	return r.FeeRule
 }
-func (w whereHelperTransferstatus) IN(slice []Transferstatus) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *transferJobR) GetRecurringTransfer() *RecurringTransfer {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+	return r.RecurringTransfer
 }
-func (w whereHelperTransferstatus) NIN(slice []Transferstatus) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *transferJobR) GetTransferBatch() *TransferBatch {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+	return r.TransferBatch
 }
 
-var TransferJobWhere = struct {
-	ID        whereHelperint
-	JobID     whereHelperstring
-	APIKey    whereHelperstring
-	Payload   whereHelpertypes_JSON
-	Status    whereHelperTransferstatus
-	CreatedBy whereHelperint
-	CreatedAt whereHelpertime_Time
-	UpdatedBy whereHelperint
-	UpdatedAt whereHelpertime_Time
-	DeletedBy whereHelpernull_Int
-	DeletedAt whereHelpernull_Time
-}{
-	ID:        whereHelperint{field: "\"transfer_jobs\".\"id\""},
-	JobID:     whereHelperstring{field: "\"transfer_jobs\".\"job_id\""},
-	APIKey:    whereHelperstring{field: "\"transfer_jobs\".\"api_key\""},
-	Payload:   whereHelpertypes_JSON{field: "\"transfer_jobs\".\"payload\""},
-	Status:    whereHelperTransferstatus{field: "\"transfer_jobs\".\"status\""},
-	CreatedBy: whereHelperint{field: "\"transfer_jobs\".\"created_by\""},
-	CreatedAt: whereHelpertime_Time{field: "\"transfer_jobs\".\"created_at\""},
-	UpdatedBy: whereHelperint{field: "\"transfer_jobs\".\"updated_by\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"transfer_jobs\".\"updated_at\""},
-	DeletedBy: whereHelpernull_Int{field: "\"transfer_jobs\".\"deleted_by\""},
-	DeletedAt: whereHelpernull_Time{field: "\"transfer_jobs\".\"deleted_at\""},
+func (r *transferJobR) GetLedgerTransactions() LedgerTransactionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.LedgerTransactions
 }
 
-// TransferJobRels is where relationship names are stored.
-var TransferJobRels = struct {
-}{}
+func (r *transferJobR) GetReconciliationDiscrepancies() ReconciliationDiscrepancySlice {
+	if r == nil {
+		return nil
+	}
+	return r.ReconciliationDiscrepancies
+}
 
-// transferJobR is where relationships are stored.
-type transferJobR struct {
+func (r *transferJobR) GetTransferJobEvents() TransferJobEventSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TransferJobEvents
 }
 
-// NewStruct creates a new relationship struct
-func (*transferJobR) NewStruct() *transferJobR {
-	return &transferJobR{}
+func (r *transferJobR) GetTransferQuotes() TransferQuoteSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TransferQuotes
 }
 
 // transferJobL is where Load methods for each relationship are stored.
 type transferJobL struct{}
 
 var (
-	transferJobAllColumns            = []string{"id", "job_id", "api_key", "payload", "status", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	transferJobAllColumns            = []string{"id", "job_id", "api_key", "payload", "status", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "transfer_batch_id", "scheduled_at", "recurring_transfer_id", "attempt_count", "next_attempt_at", "last_error", "conversion", "fee_amount", "fee_rule_id", "lease_owner", "lease_expires_at", "reference"}
 	transferJobColumnsWithoutDefault = []string{"job_id", "api_key", "payload"}
-	transferJobColumnsWithDefault    = []string{"id", "status", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	transferJobColumnsWithDefault    = []string{"id", "status", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "transfer_batch_id", "scheduled_at", "recurring_transfer_id", "attempt_count", "next_attempt_at", "last_error", "conversion", "fee_amount", "fee_rule_id", "lease_owner", "lease_expires_at", "reference"}
 	transferJobPrimaryKeyColumns     = []string{"id"}
 	transferJobGeneratedColumns      = []string{}
 )
@@ -526,6 +625,1739 @@ func (q transferJobQuery) Exists(ctx context.Context, exec boil.ContextExecutor)
 	return count > 0, nil
 }
 
+// FeeRule pointed to by the foreign key.
+func (o *TransferJob) FeeRule(mods ...qm.QueryMod) feeRuleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FeeRuleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return FeeRules(queryMods...)
+}
+
+// RecurringTransfer pointed to by the foreign key.
+func (o *TransferJob) RecurringTransfer(mods ...qm.QueryMod) recurringTransferQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RecurringTransferID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return RecurringTransfers(queryMods...)
+}
+
+// TransferBatch pointed to by the foreign key.
+func (o *TransferJob) TransferBatch(mods ...qm.QueryMod) transferBatchQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TransferBatchID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TransferBatches(queryMods...)
+}
+
+// LedgerTransactions retrieves all the ledger_transaction's LedgerTransactions with an executor.
+func (o *TransferJob) LedgerTransactions(mods ...qm.QueryMod) ledgerTransactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"ledger_transactions\".\"transfer_job_id\"=?", o.ID),
+	)
+
+	return LedgerTransactions(queryMods...)
+}
+
+// ReconciliationDiscrepancies retrieves all the reconciliation_discrepancy's ReconciliationDiscrepancies with an executor.
+func (o *TransferJob) ReconciliationDiscrepancies(mods ...qm.QueryMod) reconciliationDiscrepancyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reconciliation_discrepancies\".\"transfer_job_id\"=?", o.ID),
+	)
+
+	return ReconciliationDiscrepancies(queryMods...)
+}
+
+// TransferJobEvents retrieves all the transfer_job_event's TransferJobEvents with an executor.
+func (o *TransferJob) TransferJobEvents(mods ...qm.QueryMod) transferJobEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transfer_job_events\".\"transfer_job_id\"=?", o.ID),
+	)
+
+	return TransferJobEvents(queryMods...)
+}
+
+// TransferQuotes retrieves all the transfer_quote's TransferQuotes with an executor.
+func (o *TransferJob) TransferQuotes(mods ...qm.QueryMod) transferQuoteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transfer_quotes\".\"transfer_job_id\"=?", o.ID),
+	)
+
+	return TransferQuotes(queryMods...)
+}
+
+// LoadFeeRule allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transferJobL) LoadFeeRule(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransferJob interface{}, mods queries.Applicator) error {
+	var slice []*TransferJob
+	var object *TransferJob
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransferJob.(*TransferJob)
+		if !ok {
+			object = new(TransferJob)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransferJob))
+			}
+		}
+	} else {
+		s, ok := maybeTransferJob.(*[]*TransferJob)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransferJob))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transferJobR{}
+		}
+		if !queries.IsNil(object.FeeRuleID) {
+			args[object.FeeRuleID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transferJobR{}
+			}
+
+			if !queries.IsNil(obj.FeeRuleID) {
+				args[obj.FeeRuleID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`fee_rules`),
+		qm.WhereIn(`fee_rules.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`fee_rules.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load FeeRule")
+	}
+
+	var resultSlice []*FeeRule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice FeeRule")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for fee_rules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for fee_rules")
+	}
+
+	if len(feeRuleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.FeeRule = foreign
+		if foreign.R == nil {
+			foreign.R = &feeRuleR{}
+		}
+		foreign.R.TransferJobs = append(foreign.R.TransferJobs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.FeeRuleID, foreign.ID) {
+				local.R.FeeRule = foreign
+				if foreign.R == nil {
+					foreign.R = &feeRuleR{}
+				}
+				foreign.R.TransferJobs = append(foreign.R.TransferJobs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecurringTransfer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transferJobL) LoadRecurringTransfer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransferJob interface{}, mods queries.Applicator) error {
+	var slice []*TransferJob
+	var object *TransferJob
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransferJob.(*TransferJob)
+		if !ok {
+			object = new(TransferJob)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransferJob))
+			}
+		}
+	} else {
+		s, ok := maybeTransferJob.(*[]*TransferJob)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransferJob))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transferJobR{}
+		}
+		if !queries.IsNil(object.RecurringTransferID) {
+			args[object.RecurringTransferID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transferJobR{}
+			}
+
+			if !queries.IsNil(obj.RecurringTransferID) {
+				args[obj.RecurringTransferID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`recurring_transfers`),
+		qm.WhereIn(`recurring_transfers.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`recurring_transfers.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load RecurringTransfer")
+	}
+
+	var resultSlice []*RecurringTransfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice RecurringTransfer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for recurring_transfers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for recurring_transfers")
+	}
+
+	if len(recurringTransferAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RecurringTransfer = foreign
+		if foreign.R == nil {
+			foreign.R = &recurringTransferR{}
+		}
+		foreign.R.TransferJobs = append(foreign.R.TransferJobs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RecurringTransferID, foreign.ID) {
+				local.R.RecurringTransfer = foreign
+				if foreign.R == nil {
+					foreign.R = &recurringTransferR{}
+				}
+				foreign.R.TransferJobs = append(foreign.R.TransferJobs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransferBatch allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transferJobL) LoadTransferBatch(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransferJob interface{}, mods queries.Applicator) error {
+	var slice []*TransferJob
+	var object *TransferJob
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransferJob.(*TransferJob)
+		if !ok {
+			object = new(TransferJob)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransferJob))
+			}
+		}
+	} else {
+		s, ok := maybeTransferJob.(*[]*TransferJob)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransferJob))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transferJobR{}
+		}
+		if !queries.IsNil(object.TransferBatchID) {
+			args[object.TransferBatchID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transferJobR{}
+			}
+
+			if !queries.IsNil(obj.TransferBatchID) {
+				args[obj.TransferBatchID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transfer_batches`),
+		qm.WhereIn(`transfer_batches.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`transfer_batches.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TransferBatch")
+	}
+
+	var resultSlice []*TransferBatch
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TransferBatch")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for transfer_batches")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer_batches")
+	}
+
+	if len(transferBatchAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TransferBatch = foreign
+		if foreign.R == nil {
+			foreign.R = &transferBatchR{}
+		}
+		foreign.R.TransferJobs = append(foreign.R.TransferJobs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TransferBatchID, foreign.ID) {
+				local.R.TransferBatch = foreign
+				if foreign.R == nil {
+					foreign.R = &transferBatchR{}
+				}
+				foreign.R.TransferJobs = append(foreign.R.TransferJobs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLedgerTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transferJobL) LoadLedgerTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransferJob interface{}, mods queries.Applicator) error {
+	var slice []*TransferJob
+	var object *TransferJob
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransferJob.(*TransferJob)
+		if !ok {
+			object = new(TransferJob)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransferJob))
+			}
+		}
+	} else {
+		s, ok := maybeTransferJob.(*[]*TransferJob)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransferJob))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transferJobR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transferJobR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`ledger_transactions`),
+		qm.WhereIn(`ledger_transactions.transfer_job_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ledger_transactions")
+	}
+
+	var resultSlice []*LedgerTransaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ledger_transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on ledger_transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ledger_transactions")
+	}
+
+	if len(ledgerTransactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.LedgerTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &ledgerTransactionR{}
+			}
+			foreign.R.TransferJob = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TransferJobID) {
+				local.R.LedgerTransactions = append(local.R.LedgerTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &ledgerTransactionR{}
+				}
+				foreign.R.TransferJob = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReconciliationDiscrepancies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transferJobL) LoadReconciliationDiscrepancies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransferJob interface{}, mods queries.Applicator) error {
+	var slice []*TransferJob
+	var object *TransferJob
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransferJob.(*TransferJob)
+		if !ok {
+			object = new(TransferJob)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransferJob))
+			}
+		}
+	} else {
+		s, ok := maybeTransferJob.(*[]*TransferJob)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransferJob))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transferJobR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transferJobR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`reconciliation_discrepancies`),
+		qm.WhereIn(`reconciliation_discrepancies.transfer_job_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reconciliation_discrepancies")
+	}
+
+	var resultSlice []*ReconciliationDiscrepancy
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reconciliation_discrepancies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reconciliation_discrepancies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reconciliation_discrepancies")
+	}
+
+	if len(reconciliationDiscrepancyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReconciliationDiscrepancies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reconciliationDiscrepancyR{}
+			}
+			foreign.R.TransferJob = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TransferJobID) {
+				local.R.ReconciliationDiscrepancies = append(local.R.ReconciliationDiscrepancies, foreign)
+				if foreign.R == nil {
+					foreign.R = &reconciliationDiscrepancyR{}
+				}
+				foreign.R.TransferJob = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransferJobEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transferJobL) LoadTransferJobEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransferJob interface{}, mods queries.Applicator) error {
+	var slice []*TransferJob
+	var object *TransferJob
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransferJob.(*TransferJob)
+		if !ok {
+			object = new(TransferJob)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransferJob))
+			}
+		}
+	} else {
+		s, ok := maybeTransferJob.(*[]*TransferJob)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransferJob))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transferJobR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transferJobR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transfer_job_events`),
+		qm.WhereIn(`transfer_job_events.transfer_job_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`transfer_job_events.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transfer_job_events")
+	}
+
+	var resultSlice []*TransferJobEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transfer_job_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transfer_job_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer_job_events")
+	}
+
+	if len(transferJobEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TransferJobEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transferJobEventR{}
+			}
+			foreign.R.TransferJob = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TransferJobID {
+				local.R.TransferJobEvents = append(local.R.TransferJobEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &transferJobEventR{}
+				}
+				foreign.R.TransferJob = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransferQuotes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (transferJobL) LoadTransferQuotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransferJob interface{}, mods queries.Applicator) error {
+	var slice []*TransferJob
+	var object *TransferJob
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransferJob.(*TransferJob)
+		if !ok {
+			object = new(TransferJob)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransferJob))
+			}
+		}
+	} else {
+		s, ok := maybeTransferJob.(*[]*TransferJob)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransferJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransferJob))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transferJobR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transferJobR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transfer_quotes`),
+		qm.WhereIn(`transfer_quotes.transfer_job_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`transfer_quotes.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transfer_quotes")
+	}
+
+	var resultSlice []*TransferQuote
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transfer_quotes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transfer_quotes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer_quotes")
+	}
+
+	if len(transferQuoteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TransferQuotes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transferQuoteR{}
+			}
+			foreign.R.TransferJob = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TransferJobID) {
+				local.R.TransferQuotes = append(local.R.TransferQuotes, foreign)
+				if foreign.R == nil {
+					foreign.R = &transferQuoteR{}
+				}
+				foreign.R.TransferJob = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFeeRuleG of the transferJob to the related item.
+// Sets o.R.FeeRule to related.
+// Adds o to related.R.TransferJobs.
+// Uses the global database handle.
+func (o *TransferJob) SetFeeRuleG(ctx context.Context, insert bool, related *FeeRule) error {
+	return o.SetFeeRule(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetFeeRule of the transferJob to the related item.
+// Sets o.R.FeeRule to related.
+// Adds o to related.R.TransferJobs.
+func (o *TransferJob) SetFeeRule(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FeeRule) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transfer_jobs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"fee_rule_id"}),
+		strmangle.WhereClause("\"", "\"", 2, transferJobPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.FeeRuleID, related.ID)
+	if o.R == nil {
+		o.R = &transferJobR{
+			FeeRule: related,
+		}
+	} else {
+		o.R.FeeRule = related
+	}
+
+	if related.R == nil {
+		related.R = &feeRuleR{
+			TransferJobs: TransferJobSlice{o},
+		}
+	} else {
+		related.R.TransferJobs = append(related.R.TransferJobs, o)
+	}
+
+	return nil
+}
+
+// RemoveFeeRuleG relationship.
+// Sets o.R.FeeRule to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *TransferJob) RemoveFeeRuleG(ctx context.Context, related *FeeRule) error {
+	return o.RemoveFeeRule(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveFeeRule relationship.
+// Sets o.R.FeeRule to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *TransferJob) RemoveFeeRule(ctx context.Context, exec boil.ContextExecutor, related *FeeRule) error {
+	var err error
+
+	queries.SetScanner(&o.FeeRuleID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("fee_rule_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.FeeRule = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TransferJobs {
+		if queries.Equal(o.FeeRuleID, ri.FeeRuleID) {
+			continue
+		}
+
+		ln := len(related.R.TransferJobs)
+		if ln > 1 && i < ln-1 {
+			related.R.TransferJobs[i] = related.R.TransferJobs[ln-1]
+		}
+		related.R.TransferJobs = related.R.TransferJobs[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetRecurringTransferG of the transferJob to the related item.
+// Sets o.R.RecurringTransfer to related.
+// Adds o to related.R.TransferJobs.
+// Uses the global database handle.
+func (o *TransferJob) SetRecurringTransferG(ctx context.Context, insert bool, related *RecurringTransfer) error {
+	return o.SetRecurringTransfer(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetRecurringTransfer of the transferJob to the related item.
+// Sets o.R.RecurringTransfer to related.
+// Adds o to related.R.TransferJobs.
+func (o *TransferJob) SetRecurringTransfer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *RecurringTransfer) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transfer_jobs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"recurring_transfer_id"}),
+		strmangle.WhereClause("\"", "\"", 2, transferJobPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RecurringTransferID, related.ID)
+	if o.R == nil {
+		o.R = &transferJobR{
+			RecurringTransfer: related,
+		}
+	} else {
+		o.R.RecurringTransfer = related
+	}
+
+	if related.R == nil {
+		related.R = &recurringTransferR{
+			TransferJobs: TransferJobSlice{o},
+		}
+	} else {
+		related.R.TransferJobs = append(related.R.TransferJobs, o)
+	}
+
+	return nil
+}
+
+// RemoveRecurringTransferG relationship.
+// Sets o.R.RecurringTransfer to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *TransferJob) RemoveRecurringTransferG(ctx context.Context, related *RecurringTransfer) error {
+	return o.RemoveRecurringTransfer(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveRecurringTransfer relationship.
+// Sets o.R.RecurringTransfer to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *TransferJob) RemoveRecurringTransfer(ctx context.Context, exec boil.ContextExecutor, related *RecurringTransfer) error {
+	var err error
+
+	queries.SetScanner(&o.RecurringTransferID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("recurring_transfer_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.RecurringTransfer = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TransferJobs {
+		if queries.Equal(o.RecurringTransferID, ri.RecurringTransferID) {
+			continue
+		}
+
+		ln := len(related.R.TransferJobs)
+		if ln > 1 && i < ln-1 {
+			related.R.TransferJobs[i] = related.R.TransferJobs[ln-1]
+		}
+		related.R.TransferJobs = related.R.TransferJobs[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTransferBatchG of the transferJob to the related item.
+// Sets o.R.TransferBatch to related.
+// Adds o to related.R.TransferJobs.
+// Uses the global database handle.
+func (o *TransferJob) SetTransferBatchG(ctx context.Context, insert bool, related *TransferBatch) error {
+	return o.SetTransferBatch(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetTransferBatch of the transferJob to the related item.
+// Sets o.R.TransferBatch to related.
+// Adds o to related.R.TransferJobs.
+func (o *TransferJob) SetTransferBatch(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TransferBatch) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transfer_jobs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_batch_id"}),
+		strmangle.WhereClause("\"", "\"", 2, transferJobPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TransferBatchID, related.ID)
+	if o.R == nil {
+		o.R = &transferJobR{
+			TransferBatch: related,
+		}
+	} else {
+		o.R.TransferBatch = related
+	}
+
+	if related.R == nil {
+		related.R = &transferBatchR{
+			TransferJobs: TransferJobSlice{o},
+		}
+	} else {
+		related.R.TransferJobs = append(related.R.TransferJobs, o)
+	}
+
+	return nil
+}
+
+// RemoveTransferBatchG relationship.
+// Sets o.R.TransferBatch to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *TransferJob) RemoveTransferBatchG(ctx context.Context, related *TransferBatch) error {
+	return o.RemoveTransferBatch(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveTransferBatch relationship.
+// Sets o.R.TransferBatch to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *TransferJob) RemoveTransferBatch(ctx context.Context, exec boil.ContextExecutor, related *TransferBatch) error {
+	var err error
+
+	queries.SetScanner(&o.TransferBatchID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("transfer_batch_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.TransferBatch = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TransferJobs {
+		if queries.Equal(o.TransferBatchID, ri.TransferBatchID) {
+			continue
+		}
+
+		ln := len(related.R.TransferJobs)
+		if ln > 1 && i < ln-1 {
+			related.R.TransferJobs[i] = related.R.TransferJobs[ln-1]
+		}
+		related.R.TransferJobs = related.R.TransferJobs[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddLedgerTransactionsG adds the given related objects to the existing relationships
+// of the transfer_job, optionally inserting them as new records.
+// Appends related to o.R.LedgerTransactions.
+// Sets related.R.TransferJob appropriately.
+// Uses the global database handle.
+func (o *TransferJob) AddLedgerTransactionsG(ctx context.Context, insert bool, related ...*LedgerTransaction) error {
+	return o.AddLedgerTransactions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddLedgerTransactions adds the given related objects to the existing relationships
+// of the transfer_job, optionally inserting them as new records.
+// Appends related to o.R.LedgerTransactions.
+// Sets related.R.TransferJob appropriately.
+func (o *TransferJob) AddLedgerTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LedgerTransaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TransferJobID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"ledger_transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_job_id"}),
+				strmangle.WhereClause("\"", "\"", 2, ledgerTransactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TransferJobID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transferJobR{
+			LedgerTransactions: related,
+		}
+	} else {
+		o.R.LedgerTransactions = append(o.R.LedgerTransactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &ledgerTransactionR{
+				TransferJob: o,
+			}
+		} else {
+			rel.R.TransferJob = o
+		}
+	}
+	return nil
+}
+
+// SetLedgerTransactionsG removes all previously related items of the
+// transfer_job replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TransferJob's LedgerTransactions accordingly.
+// Replaces o.R.LedgerTransactions with related.
+// Sets related.R.TransferJob's LedgerTransactions accordingly.
+// Uses the global database handle.
+func (o *TransferJob) SetLedgerTransactionsG(ctx context.Context, insert bool, related ...*LedgerTransaction) error {
+	return o.SetLedgerTransactions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetLedgerTransactions removes all previously related items of the
+// transfer_job replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TransferJob's LedgerTransactions accordingly.
+// Replaces o.R.LedgerTransactions with related.
+// Sets related.R.TransferJob's LedgerTransactions accordingly.
+func (o *TransferJob) SetLedgerTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LedgerTransaction) error {
+	query := "update \"ledger_transactions\" set \"transfer_job_id\" = null where \"transfer_job_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.LedgerTransactions {
+			queries.SetScanner(&rel.TransferJobID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.TransferJob = nil
+		}
+		o.R.LedgerTransactions = nil
+	}
+
+	return o.AddLedgerTransactions(ctx, exec, insert, related...)
+}
+
+// RemoveLedgerTransactionsG relationships from objects passed in.
+// Removes related items from R.LedgerTransactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.TransferJob.
+// Uses the global database handle.
+func (o *TransferJob) RemoveLedgerTransactionsG(ctx context.Context, related ...*LedgerTransaction) error {
+	return o.RemoveLedgerTransactions(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveLedgerTransactions relationships from objects passed in.
+// Removes related items from R.LedgerTransactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.TransferJob.
+func (o *TransferJob) RemoveLedgerTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*LedgerTransaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TransferJobID, nil)
+		if rel.R != nil {
+			rel.R.TransferJob = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("transfer_job_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.LedgerTransactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.LedgerTransactions)
+			if ln > 1 && i < ln-1 {
+				o.R.LedgerTransactions[i] = o.R.LedgerTransactions[ln-1]
+			}
+			o.R.LedgerTransactions = o.R.LedgerTransactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddReconciliationDiscrepanciesG adds the given related objects to the existing relationships
+// of the transfer_job, optionally inserting them as new records.
+// Appends related to o.R.ReconciliationDiscrepancies.
+// Sets related.R.TransferJob appropriately.
+// Uses the global database handle.
+func (o *TransferJob) AddReconciliationDiscrepanciesG(ctx context.Context, insert bool, related ...*ReconciliationDiscrepancy) error {
+	return o.AddReconciliationDiscrepancies(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddReconciliationDiscrepancies adds the given related objects to the existing relationships
+// of the transfer_job, optionally inserting them as new records.
+// Appends related to o.R.ReconciliationDiscrepancies.
+// Sets related.R.TransferJob appropriately.
+func (o *TransferJob) AddReconciliationDiscrepancies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReconciliationDiscrepancy) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TransferJobID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_job_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reconciliationDiscrepancyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TransferJobID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transferJobR{
+			ReconciliationDiscrepancies: related,
+		}
+	} else {
+		o.R.ReconciliationDiscrepancies = append(o.R.ReconciliationDiscrepancies, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reconciliationDiscrepancyR{
+				TransferJob: o,
+			}
+		} else {
+			rel.R.TransferJob = o
+		}
+	}
+	return nil
+}
+
+// SetReconciliationDiscrepanciesG removes all previously related items of the
+// transfer_job replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TransferJob's ReconciliationDiscrepancies accordingly.
+// Replaces o.R.ReconciliationDiscrepancies with related.
+// Sets related.R.TransferJob's ReconciliationDiscrepancies accordingly.
+// Uses the global database handle.
+func (o *TransferJob) SetReconciliationDiscrepanciesG(ctx context.Context, insert bool, related ...*ReconciliationDiscrepancy) error {
+	return o.SetReconciliationDiscrepancies(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetReconciliationDiscrepancies removes all previously related items of the
+// transfer_job replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TransferJob's ReconciliationDiscrepancies accordingly.
+// Replaces o.R.ReconciliationDiscrepancies with related.
+// Sets related.R.TransferJob's ReconciliationDiscrepancies accordingly.
+func (o *TransferJob) SetReconciliationDiscrepancies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReconciliationDiscrepancy) error {
+	query := "update \"reconciliation_discrepancies\" set \"transfer_job_id\" = null where \"transfer_job_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ReconciliationDiscrepancies {
+			queries.SetScanner(&rel.TransferJobID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.TransferJob = nil
+		}
+		o.R.ReconciliationDiscrepancies = nil
+	}
+
+	return o.AddReconciliationDiscrepancies(ctx, exec, insert, related...)
+}
+
+// RemoveReconciliationDiscrepanciesG relationships from objects passed in.
+// Removes related items from R.ReconciliationDiscrepancies (uses pointer comparison, removal does not keep order)
+// Sets related.R.TransferJob.
+// Uses the global database handle.
+func (o *TransferJob) RemoveReconciliationDiscrepanciesG(ctx context.Context, related ...*ReconciliationDiscrepancy) error {
+	return o.RemoveReconciliationDiscrepancies(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveReconciliationDiscrepancies relationships from objects passed in.
+// Removes related items from R.ReconciliationDiscrepancies (uses pointer comparison, removal does not keep order)
+// Sets related.R.TransferJob.
+func (o *TransferJob) RemoveReconciliationDiscrepancies(ctx context.Context, exec boil.ContextExecutor, related ...*ReconciliationDiscrepancy) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TransferJobID, nil)
+		if rel.R != nil {
+			rel.R.TransferJob = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("transfer_job_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ReconciliationDiscrepancies {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ReconciliationDiscrepancies)
+			if ln > 1 && i < ln-1 {
+				o.R.ReconciliationDiscrepancies[i] = o.R.ReconciliationDiscrepancies[ln-1]
+			}
+			o.R.ReconciliationDiscrepancies = o.R.ReconciliationDiscrepancies[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddTransferJobEventsG adds the given related objects to the existing relationships
+// of the transfer_job, optionally inserting them as new records.
+// Appends related to o.R.TransferJobEvents.
+// Sets related.R.TransferJob appropriately.
+// Uses the global database handle.
+func (o *TransferJob) AddTransferJobEventsG(ctx context.Context, insert bool, related ...*TransferJobEvent) error {
+	return o.AddTransferJobEvents(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddTransferJobEvents adds the given related objects to the existing relationships
+// of the transfer_job, optionally inserting them as new records.
+// Appends related to o.R.TransferJobEvents.
+// Sets related.R.TransferJob appropriately.
+func (o *TransferJob) AddTransferJobEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TransferJobEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TransferJobID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transfer_job_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_job_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transferJobEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TransferJobID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transferJobR{
+			TransferJobEvents: related,
+		}
+	} else {
+		o.R.TransferJobEvents = append(o.R.TransferJobEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transferJobEventR{
+				TransferJob: o,
+			}
+		} else {
+			rel.R.TransferJob = o
+		}
+	}
+	return nil
+}
+
+// AddTransferQuotesG adds the given related objects to the existing relationships
+// of the transfer_job, optionally inserting them as new records.
+// Appends related to o.R.TransferQuotes.
+// Sets related.R.TransferJob appropriately.
+// Uses the global database handle.
+func (o *TransferJob) AddTransferQuotesG(ctx context.Context, insert bool, related ...*TransferQuote) error {
+	return o.AddTransferQuotes(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddTransferQuotes adds the given related objects to the existing relationships
+// of the transfer_job, optionally inserting them as new records.
+// Appends related to o.R.TransferQuotes.
+// Sets related.R.TransferJob appropriately.
+func (o *TransferJob) AddTransferQuotes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TransferQuote) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TransferJobID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transfer_quotes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_job_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transferQuotePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TransferJobID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &transferJobR{
+			TransferQuotes: related,
+		}
+	} else {
+		o.R.TransferQuotes = append(o.R.TransferQuotes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transferQuoteR{
+				TransferJob: o,
+			}
+		} else {
+			rel.R.TransferJob = o
+		}
+	}
+	return nil
+}
+
+// SetTransferQuotesG removes all previously related items of the
+// transfer_job replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TransferJob's TransferQuotes accordingly.
+// Replaces o.R.TransferQuotes with related.
+// Sets related.R.TransferJob's TransferQuotes accordingly.
+// Uses the global database handle.
+func (o *TransferJob) SetTransferQuotesG(ctx context.Context, insert bool, related ...*TransferQuote) error {
+	return o.SetTransferQuotes(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetTransferQuotes removes all previously related items of the
+// transfer_job replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TransferJob's TransferQuotes accordingly.
+// Replaces o.R.TransferQuotes with related.
+// Sets related.R.TransferJob's TransferQuotes accordingly.
+func (o *TransferJob) SetTransferQuotes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TransferQuote) error {
+	query := "update \"transfer_quotes\" set \"transfer_job_id\" = null where \"transfer_job_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TransferQuotes {
+			queries.SetScanner(&rel.TransferJobID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.TransferJob = nil
+		}
+		o.R.TransferQuotes = nil
+	}
+
+	return o.AddTransferQuotes(ctx, exec, insert, related...)
+}
+
+// RemoveTransferQuotesG relationships from objects passed in.
+// Removes related items from R.TransferQuotes (uses pointer comparison, removal does not keep order)
+// Sets related.R.TransferJob.
+// Uses the global database handle.
+func (o *TransferJob) RemoveTransferQuotesG(ctx context.Context, related ...*TransferQuote) error {
+	return o.RemoveTransferQuotes(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveTransferQuotes relationships from objects passed in.
+// Removes related items from R.TransferQuotes (uses pointer comparison, removal does not keep order)
+// Sets related.R.TransferJob.
+func (o *TransferJob) RemoveTransferQuotes(ctx context.Context, exec boil.ContextExecutor, related ...*TransferQuote) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TransferJobID, nil)
+		if rel.R != nil {
+			rel.R.TransferJob = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("transfer_job_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TransferQuotes {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TransferQuotes)
+			if ln > 1 && i < ln-1 {
+				o.R.TransferQuotes[i] = o.R.TransferQuotes[ln-1]
+			}
+			o.R.TransferQuotes = o.R.TransferQuotes[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // TransferJobs retrieves all the records using an executor.
 func TransferJobs(mods ...qm.QueryMod) transferJobQuery {
 	mods = append(mods, qm.From("\"transfer_jobs\""), qmhelper.WhereIsNull("\"transfer_jobs\".\"deleted_at\""))
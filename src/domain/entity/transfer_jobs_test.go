@@ -595,6 +595,1653 @@ func testTransferJobsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testTransferJobToManyLedgerTransactions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c LedgerTransaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, true, transferJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJob struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, ledgerTransactionDBTypes, false, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, ledgerTransactionDBTypes, false, ledgerTransactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.TransferJobID, a.ID)
+	queries.Assign(&c.TransferJobID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.LedgerTransactions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.TransferJobID, b.TransferJobID) {
+			bFound = true
+		}
+		if queries.Equal(v.TransferJobID, c.TransferJobID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransferJobSlice{&a}
+	if err = a.L.LoadLedgerTransactions(ctx, tx, false, (*[]*TransferJob)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.LedgerTransactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.LedgerTransactions = nil
+	if err = a.L.LoadLedgerTransactions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.LedgerTransactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransferJobToManyReconciliationDiscrepancies(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c ReconciliationDiscrepancy
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, true, transferJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJob struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, reconciliationDiscrepancyDBTypes, false, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, reconciliationDiscrepancyDBTypes, false, reconciliationDiscrepancyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.TransferJobID, a.ID)
+	queries.Assign(&c.TransferJobID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ReconciliationDiscrepancies().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.TransferJobID, b.TransferJobID) {
+			bFound = true
+		}
+		if queries.Equal(v.TransferJobID, c.TransferJobID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransferJobSlice{&a}
+	if err = a.L.LoadReconciliationDiscrepancies(ctx, tx, false, (*[]*TransferJob)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ReconciliationDiscrepancies); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ReconciliationDiscrepancies = nil
+	if err = a.L.LoadReconciliationDiscrepancies(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ReconciliationDiscrepancies); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransferJobToManyTransferJobEvents(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c TransferJobEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, true, transferJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJob struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transferJobEventDBTypes, false, transferJobEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferJobEventDBTypes, false, transferJobEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.TransferJobID = a.ID
+	c.TransferJobID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.TransferJobEvents().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.TransferJobID == b.TransferJobID {
+			bFound = true
+		}
+		if v.TransferJobID == c.TransferJobID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransferJobSlice{&a}
+	if err = a.L.LoadTransferJobEvents(ctx, tx, false, (*[]*TransferJob)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TransferJobEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TransferJobEvents = nil
+	if err = a.L.LoadTransferJobEvents(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TransferJobEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransferJobToManyTransferQuotes(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c TransferQuote
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, true, transferJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJob struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transferQuoteDBTypes, false, transferQuoteColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferQuoteDBTypes, false, transferQuoteColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.TransferJobID, a.ID)
+	queries.Assign(&c.TransferJobID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.TransferQuotes().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.TransferJobID, b.TransferJobID) {
+			bFound = true
+		}
+		if queries.Equal(v.TransferJobID, c.TransferJobID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TransferJobSlice{&a}
+	if err = a.L.LoadTransferQuotes(ctx, tx, false, (*[]*TransferJob)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TransferQuotes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TransferQuotes = nil
+	if err = a.L.LoadTransferQuotes(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TransferQuotes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTransferJobToManyAddOpLedgerTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c, d, e LedgerTransaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*LedgerTransaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, ledgerTransactionDBTypes, false, strmangle.SetComplement(ledgerTransactionPrimaryKeyColumns, ledgerTransactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*LedgerTransaction{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddLedgerTransactions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.TransferJobID) {
+			t.Error("foreign key was wrong value", a.ID, first.TransferJobID)
+		}
+		if !queries.Equal(a.ID, second.TransferJobID) {
+			t.Error("foreign key was wrong value", a.ID, second.TransferJobID)
+		}
+
+		if first.R.TransferJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.TransferJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.LedgerTransactions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.LedgerTransactions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.LedgerTransactions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTransferJobToManySetOpLedgerTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c, d, e LedgerTransaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*LedgerTransaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, ledgerTransactionDBTypes, false, strmangle.SetComplement(ledgerTransactionPrimaryKeyColumns, ledgerTransactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetLedgerTransactions(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetLedgerTransactions(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransferJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransferJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.TransferJobID) {
+		t.Error("foreign key was wrong value", a.ID, d.TransferJobID)
+	}
+	if !queries.Equal(a.ID, e.TransferJobID) {
+		t.Error("foreign key was wrong value", a.ID, e.TransferJobID)
+	}
+
+	if b.R.TransferJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.TransferJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.TransferJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.TransferJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.LedgerTransactions[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.LedgerTransactions[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTransferJobToManyRemoveOpLedgerTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c, d, e LedgerTransaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*LedgerTransaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, ledgerTransactionDBTypes, false, strmangle.SetComplement(ledgerTransactionPrimaryKeyColumns, ledgerTransactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddLedgerTransactions(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveLedgerTransactions(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.LedgerTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransferJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransferJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.TransferJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.TransferJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.TransferJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.TransferJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.LedgerTransactions) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.LedgerTransactions[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.LedgerTransactions[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testTransferJobToManyAddOpReconciliationDiscrepancies(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c, d, e ReconciliationDiscrepancy
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ReconciliationDiscrepancy{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, reconciliationDiscrepancyDBTypes, false, strmangle.SetComplement(reconciliationDiscrepancyPrimaryKeyColumns, reconciliationDiscrepancyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ReconciliationDiscrepancy{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddReconciliationDiscrepancies(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.TransferJobID) {
+			t.Error("foreign key was wrong value", a.ID, first.TransferJobID)
+		}
+		if !queries.Equal(a.ID, second.TransferJobID) {
+			t.Error("foreign key was wrong value", a.ID, second.TransferJobID)
+		}
+
+		if first.R.TransferJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.TransferJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ReconciliationDiscrepancies[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ReconciliationDiscrepancies[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ReconciliationDiscrepancies().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTransferJobToManySetOpReconciliationDiscrepancies(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c, d, e ReconciliationDiscrepancy
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ReconciliationDiscrepancy{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, reconciliationDiscrepancyDBTypes, false, strmangle.SetComplement(reconciliationDiscrepancyPrimaryKeyColumns, reconciliationDiscrepancyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetReconciliationDiscrepancies(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetReconciliationDiscrepancies(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransferJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransferJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.TransferJobID) {
+		t.Error("foreign key was wrong value", a.ID, d.TransferJobID)
+	}
+	if !queries.Equal(a.ID, e.TransferJobID) {
+		t.Error("foreign key was wrong value", a.ID, e.TransferJobID)
+	}
+
+	if b.R.TransferJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.TransferJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.TransferJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.TransferJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ReconciliationDiscrepancies[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ReconciliationDiscrepancies[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTransferJobToManyRemoveOpReconciliationDiscrepancies(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c, d, e ReconciliationDiscrepancy
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ReconciliationDiscrepancy{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, reconciliationDiscrepancyDBTypes, false, strmangle.SetComplement(reconciliationDiscrepancyPrimaryKeyColumns, reconciliationDiscrepancyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddReconciliationDiscrepancies(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveReconciliationDiscrepancies(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ReconciliationDiscrepancies().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransferJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransferJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.TransferJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.TransferJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.TransferJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.TransferJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ReconciliationDiscrepancies) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ReconciliationDiscrepancies[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ReconciliationDiscrepancies[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testTransferJobToManyAddOpTransferJobEvents(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c, d, e TransferJobEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferJobEvent{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferJobEventDBTypes, false, strmangle.SetComplement(transferJobEventPrimaryKeyColumns, transferJobEventColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*TransferJobEvent{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTransferJobEvents(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.TransferJobID {
+			t.Error("foreign key was wrong value", a.ID, first.TransferJobID)
+		}
+		if a.ID != second.TransferJobID {
+			t.Error("foreign key was wrong value", a.ID, second.TransferJobID)
+		}
+
+		if first.R.TransferJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.TransferJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.TransferJobEvents[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.TransferJobEvents[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.TransferJobEvents().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testTransferJobToManyAddOpTransferQuotes(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c, d, e TransferQuote
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferQuote{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferQuoteDBTypes, false, strmangle.SetComplement(transferQuotePrimaryKeyColumns, transferQuoteColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*TransferQuote{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTransferQuotes(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.TransferJobID) {
+			t.Error("foreign key was wrong value", a.ID, first.TransferJobID)
+		}
+		if !queries.Equal(a.ID, second.TransferJobID) {
+			t.Error("foreign key was wrong value", a.ID, second.TransferJobID)
+		}
+
+		if first.R.TransferJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.TransferJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.TransferQuotes[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.TransferQuotes[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.TransferQuotes().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTransferJobToManySetOpTransferQuotes(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c, d, e TransferQuote
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferQuote{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferQuoteDBTypes, false, strmangle.SetComplement(transferQuotePrimaryKeyColumns, transferQuoteColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetTransferQuotes(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetTransferQuotes(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransferJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransferJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.TransferJobID) {
+		t.Error("foreign key was wrong value", a.ID, d.TransferJobID)
+	}
+	if !queries.Equal(a.ID, e.TransferJobID) {
+		t.Error("foreign key was wrong value", a.ID, e.TransferJobID)
+	}
+
+	if b.R.TransferJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.TransferJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.TransferJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.TransferJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.TransferQuotes[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.TransferQuotes[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTransferJobToManyRemoveOpTransferQuotes(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c, d, e TransferQuote
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TransferQuote{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transferQuoteDBTypes, false, strmangle.SetComplement(transferQuotePrimaryKeyColumns, transferQuoteColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddTransferQuotes(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveTransferQuotes(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.TransferJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.TransferJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.TransferJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.TransferJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.TransferJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.TransferJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.TransferQuotes) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.TransferQuotes[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.TransferQuotes[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testTransferJobToOneFeeRuleUsingFeeRule(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local TransferJob
+	var foreign FeeRule
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transferJobDBTypes, true, transferJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJob struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, feeRuleDBTypes, false, feeRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FeeRule struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.FeeRuleID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.FeeRule().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddFeeRuleHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *FeeRule) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := TransferJobSlice{&local}
+	if err = local.L.LoadFeeRule(ctx, tx, false, (*[]*TransferJob)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.FeeRule == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.FeeRule = nil
+	if err = local.L.LoadFeeRule(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.FeeRule == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testTransferJobToOneRecurringTransferUsingRecurringTransfer(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local TransferJob
+	var foreign RecurringTransfer
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transferJobDBTypes, true, transferJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJob struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, recurringTransferDBTypes, false, recurringTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransfer struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.RecurringTransferID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.RecurringTransfer().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddRecurringTransferHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *RecurringTransfer) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := TransferJobSlice{&local}
+	if err = local.L.LoadRecurringTransfer(ctx, tx, false, (*[]*TransferJob)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.RecurringTransfer == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.RecurringTransfer = nil
+	if err = local.L.LoadRecurringTransfer(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.RecurringTransfer == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testTransferJobToOneTransferBatchUsingTransferBatch(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local TransferJob
+	var foreign TransferBatch
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transferJobDBTypes, true, transferJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJob struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, transferBatchDBTypes, false, transferBatchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferBatch struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.TransferBatchID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.TransferBatch().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddTransferBatchHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *TransferBatch) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := TransferJobSlice{&local}
+	if err = local.L.LoadTransferBatch(ctx, tx, false, (*[]*TransferJob)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TransferBatch == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.TransferBatch = nil
+	if err = local.L.LoadTransferBatch(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TransferBatch == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testTransferJobToOneSetOpFeeRuleUsingFeeRule(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c FeeRule
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, feeRuleDBTypes, false, strmangle.SetComplement(feeRulePrimaryKeyColumns, feeRuleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, feeRuleDBTypes, false, strmangle.SetComplement(feeRulePrimaryKeyColumns, feeRuleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*FeeRule{&b, &c} {
+		err = a.SetFeeRule(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.FeeRule != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TransferJobs[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.FeeRuleID, x.ID) {
+			t.Error("foreign key was wrong value", a.FeeRuleID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.FeeRuleID))
+		reflect.Indirect(reflect.ValueOf(&a.FeeRuleID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.FeeRuleID, x.ID) {
+			t.Error("foreign key was wrong value", a.FeeRuleID, x.ID)
+		}
+	}
+}
+
+func testTransferJobToOneRemoveOpFeeRuleUsingFeeRule(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b FeeRule
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, feeRuleDBTypes, false, strmangle.SetComplement(feeRulePrimaryKeyColumns, feeRuleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetFeeRule(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveFeeRule(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.FeeRule().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.FeeRule != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.FeeRuleID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.TransferJobs) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testTransferJobToOneSetOpRecurringTransferUsingRecurringTransfer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c RecurringTransfer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, recurringTransferDBTypes, false, strmangle.SetComplement(recurringTransferPrimaryKeyColumns, recurringTransferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, recurringTransferDBTypes, false, strmangle.SetComplement(recurringTransferPrimaryKeyColumns, recurringTransferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*RecurringTransfer{&b, &c} {
+		err = a.SetRecurringTransfer(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.RecurringTransfer != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TransferJobs[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.RecurringTransferID, x.ID) {
+			t.Error("foreign key was wrong value", a.RecurringTransferID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RecurringTransferID))
+		reflect.Indirect(reflect.ValueOf(&a.RecurringTransferID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.RecurringTransferID, x.ID) {
+			t.Error("foreign key was wrong value", a.RecurringTransferID, x.ID)
+		}
+	}
+}
+
+func testTransferJobToOneRemoveOpRecurringTransferUsingRecurringTransfer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b RecurringTransfer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, recurringTransferDBTypes, false, strmangle.SetComplement(recurringTransferPrimaryKeyColumns, recurringTransferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetRecurringTransfer(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveRecurringTransfer(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.RecurringTransfer().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.RecurringTransfer != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.RecurringTransferID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.TransferJobs) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testTransferJobToOneSetOpTransferBatchUsingTransferBatch(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b, c TransferBatch
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transferBatchDBTypes, false, strmangle.SetComplement(transferBatchPrimaryKeyColumns, transferBatchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferBatchDBTypes, false, strmangle.SetComplement(transferBatchPrimaryKeyColumns, transferBatchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*TransferBatch{&b, &c} {
+		err = a.SetTransferBatch(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.TransferBatch != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TransferJobs[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.TransferBatchID, x.ID) {
+			t.Error("foreign key was wrong value", a.TransferBatchID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TransferBatchID))
+		reflect.Indirect(reflect.ValueOf(&a.TransferBatchID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.TransferBatchID, x.ID) {
+			t.Error("foreign key was wrong value", a.TransferBatchID, x.ID)
+		}
+	}
+}
+
+func testTransferJobToOneRemoveOpTransferBatchUsingTransferBatch(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferJob
+	var b TransferBatch
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transferBatchDBTypes, false, strmangle.SetComplement(transferBatchPrimaryKeyColumns, transferBatchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetTransferBatch(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveTransferBatch(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.TransferBatch().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.TransferBatch != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.TransferBatchID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.TransferJobs) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
 func testTransferJobsReload(t *testing.T) {
 	t.Parallel()
 
@@ -669,7 +2316,7 @@ func testTransferJobsSelect(t *testing.T) {
 }
 
 var (
-	transferJobDBTypes = map[string]string{`ID`: `integer`, `JobID`: `character varying`, `APIKey`: `text`, `Payload`: `jsonb`, `Status`: `enum.transferstatus('failed','pending','success')`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	transferJobDBTypes = map[string]string{`ID`: `integer`, `JobID`: `character varying`, `APIKey`: `text`, `Payload`: `jsonb`, `Status`: `enum.transferstatus('cancelled','failed','pending','processing','scheduled','success')`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`, `TransferBatchID`: `integer`, `ScheduledAt`: `timestamp with time zone`, `RecurringTransferID`: `integer`, `AttemptCount`: `integer`, `NextAttemptAt`: `timestamp with time zone`, `LastError`: `text`, `Conversion`: `jsonb`, `FeeAmount`: `bigint`, `FeeRuleID`: `integer`, `LeaseOwner`: `text`, `LeaseExpiresAt`: `timestamp with time zone`, `Reference`: `text`}
 	_                  = bytes.MinRead
 )
 
@@ -0,0 +1,1433 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// TransferQuote is an object representing the database table.
+type TransferQuote struct {
+	ID                  int           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	QuoteID             string        `boil:"quote_id" json:"quote_id" toml:"quote_id" yaml:"quote_id"`
+	APIKey              string        `boil:"api_key" json:"api_key" toml:"api_key" yaml:"api_key"`
+	SourceCurrency      string        `boil:"source_currency" json:"source_currency" toml:"source_currency" yaml:"source_currency"`
+	DestinationCurrency string        `boil:"destination_currency" json:"destination_currency" toml:"destination_currency" yaml:"destination_currency"`
+	SourceAmount        int64         `boil:"source_amount" json:"source_amount" toml:"source_amount" yaml:"source_amount"`
+	DestinationAmount   int64         `boil:"destination_amount" json:"destination_amount" toml:"destination_amount" yaml:"destination_amount"`
+	Rate                types.Decimal `boil:"rate" json:"rate" toml:"rate" yaml:"rate"`
+	FeeAmount           int64         `boil:"fee_amount" json:"fee_amount" toml:"fee_amount" yaml:"fee_amount"`
+	ExpiresAt           time.Time     `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	TransferJobID       null.Int      `boil:"transfer_job_id" json:"transfer_job_id,omitempty" toml:"transfer_job_id" yaml:"transfer_job_id,omitempty"`
+	CreatedBy           int           `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt           time.Time     `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy           int           `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt           time.Time     `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy           null.Int      `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt           null.Time     `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *transferQuoteR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L transferQuoteL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TransferQuoteColumns = struct {
+	ID                  string
+	QuoteID             string
+	APIKey              string
+	SourceCurrency      string
+	DestinationCurrency string
+	SourceAmount        string
+	DestinationAmount   string
+	Rate                string
+	FeeAmount           string
+	ExpiresAt           string
+	TransferJobID       string
+	CreatedBy           string
+	CreatedAt           string
+	UpdatedBy           string
+	UpdatedAt           string
+	DeletedBy           string
+	DeletedAt           string
+}{
+	ID:                  "id",
+	QuoteID:             "quote_id",
+	APIKey:              "api_key",
+	SourceCurrency:      "source_currency",
+	DestinationCurrency: "destination_currency",
+	SourceAmount:        "source_amount",
+	DestinationAmount:   "destination_amount",
+	Rate:                "rate",
+	FeeAmount:           "fee_amount",
+	ExpiresAt:           "expires_at",
+	TransferJobID:       "transfer_job_id",
+	CreatedBy:           "created_by",
+	CreatedAt:           "created_at",
+	UpdatedBy:           "updated_by",
+	UpdatedAt:           "updated_at",
+	DeletedBy:           "deleted_by",
+	DeletedAt:           "deleted_at",
+}
+
+var TransferQuoteTableColumns = struct {
+	ID                  string
+	QuoteID             string
+	APIKey              string
+	SourceCurrency      string
+	DestinationCurrency string
+	SourceAmount        string
+	DestinationAmount   string
+	Rate                string
+	FeeAmount           string
+	ExpiresAt           string
+	TransferJobID       string
+	CreatedBy           string
+	CreatedAt           string
+	UpdatedBy           string
+	UpdatedAt           string
+	DeletedBy           string
+	DeletedAt           string
+}{
+	ID:                  "transfer_quotes.id",
+	QuoteID:             "transfer_quotes.quote_id",
+	APIKey:              "transfer_quotes.api_key",
+	SourceCurrency:      "transfer_quotes.source_currency",
+	DestinationCurrency: "transfer_quotes.destination_currency",
+	SourceAmount:        "transfer_quotes.source_amount",
+	DestinationAmount:   "transfer_quotes.destination_amount",
+	Rate:                "transfer_quotes.rate",
+	FeeAmount:           "transfer_quotes.fee_amount",
+	ExpiresAt:           "transfer_quotes.expires_at",
+	TransferJobID:       "transfer_quotes.transfer_job_id",
+	CreatedBy:           "transfer_quotes.created_by",
+	CreatedAt:           "transfer_quotes.created_at",
+	UpdatedBy:           "transfer_quotes.updated_by",
+	UpdatedAt:           "transfer_quotes.updated_at",
+	DeletedBy:           "transfer_quotes.deleted_by",
+	DeletedAt:           "transfer_quotes.deleted_at",
+}
+
+// Generated where
+
+var TransferQuoteWhere = struct {
+	ID                  whereHelperint
+	QuoteID             whereHelperstring
+	APIKey              whereHelperstring
+	SourceCurrency      whereHelperstring
+	DestinationCurrency whereHelperstring
+	SourceAmount        whereHelperint64
+	DestinationAmount   whereHelperint64
+	Rate                whereHelpertypes_Decimal
+	FeeAmount           whereHelperint64
+	ExpiresAt           whereHelpertime_Time
+	TransferJobID       whereHelpernull_Int
+	CreatedBy           whereHelperint
+	CreatedAt           whereHelpertime_Time
+	UpdatedBy           whereHelperint
+	UpdatedAt           whereHelpertime_Time
+	DeletedBy           whereHelpernull_Int
+	DeletedAt           whereHelpernull_Time
+}{
+	ID:                  whereHelperint{field: "\"transfer_quotes\".\"id\""},
+	QuoteID:             whereHelperstring{field: "\"transfer_quotes\".\"quote_id\""},
+	APIKey:              whereHelperstring{field: "\"transfer_quotes\".\"api_key\""},
+	SourceCurrency:      whereHelperstring{field: "\"transfer_quotes\".\"source_currency\""},
+	DestinationCurrency: whereHelperstring{field: "\"transfer_quotes\".\"destination_currency\""},
+	SourceAmount:        whereHelperint64{field: "\"transfer_quotes\".\"source_amount\""},
+	DestinationAmount:   whereHelperint64{field: "\"transfer_quotes\".\"destination_amount\""},
+	Rate:                whereHelpertypes_Decimal{field: "\"transfer_quotes\".\"rate\""},
+	FeeAmount:           whereHelperint64{field: "\"transfer_quotes\".\"fee_amount\""},
+	ExpiresAt:           whereHelpertime_Time{field: "\"transfer_quotes\".\"expires_at\""},
+	TransferJobID:       whereHelpernull_Int{field: "\"transfer_quotes\".\"transfer_job_id\""},
+	CreatedBy:           whereHelperint{field: "\"transfer_quotes\".\"created_by\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"transfer_quotes\".\"created_at\""},
+	UpdatedBy:           whereHelperint{field: "\"transfer_quotes\".\"updated_by\""},
+	UpdatedAt:           whereHelpertime_Time{field: "\"transfer_quotes\".\"updated_at\""},
+	DeletedBy:           whereHelpernull_Int{field: "\"transfer_quotes\".\"deleted_by\""},
+	DeletedAt:           whereHelpernull_Time{field: "\"transfer_quotes\".\"deleted_at\""},
+}
+
+// TransferQuoteRels is where relationship names are stored.
+var TransferQuoteRels = struct {
+	TransferJob string
+}{
+	TransferJob: "TransferJob",
+}
+
+// transferQuoteR is where relationships are stored.
+type transferQuoteR struct {
+	TransferJob *TransferJob `boil:"TransferJob" json:"TransferJob" toml:"TransferJob" yaml:"TransferJob"`
+}
+
+// NewStruct creates a new relationship struct
+func (*transferQuoteR) NewStruct() *transferQuoteR {
+	return &transferQuoteR{}
+}
+
+func (r *transferQuoteR) GetTransferJob() *TransferJob {
+	if r == nil {
+		return nil
+	}
+	return r.TransferJob
+}
+
+// transferQuoteL is where Load methods for each relationship are stored.
+type transferQuoteL struct{}
+
+var (
+	transferQuoteAllColumns            = []string{"id", "quote_id", "api_key", "source_currency", "destination_currency", "source_amount", "destination_amount", "rate", "fee_amount", "expires_at", "transfer_job_id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	transferQuoteColumnsWithoutDefault = []string{"quote_id", "api_key", "source_currency", "destination_currency", "source_amount", "destination_amount", "rate", "expires_at"}
+	transferQuoteColumnsWithDefault    = []string{"id", "fee_amount", "transfer_job_id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	transferQuotePrimaryKeyColumns     = []string{"id"}
+	transferQuoteGeneratedColumns      = []string{}
+)
+
+type (
+	// TransferQuoteSlice is an alias for a slice of pointers to TransferQuote.
+	// This should almost always be used instead of []TransferQuote.
+	TransferQuoteSlice []*TransferQuote
+	// TransferQuoteHook is the signature for custom TransferQuote hook methods
+	TransferQuoteHook func(context.Context, boil.ContextExecutor, *TransferQuote) error
+
+	transferQuoteQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	transferQuoteType                 = reflect.TypeOf(&TransferQuote{})
+	transferQuoteMapping              = queries.MakeStructMapping(transferQuoteType)
+	transferQuotePrimaryKeyMapping, _ = queries.BindMapping(transferQuoteType, transferQuoteMapping, transferQuotePrimaryKeyColumns)
+	transferQuoteInsertCacheMut       sync.RWMutex
+	transferQuoteInsertCache          = make(map[string]insertCache)
+	transferQuoteUpdateCacheMut       sync.RWMutex
+	transferQuoteUpdateCache          = make(map[string]updateCache)
+	transferQuoteUpsertCacheMut       sync.RWMutex
+	transferQuoteUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var transferQuoteAfterSelectMu sync.Mutex
+var transferQuoteAfterSelectHooks []TransferQuoteHook
+
+var transferQuoteBeforeInsertMu sync.Mutex
+var transferQuoteBeforeInsertHooks []TransferQuoteHook
+var transferQuoteAfterInsertMu sync.Mutex
+var transferQuoteAfterInsertHooks []TransferQuoteHook
+
+var transferQuoteBeforeUpdateMu sync.Mutex
+var transferQuoteBeforeUpdateHooks []TransferQuoteHook
+var transferQuoteAfterUpdateMu sync.Mutex
+var transferQuoteAfterUpdateHooks []TransferQuoteHook
+
+var transferQuoteBeforeDeleteMu sync.Mutex
+var transferQuoteBeforeDeleteHooks []TransferQuoteHook
+var transferQuoteAfterDeleteMu sync.Mutex
+var transferQuoteAfterDeleteHooks []TransferQuoteHook
+
+var transferQuoteBeforeUpsertMu sync.Mutex
+var transferQuoteBeforeUpsertHooks []TransferQuoteHook
+var transferQuoteAfterUpsertMu sync.Mutex
+var transferQuoteAfterUpsertHooks []TransferQuoteHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TransferQuote) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferQuoteAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TransferQuote) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferQuoteBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TransferQuote) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferQuoteAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TransferQuote) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferQuoteBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TransferQuote) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferQuoteAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TransferQuote) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferQuoteBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TransferQuote) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferQuoteAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TransferQuote) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferQuoteBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TransferQuote) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferQuoteAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTransferQuoteHook registers your hook function for all future operations.
+func AddTransferQuoteHook(hookPoint boil.HookPoint, transferQuoteHook TransferQuoteHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		transferQuoteAfterSelectMu.Lock()
+		transferQuoteAfterSelectHooks = append(transferQuoteAfterSelectHooks, transferQuoteHook)
+		transferQuoteAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		transferQuoteBeforeInsertMu.Lock()
+		transferQuoteBeforeInsertHooks = append(transferQuoteBeforeInsertHooks, transferQuoteHook)
+		transferQuoteBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		transferQuoteAfterInsertMu.Lock()
+		transferQuoteAfterInsertHooks = append(transferQuoteAfterInsertHooks, transferQuoteHook)
+		transferQuoteAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		transferQuoteBeforeUpdateMu.Lock()
+		transferQuoteBeforeUpdateHooks = append(transferQuoteBeforeUpdateHooks, transferQuoteHook)
+		transferQuoteBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		transferQuoteAfterUpdateMu.Lock()
+		transferQuoteAfterUpdateHooks = append(transferQuoteAfterUpdateHooks, transferQuoteHook)
+		transferQuoteAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		transferQuoteBeforeDeleteMu.Lock()
+		transferQuoteBeforeDeleteHooks = append(transferQuoteBeforeDeleteHooks, transferQuoteHook)
+		transferQuoteBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		transferQuoteAfterDeleteMu.Lock()
+		transferQuoteAfterDeleteHooks = append(transferQuoteAfterDeleteHooks, transferQuoteHook)
+		transferQuoteAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		transferQuoteBeforeUpsertMu.Lock()
+		transferQuoteBeforeUpsertHooks = append(transferQuoteBeforeUpsertHooks, transferQuoteHook)
+		transferQuoteBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		transferQuoteAfterUpsertMu.Lock()
+		transferQuoteAfterUpsertHooks = append(transferQuoteAfterUpsertHooks, transferQuoteHook)
+		transferQuoteAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single transferQuote record from the query using the global executor.
+func (q transferQuoteQuery) OneG(ctx context.Context) (*TransferQuote, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single transferQuote record from the query.
+func (q transferQuoteQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TransferQuote, error) {
+	o := &TransferQuote{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for transfer_quotes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all TransferQuote records from the query using the global executor.
+func (q transferQuoteQuery) AllG(ctx context.Context) (TransferQuoteSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all TransferQuote records from the query.
+func (q transferQuoteQuery) All(ctx context.Context, exec boil.ContextExecutor) (TransferQuoteSlice, error) {
+	var o []*TransferQuote
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to TransferQuote slice")
+	}
+
+	if len(transferQuoteAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all TransferQuote records in the query using the global executor
+func (q transferQuoteQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all TransferQuote records in the query.
+func (q transferQuoteQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count transfer_quotes rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q transferQuoteQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q transferQuoteQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if transfer_quotes exists")
+	}
+
+	return count > 0, nil
+}
+
+// TransferJob pointed to by the foreign key.
+func (o *TransferQuote) TransferJob(mods ...qm.QueryMod) transferJobQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TransferJobID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TransferJobs(queryMods...)
+}
+
+// LoadTransferJob allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transferQuoteL) LoadTransferJob(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransferQuote interface{}, mods queries.Applicator) error {
+	var slice []*TransferQuote
+	var object *TransferQuote
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransferQuote.(*TransferQuote)
+		if !ok {
+			object = new(TransferQuote)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransferQuote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransferQuote))
+			}
+		}
+	} else {
+		s, ok := maybeTransferQuote.(*[]*TransferQuote)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransferQuote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransferQuote))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transferQuoteR{}
+		}
+		if !queries.IsNil(object.TransferJobID) {
+			args[object.TransferJobID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transferQuoteR{}
+			}
+
+			if !queries.IsNil(obj.TransferJobID) {
+				args[obj.TransferJobID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transfer_jobs`),
+		qm.WhereIn(`transfer_jobs.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`transfer_jobs.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TransferJob")
+	}
+
+	var resultSlice []*TransferJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TransferJob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for transfer_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer_jobs")
+	}
+
+	if len(transferJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TransferJob = foreign
+		if foreign.R == nil {
+			foreign.R = &transferJobR{}
+		}
+		foreign.R.TransferQuotes = append(foreign.R.TransferQuotes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TransferJobID, foreign.ID) {
+				local.R.TransferJob = foreign
+				if foreign.R == nil {
+					foreign.R = &transferJobR{}
+				}
+				foreign.R.TransferQuotes = append(foreign.R.TransferQuotes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTransferJobG of the transferQuote to the related item.
+// Sets o.R.TransferJob to related.
+// Adds o to related.R.TransferQuotes.
+// Uses the global database handle.
+func (o *TransferQuote) SetTransferJobG(ctx context.Context, insert bool, related *TransferJob) error {
+	return o.SetTransferJob(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetTransferJob of the transferQuote to the related item.
+// Sets o.R.TransferJob to related.
+// Adds o to related.R.TransferQuotes.
+func (o *TransferQuote) SetTransferJob(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TransferJob) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transfer_quotes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_job_id"}),
+		strmangle.WhereClause("\"", "\"", 2, transferQuotePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TransferJobID, related.ID)
+	if o.R == nil {
+		o.R = &transferQuoteR{
+			TransferJob: related,
+		}
+	} else {
+		o.R.TransferJob = related
+	}
+
+	if related.R == nil {
+		related.R = &transferJobR{
+			TransferQuotes: TransferQuoteSlice{o},
+		}
+	} else {
+		related.R.TransferQuotes = append(related.R.TransferQuotes, o)
+	}
+
+	return nil
+}
+
+// RemoveTransferJobG relationship.
+// Sets o.R.TransferJob to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *TransferQuote) RemoveTransferJobG(ctx context.Context, related *TransferJob) error {
+	return o.RemoveTransferJob(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveTransferJob relationship.
+// Sets o.R.TransferJob to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *TransferQuote) RemoveTransferJob(ctx context.Context, exec boil.ContextExecutor, related *TransferJob) error {
+	var err error
+
+	queries.SetScanner(&o.TransferJobID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("transfer_job_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.TransferJob = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TransferQuotes {
+		if queries.Equal(o.TransferJobID, ri.TransferJobID) {
+			continue
+		}
+
+		ln := len(related.R.TransferQuotes)
+		if ln > 1 && i < ln-1 {
+			related.R.TransferQuotes[i] = related.R.TransferQuotes[ln-1]
+		}
+		related.R.TransferQuotes = related.R.TransferQuotes[:ln-1]
+		break
+	}
+	return nil
+}
+
+// TransferQuotes retrieves all the records using an executor.
+func TransferQuotes(mods ...qm.QueryMod) transferQuoteQuery {
+	mods = append(mods, qm.From("\"transfer_quotes\""), qmhelper.WhereIsNull("\"transfer_quotes\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"transfer_quotes\".*"})
+	}
+
+	return transferQuoteQuery{q}
+}
+
+// FindTransferQuoteG retrieves a single record by ID.
+func FindTransferQuoteG(ctx context.Context, iD int, selectCols ...string) (*TransferQuote, error) {
+	return FindTransferQuote(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindTransferQuote retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTransferQuote(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TransferQuote, error) {
+	transferQuoteObj := &TransferQuote{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"transfer_quotes\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, transferQuoteObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from transfer_quotes")
+	}
+
+	if err = transferQuoteObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return transferQuoteObj, err
+	}
+
+	return transferQuoteObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *TransferQuote) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TransferQuote) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no transfer_quotes provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transferQuoteColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	transferQuoteInsertCacheMut.RLock()
+	cache, cached := transferQuoteInsertCache[key]
+	transferQuoteInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			transferQuoteAllColumns,
+			transferQuoteColumnsWithDefault,
+			transferQuoteColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(transferQuoteType, transferQuoteMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(transferQuoteType, transferQuoteMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"transfer_quotes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"transfer_quotes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into transfer_quotes")
+	}
+
+	if !cached {
+		transferQuoteInsertCacheMut.Lock()
+		transferQuoteInsertCache[key] = cache
+		transferQuoteInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single TransferQuote record using the global executor.
+// See Update for more documentation.
+func (o *TransferQuote) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the TransferQuote.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TransferQuote) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	transferQuoteUpdateCacheMut.RLock()
+	cache, cached := transferQuoteUpdateCache[key]
+	transferQuoteUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			transferQuoteAllColumns,
+			transferQuotePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update transfer_quotes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"transfer_quotes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, transferQuotePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(transferQuoteType, transferQuoteMapping, append(wl, transferQuotePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update transfer_quotes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for transfer_quotes")
+	}
+
+	if !cached {
+		transferQuoteUpdateCacheMut.Lock()
+		transferQuoteUpdateCache[key] = cache
+		transferQuoteUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q transferQuoteQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q transferQuoteQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for transfer_quotes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for transfer_quotes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o TransferQuoteSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TransferQuoteSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferQuotePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"transfer_quotes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, transferQuotePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in transferQuote slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all transferQuote")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *TransferQuote) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TransferQuote) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no transfer_quotes provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transferQuoteColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	transferQuoteUpsertCacheMut.RLock()
+	cache, cached := transferQuoteUpsertCache[key]
+	transferQuoteUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			transferQuoteAllColumns,
+			transferQuoteColumnsWithDefault,
+			transferQuoteColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			transferQuoteAllColumns,
+			transferQuotePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert transfer_quotes, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(transferQuoteAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(transferQuotePrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert transfer_quotes, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(transferQuotePrimaryKeyColumns))
+			copy(conflict, transferQuotePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"transfer_quotes\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(transferQuoteType, transferQuoteMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(transferQuoteType, transferQuoteMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert transfer_quotes")
+	}
+
+	if !cached {
+		transferQuoteUpsertCacheMut.Lock()
+		transferQuoteUpsertCache[key] = cache
+		transferQuoteUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single TransferQuote record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *TransferQuote) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single TransferQuote record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TransferQuote) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no TransferQuote provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), transferQuotePrimaryKeyMapping)
+		sql = "DELETE FROM \"transfer_quotes\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"transfer_quotes\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(transferQuoteType, transferQuoteMapping, append(wl, transferQuotePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from transfer_quotes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for transfer_quotes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q transferQuoteQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q transferQuoteQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no transferQuoteQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from transfer_quotes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for transfer_quotes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o TransferQuoteSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TransferQuoteSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(transferQuoteBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferQuotePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"transfer_quotes\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, transferQuotePrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferQuotePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"transfer_quotes\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, transferQuotePrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from transferQuote slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for transfer_quotes")
+	}
+
+	if len(transferQuoteAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *TransferQuote) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no TransferQuote provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TransferQuote) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTransferQuote(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TransferQuoteSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty TransferQuoteSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TransferQuoteSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TransferQuoteSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferQuotePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"transfer_quotes\".* FROM \"transfer_quotes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, transferQuotePrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in TransferQuoteSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TransferQuoteExistsG checks if the TransferQuote row exists.
+func TransferQuoteExistsG(ctx context.Context, iD int) (bool, error) {
+	return TransferQuoteExists(ctx, boil.GetContextDB(), iD)
+}
+
+// TransferQuoteExists checks if the TransferQuote row exists.
+func TransferQuoteExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"transfer_quotes\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if transfer_quotes exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TransferQuote row exists.
+func (o *TransferQuote) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TransferQuoteExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1003 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTransferQuotes(t *testing.T) {
+	t.Parallel()
+
+	query := TransferQuotes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTransferQuotesSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferQuotesQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TransferQuotes().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferQuotesSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferQuoteSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferQuotesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferQuotesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TransferQuotes().DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferQuotesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferQuoteSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferQuotesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TransferQuoteExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if TransferQuote exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TransferQuoteExists to return true, but got false.")
+	}
+}
+
+func testTransferQuotesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	transferQuoteFound, err := FindTransferQuote(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if transferQuoteFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTransferQuotesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TransferQuotes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferQuotesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TransferQuotes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTransferQuotesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	transferQuoteOne := &TransferQuote{}
+	transferQuoteTwo := &TransferQuote{}
+	if err = randomize.Struct(seed, transferQuoteOne, transferQuoteDBTypes, false, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+	if err = randomize.Struct(seed, transferQuoteTwo, transferQuoteDBTypes, false, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = transferQuoteOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = transferQuoteTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TransferQuotes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTransferQuotesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	transferQuoteOne := &TransferQuote{}
+	transferQuoteTwo := &TransferQuote{}
+	if err = randomize.Struct(seed, transferQuoteOne, transferQuoteDBTypes, false, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+	if err = randomize.Struct(seed, transferQuoteTwo, transferQuoteDBTypes, false, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = transferQuoteOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = transferQuoteTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func transferQuoteBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferQuote) error {
+	*o = TransferQuote{}
+	return nil
+}
+
+func transferQuoteAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferQuote) error {
+	*o = TransferQuote{}
+	return nil
+}
+
+func transferQuoteAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *TransferQuote) error {
+	*o = TransferQuote{}
+	return nil
+}
+
+func transferQuoteBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TransferQuote) error {
+	*o = TransferQuote{}
+	return nil
+}
+
+func transferQuoteAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TransferQuote) error {
+	*o = TransferQuote{}
+	return nil
+}
+
+func transferQuoteBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TransferQuote) error {
+	*o = TransferQuote{}
+	return nil
+}
+
+func transferQuoteAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TransferQuote) error {
+	*o = TransferQuote{}
+	return nil
+}
+
+func transferQuoteBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferQuote) error {
+	*o = TransferQuote{}
+	return nil
+}
+
+func transferQuoteAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferQuote) error {
+	*o = TransferQuote{}
+	return nil
+}
+
+func testTransferQuotesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &TransferQuote{}
+	o := &TransferQuote{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TransferQuote object: %s", err)
+	}
+
+	AddTransferQuoteHook(boil.BeforeInsertHook, transferQuoteBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	transferQuoteBeforeInsertHooks = []TransferQuoteHook{}
+
+	AddTransferQuoteHook(boil.AfterInsertHook, transferQuoteAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	transferQuoteAfterInsertHooks = []TransferQuoteHook{}
+
+	AddTransferQuoteHook(boil.AfterSelectHook, transferQuoteAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	transferQuoteAfterSelectHooks = []TransferQuoteHook{}
+
+	AddTransferQuoteHook(boil.BeforeUpdateHook, transferQuoteBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	transferQuoteBeforeUpdateHooks = []TransferQuoteHook{}
+
+	AddTransferQuoteHook(boil.AfterUpdateHook, transferQuoteAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	transferQuoteAfterUpdateHooks = []TransferQuoteHook{}
+
+	AddTransferQuoteHook(boil.BeforeDeleteHook, transferQuoteBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	transferQuoteBeforeDeleteHooks = []TransferQuoteHook{}
+
+	AddTransferQuoteHook(boil.AfterDeleteHook, transferQuoteAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	transferQuoteAfterDeleteHooks = []TransferQuoteHook{}
+
+	AddTransferQuoteHook(boil.BeforeUpsertHook, transferQuoteBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	transferQuoteBeforeUpsertHooks = []TransferQuoteHook{}
+
+	AddTransferQuoteHook(boil.AfterUpsertHook, transferQuoteAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	transferQuoteAfterUpsertHooks = []TransferQuoteHook{}
+}
+
+func testTransferQuotesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTransferQuotesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(transferQuoteColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTransferQuoteToOneTransferJobUsingTransferJob(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local TransferQuote
+	var foreign TransferJob
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, transferJobDBTypes, false, transferJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJob struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.TransferJobID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.TransferJob().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddTransferJobHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *TransferJob) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := TransferQuoteSlice{&local}
+	if err = local.L.LoadTransferJob(ctx, tx, false, (*[]*TransferQuote)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TransferJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.TransferJob = nil
+	if err = local.L.LoadTransferJob(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TransferJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testTransferQuoteToOneSetOpTransferJobUsingTransferJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferQuote
+	var b, c TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferQuoteDBTypes, false, strmangle.SetComplement(transferQuotePrimaryKeyColumns, transferQuoteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*TransferJob{&b, &c} {
+		err = a.SetTransferJob(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.TransferJob != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TransferQuotes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.TransferJobID, x.ID) {
+			t.Error("foreign key was wrong value", a.TransferJobID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TransferJobID))
+		reflect.Indirect(reflect.ValueOf(&a.TransferJobID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.TransferJobID, x.ID) {
+			t.Error("foreign key was wrong value", a.TransferJobID, x.ID)
+		}
+	}
+}
+
+func testTransferQuoteToOneRemoveOpTransferJobUsingTransferJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferQuote
+	var b TransferJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferQuoteDBTypes, false, strmangle.SetComplement(transferQuotePrimaryKeyColumns, transferQuoteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transferJobDBTypes, false, strmangle.SetComplement(transferJobPrimaryKeyColumns, transferJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetTransferJob(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveTransferJob(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.TransferJob().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.TransferJob != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.TransferJobID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.TransferQuotes) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testTransferQuotesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferQuotesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferQuoteSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferQuotesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TransferQuotes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	transferQuoteDBTypes = map[string]string{`ID`: `integer`, `QuoteID`: `character varying`, `APIKey`: `text`, `SourceCurrency`: `character varying`, `DestinationCurrency`: `character varying`, `SourceAmount`: `bigint`, `DestinationAmount`: `bigint`, `Rate`: `numeric`, `FeeAmount`: `bigint`, `ExpiresAt`: `timestamp with time zone`, `TransferJobID`: `integer`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	_                    = bytes.MinRead
+)
+
+func testTransferQuotesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(transferQuotePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(transferQuoteAllColumns) == len(transferQuotePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuotePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTransferQuotesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(transferQuoteAllColumns) == len(transferQuotePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferQuote{}
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, transferQuoteDBTypes, true, transferQuotePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(transferQuoteAllColumns, transferQuotePrimaryKeyColumns) {
+		fields = transferQuoteAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			transferQuoteAllColumns,
+			transferQuotePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TransferQuoteSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTransferQuotesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(transferQuoteAllColumns) == len(transferQuotePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TransferQuote{}
+	if err = randomize.Struct(seed, &o, transferQuoteDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TransferQuote: %s", err)
+	}
+
+	count, err := TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, transferQuoteDBTypes, false, transferQuotePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferQuote struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TransferQuote: %s", err)
+	}
+
+	count, err = TransferQuotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
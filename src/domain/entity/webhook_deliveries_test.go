@@ -0,0 +1,1070 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+
+	query := WebhookDeliveries()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWebhookDeliveriesSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookDeliveriesQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WebhookDeliveries().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookDeliveriesSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebhookDeliverySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookDeliveriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookDeliveriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WebhookDeliveries().DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookDeliveriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebhookDeliverySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookDeliveriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WebhookDeliveryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if WebhookDelivery exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WebhookDeliveryExists to return true, but got false.")
+	}
+}
+
+func testWebhookDeliveriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	webhookDeliveryFound, err := FindWebhookDelivery(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if webhookDeliveryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWebhookDeliveriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WebhookDeliveries().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhookDeliveriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WebhookDeliveries().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWebhookDeliveriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	webhookDeliveryOne := &WebhookDelivery{}
+	webhookDeliveryTwo := &WebhookDelivery{}
+	if err = randomize.Struct(seed, webhookDeliveryOne, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webhookDeliveryTwo, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webhookDeliveryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webhookDeliveryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WebhookDeliveries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWebhookDeliveriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	webhookDeliveryOne := &WebhookDelivery{}
+	webhookDeliveryTwo := &WebhookDelivery{}
+	if err = randomize.Struct(seed, webhookDeliveryOne, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webhookDeliveryTwo, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webhookDeliveryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webhookDeliveryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func webhookDeliveryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookDelivery) error {
+	*o = WebhookDelivery{}
+	return nil
+}
+
+func webhookDeliveryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookDelivery) error {
+	*o = WebhookDelivery{}
+	return nil
+}
+
+func webhookDeliveryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *WebhookDelivery) error {
+	*o = WebhookDelivery{}
+	return nil
+}
+
+func webhookDeliveryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WebhookDelivery) error {
+	*o = WebhookDelivery{}
+	return nil
+}
+
+func webhookDeliveryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WebhookDelivery) error {
+	*o = WebhookDelivery{}
+	return nil
+}
+
+func webhookDeliveryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WebhookDelivery) error {
+	*o = WebhookDelivery{}
+	return nil
+}
+
+func webhookDeliveryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WebhookDelivery) error {
+	*o = WebhookDelivery{}
+	return nil
+}
+
+func webhookDeliveryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookDelivery) error {
+	*o = WebhookDelivery{}
+	return nil
+}
+
+func webhookDeliveryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookDelivery) error {
+	*o = WebhookDelivery{}
+	return nil
+}
+
+func testWebhookDeliveriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &WebhookDelivery{}
+	o := &WebhookDelivery{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery object: %s", err)
+	}
+
+	AddWebhookDeliveryHook(boil.BeforeInsertHook, webhookDeliveryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	webhookDeliveryBeforeInsertHooks = []WebhookDeliveryHook{}
+
+	AddWebhookDeliveryHook(boil.AfterInsertHook, webhookDeliveryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	webhookDeliveryAfterInsertHooks = []WebhookDeliveryHook{}
+
+	AddWebhookDeliveryHook(boil.AfterSelectHook, webhookDeliveryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	webhookDeliveryAfterSelectHooks = []WebhookDeliveryHook{}
+
+	AddWebhookDeliveryHook(boil.BeforeUpdateHook, webhookDeliveryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	webhookDeliveryBeforeUpdateHooks = []WebhookDeliveryHook{}
+
+	AddWebhookDeliveryHook(boil.AfterUpdateHook, webhookDeliveryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	webhookDeliveryAfterUpdateHooks = []WebhookDeliveryHook{}
+
+	AddWebhookDeliveryHook(boil.BeforeDeleteHook, webhookDeliveryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	webhookDeliveryBeforeDeleteHooks = []WebhookDeliveryHook{}
+
+	AddWebhookDeliveryHook(boil.AfterDeleteHook, webhookDeliveryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	webhookDeliveryAfterDeleteHooks = []WebhookDeliveryHook{}
+
+	AddWebhookDeliveryHook(boil.BeforeUpsertHook, webhookDeliveryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	webhookDeliveryBeforeUpsertHooks = []WebhookDeliveryHook{}
+
+	AddWebhookDeliveryHook(boil.AfterUpsertHook, webhookDeliveryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	webhookDeliveryAfterUpsertHooks = []WebhookDeliveryHook{}
+}
+
+func testWebhookDeliveriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebhookDeliveriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(webhookDeliveryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebhookDeliveryToOneTransferJobEventUsingTransferJobEvent(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local WebhookDelivery
+	var foreign TransferJobEvent
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, transferJobEventDBTypes, false, transferJobEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferJobEvent struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.TransferJobEventID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.TransferJobEvent().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddTransferJobEventHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *TransferJobEvent) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := WebhookDeliverySlice{&local}
+	if err = local.L.LoadTransferJobEvent(ctx, tx, false, (*[]*WebhookDelivery)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TransferJobEvent == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.TransferJobEvent = nil
+	if err = local.L.LoadTransferJobEvent(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TransferJobEvent == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testWebhookDeliveryToOneWebhookEndpointUsingWebhookEndpoint(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local WebhookDelivery
+	var foreign WebhookEndpoint
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, webhookEndpointDBTypes, false, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.WebhookEndpointID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.WebhookEndpoint().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddWebhookEndpointHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *WebhookEndpoint) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := WebhookDeliverySlice{&local}
+	if err = local.L.LoadWebhookEndpoint(ctx, tx, false, (*[]*WebhookDelivery)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.WebhookEndpoint == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.WebhookEndpoint = nil
+	if err = local.L.LoadWebhookEndpoint(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.WebhookEndpoint == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testWebhookDeliveryToOneSetOpTransferJobEventUsingTransferJobEvent(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WebhookDelivery
+	var b, c TransferJobEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookDeliveryDBTypes, false, strmangle.SetComplement(webhookDeliveryPrimaryKeyColumns, webhookDeliveryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, transferJobEventDBTypes, false, strmangle.SetComplement(transferJobEventPrimaryKeyColumns, transferJobEventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transferJobEventDBTypes, false, strmangle.SetComplement(transferJobEventPrimaryKeyColumns, transferJobEventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*TransferJobEvent{&b, &c} {
+		err = a.SetTransferJobEvent(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.TransferJobEvent != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.WebhookDeliveries[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.TransferJobEventID != x.ID {
+			t.Error("foreign key was wrong value", a.TransferJobEventID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TransferJobEventID))
+		reflect.Indirect(reflect.ValueOf(&a.TransferJobEventID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.TransferJobEventID != x.ID {
+			t.Error("foreign key was wrong value", a.TransferJobEventID, x.ID)
+		}
+	}
+}
+func testWebhookDeliveryToOneSetOpWebhookEndpointUsingWebhookEndpoint(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WebhookDelivery
+	var b, c WebhookEndpoint
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookDeliveryDBTypes, false, strmangle.SetComplement(webhookDeliveryPrimaryKeyColumns, webhookDeliveryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, webhookEndpointDBTypes, false, strmangle.SetComplement(webhookEndpointPrimaryKeyColumns, webhookEndpointColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, webhookEndpointDBTypes, false, strmangle.SetComplement(webhookEndpointPrimaryKeyColumns, webhookEndpointColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*WebhookEndpoint{&b, &c} {
+		err = a.SetWebhookEndpoint(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.WebhookEndpoint != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.WebhookDeliveries[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.WebhookEndpointID != x.ID {
+			t.Error("foreign key was wrong value", a.WebhookEndpointID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.WebhookEndpointID))
+		reflect.Indirect(reflect.ValueOf(&a.WebhookEndpointID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.WebhookEndpointID != x.ID {
+			t.Error("foreign key was wrong value", a.WebhookEndpointID, x.ID)
+		}
+	}
+}
+
+func testWebhookDeliveriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhookDeliveriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebhookDeliverySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhookDeliveriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WebhookDeliveries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	webhookDeliveryDBTypes = map[string]string{`ID`: `integer`, `WebhookEndpointID`: `integer`, `TransferJobEventID`: `integer`, `EventType`: `character varying`, `Payload`: `jsonb`, `Status`: `enum.webhookdeliverystatus('failed','pending','success')`, `Attempts`: `integer`, `NextAttemptAt`: `timestamp with time zone`, `ResponseCode`: `integer`, `LastError`: `text`, `DeliveredAt`: `timestamp with time zone`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	_                      = bytes.MinRead
+)
+
+func testWebhookDeliveriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(webhookDeliveryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(webhookDeliveryAllColumns) == len(webhookDeliveryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWebhookDeliveriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(webhookDeliveryAllColumns) == len(webhookDeliveryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookDelivery{}
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webhookDeliveryDBTypes, true, webhookDeliveryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(webhookDeliveryAllColumns, webhookDeliveryPrimaryKeyColumns) {
+		fields = webhookDeliveryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			webhookDeliveryAllColumns,
+			webhookDeliveryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WebhookDeliverySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWebhookDeliveriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(webhookDeliveryAllColumns) == len(webhookDeliveryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := WebhookDelivery{}
+	if err = randomize.Struct(seed, &o, webhookDeliveryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WebhookDelivery: %s", err)
+	}
+
+	count, err := WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, webhookDeliveryDBTypes, false, webhookDeliveryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebhookDelivery struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WebhookDelivery: %s", err)
+	}
+
+	count, err = WebhookDeliveries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
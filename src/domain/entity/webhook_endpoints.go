@@ -0,0 +1,1545 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WebhookEndpoint is an object representing the database table.
+type WebhookEndpoint struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	AccountID int       `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	URL       string    `boil:"url" json:"url" toml:"url" yaml:"url"`
+	Secret    string    `boil:"secret" json:"secret" toml:"secret" yaml:"secret"`
+	IsActive  bool      `boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
+	CreatedBy int       `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy int       `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy null.Int  `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *webhookEndpointR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webhookEndpointL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebhookEndpointColumns = struct {
+	ID        string
+	AccountID string
+	URL       string
+	Secret    string
+	IsActive  string
+	CreatedBy string
+	CreatedAt string
+	UpdatedBy string
+	UpdatedAt string
+	DeletedBy string
+	DeletedAt string
+}{
+	ID:        "id",
+	AccountID: "account_id",
+	URL:       "url",
+	Secret:    "secret",
+	IsActive:  "is_active",
+	CreatedBy: "created_by",
+	CreatedAt: "created_at",
+	UpdatedBy: "updated_by",
+	UpdatedAt: "updated_at",
+	DeletedBy: "deleted_by",
+	DeletedAt: "deleted_at",
+}
+
+var WebhookEndpointTableColumns = struct {
+	ID        string
+	AccountID string
+	URL       string
+	Secret    string
+	IsActive  string
+	CreatedBy string
+	CreatedAt string
+	UpdatedBy string
+	UpdatedAt string
+	DeletedBy string
+	DeletedAt string
+}{
+	ID:        "webhook_endpoints.id",
+	AccountID: "webhook_endpoints.account_id",
+	URL:       "webhook_endpoints.url",
+	Secret:    "webhook_endpoints.secret",
+	IsActive:  "webhook_endpoints.is_active",
+	CreatedBy: "webhook_endpoints.created_by",
+	CreatedAt: "webhook_endpoints.created_at",
+	UpdatedBy: "webhook_endpoints.updated_by",
+	UpdatedAt: "webhook_endpoints.updated_at",
+	DeletedBy: "webhook_endpoints.deleted_by",
+	DeletedAt: "webhook_endpoints.deleted_at",
+}
+
+// Generated where
+
+var WebhookEndpointWhere = struct {
+	ID        whereHelperint
+	AccountID whereHelperint
+	URL       whereHelperstring
+	Secret    whereHelperstring
+	IsActive  whereHelperbool
+	CreatedBy whereHelperint
+	CreatedAt whereHelpertime_Time
+	UpdatedBy whereHelperint
+	UpdatedAt whereHelpertime_Time
+	DeletedBy whereHelpernull_Int
+	DeletedAt whereHelpernull_Time
+}{
+	ID:        whereHelperint{field: "\"webhook_endpoints\".\"id\""},
+	AccountID: whereHelperint{field: "\"webhook_endpoints\".\"account_id\""},
+	URL:       whereHelperstring{field: "\"webhook_endpoints\".\"url\""},
+	Secret:    whereHelperstring{field: "\"webhook_endpoints\".\"secret\""},
+	IsActive:  whereHelperbool{field: "\"webhook_endpoints\".\"is_active\""},
+	CreatedBy: whereHelperint{field: "\"webhook_endpoints\".\"created_by\""},
+	CreatedAt: whereHelpertime_Time{field: "\"webhook_endpoints\".\"created_at\""},
+	UpdatedBy: whereHelperint{field: "\"webhook_endpoints\".\"updated_by\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"webhook_endpoints\".\"updated_at\""},
+	DeletedBy: whereHelpernull_Int{field: "\"webhook_endpoints\".\"deleted_by\""},
+	DeletedAt: whereHelpernull_Time{field: "\"webhook_endpoints\".\"deleted_at\""},
+}
+
+// WebhookEndpointRels is where relationship names are stored.
+var WebhookEndpointRels = struct {
+	Account           string
+	WebhookDeliveries string
+}{
+	Account:           "Account",
+	WebhookDeliveries: "WebhookDeliveries",
+}
+
+// webhookEndpointR is where relationships are stored.
+type webhookEndpointR struct {
+	Account           *Account             `boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
+	WebhookDeliveries WebhookDeliverySlice `boil:"WebhookDeliveries" json:"WebhookDeliveries" toml:"WebhookDeliveries" yaml:"WebhookDeliveries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*webhookEndpointR) NewStruct() *webhookEndpointR {
+	return &webhookEndpointR{}
+}
+
+func (r *webhookEndpointR) GetAccount() *Account {
+	if r == nil {
+		return nil
+	}
+	return r.Account
+}
+
+func (r *webhookEndpointR) GetWebhookDeliveries() WebhookDeliverySlice {
+	if r == nil {
+		return nil
+	}
+	return r.WebhookDeliveries
+}
+
+// webhookEndpointL is where Load methods for each relationship are stored.
+type webhookEndpointL struct{}
+
+var (
+	webhookEndpointAllColumns            = []string{"id", "account_id", "url", "secret", "is_active", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	webhookEndpointColumnsWithoutDefault = []string{"account_id", "url", "secret"}
+	webhookEndpointColumnsWithDefault    = []string{"id", "is_active", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	webhookEndpointPrimaryKeyColumns     = []string{"id"}
+	webhookEndpointGeneratedColumns      = []string{}
+)
+
+type (
+	// WebhookEndpointSlice is an alias for a slice of pointers to WebhookEndpoint.
+	// This should almost always be used instead of []WebhookEndpoint.
+	WebhookEndpointSlice []*WebhookEndpoint
+	// WebhookEndpointHook is the signature for custom WebhookEndpoint hook methods
+	WebhookEndpointHook func(context.Context, boil.ContextExecutor, *WebhookEndpoint) error
+
+	webhookEndpointQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webhookEndpointType                 = reflect.TypeOf(&WebhookEndpoint{})
+	webhookEndpointMapping              = queries.MakeStructMapping(webhookEndpointType)
+	webhookEndpointPrimaryKeyMapping, _ = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, webhookEndpointPrimaryKeyColumns)
+	webhookEndpointInsertCacheMut       sync.RWMutex
+	webhookEndpointInsertCache          = make(map[string]insertCache)
+	webhookEndpointUpdateCacheMut       sync.RWMutex
+	webhookEndpointUpdateCache          = make(map[string]updateCache)
+	webhookEndpointUpsertCacheMut       sync.RWMutex
+	webhookEndpointUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var webhookEndpointAfterSelectMu sync.Mutex
+var webhookEndpointAfterSelectHooks []WebhookEndpointHook
+
+var webhookEndpointBeforeInsertMu sync.Mutex
+var webhookEndpointBeforeInsertHooks []WebhookEndpointHook
+var webhookEndpointAfterInsertMu sync.Mutex
+var webhookEndpointAfterInsertHooks []WebhookEndpointHook
+
+var webhookEndpointBeforeUpdateMu sync.Mutex
+var webhookEndpointBeforeUpdateHooks []WebhookEndpointHook
+var webhookEndpointAfterUpdateMu sync.Mutex
+var webhookEndpointAfterUpdateHooks []WebhookEndpointHook
+
+var webhookEndpointBeforeDeleteMu sync.Mutex
+var webhookEndpointBeforeDeleteHooks []WebhookEndpointHook
+var webhookEndpointAfterDeleteMu sync.Mutex
+var webhookEndpointAfterDeleteHooks []WebhookEndpointHook
+
+var webhookEndpointBeforeUpsertMu sync.Mutex
+var webhookEndpointBeforeUpsertHooks []WebhookEndpointHook
+var webhookEndpointAfterUpsertMu sync.Mutex
+var webhookEndpointAfterUpsertHooks []WebhookEndpointHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebhookEndpoint) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebhookEndpoint) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebhookEndpoint) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebhookEndpoint) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebhookEndpoint) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebhookEndpoint) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebhookEndpoint) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebhookEndpoint) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebhookEndpoint) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEndpointAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebhookEndpointHook registers your hook function for all future operations.
+func AddWebhookEndpointHook(hookPoint boil.HookPoint, webhookEndpointHook WebhookEndpointHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		webhookEndpointAfterSelectMu.Lock()
+		webhookEndpointAfterSelectHooks = append(webhookEndpointAfterSelectHooks, webhookEndpointHook)
+		webhookEndpointAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		webhookEndpointBeforeInsertMu.Lock()
+		webhookEndpointBeforeInsertHooks = append(webhookEndpointBeforeInsertHooks, webhookEndpointHook)
+		webhookEndpointBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		webhookEndpointAfterInsertMu.Lock()
+		webhookEndpointAfterInsertHooks = append(webhookEndpointAfterInsertHooks, webhookEndpointHook)
+		webhookEndpointAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		webhookEndpointBeforeUpdateMu.Lock()
+		webhookEndpointBeforeUpdateHooks = append(webhookEndpointBeforeUpdateHooks, webhookEndpointHook)
+		webhookEndpointBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		webhookEndpointAfterUpdateMu.Lock()
+		webhookEndpointAfterUpdateHooks = append(webhookEndpointAfterUpdateHooks, webhookEndpointHook)
+		webhookEndpointAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		webhookEndpointBeforeDeleteMu.Lock()
+		webhookEndpointBeforeDeleteHooks = append(webhookEndpointBeforeDeleteHooks, webhookEndpointHook)
+		webhookEndpointBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		webhookEndpointAfterDeleteMu.Lock()
+		webhookEndpointAfterDeleteHooks = append(webhookEndpointAfterDeleteHooks, webhookEndpointHook)
+		webhookEndpointAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		webhookEndpointBeforeUpsertMu.Lock()
+		webhookEndpointBeforeUpsertHooks = append(webhookEndpointBeforeUpsertHooks, webhookEndpointHook)
+		webhookEndpointBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		webhookEndpointAfterUpsertMu.Lock()
+		webhookEndpointAfterUpsertHooks = append(webhookEndpointAfterUpsertHooks, webhookEndpointHook)
+		webhookEndpointAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single webhookEndpoint record from the query using the global executor.
+func (q webhookEndpointQuery) OneG(ctx context.Context) (*WebhookEndpoint, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single webhookEndpoint record from the query.
+func (q webhookEndpointQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebhookEndpoint, error) {
+	o := &WebhookEndpoint{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for webhook_endpoints")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all WebhookEndpoint records from the query using the global executor.
+func (q webhookEndpointQuery) AllG(ctx context.Context) (WebhookEndpointSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all WebhookEndpoint records from the query.
+func (q webhookEndpointQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebhookEndpointSlice, error) {
+	var o []*WebhookEndpoint
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to WebhookEndpoint slice")
+	}
+
+	if len(webhookEndpointAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all WebhookEndpoint records in the query using the global executor
+func (q webhookEndpointQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all WebhookEndpoint records in the query.
+func (q webhookEndpointQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count webhook_endpoints rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q webhookEndpointQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q webhookEndpointQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if webhook_endpoints exists")
+	}
+
+	return count > 0, nil
+}
+
+// Account pointed to by the foreign key.
+func (o *WebhookEndpoint) Account(mods ...qm.QueryMod) accountQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AccountID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Accounts(queryMods...)
+}
+
+// WebhookDeliveries retrieves all the webhook_delivery's WebhookDeliveries with an executor.
+func (o *WebhookEndpoint) WebhookDeliveries(mods ...qm.QueryMod) webhookDeliveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhook_deliveries\".\"webhook_endpoint_id\"=?", o.ID),
+	)
+
+	return WebhookDeliveries(queryMods...)
+}
+
+// LoadAccount allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (webhookEndpointL) LoadAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebhookEndpoint interface{}, mods queries.Applicator) error {
+	var slice []*WebhookEndpoint
+	var object *WebhookEndpoint
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebhookEndpoint.(*WebhookEndpoint)
+		if !ok {
+			object = new(WebhookEndpoint)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebhookEndpoint)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebhookEndpoint))
+			}
+		}
+	} else {
+		s, ok := maybeWebhookEndpoint.(*[]*WebhookEndpoint)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebhookEndpoint)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebhookEndpoint))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &webhookEndpointR{}
+		}
+		args[object.AccountID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &webhookEndpointR{}
+			}
+
+			args[obj.AccountID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`accounts`),
+		qm.WhereIn(`accounts.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`accounts.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Account")
+	}
+
+	var resultSlice []*Account
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Account")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for accounts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for accounts")
+	}
+
+	if len(accountAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Account = foreign
+		if foreign.R == nil {
+			foreign.R = &accountR{}
+		}
+		foreign.R.WebhookEndpoints = append(foreign.R.WebhookEndpoints, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AccountID == foreign.ID {
+				local.R.Account = foreign
+				if foreign.R == nil {
+					foreign.R = &accountR{}
+				}
+				foreign.R.WebhookEndpoints = append(foreign.R.WebhookEndpoints, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebhookDeliveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (webhookEndpointL) LoadWebhookDeliveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebhookEndpoint interface{}, mods queries.Applicator) error {
+	var slice []*WebhookEndpoint
+	var object *WebhookEndpoint
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebhookEndpoint.(*WebhookEndpoint)
+		if !ok {
+			object = new(WebhookEndpoint)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebhookEndpoint)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebhookEndpoint))
+			}
+		}
+	} else {
+		s, ok := maybeWebhookEndpoint.(*[]*WebhookEndpoint)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebhookEndpoint)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebhookEndpoint))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &webhookEndpointR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &webhookEndpointR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webhook_deliveries`),
+		qm.WhereIn(`webhook_deliveries.webhook_endpoint_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`webhook_deliveries.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhook_deliveries")
+	}
+
+	var resultSlice []*WebhookDelivery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhook_deliveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhook_deliveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook_deliveries")
+	}
+
+	if len(webhookDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebhookDeliveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookDeliveryR{}
+			}
+			foreign.R.WebhookEndpoint = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebhookEndpointID {
+				local.R.WebhookDeliveries = append(local.R.WebhookDeliveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookDeliveryR{}
+				}
+				foreign.R.WebhookEndpoint = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAccountG of the webhookEndpoint to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.WebhookEndpoints.
+// Uses the global database handle.
+func (o *WebhookEndpoint) SetAccountG(ctx context.Context, insert bool, related *Account) error {
+	return o.SetAccount(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetAccount of the webhookEndpoint to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.WebhookEndpoints.
+func (o *WebhookEndpoint) SetAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Account) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"webhook_endpoints\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+		strmangle.WhereClause("\"", "\"", 2, webhookEndpointPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AccountID = related.ID
+	if o.R == nil {
+		o.R = &webhookEndpointR{
+			Account: related,
+		}
+	} else {
+		o.R.Account = related
+	}
+
+	if related.R == nil {
+		related.R = &accountR{
+			WebhookEndpoints: WebhookEndpointSlice{o},
+		}
+	} else {
+		related.R.WebhookEndpoints = append(related.R.WebhookEndpoints, o)
+	}
+
+	return nil
+}
+
+// AddWebhookDeliveriesG adds the given related objects to the existing relationships
+// of the webhook_endpoint, optionally inserting them as new records.
+// Appends related to o.R.WebhookDeliveries.
+// Sets related.R.WebhookEndpoint appropriately.
+// Uses the global database handle.
+func (o *WebhookEndpoint) AddWebhookDeliveriesG(ctx context.Context, insert bool, related ...*WebhookDelivery) error {
+	return o.AddWebhookDeliveries(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddWebhookDeliveries adds the given related objects to the existing relationships
+// of the webhook_endpoint, optionally inserting them as new records.
+// Appends related to o.R.WebhookDeliveries.
+// Sets related.R.WebhookEndpoint appropriately.
+func (o *WebhookEndpoint) AddWebhookDeliveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebhookDelivery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebhookEndpointID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webhook_deliveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"webhook_endpoint_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webhookDeliveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebhookEndpointID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &webhookEndpointR{
+			WebhookDeliveries: related,
+		}
+	} else {
+		o.R.WebhookDeliveries = append(o.R.WebhookDeliveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookDeliveryR{
+				WebhookEndpoint: o,
+			}
+		} else {
+			rel.R.WebhookEndpoint = o
+		}
+	}
+	return nil
+}
+
+// WebhookEndpoints retrieves all the records using an executor.
+func WebhookEndpoints(mods ...qm.QueryMod) webhookEndpointQuery {
+	mods = append(mods, qm.From("\"webhook_endpoints\""), qmhelper.WhereIsNull("\"webhook_endpoints\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webhook_endpoints\".*"})
+	}
+
+	return webhookEndpointQuery{q}
+}
+
+// FindWebhookEndpointG retrieves a single record by ID.
+func FindWebhookEndpointG(ctx context.Context, iD int, selectCols ...string) (*WebhookEndpoint, error) {
+	return FindWebhookEndpoint(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindWebhookEndpoint retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebhookEndpoint(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*WebhookEndpoint, error) {
+	webhookEndpointObj := &WebhookEndpoint{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webhook_endpoints\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, webhookEndpointObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from webhook_endpoints")
+	}
+
+	if err = webhookEndpointObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return webhookEndpointObj, err
+	}
+
+	return webhookEndpointObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *WebhookEndpoint) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebhookEndpoint) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no webhook_endpoints provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookEndpointColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webhookEndpointInsertCacheMut.RLock()
+	cache, cached := webhookEndpointInsertCache[key]
+	webhookEndpointInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointColumnsWithDefault,
+			webhookEndpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webhook_endpoints\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webhook_endpoints\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into webhook_endpoints")
+	}
+
+	if !cached {
+		webhookEndpointInsertCacheMut.Lock()
+		webhookEndpointInsertCache[key] = cache
+		webhookEndpointInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single WebhookEndpoint record using the global executor.
+// See Update for more documentation.
+func (o *WebhookEndpoint) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the WebhookEndpoint.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebhookEndpoint) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	webhookEndpointUpdateCacheMut.RLock()
+	cache, cached := webhookEndpointUpdateCache[key]
+	webhookEndpointUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update webhook_endpoints, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webhook_endpoints\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webhookEndpointPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, append(wl, webhookEndpointPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update webhook_endpoints row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for webhook_endpoints")
+	}
+
+	if !cached {
+		webhookEndpointUpdateCacheMut.Lock()
+		webhookEndpointUpdateCache[key] = cache
+		webhookEndpointUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q webhookEndpointQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webhookEndpointQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for webhook_endpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for webhook_endpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o WebhookEndpointSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebhookEndpointSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webhook_endpoints\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webhookEndpointPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in webhookEndpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all webhookEndpoint")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *WebhookEndpoint) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebhookEndpoint) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no webhook_endpoints provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookEndpointColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webhookEndpointUpsertCacheMut.RLock()
+	cache, cached := webhookEndpointUpsertCache[key]
+	webhookEndpointUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointColumnsWithDefault,
+			webhookEndpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert webhook_endpoints, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webhookEndpointAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(webhookEndpointPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert webhook_endpoints, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(webhookEndpointPrimaryKeyColumns))
+			copy(conflict, webhookEndpointPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"webhook_endpoints\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert webhook_endpoints")
+	}
+
+	if !cached {
+		webhookEndpointUpsertCacheMut.Lock()
+		webhookEndpointUpsertCache[key] = cache
+		webhookEndpointUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single WebhookEndpoint record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *WebhookEndpoint) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single WebhookEndpoint record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebhookEndpoint) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no WebhookEndpoint provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webhookEndpointPrimaryKeyMapping)
+		sql = "DELETE FROM \"webhook_endpoints\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"webhook_endpoints\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(webhookEndpointType, webhookEndpointMapping, append(wl, webhookEndpointPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from webhook_endpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for webhook_endpoints")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q webhookEndpointQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q webhookEndpointQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no webhookEndpointQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from webhook_endpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for webhook_endpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o WebhookEndpointSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebhookEndpointSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(webhookEndpointBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"webhook_endpoints\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookEndpointPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"webhook_endpoints\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, webhookEndpointPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from webhookEndpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for webhook_endpoints")
+	}
+
+	if len(webhookEndpointAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *WebhookEndpoint) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: no WebhookEndpoint provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebhookEndpoint) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebhookEndpoint(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebhookEndpointSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("entity: empty WebhookEndpointSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebhookEndpointSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebhookEndpointSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webhook_endpoints\".* FROM \"webhook_endpoints\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookEndpointPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in WebhookEndpointSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebhookEndpointExistsG checks if the WebhookEndpoint row exists.
+func WebhookEndpointExistsG(ctx context.Context, iD int) (bool, error) {
+	return WebhookEndpointExists(ctx, boil.GetContextDB(), iD)
+}
+
+// WebhookEndpointExists checks if the WebhookEndpoint row exists.
+func WebhookEndpointExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webhook_endpoints\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if webhook_endpoints exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebhookEndpoint row exists.
+func (o *WebhookEndpoint) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebhookEndpointExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1105 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWebhookEndpoints(t *testing.T) {
+	t.Parallel()
+
+	query := WebhookEndpoints()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWebhookEndpointsSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookEndpointsQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WebhookEndpoints().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookEndpointsSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebhookEndpointSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookEndpointsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookEndpointsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WebhookEndpoints().DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookEndpointsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebhookEndpointSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookEndpointsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WebhookEndpointExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if WebhookEndpoint exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WebhookEndpointExists to return true, but got false.")
+	}
+}
+
+func testWebhookEndpointsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	webhookEndpointFound, err := FindWebhookEndpoint(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if webhookEndpointFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWebhookEndpointsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WebhookEndpoints().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhookEndpointsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WebhookEndpoints().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWebhookEndpointsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	webhookEndpointOne := &WebhookEndpoint{}
+	webhookEndpointTwo := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, webhookEndpointOne, webhookEndpointDBTypes, false, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webhookEndpointTwo, webhookEndpointDBTypes, false, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webhookEndpointOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webhookEndpointTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WebhookEndpoints().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWebhookEndpointsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	webhookEndpointOne := &WebhookEndpoint{}
+	webhookEndpointTwo := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, webhookEndpointOne, webhookEndpointDBTypes, false, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webhookEndpointTwo, webhookEndpointDBTypes, false, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webhookEndpointOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webhookEndpointTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func webhookEndpointBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEndpoint) error {
+	*o = WebhookEndpoint{}
+	return nil
+}
+
+func webhookEndpointAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEndpoint) error {
+	*o = WebhookEndpoint{}
+	return nil
+}
+
+func webhookEndpointAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEndpoint) error {
+	*o = WebhookEndpoint{}
+	return nil
+}
+
+func webhookEndpointBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEndpoint) error {
+	*o = WebhookEndpoint{}
+	return nil
+}
+
+func webhookEndpointAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEndpoint) error {
+	*o = WebhookEndpoint{}
+	return nil
+}
+
+func webhookEndpointBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEndpoint) error {
+	*o = WebhookEndpoint{}
+	return nil
+}
+
+func webhookEndpointAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEndpoint) error {
+	*o = WebhookEndpoint{}
+	return nil
+}
+
+func webhookEndpointBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEndpoint) error {
+	*o = WebhookEndpoint{}
+	return nil
+}
+
+func webhookEndpointAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEndpoint) error {
+	*o = WebhookEndpoint{}
+	return nil
+}
+
+func testWebhookEndpointsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &WebhookEndpoint{}
+	o := &WebhookEndpoint{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint object: %s", err)
+	}
+
+	AddWebhookEndpointHook(boil.BeforeInsertHook, webhookEndpointBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	webhookEndpointBeforeInsertHooks = []WebhookEndpointHook{}
+
+	AddWebhookEndpointHook(boil.AfterInsertHook, webhookEndpointAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	webhookEndpointAfterInsertHooks = []WebhookEndpointHook{}
+
+	AddWebhookEndpointHook(boil.AfterSelectHook, webhookEndpointAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	webhookEndpointAfterSelectHooks = []WebhookEndpointHook{}
+
+	AddWebhookEndpointHook(boil.BeforeUpdateHook, webhookEndpointBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	webhookEndpointBeforeUpdateHooks = []WebhookEndpointHook{}
+
+	AddWebhookEndpointHook(boil.AfterUpdateHook, webhookEndpointAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	webhookEndpointAfterUpdateHooks = []WebhookEndpointHook{}
+
+	AddWebhookEndpointHook(boil.BeforeDeleteHook, webhookEndpointBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	webhookEndpointBeforeDeleteHooks = []WebhookEndpointHook{}
+
+	AddWebhookEndpointHook(boil.AfterDeleteHook, webhookEndpointAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	webhookEndpointAfterDeleteHooks = []WebhookEndpointHook{}
+
+	AddWebhookEndpointHook(boil.BeforeUpsertHook, webhookEndpointBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	webhookEndpointBeforeUpsertHooks = []WebhookEndpointHook{}
+
+	AddWebhookEndpointHook(boil.AfterUpsertHook, webhookEndpointAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	webhookEndpointAfterUpsertHooks = []WebhookEndpointHook{}
+}
+
+func testWebhookEndpointsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebhookEndpointsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(webhookEndpointColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebhookEndpointToManyWebhookDeliveries(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WebhookEndpoint
+	var b, c WebhookDelivery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.WebhookEndpointID = a.ID
+	c.WebhookEndpointID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.WebhookDeliveries().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.WebhookEndpointID == b.WebhookEndpointID {
+			bFound = true
+		}
+		if v.WebhookEndpointID == c.WebhookEndpointID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := WebhookEndpointSlice{&a}
+	if err = a.L.LoadWebhookDeliveries(ctx, tx, false, (*[]*WebhookEndpoint)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WebhookDeliveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.WebhookDeliveries = nil
+	if err = a.L.LoadWebhookDeliveries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WebhookDeliveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testWebhookEndpointToManyAddOpWebhookDeliveries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WebhookEndpoint
+	var b, c, d, e WebhookDelivery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookEndpointDBTypes, false, strmangle.SetComplement(webhookEndpointPrimaryKeyColumns, webhookEndpointColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WebhookDelivery{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, webhookDeliveryDBTypes, false, strmangle.SetComplement(webhookDeliveryPrimaryKeyColumns, webhookDeliveryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*WebhookDelivery{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddWebhookDeliveries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.WebhookEndpointID {
+			t.Error("foreign key was wrong value", a.ID, first.WebhookEndpointID)
+		}
+		if a.ID != second.WebhookEndpointID {
+			t.Error("foreign key was wrong value", a.ID, second.WebhookEndpointID)
+		}
+
+		if first.R.WebhookEndpoint != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.WebhookEndpoint != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.WebhookDeliveries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.WebhookDeliveries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.WebhookDeliveries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testWebhookEndpointToOneAccountUsingAccount(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local WebhookEndpoint
+	var foreign Account
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, webhookEndpointDBTypes, false, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, accountDBTypes, false, accountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Account struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.AccountID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Account().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddAccountHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Account) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := WebhookEndpointSlice{&local}
+	if err = local.L.LoadAccount(ctx, tx, false, (*[]*WebhookEndpoint)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Account == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Account = nil
+	if err = local.L.LoadAccount(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Account == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testWebhookEndpointToOneSetOpAccountUsingAccount(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WebhookEndpoint
+	var b, c Account
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookEndpointDBTypes, false, strmangle.SetComplement(webhookEndpointPrimaryKeyColumns, webhookEndpointColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Account{&b, &c} {
+		err = a.SetAccount(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Account != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.WebhookEndpoints[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.AccountID != x.ID {
+			t.Error("foreign key was wrong value", a.AccountID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AccountID))
+		reflect.Indirect(reflect.ValueOf(&a.AccountID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.AccountID != x.ID {
+			t.Error("foreign key was wrong value", a.AccountID, x.ID)
+		}
+	}
+}
+
+func testWebhookEndpointsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhookEndpointsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebhookEndpointSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhookEndpointsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WebhookEndpoints().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	webhookEndpointDBTypes = map[string]string{`ID`: `integer`, `AccountID`: `integer`, `URL`: `text`, `Secret`: `text`, `IsActive`: `boolean`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	_                      = bytes.MinRead
+)
+
+func testWebhookEndpointsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(webhookEndpointPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(webhookEndpointAllColumns) == len(webhookEndpointPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWebhookEndpointsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(webhookEndpointAllColumns) == len(webhookEndpointPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEndpoint{}
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webhookEndpointDBTypes, true, webhookEndpointPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(webhookEndpointAllColumns, webhookEndpointPrimaryKeyColumns) {
+		fields = webhookEndpointAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			webhookEndpointAllColumns,
+			webhookEndpointPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WebhookEndpointSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWebhookEndpointsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(webhookEndpointAllColumns) == len(webhookEndpointPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := WebhookEndpoint{}
+	if err = randomize.Struct(seed, &o, webhookEndpointDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WebhookEndpoint: %s", err)
+	}
+
+	count, err := WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, webhookEndpointDBTypes, false, webhookEndpointPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebhookEndpoint struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WebhookEndpoint: %s", err)
+	}
+
+	count, err = WebhookEndpoints().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
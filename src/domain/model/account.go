@@ -18,8 +18,19 @@ var (
 	GetByParamAccountPgKey     string = "gppgAccount:%s"
 	MustRevalidate             string = "must-revalidate"
 	RegExpEmail                string = `^[a-zA-Z0-9._+\-]+@[a-zA-Z0-9]+[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,10}$`
+
+	// APIKeyVisibleChars is how many trailing characters of an api key a masked key keeps
+	APIKeyVisibleChars int = 4
 )
 
+// MaskAPIKey hides an api key but its last characters, enough for a merchant to tell its keys apart
+func MaskAPIKey(v string) string {
+	if len(v) <= APIKeyVisibleChars {
+		return strings.Repeat("*", len(v))
+	}
+	return strings.Repeat("*", len(v)-APIKeyVisibleChars) + v[len(v)-APIKeyVisibleChars:]
+}
+
 type Login struct {
 	Email        string `json:"username"`
 	Password     string `json:"password"`
@@ -52,14 +63,19 @@ func (l *Login) Validate() error {
 }
 
 type UpdateAccountData struct {
-	Name     string `json:"name"`
-	UpdateBy int64  `json:"-"`
+	Name     string      `json:"name"`
+	Plan     null.String `json:"plan"`
+	UpdateBy int64       `json:"-"`
 }
 
 func (u *UpdateAccountData) Validate() error {
 	if u.Name == "" {
 		return errormsg.WrapErr(svcerr.BrickSVCInvalidEmptyName, nil, "invalid empty name")
 	}
+
+	if u.Plan.Valid && u.Plan.String == "" {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, nil, "invalid empty plan")
+	}
 	return nil
 }
 
@@ -122,6 +138,7 @@ type Account struct {
 	Name   string `json:"name"`
 	Email  string `json:"email"`
 	APIKey string `json:"api_key"`
+	Plan   string `json:"plan"`
 	BaseInformation
 }
 
@@ -161,6 +178,7 @@ func (g *GetAccountByParam) GetQuery() []qm.QueryMod {
 
 type GetAccountsByParam struct {
 	GetAccountByParam
+	CursorParam
 	OrderBy null.String `schema:"order_by" json:"order_by" query:"order_by"`
 	Limit   int64       `schema:"limit" json:"limit" query:"limit"`
 	Page    int64       `schema:"page" json:"page" query:"page"`
@@ -210,6 +228,7 @@ func TransformPSQLSingleAccount(account *entity.Account) Account {
 		Name:            account.Name,
 		Email:           account.Email,
 		APIKey:          account.APIKey.String,
+		Plan:            account.Plan,
 		BaseInformation: creationInfo,
 	}
 }
@@ -231,6 +250,7 @@ func TransformPSQLAccount(account *entity.AccountSlice) []Account {
 			Name:            v.Name,
 			Email:           v.Email,
 			APIKey:          v.APIKey.String,
+			Plan:            v.Plan,
 			BaseInformation: creationInfo,
 		})
 	}
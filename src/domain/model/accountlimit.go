@@ -0,0 +1,145 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	GetAccountLimitUsageKey string = "alUsage:%d:%s:%s"
+
+	// AccountLimitUsageRetention keeps the counter of a day a little longer than the day itself
+	AccountLimitUsageRetention time.Duration = 48 * time.Hour
+	accountLimitDayLayout      string        = "20060102"
+)
+
+// TransferLimit caps the transfers of an account in one currency, a zero limit is unlimited
+type TransferLimit struct {
+	MaxPerTransaction int64 `mapstructure:"max_per_transaction"`
+	DailyAmount       int64 `mapstructure:"daily_amount"`
+	DailyCount        int64 `mapstructure:"daily_count"`
+}
+
+// AccountLimitUsageKey is the redis counter of the transfers made by the account in currency on the UTC day of at
+func AccountLimitUsageKey(accountID int, currency string, at time.Time) string {
+	return fmt.Sprintf(GetAccountLimitUsageKey, accountID, currency, at.UTC().Format(accountLimitDayLayout))
+}
+
+// AccountLimitDay returns the UTC day holding at
+func AccountLimitDay(at time.Time) (time.Time, time.Time) {
+	start := at.UTC().Truncate(24 * time.Hour)
+	return start, start.Add(24 * time.Hour)
+}
+
+// CheckTransferLimit rejects an amount above the per transaction limit, the daily limits are checked while reserving
+func CheckTransferLimit(v *entity.AccountLimit, amount int64) error {
+	if v.MaxPerTransaction > 0 && amount > v.MaxPerTransaction {
+		return errormsg.WrapErr(svcerr.BrickSVCTransferLimitExceeded, nil, fmt.Sprintf("amount %d is above the limit of %d %s per transaction", amount, v.MaxPerTransaction, v.Currency))
+	}
+	return nil
+}
+
+type TransferLimitUsage struct {
+	Amount int64 `boil:"amount" json:"amount" redis:"amount"`
+	Count  int64 `boil:"count" json:"count" redis:"count"`
+}
+
+type UpsertAccountLimit struct {
+	Currency          string `json:"currency" example:"IDR"`
+	MaxPerTransaction int64  `json:"max_per_transaction" example:"5000000000"`
+	DailyAmount       int64  `json:"daily_amount" example:"20000000000"`
+	DailyCount        int64  `json:"daily_count" example:"100"`
+}
+
+func (u *UpsertAccountLimit) Validate() error {
+	if _, ok := CurrencyMinorUnits[u.Currency]; !ok {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidAccountLimit, nil, "currency must be an uppercase ISO 4217 code")
+	}
+
+	if u.MaxPerTransaction < 0 || u.DailyAmount < 0 || u.DailyCount < 0 {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidAccountLimit, nil, "limit must not be negative, use 0 for unlimited")
+	}
+
+	if u.DailyAmount > 0 && u.MaxPerTransaction > u.DailyAmount {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidAccountLimit, nil, "max_per_transaction must not be above daily_amount")
+	}
+	return nil
+}
+
+func (u *UpsertAccountLimit) ToEntity(accountID int64) (entity.AccountLimit, error) {
+	if err := u.Validate(); err != nil {
+		return entity.AccountLimit{}, err
+	}
+
+	return entity.AccountLimit{
+		AccountID:         int(accountID),
+		Currency:          u.Currency,
+		MaxPerTransaction: u.MaxPerTransaction,
+		DailyAmount:       u.DailyAmount,
+		DailyCount:        int(u.DailyCount),
+	}, nil
+}
+
+type GetAccountLimitByParam struct {
+	ID        null.Int64  `json:"id" schema:"id" query:"id"`
+	AccountID null.Int64  `json:"account_id" schema:"account_id" query:"account_id"`
+	Currency  null.String `json:"currency" schema:"currency" query:"currency"`
+}
+
+func (g *GetAccountLimitByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.AccountID.Valid {
+		res = append(res, qm.Where("account_id=?", g.AccountID.Int64))
+	}
+
+	if g.Currency.Valid {
+		res = append(res, qm.Where("currency=?", g.Currency.String))
+	}
+	return res
+}
+
+type AccountLimit struct {
+	ID                int64              `json:"id"`
+	AccountID         int64              `json:"account_id"`
+	Currency          string             `json:"currency"`
+	MaxPerTransaction int64              `json:"max_per_transaction"`
+	DailyAmount       int64              `json:"daily_amount"`
+	DailyCount        int64              `json:"daily_count"`
+	IsDefault         bool               `json:"is_default"`
+	Usage             TransferLimitUsage `json:"usage"`
+	BaseInformation
+}
+
+// TransformPSQLSingleAccountLimit returns the limit with the usage of the current day, a limit without id comes from the configured defaults
+func TransformPSQLSingleAccountLimit(v *entity.AccountLimit, usage TransferLimitUsage) AccountLimit {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(v.CreatedBy),
+		CreatedAt: v.CreatedAt,
+		UpdatedBy: int64(v.UpdatedBy),
+		UpdatedAt: v.UpdatedAt,
+		DeletedBy: int64(v.DeletedBy.Int),
+		DeletedAt: v.DeletedAt.Time,
+	}
+
+	return AccountLimit{
+		ID:                int64(v.ID),
+		AccountID:         int64(v.AccountID),
+		Currency:          v.Currency,
+		MaxPerTransaction: v.MaxPerTransaction,
+		DailyAmount:       v.DailyAmount,
+		DailyCount:        int64(v.DailyCount),
+		IsDefault:         v.ID == 0,
+		Usage:             usage,
+		BaseInformation:   creationInfo,
+	}
+}
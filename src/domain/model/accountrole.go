@@ -36,6 +36,7 @@ func (g *GetAccountRoleByParam) GetQuery() []qm.QueryMod {
 
 type GetAccountRolesByParam struct {
 	GetAccountRoleByParam
+	CursorParam
 	OrderBy null.String `schema:"order_by" json:"order_by" query:"order_by"`
 	Limit   int64       `schema:"limit" json:"limit" query:"limit"`
 	Page    int64       `schema:"page" json:"page" query:"page"`
@@ -1,16 +1,23 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
 
 var (
 	DefaultRedisExpiration time.Duration = 5 * time.Minute
 	TokenTypeBearer                      = "Bearer"
+	APIKeyHeader                         = "API-Key"
 )
 
 type Header struct {
-	CacheControl  string `reqHeader:"Cache-Control"`
-	Authorization string `reqHeader:"Authorization"`
-	APIKey        string `reqHeader:"API-Key"`
+	CacheControl   string `reqHeader:"Cache-Control"`
+	Authorization  string `reqHeader:"Authorization"`
+	APIKey         string `reqHeader:"API-Key"`
+	IdempotencyKey string `reqHeader:"Idempotency-Key"`
+	LastEventID    string `reqHeader:"Last-Event-ID"`
 }
 
 type BaseInformation struct {
@@ -23,9 +30,20 @@ type BaseInformation struct {
 }
 
 type Pagination struct {
-	CurrentPage     int64  `json:"current_page"`
-	CurrentElements int64  `json:"current_elements"`
-	TotalPages      int64  `json:"total_pages"`
-	TotalElements   int64  `json:"total_elements"`
-	SortBy          string `json:"sort_by"`
+	CurrentPage     int64      `json:"current_page"`
+	CurrentElements int64      `json:"current_elements"`
+	TotalPages      int64      `json:"total_pages"`
+	TotalElements   int64      `json:"total_elements"`
+	SortBy          string     `json:"sort_by"`
+	NextCursor      string     `json:"next_cursor"`
+	PrevCursor      string     `json:"prev_cursor"`
+	EstimatedTotal  null.Int64 `json:"estimated_total" swaggertype:"integer"`
+}
+
+// TotalPages is the number of pages of limit elements needed to hold count elements, an empty result still has a page
+func TotalPages(count int64, limit int64) int64 {
+	if count <= 0 || limit <= 0 {
+		return 1
+	}
+	return (count + limit - 1) / limit
 }
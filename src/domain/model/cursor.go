@@ -0,0 +1,164 @@
+package model
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	PaginationOffset string = "offset"
+	PaginationCursor string = "cursor"
+)
+
+// CursorSortBy is the order of a cursor walk, the id breaks ties between rows created at the same time
+var CursorSortBy string = "created_at desc,id desc"
+
+// Cursor is the position of a row in the cursor order, handed to the client as an opaque string
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        int64     `json:"i"`
+	Prev      bool      `json:"p,omitempty"`
+}
+
+func (c Cursor) Encode() string {
+	res, _ := jsoniter.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(res)
+}
+
+func DecodeCursor(v string) (Cursor, error) {
+	var res Cursor
+	body, err := base64.RawURLEncoding.DecodeString(v)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCInvalidCursor, err, "error decode cursor")
+	}
+
+	if err := jsoniter.Unmarshal(body, &res); err != nil || res.ID <= 0 {
+		return res, errormsg.WrapErr(svcerr.BrickSVCInvalidCursor, err, "error decode cursor")
+	}
+	return res, nil
+}
+
+// CursorParam walks a list by (created_at, id) instead of page offsets, the count is only estimated when asked for
+type CursorParam struct {
+	Cursor     null.String `schema:"cursor" json:"cursor" query:"cursor"`
+	Pagination null.String `schema:"pagination" json:"pagination" query:"pagination"`
+	WithTotal  bool        `schema:"with_total" json:"with_total" query:"with_total"`
+}
+
+// UseCursor reports whether the list is walked by cursor, which is only when a cursor or pagination=cursor is given,
+// offset pagination stays the default
+func (c *CursorParam) UseCursor(page int64, orderBy null.String) (bool, error) {
+	if c.Pagination.Valid && c.Pagination.String != PaginationOffset && c.Pagination.String != PaginationCursor {
+		return false, errormsg.WrapErr(svcerr.BrickSVCInvalidCursor, nil, "pagination must be offset or cursor")
+	}
+
+	useCursor := c.Cursor.Valid || c.Pagination.String == PaginationCursor
+	if !useCursor {
+		return false, nil
+	}
+
+	if c.Pagination.String == PaginationOffset {
+		return false, errormsg.WrapErr(svcerr.BrickSVCInvalidCursor, nil, "cursor cannot be combined with offset pagination")
+	}
+
+	if page > 0 || orderBy.Valid {
+		return false, errormsg.WrapErr(svcerr.BrickSVCInvalidCursor, nil, "cursor cannot be combined with page or order_by")
+	}
+	return true, nil
+}
+
+// CursorQuery returns the query mods reading the page after or before the cursor, one row more than the limit is read
+// to tell whether the walk goes on and a page before the cursor is read in reverse
+func (c *CursorParam) CursorQuery(limit int64) ([]qm.QueryMod, Cursor, error) {
+	var res []qm.QueryMod
+	var cursor Cursor
+	if c.Cursor.Valid {
+		var err error
+		cursor, err = DecodeCursor(c.Cursor.String)
+		if err != nil {
+			return nil, cursor, err
+		}
+
+		if cursor.Prev {
+			res = append(res, qm.Where("(created_at, id)>(?, ?)", cursor.CreatedAt, cursor.ID))
+		} else {
+			res = append(res, qm.Where("(created_at, id)<(?, ?)", cursor.CreatedAt, cursor.ID))
+		}
+	}
+
+	if cursor.Prev {
+		res = append(res, qm.OrderBy("created_at asc, id asc"))
+	} else {
+		res = append(res, qm.OrderBy("created_at desc, id desc"))
+	}
+	return append(res, qm.Limit(int(limit+1))), cursor, nil
+}
+
+// CursorPage trims the row read past the limit, restores the cursor order and returns the cursors around the page,
+// key gives the position of a row
+func CursorPage[S ~[]E, E any](c *CursorParam, cursor Cursor, rows S, limit int64, key func(E) Cursor) (S, Pagination) {
+	more := int64(len(rows)) > limit
+	if more {
+		rows = rows[:limit]
+	}
+
+	if cursor.Prev {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	res := Pagination{
+		CurrentElements: int64(len(rows)),
+		SortBy:          CursorSortBy,
+	}
+	if len(rows) == 0 {
+		return rows, res
+	}
+
+	first, last := key(rows[0]), key(rows[len(rows)-1])
+	first.Prev = true
+	// walking back there is always a page after, walking forward there is a page before once a cursor was followed
+	if cursor.Prev {
+		res.NextCursor = last.Encode()
+		if more {
+			res.PrevCursor = first.Encode()
+		}
+	} else {
+		if more {
+			res.NextCursor = last.Encode()
+		}
+		if c.Cursor.Valid {
+			res.PrevCursor = first.Encode()
+		}
+	}
+	return rows, res
+}
+
+// EstimateCount reads the number of rows the planner expects the query to return, which is cheap but approximate
+func EstimateCount(ctx context.Context, exec boil.ContextExecutor, q *queries.Query) (int64, error) {
+	query, args := queries.BuildQuery(q)
+	var plan []byte
+	if err := exec.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON) "+query, args...).Scan(&plan); err != nil {
+		return 0, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error estimate count")
+	}
+
+	var res []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := jsoniter.Unmarshal(plan, &res); err != nil || len(res) == 0 {
+		return 0, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal plan")
+	}
+	return int64(res[0].Plan.Rows), nil
+}
@@ -0,0 +1,169 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/kafkalib"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type CreateDeadLetterMessage struct {
+	Topic     string
+	Partition int
+	Offset    int
+	Key       string
+	Value     string
+	Header    map[string]string
+}
+
+func (c *CreateDeadLetterMessage) ToEntity() (entity.DeadLetterMessage, error) {
+	headers, err := jsoniter.Marshal(c.Header)
+	if err != nil {
+		return entity.DeadLetterMessage{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+
+	// messages dead lettered without passing a retry handler are replayed onto the topic they came from
+	originalTopic := c.Header[kafkalib.HeaderOriginalTopic]
+	if originalTopic == "" {
+		originalTopic = c.Topic
+	}
+	attempts, _ := strconv.Atoi(c.Header[kafkalib.HeaderRetryAttempt])
+
+	res := entity.DeadLetterMessage{
+		Topic:         c.Topic,
+		Partition:     c.Partition,
+		MessageOffset: int64(c.Offset),
+		OriginalTopic: originalTopic,
+		MessageKey:    c.Key,
+		Payload:       c.Value,
+		Headers:       headers,
+		RetryAttempts: attempts,
+		Status:        entity.DeadletterstatusPending,
+	}
+	if v, ok := c.Header[kafkalib.HeaderError]; ok {
+		res.Error = null.StringFrom(v)
+	}
+	return res, nil
+}
+
+// OutboxDeadLetterTopic is the topic recorded on outbox messages parked after running out of publish attempts, they
+// were never on kafka so the outbox id stands in for the offset
+var OutboxDeadLetterTopic string = "outbox"
+
+func NewOutboxDeadLetterMessage(v *entity.OutboxMessage) (entity.DeadLetterMessage, error) {
+	c := CreateDeadLetterMessage{
+		Topic:  OutboxDeadLetterTopic,
+		Offset: v.ID,
+		Key:    v.MessageKey,
+		Value:  string(v.Payload),
+		Header: map[string]string{
+			kafkalib.HeaderOriginalTopic: v.Topic,
+			kafkalib.HeaderRetryAttempt:  strconv.Itoa(v.Attempts),
+			kafkalib.HeaderError:         v.LastError.String,
+		},
+	}
+	return c.ToEntity()
+}
+
+type GetDeadLetterMessageByParam struct {
+	ID            null.Int64  `json:"id" schema:"id" query:"id"`
+	OriginalTopic null.String `json:"original_topic" schema:"original_topic" query:"original_topic"`
+	Status        null.String `json:"status" schema:"status" query:"status"`
+}
+
+func (g *GetDeadLetterMessageByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.OriginalTopic.Valid {
+		res = append(res, qm.Where("original_topic=?", g.OriginalTopic.String))
+	}
+
+	if g.Status.Valid {
+		res = append(res, qm.Where("status=?", g.Status.String))
+	}
+	return res
+}
+
+type GetDeadLetterMessagesByParam struct {
+	GetDeadLetterMessageByParam
+	OrderBy null.String `schema:"order_by" json:"order_by" query:"order_by"`
+	Limit   int64       `schema:"limit" json:"limit" query:"limit"`
+	Page    int64       `schema:"page" json:"page" query:"page"`
+}
+
+func (g *GetDeadLetterMessagesByParam) GetQuery() []qm.QueryMod {
+	res := g.GetDeadLetterMessageByParam.GetQuery()
+	if g.OrderBy.Valid {
+		order := strings.Split(g.OrderBy.String, ",")
+		for _, o := range order {
+			res = append(res, qm.OrderBy(o))
+		}
+	}
+	return res
+}
+
+type DeadLetterMessage struct {
+	ID            int64             `json:"id"`
+	Topic         string            `json:"topic"`
+	Partition     int64             `json:"partition"`
+	Offset        int64             `json:"offset"`
+	OriginalTopic string            `json:"original_topic"`
+	Key           string            `json:"key"`
+	Payload       string            `json:"payload"`
+	Headers       map[string]string `json:"headers"`
+	Error         string            `json:"error"`
+	RetryAttempts int64             `json:"retry_attempts"`
+	Status        string            `json:"status"`
+	ReplayedAt    time.Time         `json:"replayed_at"`
+	BaseInformation
+}
+
+func TransformPSQLSingleDeadLetterMessage(v *entity.DeadLetterMessage) DeadLetterMessage {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(v.CreatedBy),
+		CreatedAt: v.CreatedAt,
+		UpdatedBy: int64(v.UpdatedBy),
+		UpdatedAt: v.UpdatedAt,
+		DeletedBy: int64(v.DeletedBy.Int),
+		DeletedAt: v.DeletedAt.Time,
+	}
+
+	headers := map[string]string{}
+	if err := v.Headers.Unmarshal(&headers); err != nil {
+		headers = map[string]string{}
+	}
+
+	return DeadLetterMessage{
+		ID:              int64(v.ID),
+		Topic:           v.Topic,
+		Partition:       int64(v.Partition),
+		Offset:          v.MessageOffset,
+		OriginalTopic:   v.OriginalTopic,
+		Key:             v.MessageKey,
+		Payload:         v.Payload,
+		Headers:         headers,
+		Error:           v.Error.String,
+		RetryAttempts:   int64(v.RetryAttempts),
+		Status:          v.Status.String(),
+		ReplayedAt:      v.ReplayedAt.Time,
+		BaseInformation: creationInfo,
+	}
+}
+
+func TransformPSQLDeadLetterMessage(v *entity.DeadLetterMessageSlice) []DeadLetterMessage {
+	var res []DeadLetterMessage
+	for _, d := range *v {
+		res = append(res, TransformPSQLSingleDeadLetterMessage(d))
+	}
+	return res
+}
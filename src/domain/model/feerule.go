@@ -0,0 +1,261 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// DefaultAccountPlan is the plan given to accounts until an admin moves them to another one
+var DefaultAccountPlan string = "standard"
+
+type CreateFeeRule struct {
+	Name              string      `json:"name" example:"BCA to BRI"`
+	SourceBankID      null.Int64  `json:"source_bank_id"`
+	DestinationBankID null.Int64  `json:"destination_bank_id"`
+	Plan              null.String `json:"plan"`
+	Currency          string      `json:"currency" example:"IDR"`
+	MinAmount         int64       `json:"min_amount" example:"0"`
+	MaxAmount         null.Int64  `json:"max_amount"`
+	FeeType           string      `json:"fee_type" enums:"flat,percentage,capped" example:"capped"`
+	FlatAmount        int64       `json:"flat_amount" example:"250000"`
+	PercentageBPS     int64       `json:"percentage_bps" example:"10"`
+	MinFee            null.Int64  `json:"min_fee"`
+	MaxFee            null.Int64  `json:"max_fee"`
+}
+
+func (c *CreateFeeRule) Validate() error {
+	if c.Name == "" {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFeeRule, nil, "name is required")
+	}
+
+	if _, ok := CurrencyMinorUnits[c.Currency]; !ok {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFeeRule, nil, "currency must be an uppercase ISO 4217 code")
+	}
+
+	if c.Plan.Valid && c.Plan.String == "" {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFeeRule, nil, "plan must not be empty, omit it to match every plan")
+	}
+
+	if c.MinAmount < 0 {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFeeRule, nil, "min_amount must not be negative")
+	}
+
+	if c.MaxAmount.Valid && c.MaxAmount.Int64 <= c.MinAmount {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFeeRule, nil, "max_amount must be greater than min_amount")
+	}
+
+	if c.FlatAmount < 0 || c.PercentageBPS < 0 || c.PercentageBPS > 10000 {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFeeRule, nil, "flat_amount must not be negative and percentage_bps must be between 0 and 10000")
+	}
+
+	switch entity.Feetype(c.FeeType) {
+	case entity.FeetypeFlat:
+		if c.PercentageBPS != 0 || c.MinFee.Valid || c.MaxFee.Valid {
+			return errormsg.WrapErr(svcerr.BrickSVCInvalidFeeRule, nil, "flat fee only takes flat_amount")
+		}
+	case entity.FeetypePercentage:
+		if c.FlatAmount != 0 || c.MinFee.Valid || c.MaxFee.Valid {
+			return errormsg.WrapErr(svcerr.BrickSVCInvalidFeeRule, nil, "percentage fee only takes percentage_bps, use capped to bound it")
+		}
+	case entity.FeetypeCapped:
+		if !c.MaxFee.Valid {
+			return errormsg.WrapErr(svcerr.BrickSVCInvalidFeeRule, nil, "capped fee requires max_fee")
+		}
+
+		if c.MinFee.Valid && (c.MinFee.Int64 < 0 || c.MinFee.Int64 > c.MaxFee.Int64) {
+			return errormsg.WrapErr(svcerr.BrickSVCInvalidFeeRule, nil, "min_fee must be between 0 and max_fee")
+		}
+
+		if c.MaxFee.Int64 < 0 {
+			return errormsg.WrapErr(svcerr.BrickSVCInvalidFeeRule, nil, "max_fee must not be negative")
+		}
+	default:
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFeeRule, nil, "fee_type must be one of flat, percentage or capped")
+	}
+	return nil
+}
+
+// FillEntity replaces every rule field so an update behaves like the create payload
+func (c *CreateFeeRule) FillEntity(v *entity.FeeRule) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	v.Name = c.Name
+	v.SourceBankID = null.NewInt(int(c.SourceBankID.Int64), c.SourceBankID.Valid)
+	v.DestinationBankID = null.NewInt(int(c.DestinationBankID.Int64), c.DestinationBankID.Valid)
+	v.Plan = c.Plan
+	v.Currency = c.Currency
+	v.MinAmount = c.MinAmount
+	v.MaxAmount = c.MaxAmount
+	v.FeeType = entity.Feetype(c.FeeType)
+	v.FlatAmount = c.FlatAmount
+	v.PercentageBPS = int(c.PercentageBPS)
+	v.MinFee = c.MinFee
+	v.MaxFee = c.MaxFee
+	return nil
+}
+
+// CalculateFee applies the rule to an amount in minor units, percentages are rounded up
+func CalculateFee(rule *entity.FeeRule, amount int64) int64 {
+	switch rule.FeeType {
+	case entity.FeetypeFlat:
+		return rule.FlatAmount
+	case entity.FeetypePercentage:
+		return FeeFromBPS(amount, int64(rule.PercentageBPS))
+	case entity.FeetypeCapped:
+		fee := rule.FlatAmount + FeeFromBPS(amount, int64(rule.PercentageBPS))
+		if rule.MinFee.Valid && fee < rule.MinFee.Int64 {
+			fee = rule.MinFee.Int64
+		}
+		if rule.MaxFee.Valid && fee > rule.MaxFee.Int64 {
+			fee = rule.MaxFee.Int64
+		}
+		return fee
+	}
+	return 0
+}
+
+// MatchFeeRule describes a transfer looking for the fee rule that applies to it
+type MatchFeeRule struct {
+	SourceBankID      int64
+	DestinationBankID int64
+	Plan              string
+	Currency          string
+	Amount            int64
+}
+
+// GetQuery keeps the rules whose tier holds the amount, an empty bank or plan column matches anything
+func (m *MatchFeeRule) GetQuery() []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.Where("currency=?", m.Currency),
+		qm.Where("min_amount<=?", m.Amount),
+		qm.Where("(max_amount IS NULL OR max_amount>?)", m.Amount),
+		qm.Where("(source_bank_id IS NULL OR source_bank_id=?)", m.SourceBankID),
+		qm.Where("(destination_bank_id IS NULL OR destination_bank_id=?)", m.DestinationBankID),
+		qm.Where("(plan IS NULL OR plan=?)", m.Plan),
+		qm.OrderBy("((source_bank_id IS NOT NULL)::int + (destination_bank_id IS NOT NULL)::int + (plan IS NOT NULL)::int) DESC, min_amount DESC, id"),
+	}
+}
+
+type GetFeeRuleByParam struct {
+	ID                null.Int64  `json:"id" schema:"id" query:"id"`
+	SourceBankID      null.Int64  `json:"source_bank_id" schema:"source_bank_id" query:"source_bank_id"`
+	DestinationBankID null.Int64  `json:"destination_bank_id" schema:"destination_bank_id" query:"destination_bank_id"`
+	Plan              null.String `json:"plan" schema:"plan" query:"plan"`
+	Currency          null.String `json:"currency" schema:"currency" query:"currency"`
+	FeeType           null.String `json:"fee_type" schema:"fee_type" query:"fee_type"`
+}
+
+func (g *GetFeeRuleByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.SourceBankID.Valid {
+		res = append(res, qm.Where("source_bank_id=?", g.SourceBankID.Int64))
+	}
+
+	if g.DestinationBankID.Valid {
+		res = append(res, qm.Where("destination_bank_id=?", g.DestinationBankID.Int64))
+	}
+
+	if g.Plan.Valid {
+		res = append(res, qm.Where("plan=?", g.Plan.String))
+	}
+
+	if g.Currency.Valid {
+		res = append(res, qm.Where("currency=?", g.Currency.String))
+	}
+
+	if g.FeeType.Valid {
+		res = append(res, qm.Where("fee_type=?", g.FeeType.String))
+	}
+	return res
+}
+
+type GetFeeRulesByParam struct {
+	GetFeeRuleByParam
+	OrderBy null.String `schema:"order_by" json:"order_by" query:"order_by"`
+	Limit   int64       `schema:"limit" json:"limit" query:"limit"`
+	Page    int64       `schema:"page" json:"page" query:"page"`
+}
+
+func (g *GetFeeRulesByParam) GetQuery() []qm.QueryMod {
+	res := g.GetFeeRuleByParam.GetQuery()
+	if g.OrderBy.Valid {
+		order := strings.Split(g.OrderBy.String, ",")
+		for _, o := range order {
+			res = append(res, qm.OrderBy(o))
+		}
+	}
+	return res
+}
+
+type FeeRule struct {
+	ID                int64   `json:"id"`
+	Name              string  `json:"name"`
+	SourceBankID      *int64  `json:"source_bank_id"`
+	DestinationBankID *int64  `json:"destination_bank_id"`
+	Plan              *string `json:"plan"`
+	Currency          string  `json:"currency"`
+	MinAmount         int64   `json:"min_amount"`
+	MaxAmount         *int64  `json:"max_amount"`
+	FeeType           string  `json:"fee_type"`
+	FlatAmount        int64   `json:"flat_amount"`
+	PercentageBPS     int64   `json:"percentage_bps"`
+	MinFee            *int64  `json:"min_fee"`
+	MaxFee            *int64  `json:"max_fee"`
+	BaseInformation
+}
+
+func TransformPSQLSingleFeeRule(v *entity.FeeRule) FeeRule {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(v.CreatedBy),
+		CreatedAt: v.CreatedAt,
+		UpdatedBy: int64(v.UpdatedBy),
+		UpdatedAt: v.UpdatedAt,
+		DeletedBy: int64(v.DeletedBy.Int),
+		DeletedAt: v.DeletedAt.Time,
+	}
+
+	res := FeeRule{
+		ID:              int64(v.ID),
+		Name:            v.Name,
+		Plan:            v.Plan.Ptr(),
+		Currency:        v.Currency,
+		MinAmount:       v.MinAmount,
+		MaxAmount:       v.MaxAmount.Ptr(),
+		FeeType:         v.FeeType.String(),
+		FlatAmount:      v.FlatAmount,
+		PercentageBPS:   int64(v.PercentageBPS),
+		MinFee:          v.MinFee.Ptr(),
+		MaxFee:          v.MaxFee.Ptr(),
+		BaseInformation: creationInfo,
+	}
+
+	if v.SourceBankID.Valid {
+		id := int64(v.SourceBankID.Int)
+		res.SourceBankID = &id
+	}
+
+	if v.DestinationBankID.Valid {
+		id := int64(v.DestinationBankID.Int)
+		res.DestinationBankID = &id
+	}
+	return res
+}
+
+func TransformPSQLFeeRule(v *entity.FeeRuleSlice) []FeeRule {
+	var res []FeeRule
+	for _, f := range *v {
+		res = append(res, TransformPSQLSingleFeeRule(f))
+	}
+	return res
+}
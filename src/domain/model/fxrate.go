@@ -0,0 +1,192 @@
+package model
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+var (
+	FXRateSourceFile string = "file"
+	FXRateSourceAPI  string = "api"
+
+	// fxRateScale is the number of fractional digits kept by the rate columns
+	fxRateScale int = 12
+)
+
+type CreateFXRate struct {
+	BaseCurrency  string `json:"base_currency" example:"USD"`
+	QuoteCurrency string `json:"quote_currency" example:"IDR"`
+	Rate          string `json:"rate" example:"15650.25"`
+	FeeBPS        int64  `json:"fee_bps" example:"50"`
+}
+
+func (c *CreateFXRate) Validate() error {
+	_, baseOK := CurrencyMinorUnits[c.BaseCurrency]
+	_, quoteOK := CurrencyMinorUnits[c.QuoteCurrency]
+	if !baseOK || !quoteOK {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFXRate, nil, "currency must be an uppercase ISO 4217 code")
+	}
+
+	if c.BaseCurrency == c.QuoteCurrency {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFXRate, nil, "base and quote currency must differ")
+	}
+
+	rate, ok := new(big.Rat).SetString(c.Rate)
+	if !ok || rate.Sign() <= 0 {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFXRate, nil, "rate must be a positive decimal")
+	}
+
+	if c.FeeBPS < 0 || c.FeeBPS > 10000 {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFXRate, nil, "fee_bps must be between 0 and 10000")
+	}
+	return nil
+}
+
+func (c *CreateFXRate) ToEntity(source string) (entity.FXRate, error) {
+	if err := c.Validate(); err != nil {
+		return entity.FXRate{}, err
+	}
+
+	rate, _ := new(big.Rat).SetString(c.Rate)
+	return entity.FXRate{
+		BaseCurrency:  c.BaseCurrency,
+		QuoteCurrency: c.QuoteCurrency,
+		Rate:          RatToDecimal(rate),
+		FeeBPS:        int(c.FeeBPS),
+		Source:        source,
+	}, nil
+}
+
+// RatToDecimal rounds the rate to the scale of the rate columns
+func RatToDecimal(v *big.Rat) types.Decimal {
+	res, _ := new(decimal.Big).SetString(v.FloatString(fxRateScale))
+	return types.NewDecimal(res)
+}
+
+func DecimalToRat(v types.Decimal) (*big.Rat, error) {
+	if v.Big == nil {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCInvalidFXRate, nil, "rate is empty")
+	}
+	res, ok := new(big.Rat).SetString(v.Big.String())
+	if !ok {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCInvalidFXRate, nil, "invalid rate "+v.Big.String())
+	}
+	return res, nil
+}
+
+// FormatRate writes the rate as a plain decimal without trailing zeros
+func FormatRate(v types.Decimal) string {
+	rate, err := DecimalToRat(v)
+	if err != nil {
+		return ""
+	}
+	res := rate.FloatString(fxRateScale)
+	if strings.Contains(res, ".") {
+		res = strings.TrimRight(strings.TrimRight(res, "0"), ".")
+	}
+	return res
+}
+
+// QuoteRate returns the rate converting base into quote currency, a pair stored the other way round is inverted
+func QuoteRate(v *entity.FXRate, base string) (*big.Rat, error) {
+	rate, err := DecimalToRat(v.Rate)
+	if err != nil {
+		return nil, err
+	}
+	if v.BaseCurrency != base {
+		rate.Inv(rate)
+	}
+	return rate, nil
+}
+
+type GetFXRateByParam struct {
+	ID            null.Int64  `json:"id" schema:"id" query:"id"`
+	BaseCurrency  null.String `json:"base_currency" schema:"base_currency" query:"base_currency"`
+	QuoteCurrency null.String `json:"quote_currency" schema:"quote_currency" query:"quote_currency"`
+	Source        null.String `json:"source" schema:"source" query:"source"`
+}
+
+func (g *GetFXRateByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.BaseCurrency.Valid {
+		res = append(res, qm.Where("base_currency=?", g.BaseCurrency.String))
+	}
+
+	if g.QuoteCurrency.Valid {
+		res = append(res, qm.Where("quote_currency=?", g.QuoteCurrency.String))
+	}
+
+	if g.Source.Valid {
+		res = append(res, qm.Where("source=?", g.Source.String))
+	}
+	return res
+}
+
+type GetFXRatesByParam struct {
+	GetFXRateByParam
+	OrderBy null.String `schema:"order_by" json:"order_by" query:"order_by"`
+	Limit   int64       `schema:"limit" json:"limit" query:"limit"`
+	Page    int64       `schema:"page" json:"page" query:"page"`
+}
+
+func (g *GetFXRatesByParam) GetQuery() []qm.QueryMod {
+	res := g.GetFXRateByParam.GetQuery()
+	if g.OrderBy.Valid {
+		order := strings.Split(g.OrderBy.String, ",")
+		for _, o := range order {
+			res = append(res, qm.OrderBy(o))
+		}
+	}
+	return res
+}
+
+type FXRate struct {
+	ID            int64  `json:"id"`
+	BaseCurrency  string `json:"base_currency"`
+	QuoteCurrency string `json:"quote_currency"`
+	Rate          string `json:"rate"`
+	FeeBPS        int64  `json:"fee_bps"`
+	Source        string `json:"source"`
+	BaseInformation
+}
+
+func TransformPSQLSingleFXRate(v *entity.FXRate) FXRate {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(v.CreatedBy),
+		CreatedAt: v.CreatedAt,
+		UpdatedBy: int64(v.UpdatedBy),
+		UpdatedAt: v.UpdatedAt,
+		DeletedBy: int64(v.DeletedBy.Int),
+		DeletedAt: v.DeletedAt.Time,
+	}
+
+	return FXRate{
+		ID:              int64(v.ID),
+		BaseCurrency:    v.BaseCurrency,
+		QuoteCurrency:   v.QuoteCurrency,
+		Rate:            FormatRate(v.Rate),
+		FeeBPS:          int64(v.FeeBPS),
+		Source:          v.Source,
+		BaseInformation: creationInfo,
+	}
+}
+
+func TransformPSQLFXRate(v *entity.FXRateSlice) []FXRate {
+	var res []FXRate
+	for _, f := range *v {
+		res = append(res, TransformPSQLSingleFXRate(f))
+	}
+	return res
+}
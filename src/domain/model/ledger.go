@@ -0,0 +1,274 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/lucsky/cuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// LedgerPostingOrigin is the posting a settlement, reversal or refund unwinds, jobs submitted before the ledger existed have none
+var LedgerPostingOrigin = map[entity.Ledgertransactiontype]entity.Ledgertransactiontype{
+	entity.LedgertransactiontypeSettlement: entity.LedgertransactiontypeTransfer,
+	entity.LedgertransactiontypeReversal:   entity.LedgertransactiontypeTransfer,
+	entity.LedgertransactiontypeRefund:     entity.LedgertransactiontypeFee,
+}
+
+// LedgerLine is one side of a posting, system accounts leave the api key empty
+type LedgerLine struct {
+	Kind      entity.Ledgeraccountkind
+	APIKey    string
+	Direction entity.Ledgerdirection
+	Amount    int64
+}
+
+// LedgerPosting is a balanced set of lines booked as one ledger transaction
+type LedgerPosting struct {
+	Type          entity.Ledgertransactiontype
+	TransferJobID null.Int
+	Currency      string
+	Description   string
+	Lines         []LedgerLine
+}
+
+// Validate rejects a posting whose debits and credits differ, nothing unbalanced reaches the ledger
+func (p *LedgerPosting) Validate() error {
+	if _, ok := CurrencyMinorUnits[p.Currency]; !ok {
+		return errormsg.WrapErr(svcerr.BrickSVCUnbalancedLedger, nil, "currency must be an uppercase ISO 4217 code")
+	}
+
+	if len(p.Lines) < 2 {
+		return errormsg.WrapErr(svcerr.BrickSVCUnbalancedLedger, nil, "posting needs at least a debit and a credit")
+	}
+
+	var debit, credit int64
+	for _, l := range p.Lines {
+		if l.Amount <= 0 {
+			return errormsg.WrapErr(svcerr.BrickSVCUnbalancedLedger, nil, "entry amount must be greater than zero")
+		}
+		switch l.Direction {
+		case entity.LedgerdirectionDebit:
+			debit += l.Amount
+		case entity.LedgerdirectionCredit:
+			credit += l.Amount
+		default:
+			return errormsg.WrapErr(svcerr.BrickSVCUnbalancedLedger, nil, "invalid entry direction "+l.Direction.String())
+		}
+	}
+
+	if debit != credit {
+		return errormsg.WrapErr(svcerr.BrickSVCUnbalancedLedger, nil, fmt.Sprintf("%s posting debits %d but credits %d %s", p.Type, debit, credit, p.Currency))
+	}
+	return nil
+}
+
+func (p *LedgerPosting) ToEntity() entity.LedgerTransaction {
+	return entity.LedgerTransaction{
+		TransactionID: cuid.New(),
+		Type:          p.Type,
+		TransferJobID: p.TransferJobID,
+		Currency:      p.Currency,
+		Description:   p.Description,
+	}
+}
+
+func newLedgerPosting(tp entity.Ledgertransactiontype, v *entity.TransferJob, currency string, amount int64, debit LedgerLine, credit LedgerLine) LedgerPosting {
+	debit.Direction = entity.LedgerdirectionDebit
+	debit.Amount = amount
+	credit.Direction = entity.LedgerdirectionCredit
+	credit.Amount = amount
+	return LedgerPosting{
+		Type:          tp,
+		TransferJobID: null.IntFrom(v.ID),
+		Currency:      currency,
+		Description:   fmt.Sprintf("%s of transfer %s", tp, v.JobID),
+		Lines:         []LedgerLine{debit, credit},
+	}
+}
+
+// NewTransferLedgerPostings returns what a job moving between the statuses books: the amount and fee leave the merchant
+// once the job is submitted, settle when the provider succeeds and are reversed and refunded when it fails
+func NewTransferLedgerPostings(v *entity.TransferJob, from entity.Transferstatus, to entity.Transferstatus) ([]LedgerPosting, error) {
+	var payload CreateTransfer
+	if err := v.Payload.Unmarshal(&payload); err != nil {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+	if payload.Currency == "" {
+		payload.Currency = DefaultCurrency
+	}
+
+	fee, err := TransferTotalFee(v)
+	if err != nil {
+		return nil, err
+	}
+
+	merchant := LedgerLine{Kind: entity.LedgeraccountkindMerchant, APIKey: v.APIKey}
+	clearing := LedgerLine{Kind: entity.LedgeraccountkindClearing}
+	settlement := LedgerLine{Kind: entity.LedgeraccountkindSettlement}
+	feeRevenue := LedgerLine{Kind: entity.LedgeraccountkindFeeRevenue}
+
+	var res []LedgerPosting
+	switch {
+	case to == entity.TransferstatusProcessing:
+		res = append(res, newLedgerPosting(entity.LedgertransactiontypeTransfer, v, payload.Currency, payload.Amount, merchant, clearing))
+		if fee > 0 {
+			res = append(res, newLedgerPosting(entity.LedgertransactiontypeFee, v, payload.Currency, fee, merchant, feeRevenue))
+		}
+	case from == entity.TransferstatusProcessing && to == entity.TransferstatusSuccess:
+		res = append(res, newLedgerPosting(entity.LedgertransactiontypeSettlement, v, payload.Currency, payload.Amount, clearing, settlement))
+	case from == entity.TransferstatusProcessing && to == entity.TransferstatusFailed:
+		res = append(res, newLedgerPosting(entity.LedgertransactiontypeReversal, v, payload.Currency, payload.Amount, clearing, merchant))
+		if fee > 0 {
+			res = append(res, newLedgerPosting(entity.LedgertransactiontypeRefund, v, payload.Currency, fee, feeRevenue, merchant))
+		}
+	}
+
+	for i := range res {
+		if err := res[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// LedgerBalance is the balance on the normal side of the account, settlement holds what left to the provider and is read as debit minus credit
+func LedgerBalance(kind entity.Ledgeraccountkind, debit int64, credit int64) int64 {
+	if kind == entity.LedgeraccountkindSettlement {
+		return debit - credit
+	}
+	return credit - debit
+}
+
+type GetLedgerAccountByParam struct {
+	ID       null.Int64  `json:"id" schema:"id" query:"id"`
+	Kind     null.String `json:"kind" schema:"kind" query:"kind"`
+	APIKey   null.String `json:"api_key" schema:"api_key" query:"api_key"`
+	Currency null.String `json:"currency" schema:"currency" query:"currency"`
+}
+
+func (g *GetLedgerAccountByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("ledger_accounts.id=?", g.ID.Int64))
+	}
+
+	if g.Kind.Valid {
+		res = append(res, qm.Where("ledger_accounts.kind=?", g.Kind.String))
+	}
+
+	if g.APIKey.Valid {
+		res = append(res, qm.Where("ledger_accounts.api_key=?", g.APIKey.String))
+	}
+
+	if g.Currency.Valid {
+		res = append(res, qm.Where("ledger_accounts.currency=?", g.Currency.String))
+	}
+	return res
+}
+
+type GetLedgerAccountsByParam struct {
+	GetLedgerAccountByParam
+	Limit int64 `schema:"limit" json:"limit" query:"limit"`
+	Page  int64 `schema:"page" json:"page" query:"page"`
+}
+
+func (g *GetLedgerAccountsByParam) GetQuery() []qm.QueryMod {
+	return g.GetLedgerAccountByParam.GetQuery()
+}
+
+type GetLedgerEntriesByParam struct {
+	LedgerAccountID int64       `json:"-" schema:"-" query:"-"`
+	Type            null.String `json:"type" schema:"type" query:"type"`
+	TransferJobID   null.Int64  `json:"transfer_job_id" schema:"transfer_job_id" query:"transfer_job_id"`
+	Limit           int64       `schema:"limit" json:"limit" query:"limit"`
+	Page            int64       `schema:"page" json:"page" query:"page"`
+}
+
+func (g *GetLedgerEntriesByParam) GetQuery() []qm.QueryMod {
+	res := []qm.QueryMod{
+		qm.InnerJoin("ledger_transactions ON ledger_transactions.id = ledger_entries.ledger_transaction_id"),
+		qm.Where("ledger_entries.ledger_account_id=?", g.LedgerAccountID),
+	}
+
+	if g.Type.Valid {
+		res = append(res, qm.Where("ledger_transactions.type=?", g.Type.String))
+	}
+
+	if g.TransferJobID.Valid {
+		res = append(res, qm.Where("ledger_transactions.transfer_job_id=?", g.TransferJobID.Int64))
+	}
+	return res
+}
+
+// LedgerAccountBalance is an account with the totals of its entries
+type LedgerAccountBalance struct {
+	ID        int       `boil:"id"`
+	Kind      string    `boil:"kind"`
+	APIKey    string    `boil:"api_key"`
+	Currency  string    `boil:"currency"`
+	Debit     int64     `boil:"debit"`
+	Credit    int64     `boil:"credit"`
+	CreatedAt time.Time `boil:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at"`
+}
+
+type LedgerAccount struct {
+	ID        int64     `json:"id"`
+	Kind      string    `json:"kind"`
+	APIKey    string    `json:"api_key"`
+	Currency  string    `json:"currency"`
+	Debit     int64     `json:"debit"`
+	Credit    int64     `json:"credit"`
+	Balance   int64     `json:"balance"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func TransformSingleLedgerAccount(v *LedgerAccountBalance) LedgerAccount {
+	return LedgerAccount{
+		ID:        int64(v.ID),
+		Kind:      v.Kind,
+		APIKey:    MaskAPIKey(v.APIKey),
+		Currency:  v.Currency,
+		Debit:     v.Debit,
+		Credit:    v.Credit,
+		Balance:   LedgerBalance(entity.Ledgeraccountkind(v.Kind), v.Debit, v.Credit),
+		CreatedAt: v.CreatedAt,
+		UpdatedAt: v.UpdatedAt,
+	}
+}
+
+func TransformLedgerAccount(v []LedgerAccountBalance) []LedgerAccount {
+	var res []LedgerAccount
+	for i := range v {
+		res = append(res, TransformSingleLedgerAccount(&v[i]))
+	}
+	return res
+}
+
+type LedgerEntry struct {
+	ID            int64     `boil:"id" json:"id"`
+	TransactionID string    `boil:"transaction_id" json:"transaction_id"`
+	Type          string    `boil:"type" json:"type"`
+	TransferJobID *int64    `boil:"transfer_job_id" json:"transfer_job_id"`
+	Description   string    `boil:"description" json:"description"`
+	Direction     string    `boil:"direction" json:"direction"`
+	Amount        int64     `boil:"amount" json:"amount"`
+	Currency      string    `boil:"currency" json:"currency"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at"`
+}
+
+// LedgerInvariant sums the entries of one currency, every transaction has to balance on its own
+type LedgerInvariant struct {
+	Currency               string `boil:"currency" json:"currency"`
+	Debit                  int64  `boil:"debit" json:"debit"`
+	Credit                 int64  `boil:"credit" json:"credit"`
+	Transactions           int64  `boil:"transactions" json:"transactions"`
+	UnbalancedTransactions int64  `boil:"unbalanced_transactions" json:"unbalanced_transactions"`
+	Balanced               bool   `boil:"-" json:"balanced"`
+}
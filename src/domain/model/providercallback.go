@@ -0,0 +1,105 @@
+package model
+
+import (
+	"crypto/hmac"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/clientresponse"
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/hash"
+	jsoniter "github.com/json-iterator/go"
+)
+
+var (
+	ProviderSignatureHeader string = "X-Provider-Signature"
+	ProviderTimestampHeader string = "X-Provider-Timestamp"
+
+	DefaultProviderCallbackTolerance time.Duration = 5 * time.Minute
+
+	// DefaultProviderStatuses maps the statuses of the mock bank, a status still in flight maps to processing
+	DefaultProviderStatuses = map[string]entity.Transferstatus{
+		"pending":    entity.TransferstatusProcessing,
+		"processing": entity.TransferstatusProcessing,
+		"success":    entity.TransferstatusSuccess,
+		"failed":     entity.TransferstatusFailed,
+	}
+)
+
+// ProviderCallbackConf is how a provider signs its callbacks and names its statuses, statuses left out use the defaults
+type ProviderCallbackConf struct {
+	Secret    string            `mapstructure:"secret"`
+	Tolerance time.Duration     `mapstructure:"tolerance"`
+	Statuses  map[string]string `mapstructure:"statuses"`
+}
+
+type ProviderCallback struct {
+	Timestamp string
+	Signature string
+	Body      []byte
+}
+
+// Verify checks the signature, sha256= followed by the hex HMAC-SHA256 of the timestamp, a dot and the raw body, and rejects a timestamp outside the tolerance
+func (p *ProviderCallback) Verify(conf ProviderCallbackConf, now time.Time) error {
+	if conf.Secret == "" {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidProviderCallback, nil, "provider has no callback secret")
+	}
+
+	unix, err := strconv.ParseInt(p.Timestamp, 10, 64)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidProviderCallback, err, "invalid timestamp")
+	}
+
+	tolerance := conf.Tolerance
+	if tolerance <= 0 {
+		tolerance = DefaultProviderCallbackTolerance
+	}
+	if at := time.Unix(unix, 0); at.Before(now.Add(-tolerance)) || at.After(now.Add(tolerance)) {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidProviderCallback, nil, "timestamp outside tolerance")
+	}
+
+	expected := "sha256=" + hash.HMAC(p.Timestamp+"."+string(p.Body), conf.Secret)
+	if !hmac.Equal([]byte(expected), []byte(p.Signature)) {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidProviderCallback, nil, "signature mismatch")
+	}
+	return nil
+}
+
+func (p *ProviderCallback) Transfer() (clientresponse.Transfer, error) {
+	var res clientresponse.Transfer
+	if err := jsoniter.Unmarshal(p.Body, &res); err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body")
+	}
+
+	if res.ID == "" {
+		return res, errormsg.WrapErr(svcerr.BrickSVCBadRequest, nil, "id is required")
+	}
+	return res, nil
+}
+
+// MapProviderStatus returns our status for the status the provider reported, statuses are matched case insensitively
+func MapProviderStatus(conf ProviderCallbackConf, status string) (entity.Transferstatus, error) {
+	status = strings.ToLower(status)
+	if v, ok := conf.Statuses[status]; ok {
+		res := entity.Transferstatus(v)
+		if err := res.IsValid(); err != nil {
+			return res, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "invalid status mapping for "+status)
+		}
+		return res, nil
+	}
+
+	if v, ok := DefaultProviderStatuses[status]; ok {
+		return v, nil
+	}
+	return "", errormsg.WrapErr(svcerr.BrickSVCBadRequest, nil, "unknown provider status "+status)
+}
+
+type ProviderCallbackAck struct {
+	ID      string `json:"id"`
+	JobID   string `json:"job_id"`
+	Status  string `json:"status"`
+	Applied bool   `json:"applied"`
+}
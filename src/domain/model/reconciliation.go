@@ -0,0 +1,331 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/clientresponse"
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/lucsky/cuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	// MaxReconciliationPeriod bounds a report so the provider list of one run stays small
+	MaxReconciliationPeriod time.Duration = 31 * 24 * time.Hour
+
+	ReconciliationCSVHeader = []string{
+		"type", "job_id", "provider_id", "local_amount", "provider_amount",
+		"local_currency", "provider_currency", "local_status", "provider_status",
+	}
+)
+
+type CreateReconciliation struct {
+	PeriodStart time.Time `json:"period_start" example:"2024-03-17T00:00:00Z"`
+	PeriodEnd   time.Time `json:"period_end" example:"2024-03-18T00:00:00Z"`
+}
+
+func (c *CreateReconciliation) Validate() error {
+	if c.PeriodStart.IsZero() || c.PeriodEnd.IsZero() {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidReconciliation, nil, "period_start and period_end are required")
+	}
+
+	if !c.PeriodEnd.After(c.PeriodStart) {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidReconciliation, nil, "period_end must be after period_start")
+	}
+
+	if c.PeriodEnd.Sub(c.PeriodStart) > MaxReconciliationPeriod {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidReconciliation, nil, "period must not be longer than 31 days")
+	}
+
+	if c.PeriodStart.After(time.Now()) {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidReconciliation, nil, "period must not start in the future")
+	}
+	return nil
+}
+
+func (c *CreateReconciliation) ToEntity(createdBy int64) (entity.ReconciliationReport, error) {
+	if err := c.Validate(); err != nil {
+		return entity.ReconciliationReport{}, err
+	}
+
+	return entity.ReconciliationReport{
+		ReportID:    cuid.New(),
+		PeriodStart: c.PeriodStart.UTC(),
+		PeriodEnd:   c.PeriodEnd.UTC(),
+		Status:      entity.ReconciliationstatusRunning,
+		CreatedBy:   int(createdBy),
+		UpdatedBy:   int(createdBy),
+	}, nil
+}
+
+// ReconciliationDay returns the UTC day before at, the daily run reconciles a day once it is over
+func ReconciliationDay(at time.Time) CreateReconciliation {
+	end := at.UTC().Truncate(24 * time.Hour)
+	return CreateReconciliation{
+		PeriodStart: end.Add(-24 * time.Hour),
+		PeriodEnd:   end,
+	}
+}
+
+// TransferProviderID is the id the provider gave the job, empty until the job is submitted
+func TransferProviderID(v *entity.TransferJob) string {
+	var submitted clientresponse.Transfer
+	if err := v.Payload.Unmarshal(&submitted); err != nil {
+		return ""
+	}
+	return submitted.ID
+}
+
+// InReconciliationPeriod keeps the provider records dated inside the period, a record without a readable date is kept and matched by its id
+func InReconciliationPeriod(v *clientresponse.Transfer, start time.Time, end time.Time) bool {
+	at, err := time.Parse(time.RFC3339, v.TransactionDate)
+	if err != nil {
+		return true
+	}
+	return !at.Before(start) && at.Before(end)
+}
+
+// ReconcileStatusMatches reports whether the job status agrees with the provider, a record still in flight matches a job not settled yet
+func ReconcileStatusMatches(local entity.Transferstatus, provider string) bool {
+	switch provider {
+	case entity.TransferstatusSuccess.String():
+		return local == entity.TransferstatusSuccess
+	case entity.TransferstatusFailed.String():
+		return local == entity.TransferstatusFailed
+	}
+	return local == entity.TransferstatusPending || local == entity.TransferstatusProcessing
+}
+
+// CompareReconciliation returns the amount and status discrepancies between a job and the provider record holding its provider id
+func CompareReconciliation(v *entity.TransferJob, record *clientresponse.Transfer) (entity.ReconciliationDiscrepancySlice, error) {
+	var local clientresponse.Transfer
+	if err := v.Payload.Unmarshal(&local); err != nil {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+	if local.Currency == "" {
+		local.Currency = DefaultCurrency
+	}
+	if record.Currency == "" {
+		record.Currency = DefaultCurrency
+	}
+
+	var res entity.ReconciliationDiscrepancySlice
+	if local.Amount != record.Amount || local.Currency != record.Currency {
+		res = append(res, NewReconciliationDiscrepancy(entity.DiscrepancytypeAmountMismatch, v, record))
+	}
+
+	if !ReconcileStatusMatches(v.Status, record.Status) {
+		res = append(res, NewReconciliationDiscrepancy(entity.DiscrepancytypeStatusMismatch, v, record))
+	}
+	return res, nil
+}
+
+// NewReconciliationDiscrepancy fills the side the job or the record is known on, either may be nil when it is missing
+func NewReconciliationDiscrepancy(tp entity.Discrepancytype, v *entity.TransferJob, record *clientresponse.Transfer) *entity.ReconciliationDiscrepancy {
+	res := &entity.ReconciliationDiscrepancy{
+		Type: tp,
+	}
+
+	if v != nil {
+		var local clientresponse.Transfer
+		_ = v.Payload.Unmarshal(&local)
+		if local.Currency == "" {
+			local.Currency = DefaultCurrency
+		}
+		res.TransferJobID = null.IntFrom(v.ID)
+		res.JobID = null.StringFrom(v.JobID)
+		res.ProviderID = local.ID
+		res.LocalAmount = null.Int64From(local.Amount)
+		res.LocalCurrency = null.StringFrom(local.Currency)
+		res.LocalStatus = null.StringFrom(v.Status.String())
+	}
+
+	if record != nil {
+		res.ProviderID = record.ID
+		res.ProviderAmount = null.Int64From(record.Amount)
+		res.ProviderCurrency = null.StringFrom(record.Currency)
+		res.ProviderStatus = null.StringFrom(record.Status)
+	}
+	return res
+}
+
+type GetReconciliationReportByParam struct {
+	ID          null.Int64  `json:"id" schema:"id" query:"id"`
+	ReportID    null.String `json:"report_id" schema:"report_id" query:"report_id"`
+	Status      null.String `json:"status" schema:"status" query:"status"`
+	PeriodStart null.Time   `json:"period_start" schema:"period_start" query:"period_start"`
+	PeriodEnd   null.Time   `json:"period_end" schema:"period_end" query:"period_end"`
+}
+
+func (g *GetReconciliationReportByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.ReportID.Valid {
+		res = append(res, qm.Where("report_id=?", g.ReportID.String))
+	}
+
+	if g.Status.Valid {
+		res = append(res, qm.Where("status=?", g.Status.String))
+	}
+
+	if g.PeriodStart.Valid {
+		res = append(res, qm.Where("period_start=?", g.PeriodStart.Time))
+	}
+
+	if g.PeriodEnd.Valid {
+		res = append(res, qm.Where("period_end=?", g.PeriodEnd.Time))
+	}
+	return res
+}
+
+type GetReconciliationReportsByParam struct {
+	GetReconciliationReportByParam
+	OrderBy null.String `schema:"order_by" json:"order_by" query:"order_by"`
+	Limit   int64       `schema:"limit" json:"limit" query:"limit"`
+	Page    int64       `schema:"page" json:"page" query:"page"`
+}
+
+func (g *GetReconciliationReportsByParam) GetQuery() []qm.QueryMod {
+	res := g.GetReconciliationReportByParam.GetQuery()
+	if g.OrderBy.Valid {
+		order := strings.Split(g.OrderBy.String, ",")
+		for _, o := range order {
+			res = append(res, qm.OrderBy(o))
+		}
+	}
+	return res
+}
+
+type GetReconciliationDiscrepanciesByParam struct {
+	ReconciliationReportID int64       `json:"-" schema:"-" query:"-"`
+	Type                   null.String `json:"type" schema:"type" query:"type"`
+	Limit                  int64       `schema:"limit" json:"limit" query:"limit"`
+	Page                   int64       `schema:"page" json:"page" query:"page"`
+}
+
+func (g *GetReconciliationDiscrepanciesByParam) GetQuery() []qm.QueryMod {
+	res := []qm.QueryMod{
+		qm.Where("reconciliation_report_id=?", g.ReconciliationReportID),
+	}
+
+	if g.Type.Valid {
+		res = append(res, qm.Where("type=?", g.Type.String))
+	}
+	return res
+}
+
+type ReconciliationReport struct {
+	ID               int64     `json:"id"`
+	ReportID         string    `json:"report_id"`
+	PeriodStart      time.Time `json:"period_start"`
+	PeriodEnd        time.Time `json:"period_end"`
+	Status           string    `json:"status"`
+	ProviderCount    int64     `json:"provider_count"`
+	JobCount         int64     `json:"job_count"`
+	MatchedCount     int64     `json:"matched_count"`
+	DiscrepancyCount int64     `json:"discrepancy_count"`
+	Error            string    `json:"error"`
+	FinishedAt       time.Time `json:"finished_at"`
+	BaseInformation
+}
+
+func TransformPSQLSingleReconciliationReport(v *entity.ReconciliationReport) ReconciliationReport {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(v.CreatedBy),
+		CreatedAt: v.CreatedAt,
+		UpdatedBy: int64(v.UpdatedBy),
+		UpdatedAt: v.UpdatedAt,
+		DeletedBy: int64(v.DeletedBy.Int),
+		DeletedAt: v.DeletedAt.Time,
+	}
+
+	return ReconciliationReport{
+		ID:               int64(v.ID),
+		ReportID:         v.ReportID,
+		PeriodStart:      v.PeriodStart,
+		PeriodEnd:        v.PeriodEnd,
+		Status:           v.Status.String(),
+		ProviderCount:    int64(v.ProviderCount),
+		JobCount:         int64(v.JobCount),
+		MatchedCount:     int64(v.MatchedCount),
+		DiscrepancyCount: int64(v.DiscrepancyCount),
+		Error:            v.Error.String,
+		FinishedAt:       v.FinishedAt.Time,
+		BaseInformation:  creationInfo,
+	}
+}
+
+func TransformPSQLReconciliationReport(v *entity.ReconciliationReportSlice) []ReconciliationReport {
+	var res []ReconciliationReport
+	for _, r := range *v {
+		res = append(res, TransformPSQLSingleReconciliationReport(r))
+	}
+	return res
+}
+
+type ReconciliationDiscrepancy struct {
+	ID               int64     `json:"id"`
+	Type             string    `json:"type"`
+	JobID            *string   `json:"job_id"`
+	ProviderID       string    `json:"provider_id"`
+	LocalAmount      *int64    `json:"local_amount"`
+	ProviderAmount   *int64    `json:"provider_amount"`
+	LocalCurrency    *string   `json:"local_currency"`
+	ProviderCurrency *string   `json:"provider_currency"`
+	LocalStatus      *string   `json:"local_status"`
+	ProviderStatus   *string   `json:"provider_status"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+func TransformPSQLSingleReconciliationDiscrepancy(v *entity.ReconciliationDiscrepancy) ReconciliationDiscrepancy {
+	return ReconciliationDiscrepancy{
+		ID:               int64(v.ID),
+		Type:             v.Type.String(),
+		JobID:            v.JobID.Ptr(),
+		ProviderID:       v.ProviderID,
+		LocalAmount:      v.LocalAmount.Ptr(),
+		ProviderAmount:   v.ProviderAmount.Ptr(),
+		LocalCurrency:    v.LocalCurrency.Ptr(),
+		ProviderCurrency: v.ProviderCurrency.Ptr(),
+		LocalStatus:      v.LocalStatus.Ptr(),
+		ProviderStatus:   v.ProviderStatus.Ptr(),
+		CreatedAt:        v.CreatedAt,
+	}
+}
+
+func TransformPSQLReconciliationDiscrepancy(v *entity.ReconciliationDiscrepancySlice) []ReconciliationDiscrepancy {
+	var res []ReconciliationDiscrepancy
+	for _, d := range *v {
+		res = append(res, TransformPSQLSingleReconciliationDiscrepancy(d))
+	}
+	return res
+}
+
+// ReconciliationCSVRecord writes the discrepancy in the order of ReconciliationCSVHeader, a side that is missing stays empty
+func ReconciliationCSVRecord(v *entity.ReconciliationDiscrepancy) []string {
+	amount := func(a null.Int64) string {
+		if !a.Valid {
+			return ""
+		}
+		return strconv.FormatInt(a.Int64, 10)
+	}
+	return []string{
+		v.Type.String(),
+		v.JobID.String,
+		v.ProviderID,
+		amount(v.LocalAmount),
+		amount(v.ProviderAmount),
+		v.LocalCurrency.String,
+		v.ProviderCurrency.String,
+		v.LocalStatus.String,
+		v.ProviderStatus.String,
+	}
+}
@@ -0,0 +1,239 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/lucsky/cuid"
+	"github.com/robfig/cron/v3"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type CreateRecurringTransfer struct {
+	SourceBankAccount      string     `json:"source_bank_account"`
+	DestinationBankAccount string     `json:"destination_bank_account"`
+	SourceBankID           int64      `json:"source_bank_id"`
+	DestinationBankID      int64      `json:"destination_bank_id"`
+	Amount                 int64      `json:"amount" example:"1099"`
+	Currency               string     `json:"currency" example:"IDR"`
+	Schedule               string     `json:"schedule" example:"0 9 * * 1"`
+	StartAt                time.Time  `json:"start_at"`
+	EndAt                  null.Time  `json:"end_at"`
+	MaxOccurrences         null.Int64 `json:"max_occurrences"`
+}
+
+func (c *CreateRecurringTransfer) ToCreateTransfer(at time.Time) CreateTransfer {
+	return CreateTransfer{
+		SourceBankAccount:      c.SourceBankAccount,
+		DestinationBankAccount: c.DestinationBankAccount,
+		SourceBankID:           c.SourceBankID,
+		DestinationBankID:      c.DestinationBankID,
+		Amount:                 c.Amount,
+		Currency:               c.Currency,
+		TransactionDate:        at,
+	}
+}
+
+func (c *CreateRecurringTransfer) Validate() error {
+	transfer := c.ToCreateTransfer(c.StartAt)
+	if err := transfer.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := cron.ParseStandard(c.Schedule); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidRecurringTransfer, err, "invalid schedule")
+	}
+
+	if c.EndAt.Valid && !c.EndAt.Time.After(c.StartAt) {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidRecurringTransfer, nil, "end_at must be after start_at")
+	}
+
+	if c.MaxOccurrences.Valid && c.MaxOccurrences.Int64 <= 0 {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidRecurringTransfer, nil, "max_occurrences must be greater than zero")
+	}
+	return nil
+}
+
+func (c *CreateRecurringTransfer) ToEntity(apikey string) (entity.RecurringTransfer, error) {
+	if c.StartAt.IsZero() {
+		c.StartAt = time.Now().UTC()
+	}
+
+	if err := c.Validate(); err != nil {
+		return entity.RecurringTransfer{}, err
+	}
+
+	payload, err := jsoniter.Marshal(c.ToCreateTransfer(time.Time{}))
+	if err != nil {
+		return entity.RecurringTransfer{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+
+	res := entity.RecurringTransfer{
+		MandateID: cuid.New(),
+		APIKey:    apikey,
+		Payload:   payload,
+		Schedule:  c.Schedule,
+		StartAt:   c.StartAt.UTC(),
+		Status:    entity.RecurringstatusActive,
+	}
+	if c.EndAt.Valid {
+		res.EndAt = null.TimeFrom(c.EndAt.Time.UTC())
+	}
+	if c.MaxOccurrences.Valid {
+		res.MaxOccurrences = null.IntFrom(int(c.MaxOccurrences.Int64))
+	}
+
+	// the start time itself counts as an occurrence when it matches the schedule
+	if err := ScheduleNextRecurringRun(&res, res.StartAt.Add(-time.Second)); err != nil {
+		return entity.RecurringTransfer{}, err
+	}
+	if res.Status != entity.RecurringstatusActive {
+		return entity.RecurringTransfer{}, errormsg.WrapErr(svcerr.BrickSVCInvalidRecurringTransfer, nil, "schedule has no occurrence before end_at")
+	}
+	return res, nil
+}
+
+// ScheduleNextRecurringRun sets the first occurrence after the given time, or completes the mandate when none is left
+func ScheduleNextRecurringRun(v *entity.RecurringTransfer, after time.Time) error {
+	schedule, err := cron.ParseStandard(v.Schedule)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidRecurringTransfer, err, "invalid schedule")
+	}
+
+	next := schedule.Next(after.UTC())
+	if next.Before(v.StartAt) {
+		next = schedule.Next(v.StartAt.Add(-time.Second))
+	}
+
+	maxReached := v.MaxOccurrences.Valid && v.Occurrences >= v.MaxOccurrences.Int
+	endReached := next.IsZero() || (v.EndAt.Valid && next.After(v.EndAt.Time))
+	if maxReached || endReached {
+		v.Status = entity.RecurringstatusCompleted
+		v.NextRunAt = null.Time{}
+		return nil
+	}
+
+	v.NextRunAt = null.TimeFrom(next)
+	return nil
+}
+
+// NewRecurringTransferJob builds the transfer job of the mandate occurrence due at runAt
+func NewRecurringTransferJob(v *entity.RecurringTransfer, runAt time.Time) (entity.TransferJob, error) {
+	var data CreateTransfer
+	if err := v.Payload.Unmarshal(&data); err != nil {
+		return entity.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+
+	data.TransactionDate = runAt
+	res, err := data.ToEntity(v.APIKey)
+	if err != nil {
+		return entity.TransferJob{}, err
+	}
+	res.RecurringTransferID = null.IntFrom(v.ID)
+	return res, nil
+}
+
+type GetRecurringTransferByParam struct {
+	ID           null.Int64  `json:"id" schema:"id" query:"id"`
+	MandateID    null.String `json:"mandate_id" schema:"mandate_id" query:"mandate_id"`
+	APIKey       null.String `json:"api_key" schema:"api_key" query:"api_key"`
+	Status       null.String `json:"status" schema:"status" query:"status"`
+	NextRunAtLTE null.Time   `json:"next_run_at_lte" schema:"next_run_at_lte" query:"next_run_at_lte"`
+}
+
+func (g *GetRecurringTransferByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.MandateID.Valid {
+		res = append(res, qm.Where("mandate_id=?", g.MandateID.String))
+	}
+
+	if g.APIKey.Valid {
+		res = append(res, qm.Where("api_key=?", g.APIKey.String))
+	}
+
+	if g.Status.Valid {
+		res = append(res, qm.Where("status=?", g.Status.String))
+	}
+
+	if g.NextRunAtLTE.Valid {
+		res = append(res, qm.Where("next_run_at<=?", g.NextRunAtLTE.Time))
+	}
+	return res
+}
+
+type GetRecurringTransfersByParam struct {
+	GetRecurringTransferByParam
+	OrderBy null.String `schema:"order_by" json:"order_by" query:"order_by"`
+	Limit   int64       `schema:"limit" json:"limit" query:"limit"`
+	Page    int64       `schema:"page" json:"page" query:"page"`
+}
+
+func (g *GetRecurringTransfersByParam) GetQuery() []qm.QueryMod {
+	res := g.GetRecurringTransferByParam.GetQuery()
+	if g.OrderBy.Valid {
+		order := strings.Split(g.OrderBy.String, ",")
+		for _, o := range order {
+			res = append(res, qm.OrderBy(o))
+		}
+	}
+	return res
+}
+
+type RecurringTransfer struct {
+	ID             int64                `json:"id"`
+	MandateID      string               `json:"mandate_id"`
+	Payload        string               `json:"payload"`
+	Schedule       string               `json:"schedule"`
+	StartAt        time.Time            `json:"start_at"`
+	EndAt          time.Time            `json:"end_at"`
+	MaxOccurrences int64                `json:"max_occurrences"`
+	Occurrences    int64                `json:"occurrences"`
+	NextRunAt      time.Time            `json:"next_run_at"`
+	LastRunAt      time.Time            `json:"last_run_at"`
+	Status         string               `json:"status"`
+	Jobs           []TransferJobSummary `json:"jobs,omitempty"`
+	BaseInformation
+}
+
+func TransformPSQLSingleRecurringTransfer(v *entity.RecurringTransfer) RecurringTransfer {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(v.CreatedBy),
+		CreatedAt: v.CreatedAt,
+		UpdatedBy: int64(v.UpdatedBy),
+		UpdatedAt: v.UpdatedAt,
+		DeletedBy: int64(v.DeletedBy.Int),
+		DeletedAt: v.DeletedAt.Time,
+	}
+
+	return RecurringTransfer{
+		ID:              int64(v.ID),
+		MandateID:       v.MandateID,
+		Payload:         string(v.Payload),
+		Schedule:        v.Schedule,
+		StartAt:         v.StartAt,
+		EndAt:           v.EndAt.Time,
+		MaxOccurrences:  int64(v.MaxOccurrences.Int),
+		Occurrences:     int64(v.Occurrences),
+		NextRunAt:       v.NextRunAt.Time,
+		LastRunAt:       v.LastRunAt.Time,
+		Status:          v.Status.String(),
+		BaseInformation: creationInfo,
+	}
+}
+
+func TransformPSQLRecurringTransfer(v *entity.RecurringTransferSlice) []RecurringTransfer {
+	var res []RecurringTransfer
+	for _, r := range *v {
+		res = append(res, TransformPSQLSingleRecurringTransfer(r))
+	}
+	return res
+}
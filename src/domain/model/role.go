@@ -43,6 +43,7 @@ func (g *GetRoleByParam) GetQuery() []qm.QueryMod {
 
 type GetRolesByParam struct {
 	GetRoleByParam
+	CursorParam
 	OrderBy null.String `schema:"order_by" json:"order_by" query:"order_by"`
 	Limit   int64       `schema:"limit" json:"limit" query:"limit"`
 	Page    int64       `schema:"page" json:"page" query:"page"`
@@ -0,0 +1,155 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	SettlementFormatCSV    string = "csv"
+	SettlementFormatNDJSON string = "ndjson"
+
+	// SettlementContentTypes maps the report formats to the content type they are served with
+	SettlementContentTypes = map[string]string{
+		SettlementFormatCSV:    "text/csv",
+		SettlementFormatNDJSON: "application/x-ndjson",
+	}
+
+	SettlementCSVHeader = []string{
+		"api_key", "day", "status", "currency", "count", "amount", "fee", "total",
+	}
+
+	settlementDayLayout string = "2006-01-02"
+)
+
+// SettlementDay returns the report of the UTC day before at, the scheduled report is written once the day is over
+func SettlementDay(at time.Time, format string) GetSettlementReportByParam {
+	day := at.UTC().Truncate(24 * time.Hour).Add(-24 * time.Hour).Format(settlementDayLayout)
+	return GetSettlementReportByParam{
+		From:   day,
+		To:     day,
+		Format: format,
+	}
+}
+
+type GetSettlementReportByParam struct {
+	APIKey      null.String `json:"api_key" schema:"api_key" query:"api_key"`
+	Status      null.String `json:"status" schema:"status" query:"status"`
+	From        string      `json:"from" schema:"from" query:"from"`
+	To          string      `json:"to" schema:"to" query:"to"`
+	Format      string      `json:"format" schema:"format" query:"format"`
+	PeriodStart time.Time   `json:"-" schema:"-" query:"-"`
+	PeriodEnd   time.Time   `json:"-" schema:"-" query:"-"`
+}
+
+// Validate fills the period from the inclusive UTC days from and to, both default to yesterday and the format to csv
+func (g *GetSettlementReportByParam) Validate() error {
+	if g.Format == "" {
+		g.Format = SettlementFormatCSV
+	}
+
+	if _, ok := SettlementContentTypes[g.Format]; !ok {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidSettlementReport, nil, "format must be csv or ndjson")
+	}
+
+	if g.Status.Valid {
+		if err := entity.Transferstatus(g.Status.String).IsValid(); err != nil {
+			return errormsg.WrapErr(svcerr.BrickSVCInvalidSettlementReport, err, "invalid status")
+		}
+	}
+
+	if g.From == "" {
+		g.From = SettlementDay(time.Now(), g.Format).From
+	}
+
+	if g.To == "" {
+		g.To = g.From
+	}
+
+	start, err := time.Parse(settlementDayLayout, g.From)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidSettlementReport, err, "from must be a date formatted as YYYY-MM-DD")
+	}
+
+	end, err := time.Parse(settlementDayLayout, g.To)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidSettlementReport, err, "to must be a date formatted as YYYY-MM-DD")
+	}
+
+	if end.Before(start) {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidSettlementReport, nil, "to must not be before from")
+	}
+
+	g.PeriodStart = start
+	g.PeriodEnd = end.Add(24 * time.Hour)
+	return nil
+}
+
+// FileName is the name the report is downloaded or written as
+func (g *GetSettlementReportByParam) FileName() string {
+	name := "settlement-" + g.From
+	if g.To != g.From {
+		name += "-" + g.To
+	}
+	return name + "." + g.Format
+}
+
+func (g *GetSettlementReportByParam) GetQuery() []qm.QueryMod {
+	res := []qm.QueryMod{
+		qm.Select(
+			"transfer_jobs.api_key",
+			"to_char(transfer_jobs.created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD') AS day",
+			"transfer_jobs.status",
+			fmt.Sprintf("COALESCE(NULLIF(transfer_jobs.payload->>'currency', ''), '%s') AS currency", DefaultCurrency),
+			"COUNT(*) AS count",
+			"COALESCE(SUM((transfer_jobs.payload->>'amount')::bigint), 0) AS amount",
+			"COALESCE(SUM(transfer_jobs.fee_amount + COALESCE((transfer_jobs.conversion->>'fee_amount')::bigint, 0)), 0) AS fee",
+		),
+		qm.Where("transfer_jobs.created_at>=?", g.PeriodStart),
+		qm.Where("transfer_jobs.created_at<?", g.PeriodEnd),
+	}
+
+	if g.APIKey.Valid {
+		res = append(res, qm.Where("transfer_jobs.api_key=?", g.APIKey.String))
+	}
+
+	if g.Status.Valid {
+		res = append(res, qm.Where("transfer_jobs.status=?", g.Status.String))
+	}
+	return append(res,
+		qm.GroupBy("transfer_jobs.api_key, day, transfer_jobs.status, currency"),
+		qm.OrderBy("day, transfer_jobs.api_key, transfer_jobs.status, currency"),
+	)
+}
+
+// SettlementRow totals the jobs of an api key created on one UTC day with the same status and currency, the fee includes the conversion fee
+type SettlementRow struct {
+	APIKey   string `json:"api_key"`
+	Day      string `json:"day"`
+	Status   string `json:"status"`
+	Currency string `json:"currency"`
+	Count    int64  `json:"count"`
+	Amount   int64  `json:"amount"`
+	Fee      int64  `json:"fee"`
+	Total    int64  `json:"total"`
+}
+
+func SettlementCSVRecord(v *SettlementRow) []string {
+	return []string{
+		v.APIKey,
+		v.Day,
+		v.Status,
+		v.Currency,
+		strconv.FormatInt(v.Count, 10),
+		strconv.FormatInt(v.Amount, 10),
+		strconv.FormatInt(v.Fee, 10),
+		strconv.FormatInt(v.Total, 10),
+	}
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -8,6 +10,7 @@ import (
 	"github.com/achwanyusuf/bricksvc/src/domain/entity"
 	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
 	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/hash"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/lucsky/cuid"
 	"github.com/volatiletech/null/v8"
@@ -19,14 +22,43 @@ var (
 	GetSingleByParamTransferKey string = "gspTransfer:%s"
 	GetByParamTransferKey       string = "gpTransfer:%s"
 	GetByParamTransferPgKey     string = "gppgTransfer:%s"
+	IdempotencyTransferKey      string = "idemTransfer:%s:%s"
+
+	DefaultIdempotencyRetention time.Duration = 24 * time.Hour
+
+	// DefaultTransferLease is how long a claimed job stays with the replica that claimed it, a crashed replica loses it once expired
+	DefaultTransferLease time.Duration = 1 * time.Minute
 )
 
+// NewTransferLeaseOwner names the process holding a lease, replicas on the same host still get their own
+func NewTransferLeaseOwner() string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%s:%d:%s", host, os.Getpid(), cuid.New())
+}
+
+// TransferReader is who reads transfer jobs, a merchant by its api key or an account by its token, only a super admin
+// reads the jobs of every api key
+type TransferReader struct {
+	APIKey    string
+	AccountID int64
+	Scope     string
+}
+
+// TransferJob is the response of a job, the api key is masked
 type TransferJob struct {
-	ID      int    `json:"id"`
-	JobID   string `json:"job_id"`
-	APIKey  string `json:"api_key"`
-	Payload string `json:"payload"`
-	Status  string `json:"status"`
+	ID            int                 `json:"id"`
+	JobID         string              `json:"job_id"`
+	APIKey        string              `json:"api_key"`
+	Payload       string              `json:"payload"`
+	Status        string              `json:"status"`
+	ScheduledAt   time.Time           `json:"scheduled_at"`
+	AttemptCount  int64               `json:"attempt_count"`
+	NextAttemptAt time.Time           `json:"next_attempt_at"`
+	LastError     string              `json:"last_error"`
+	Reference     string              `json:"reference"`
+	Conversion    *TransferConversion `json:"conversion"`
+	FeeAmount     int64               `json:"fee_amount"`
+	FeeRuleID     int64               `json:"fee_rule_id"`
 	BaseInformation
 }
 
@@ -35,6 +67,10 @@ func TransformTransferJob(v entity.TransferJob) (TransferJob, error) {
 	if err != nil {
 		return TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
 	}
+	conversion, err := TransformTransferConversion(v.Conversion)
+	if err != nil {
+		return TransferJob{}, err
+	}
 	deletedBy := null.Int64{}
 	if v.DeletedBy.Valid {
 		deletedBy = null.Int64From(int64(v.DeletedBy.Int))
@@ -50,25 +86,77 @@ func TransformTransferJob(v entity.TransferJob) (TransferJob, error) {
 	return TransferJob{
 		ID:              v.ID,
 		JobID:           v.JobID,
-		APIKey:          v.APIKey,
+		APIKey:          MaskAPIKey(v.APIKey),
 		Payload:         string(payload),
 		Status:          v.Status.String(),
+		ScheduledAt:     v.ScheduledAt.Time,
+		AttemptCount:    int64(v.AttemptCount),
+		NextAttemptAt:   v.NextAttemptAt.Time,
+		LastError:       v.LastError.String,
+		Reference:       v.Reference.String,
+		Conversion:      conversion,
+		FeeAmount:       v.FeeAmount,
+		FeeRuleID:       int64(v.FeeRuleID.Int),
 		BaseInformation: creationInfo,
 	}, nil
 }
 
+type TransferJobSummary struct {
+	JobID  string `json:"job_id"`
+	Status string `json:"status"`
+}
+
+func TransformTransferJobSummary(jobs entity.TransferJobSlice) []TransferJobSummary {
+	res := []TransferJobSummary{}
+	for _, v := range jobs {
+		res = append(res, TransferJobSummary{
+			JobID:  v.JobID,
+			Status: v.Status.String(),
+		})
+	}
+	return res
+}
+
 type CreateTransfer struct {
 	SourceBankAccount      string    `json:"source_bank_account" query:"source_bank_account"`
 	DestinationBankAccount string    `json:"destination_bank_account" query:"destination_bank_account"`
 	SourceBankID           int64     `json:"source_bank_id" query:"source_bank_id"`
 	DestinationBankID      int64     `json:"destination_bank_id" query:"destination_bank_id"`
-	Amount                 float64   `json:"amount" query:"amount"`
+	Amount                 int64     `json:"amount" query:"amount" example:"1099"`
+	Currency               string    `json:"currency" query:"currency" example:"IDR"`
 	TransactionDate        time.Time `json:"transaction_time" query:"transaction_time"`
+	QuoteID                string    `json:"quote_id,omitempty" query:"quote_id"`
+	Reference              string    `json:"reference,omitempty" query:"reference"`
+}
+
+func (c *CreateTransfer) Validate() error {
+	if c.SourceBankAccount == "" || c.DestinationBankAccount == "" {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidTransferData, nil, "bank account is required")
+	}
+
+	if c.SourceBankID <= 0 || c.DestinationBankID <= 0 {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidTransferData, nil, "bank id is required")
+	}
+
+	if len(c.Reference) > 64 {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidTransferData, nil, "reference must not exceed 64 characters")
+	}
+
+	return ValidateMoney(c.Amount, c.Currency)
+}
+
+func (c *CreateTransfer) Hash() (string, error) {
+	body, err := jsoniter.Marshal(c)
+	if err != nil {
+		return "", errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+	return hash.SHA(string(body)), nil
 }
 
 func (c *CreateTransfer) ToClientRes() clientresponse.Transfer {
 	return clientresponse.Transfer{
-		Amount:                 int(c.Amount),
+		Amount:                 c.Amount,
+		Currency:               c.Currency,
 		Status:                 entity.TransferstatusPending.String(),
 		TransactionDate:        c.TransactionDate.Format(time.RFC3339),
 		SourceBankAccount:      c.SourceBankAccount,
@@ -78,27 +166,127 @@ func (c *CreateTransfer) ToClientRes() clientresponse.Transfer {
 	}
 }
 
+// IsScheduled reports whether the transfer is dated in the future and must wait before being dispatched
+func (c *CreateTransfer) IsScheduled() bool {
+	return c.TransactionDate.After(time.Now())
+}
+
 func (c *CreateTransfer) ToEntity(apikey string) (entity.TransferJob, error) {
 	payload, err := jsoniter.Marshal(c)
 	if err != nil {
 		return entity.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
 	}
-	return entity.TransferJob{
+	res := entity.TransferJob{
 		JobID:   cuid.New(),
 		APIKey:  apikey,
 		Payload: payload,
 		Status:  entity.TransferstatusPending,
-	}, nil
+	}
+	if c.Reference != "" {
+		res.Reference = null.StringFrom(c.Reference)
+	}
+	if c.IsScheduled() {
+		res.Status = entity.TransferstatusScheduled
+		res.ScheduledAt = null.TimeFrom(c.TransactionDate.UTC())
+	}
+	return res, nil
+}
+
+type UpdateTransfer struct {
+	SourceBankAccount      null.String `json:"source_bank_account"`
+	DestinationBankAccount null.String `json:"destination_bank_account"`
+	SourceBankID           null.Int64  `json:"source_bank_id"`
+	DestinationBankID      null.Int64  `json:"destination_bank_id"`
+	Amount                 null.Int64  `json:"amount"`
+	Currency               null.String `json:"currency"`
+	TransactionDate        null.Time   `json:"transaction_time"`
+}
+
+func (v *UpdateTransfer) FillEntity(transferJob *entity.TransferJob) error {
+	var data CreateTransfer
+	if err := transferJob.Payload.Unmarshal(&data); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+
+	// the quote locked the converted amount, changing it would break the locked rate
+	if transferJob.Conversion.Valid && (v.Amount.Valid || v.Currency.Valid) {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidQuote, nil, "amount of a quoted transfer cannot be amended")
+	}
+
+	if v.SourceBankAccount.Valid {
+		data.SourceBankAccount = v.SourceBankAccount.String
+	}
+
+	if v.DestinationBankAccount.Valid {
+		data.DestinationBankAccount = v.DestinationBankAccount.String
+	}
+
+	if v.SourceBankID.Valid {
+		data.SourceBankID = v.SourceBankID.Int64
+	}
+
+	if v.DestinationBankID.Valid {
+		data.DestinationBankID = v.DestinationBankID.Int64
+	}
+
+	if v.Amount.Valid {
+		data.Amount = v.Amount.Int64
+	}
+
+	if v.Currency.Valid {
+		data.Currency = v.Currency.String
+	}
+
+	if v.TransactionDate.Valid {
+		data.TransactionDate = v.TransactionDate.Time
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	payload, err := jsoniter.Marshal(&data)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+	transferJob.Payload = payload
+	transferJob.ScheduledAt = null.TimeFrom(data.TransactionDate.UTC())
+	return nil
+}
+
+type TransferIdempotency struct {
+	JobID       string `json:"job_id"`
+	RequestHash string `json:"request_hash"`
 }
 
 type GetTransferJobByParam struct {
-	ID          null.Int64  `json:"id" schema:"id" query:"id"`
-	JobID       null.String `json:"job_id" schema:"job_id" query:"job_id"`
-	APIKey      null.String `json:"api_key" schema:"api_key" query:"api_key"`
-	Payload     null.String `json:"payload" schema:"payload" query:"payload"`
-	Status      null.String `json:"status" schema:"status" query:"status"`
-	CreatedAtGT null.Time   `json:"created_at_gt" schema:"created_at_gt" query:"created_at_gt"`
-	CreatedAtLT null.Time   `json:"created_at_lt" schema:"created_at_lt" query:"created_at_lt"`
+	ID                     null.Int64  `json:"id" schema:"id" query:"id"`
+	JobID                  null.String `json:"job_id" schema:"job_id" query:"job_id"`
+	APIKey                 null.String `json:"api_key" schema:"api_key" query:"api_key"`
+	Payload                null.String `json:"payload" schema:"payload" query:"payload"`
+	Status                 null.String `json:"status" schema:"status" query:"status"`
+	Reference              null.String `json:"reference" schema:"reference" query:"reference"`
+	AmountGTE              null.Int64  `json:"amount_gte" schema:"amount_gte" query:"amount_gte"`
+	AmountLTE              null.Int64  `json:"amount_lte" schema:"amount_lte" query:"amount_lte"`
+	SourceBankID           null.Int64  `json:"source_bank_id" schema:"source_bank_id" query:"source_bank_id"`
+	DestinationBankID      null.Int64  `json:"destination_bank_id" schema:"destination_bank_id" query:"destination_bank_id"`
+	SourceBankAccount      null.String `json:"source_bank_account" schema:"source_bank_account" query:"source_bank_account"`
+	DestinationBankAccount null.String `json:"destination_bank_account" schema:"destination_bank_account" query:"destination_bank_account"`
+	BankAccount            null.String `json:"bank_account" schema:"bank_account" query:"bank_account"`
+	CreatedAtGT            null.Time   `json:"created_at_gt" schema:"created_at_gt" query:"created_at_gt"`
+	CreatedAtLT            null.Time   `json:"created_at_lt" schema:"created_at_lt" query:"created_at_lt"`
+	UpdatedAtGT            null.Time   `json:"updated_at_gt" schema:"updated_at_gt" query:"updated_at_gt"`
+	UpdatedAtLT            null.Time   `json:"updated_at_lt" schema:"updated_at_lt" query:"updated_at_lt"`
+	ScheduledAtLTE         null.Time   `json:"scheduled_at_lte" schema:"scheduled_at_lte" query:"scheduled_at_lte"`
+	RecurringTransferID    null.Int64  `json:"recurring_transfer_id" schema:"recurring_transfer_id" query:"recurring_transfer_id"`
+	NextAttemptAtLTE       null.Time   `json:"next_attempt_at_lte" schema:"next_attempt_at_lte" query:"next_attempt_at_lte"`
+	ProviderID             null.String `json:"provider_id" schema:"provider_id" query:"provider_id"`
+}
+
+// payloadContains matches the jobs whose payload holds key with value, served by the GIN index on payload
+func payloadContains(key string, value interface{}) qm.QueryMod {
+	v, _ := jsoniter.MarshalToString(map[string]interface{}{key: value})
+	return qm.Where("payload @> ?::jsonb", v)
 }
 
 func (g *GetTransferJobByParam) GetQuery() []qm.QueryMod {
@@ -123,45 +311,85 @@ func (g *GetTransferJobByParam) GetQuery() []qm.QueryMod {
 		res = append(res, qm.Where("status=?", g.Status.String))
 	}
 
+	if g.Reference.Valid {
+		res = append(res, qm.Where("reference=?", g.Reference.String))
+	}
+
+	if g.AmountGTE.Valid {
+		res = append(res, qm.Where("(payload->>'amount')::bigint>=?", g.AmountGTE.Int64))
+	}
+
+	if g.AmountLTE.Valid {
+		res = append(res, qm.Where("(payload->>'amount')::bigint<=?", g.AmountLTE.Int64))
+	}
+
+	if g.SourceBankID.Valid {
+		res = append(res, payloadContains("source_bank_id", g.SourceBankID.Int64))
+	}
+
+	if g.DestinationBankID.Valid {
+		res = append(res, payloadContains("destination_bank_id", g.DestinationBankID.Int64))
+	}
+
+	if g.SourceBankAccount.Valid {
+		res = append(res, payloadContains("source_bank_account", g.SourceBankAccount.String))
+	}
+
+	if g.DestinationBankAccount.Valid {
+		res = append(res, payloadContains("destination_bank_account", g.DestinationBankAccount.String))
+	}
+
+	if g.BankAccount.Valid {
+		res = append(res, qm.Expr(
+			payloadContains("source_bank_account", g.BankAccount.String),
+			qm.Or2(payloadContains("destination_bank_account", g.BankAccount.String)),
+		))
+	}
+
 	if g.CreatedAtGT.Valid {
-		res = append(res, qm.Where("created_at >", g.CreatedAtGT))
+		res = append(res, qm.Where("created_at>?", g.CreatedAtGT.Time))
 	}
 
 	if g.CreatedAtLT.Valid {
-		res = append(res, qm.Where("created_at <", g.CreatedAtLT))
+		res = append(res, qm.Where("created_at<?", g.CreatedAtLT.Time))
 	}
-	return res
-}
 
-type GetTransferJobsByParam struct {
-	GetTransferJobByParam
-	OrderBy null.String `schema:"order_by" json:"order_by" query:"order_by"`
-	Limit   int64       `schema:"limit" json:"limit" query:"limit"`
-	Page    int64       `schema:"page" json:"page" query:"page"`
-}
+	if g.UpdatedAtGT.Valid {
+		res = append(res, qm.Where("updated_at>?", g.UpdatedAtGT.Time))
+	}
 
-func (g *GetTransferJobsByParam) GetQuery() []qm.QueryMod {
-	var res []qm.QueryMod
-	if g.ID.Valid {
-		res = append(res, qm.Where("id=?", g.ID.Int64))
+	if g.UpdatedAtLT.Valid {
+		res = append(res, qm.Where("updated_at<?", g.UpdatedAtLT.Time))
 	}
 
-	if g.JobID.Valid {
-		res = append(res, qm.Where("job_id=?", g.JobID.String))
+	if g.ScheduledAtLTE.Valid {
+		res = append(res, qm.Where("scheduled_at<=?", g.ScheduledAtLTE.Time))
 	}
 
-	if g.APIKey.Valid {
-		res = append(res, qm.Where("api_key=?", g.APIKey.String))
+	if g.RecurringTransferID.Valid {
+		res = append(res, qm.Where("recurring_transfer_id=?", g.RecurringTransferID.Int64))
 	}
 
-	if g.Payload.Valid {
-		res = append(res, qm.Where("payload=?", g.Payload.String))
+	if g.NextAttemptAtLTE.Valid {
+		res = append(res, qm.Where("next_attempt_at<=?", g.NextAttemptAtLTE.Time))
 	}
 
-	if g.Status.Valid {
-		res = append(res, qm.Where("status=?", g.Status.String))
+	if g.ProviderID.Valid {
+		res = append(res, qm.Where("payload->>'id'=?", g.ProviderID.String))
 	}
+	return res
+}
 
+type GetTransferJobsByParam struct {
+	GetTransferJobByParam
+	CursorParam
+	OrderBy null.String `schema:"order_by" json:"order_by" query:"order_by"`
+	Limit   int64       `schema:"limit" json:"limit" query:"limit"`
+	Page    int64       `schema:"page" json:"page" query:"page"`
+}
+
+func (g *GetTransferJobsByParam) GetQuery() []qm.QueryMod {
+	res := g.GetTransferJobByParam.GetQuery()
 	if g.OrderBy.Valid {
 		order := strings.Split(g.OrderBy.String, ",")
 		for _, o := range order {
@@ -186,13 +414,25 @@ func TransformPSQLSingleTransferJob(v *entity.TransferJob) (TransferJob, error)
 	if err != nil {
 		return TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
 	}
+	conversion, err := TransformTransferConversion(v.Conversion)
+	if err != nil {
+		return TransferJob{}, err
+	}
 
 	return TransferJob{
 		ID:              v.ID,
 		JobID:           v.JobID,
-		APIKey:          v.APIKey,
+		APIKey:          MaskAPIKey(v.APIKey),
 		Payload:         string(payload),
 		Status:          v.Status.String(),
+		ScheduledAt:     v.ScheduledAt.Time,
+		AttemptCount:    int64(v.AttemptCount),
+		NextAttemptAt:   v.NextAttemptAt.Time,
+		LastError:       v.LastError.String,
+		Reference:       v.Reference.String,
+		Conversion:      conversion,
+		FeeAmount:       v.FeeAmount,
+		FeeRuleID:       int64(v.FeeRuleID.Int),
 		BaseInformation: creationInfo,
 	}, nil
 }
@@ -213,13 +453,25 @@ func TransformPSQLTransferJob(role *entity.TransferJobSlice) ([]TransferJob, err
 		if err != nil {
 			return []TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
 		}
+		conversion, err := TransformTransferConversion(v.Conversion)
+		if err != nil {
+			return []TransferJob{}, err
+		}
 
 		res = append(res, TransferJob{
 			ID:              v.ID,
 			JobID:           v.JobID,
-			APIKey:          v.APIKey,
+			APIKey:          MaskAPIKey(v.APIKey),
 			Payload:         string(payload),
 			Status:          v.Status.String(),
+			ScheduledAt:     v.ScheduledAt.Time,
+			AttemptCount:    int64(v.AttemptCount),
+			NextAttemptAt:   v.NextAttemptAt.Time,
+			LastError:       v.LastError.String,
+			Reference:       v.Reference.String,
+			Conversion:      conversion,
+			FeeAmount:       v.FeeAmount,
+			FeeRuleID:       int64(v.FeeRuleID.Int),
 			BaseInformation: creationInfo,
 		})
 	}
@@ -229,7 +481,7 @@ func TransformPSQLTransferJob(role *entity.TransferJobSlice) ([]TransferJob, err
 
 type GetTransferByParam struct {
 	ID                     string `json:"id" query:"id"`
-	Amount                 int    `json:"amoun" query:"amoun"`
+	Amount                 int64  `json:"amoun" query:"amoun"`
 	Status                 string `json:"status" query:"status"`
 	TransactionDate        string `json:"transaction_date" query:"transaction_date"`
 	SourceBankAccount      string `json:"source_bank_account" query:"source_bank_account"`
@@ -237,3 +489,102 @@ type GetTransferByParam struct {
 	SourceBankID           int    `json:"source_bank_id" query:"source_bank_id"`
 	DestinationBankID      int    `json:"destination_bank_id" query:"destination_bank_id"`
 }
+
+var (
+	TransferActorAPI       string = "api"
+	TransferActorConsumer  string = "consumer"
+	TransferActorScheduler string = "scheduler"
+	TransferActorMerchant  string = "merchant"
+	TransferActorProvider  string = "provider"
+)
+
+// TransferStatusTransitions lists the statuses a transfer job may move to from its current status.
+var TransferStatusTransitions = map[entity.Transferstatus][]entity.Transferstatus{
+	entity.TransferstatusPending:    {entity.TransferstatusProcessing, entity.TransferstatusFailed, entity.TransferstatusCancelled},
+	entity.TransferstatusProcessing: {entity.TransferstatusSuccess, entity.TransferstatusFailed},
+	entity.TransferstatusScheduled:  {entity.TransferstatusPending, entity.TransferstatusCancelled},
+}
+
+func ValidateTransferTransition(from entity.Transferstatus, to entity.Transferstatus) error {
+	for _, v := range TransferStatusTransitions[from] {
+		if v == to {
+			return nil
+		}
+	}
+	return errormsg.WrapErr(svcerr.BrickSVCInvalidStatusTransition, nil, fmt.Sprintf("invalid transition from %s to %s", from, to))
+}
+
+// IsTransferClaimed reports whether a replica holds an unexpired lease on the job. The submit lease outlasts the provider
+// call, so once it expired without a provider id stored the replica is gone and the submission is treated as not made:
+// a redelivered message submits the job again and a merchant may cancel it. The poller claiming the job afterwards
+// clears the stale lease when it releases it.
+func IsTransferClaimed(v *entity.TransferJob, now time.Time) bool {
+	return v.LeaseOwner.Valid && v.LeaseExpiresAt.Valid && v.LeaseExpiresAt.Time.After(now)
+}
+
+// IsTransferSubmitted reports whether the provider response, and so the provider ID, is already stored in the job payload
+func IsTransferSubmitted(v *entity.TransferJob) bool {
+	var submitted clientresponse.Transfer
+	err := v.Payload.Unmarshal(&submitted)
+	return err == nil && submitted.ID != ""
+}
+
+// TransferTotalFee is what the merchant pays on top of the amount, the transfer fee and the conversion fee of a quoted
+// transfer, both in the source currency
+func TransferTotalFee(v *entity.TransferJob) (int64, error) {
+	conversion, err := TransformTransferConversion(v.Conversion)
+	if err != nil {
+		return 0, err
+	}
+	if conversion != nil {
+		return v.FeeAmount + conversion.FeeAmount, nil
+	}
+	return v.FeeAmount, nil
+}
+
+type TransferJobEvent struct {
+	ID               int       `json:"id"`
+	JobID            string    `json:"job_id"`
+	FromStatus       string    `json:"from_status"`
+	ToStatus         string    `json:"to_status"`
+	Reason           string    `json:"reason"`
+	Actor            string    `json:"actor"`
+	ProviderResponse string    `json:"provider_response"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+func NewTransferJobEvent(from entity.Transferstatus, to entity.Transferstatus, reason string, actor string, providerResponse interface{}) (entity.TransferJobEvent, error) {
+	event := entity.TransferJobEvent{
+		ToStatus: to,
+		Reason:   reason,
+		Actor:    actor,
+	}
+	if from != "" {
+		event.FromStatus = entity.NullTransferstatusFrom(from)
+	}
+	if providerResponse != nil {
+		res, err := jsoniter.Marshal(providerResponse)
+		if err != nil {
+			return entity.TransferJobEvent{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+		}
+		event.ProviderResponse = null.JSONFrom(res)
+	}
+	return event, nil
+}
+
+func TransformPSQLTransferJobEvent(jobID string, events *entity.TransferJobEventSlice) []TransferJobEvent {
+	var res []TransferJobEvent
+	for _, v := range *events {
+		res = append(res, TransferJobEvent{
+			ID:               v.ID,
+			JobID:            jobID,
+			FromStatus:       v.FromStatus.Val.String(),
+			ToStatus:         v.ToStatus.String(),
+			Reason:           v.Reason,
+			Actor:            v.Actor,
+			ProviderResponse: string(v.ProviderResponse.JSON),
+			CreatedAt:        v.CreatedAt,
+		})
+	}
+	return res
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestIsTransferClaimed(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		name string
+		job  entity.TransferJob
+		want bool
+	}{
+		{name: "no lease", job: entity.TransferJob{}, want: false},
+		{
+			name: "unexpired lease",
+			job:  entity.TransferJob{LeaseOwner: null.StringFrom("consumer"), LeaseExpiresAt: null.TimeFrom(now.Add(time.Minute))},
+			want: true,
+		},
+		{
+			name: "lease of a crashed replica",
+			job:  entity.TransferJob{LeaseOwner: null.StringFrom("consumer"), LeaseExpiresAt: null.TimeFrom(now.Add(-time.Minute))},
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsTransferClaimed(&tc.job, now); got != tc.want {
+				t.Fatalf("IsTransferClaimed() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package model
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	jsoniter "github.com/json-iterator/go"
+)
+
+var (
+	DefaultTransferBatchMaxRows int = 1000
+
+	TransferBatchStatusProcessing string = "processing"
+	TransferBatchStatusCompleted  string = "completed"
+
+	transferBatchCSVColumns = []string{"source_bank_account", "destination_bank_account", "source_bank_id", "destination_bank_id", "amount", "currency", "transaction_time"}
+)
+
+type CreateTransferBatchRow struct {
+	Row  int
+	Data CreateTransfer
+	Err  error
+}
+
+type TransferBatchError struct {
+	Row     int    `json:"row"`
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+func NewTransferBatchError(row int, err error) TransferBatchError {
+	errData := errormsg.GetErrorData(err)
+	message := errData.WrappedMessage.Translation.EN
+	if errData.DebugError != nil {
+		message = errData.DebugError.Error()
+	}
+	return TransferBatchError{
+		Row:     row,
+		Code:    errData.Code,
+		Message: message,
+	}
+}
+
+func TransformCreateTransferBatchRows(v []CreateTransfer) []CreateTransferBatchRow {
+	var res []CreateTransferBatchRow
+	for i, data := range v {
+		res = append(res, CreateTransferBatchRow{
+			Row:  i + 1,
+			Data: data,
+		})
+	}
+	return res
+}
+
+// ParseTransferBatchCSV reads a csv with a header row, rows that cannot be parsed are returned with their error
+func ParseTransferBatchCSV(r io.Reader) ([]CreateTransferBatchRow, error) {
+	var res []CreateTransferBatchRow
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCInvalidTransferBatch, err, "error read csv header")
+	}
+
+	columns := map[string]int{}
+	for i, v := range header {
+		columns[strings.ToLower(strings.TrimSpace(v))] = i
+	}
+	for _, v := range transferBatchCSVColumns {
+		if _, ok := columns[v]; !ok {
+			return res, errormsg.WrapErr(svcerr.BrickSVCInvalidTransferBatch, nil, "missing csv column "+v)
+		}
+	}
+
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			res = append(res, CreateTransferBatchRow{
+				Row: row,
+				Err: errormsg.WrapErr(svcerr.BrickSVCInvalidTransferData, err, "error read csv row"),
+			})
+			continue
+		}
+
+		data, err := parseTransferBatchCSVRecord(columns, record)
+		res = append(res, CreateTransferBatchRow{
+			Row:  row,
+			Data: data,
+			Err:  err,
+		})
+	}
+	return res, nil
+}
+
+func parseTransferBatchCSVRecord(columns map[string]int, record []string) (CreateTransfer, error) {
+	var res CreateTransfer
+	res.SourceBankAccount = record[columns["source_bank_account"]]
+	res.DestinationBankAccount = record[columns["destination_bank_account"]]
+
+	sourceBankID, err := strconv.ParseInt(record[columns["source_bank_id"]], 10, 64)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCInvalidTransferData, nil, "invalid source_bank_id")
+	}
+	res.SourceBankID = sourceBankID
+
+	destinationBankID, err := strconv.ParseInt(record[columns["destination_bank_id"]], 10, 64)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCInvalidTransferData, nil, "invalid destination_bank_id")
+	}
+	res.DestinationBankID = destinationBankID
+
+	amount, err := ParseMinorUnits(record[columns["amount"]])
+	if err != nil {
+		return res, err
+	}
+	res.Amount = amount
+	res.Currency = record[columns["currency"]]
+
+	if v := record[columns["transaction_time"]]; v != "" {
+		transactionDate, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return res, errormsg.WrapErr(svcerr.BrickSVCInvalidTransferData, nil, "invalid transaction_time")
+		}
+		res.TransactionDate = transactionDate
+	}
+	return res, nil
+}
+
+type TransferBatch struct {
+	BatchID       string               `json:"batch_id"`
+	Status        string               `json:"status"`
+	TotalRows     int64                `json:"total_rows"`
+	AcceptedRows  int64                `json:"accepted_rows"`
+	RejectedRows  int64                `json:"rejected_rows"`
+	StatusSummary map[string]int64     `json:"status_summary"`
+	Errors        []TransferBatchError `json:"errors"`
+	Jobs          []TransferJobSummary `json:"jobs"`
+	BaseInformation
+}
+
+func TransformPSQLSingleTransferBatch(v *entity.TransferBatch, jobs entity.TransferJobSlice) (TransferBatch, error) {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(v.CreatedBy),
+		CreatedAt: v.CreatedAt,
+		UpdatedBy: int64(v.UpdatedBy),
+		UpdatedAt: v.UpdatedAt,
+		DeletedBy: int64(v.DeletedBy.Int),
+		DeletedAt: v.DeletedAt.Time,
+	}
+
+	batchErrors := []TransferBatchError{}
+	if err := jsoniter.Unmarshal(v.Errors, &batchErrors); err != nil {
+		return TransferBatch{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+
+	status := TransferBatchStatusCompleted
+	summary := map[string]int64{}
+	for _, job := range jobs {
+		summary[job.Status.String()]++
+		if job.Status == entity.TransferstatusPending || job.Status == entity.TransferstatusProcessing {
+			status = TransferBatchStatusProcessing
+		}
+	}
+
+	return TransferBatch{
+		BatchID:         v.BatchID,
+		Status:          status,
+		TotalRows:       int64(v.TotalRows),
+		AcceptedRows:    int64(v.AcceptedRows),
+		RejectedRows:    int64(v.RejectedRows),
+		StatusSummary:   summary,
+		Errors:          batchErrors,
+		Jobs:            TransformTransferJobSummary(jobs),
+		BaseInformation: creationInfo,
+	}, nil
+}
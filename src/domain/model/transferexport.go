@@ -0,0 +1,262 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/lucsky/cuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	// TransferExportAllColumns are the columns an export can select, in the order of an export without a selection
+	TransferExportAllColumns = []string{
+		"id", "job_id", "api_key", "reference", "status",
+		"source_bank_id", "source_bank_account", "destination_bank_id", "destination_bank_account",
+		"amount", "currency", "fee_amount", "transaction_time", "created_at", "updated_at",
+	}
+
+	DefaultTransferExportFetchSize int = 500
+
+	// TransferExportDownloadPath is where the file of a completed export job is downloaded
+	TransferExportDownloadPath string = "/api/v1/transfer/export/%s/download"
+)
+
+// GetTransferExportByParam takes the filters of the transfer list, the format and a comma separated column selection
+type GetTransferExportByParam struct {
+	GetTransferJobByParam
+	Format  string `json:"format" schema:"format" query:"format" example:"csv"`
+	Columns string `json:"columns" schema:"columns" query:"columns" example:"job_id,status,amount,currency"`
+}
+
+// Validate defaults the format to csv and the selection to every column, an unknown or repeated column is rejected
+func (g *GetTransferExportByParam) Validate() error {
+	if g.Format == "" {
+		g.Format = SettlementFormatCSV
+	}
+
+	// exports are written in the formats of the settlement report
+	if _, ok := SettlementContentTypes[g.Format]; !ok {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidTransferExport, nil, "format must be csv or ndjson")
+	}
+
+	if strings.TrimSpace(g.Columns) == "" {
+		g.Columns = strings.Join(TransferExportAllColumns, ",")
+		return nil
+	}
+
+	known := map[string]bool{}
+	for _, v := range TransferExportAllColumns {
+		known[v] = true
+	}
+
+	columns := g.ColumnList()
+	seen := map[string]bool{}
+	for _, v := range columns {
+		if !known[v] {
+			return errormsg.WrapErr(svcerr.BrickSVCInvalidTransferExport, nil, "unknown column "+v)
+		}
+
+		if seen[v] {
+			return errormsg.WrapErr(svcerr.BrickSVCInvalidTransferExport, nil, "column "+v+" is selected twice")
+		}
+		seen[v] = true
+	}
+	g.Columns = strings.Join(columns, ",")
+	return nil
+}
+
+func (g *GetTransferExportByParam) ColumnList() []string {
+	var res []string
+	for _, v := range strings.Split(g.Columns, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// FileName is the name the export is downloaded as
+func (g *GetTransferExportByParam) FileName(at time.Time) string {
+	return "transfers-" + at.UTC().Format("20060102T150405Z") + "." + g.Format
+}
+
+// GetQuery walks the jobs in id order so an export is read in the order they were created
+func (g *GetTransferExportByParam) GetQuery() []qm.QueryMod {
+	return append(g.GetTransferJobByParam.GetQuery(), qm.OrderBy("id"))
+}
+
+func (g *GetTransferExportByParam) ToEntity(createdBy int64) (entity.TransferExport, error) {
+	if err := g.Validate(); err != nil {
+		return entity.TransferExport{}, err
+	}
+
+	filter, err := jsoniter.Marshal(g.GetTransferJobByParam)
+	if err != nil {
+		return entity.TransferExport{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+
+	return entity.TransferExport{
+		ExportID:  cuid.New(),
+		Format:    g.Format,
+		Columns:   g.Columns,
+		Filter:    filter,
+		Status:    entity.TransferexportstatusRunning,
+		CreatedBy: int(createdBy),
+		UpdatedBy: int(createdBy),
+	}, nil
+}
+
+// TransferExportParam restores the filters an export job was created with
+func TransferExportParam(v *entity.TransferExport) (GetTransferExportByParam, error) {
+	res := GetTransferExportByParam{
+		Format:  v.Format,
+		Columns: v.Columns,
+	}
+	if err := v.Filter.Unmarshal(&res.GetTransferJobByParam); err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal export filter")
+	}
+	return res, nil
+}
+
+// TransferExportValues reads the selected columns of a job, the payload is decoded once for all of them and a missing time is nil
+func TransferExportValues(v *entity.TransferJob, columns []string) ([]interface{}, error) {
+	var payload CreateTransfer
+	if err := v.Payload.Unmarshal(&payload); err != nil {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+	if payload.Currency == "" {
+		payload.Currency = DefaultCurrency
+	}
+
+	res := make([]interface{}, len(columns))
+	for i, c := range columns {
+		switch c {
+		case "id":
+			res[i] = int64(v.ID)
+		case "job_id":
+			res[i] = v.JobID
+		case "api_key":
+			res[i] = MaskAPIKey(v.APIKey)
+		case "reference":
+			res[i] = v.Reference.String
+		case "status":
+			res[i] = v.Status.String()
+		case "source_bank_id":
+			res[i] = payload.SourceBankID
+		case "source_bank_account":
+			res[i] = payload.SourceBankAccount
+		case "destination_bank_id":
+			res[i] = payload.DestinationBankID
+		case "destination_bank_account":
+			res[i] = payload.DestinationBankAccount
+		case "amount":
+			res[i] = payload.Amount
+		case "currency":
+			res[i] = payload.Currency
+		case "fee_amount":
+			res[i] = v.FeeAmount
+		case "transaction_time":
+			if !payload.TransactionDate.IsZero() {
+				res[i] = payload.TransactionDate.UTC()
+			}
+		case "created_at":
+			res[i] = v.CreatedAt.UTC()
+		case "updated_at":
+			res[i] = v.UpdatedAt.UTC()
+		}
+	}
+	return res, nil
+}
+
+func TransferExportCSVRecord(values []interface{}) []string {
+	res := make([]string, len(values))
+	for i, v := range values {
+		switch val := v.(type) {
+		case string:
+			res[i] = val
+		case int64:
+			res[i] = strconv.FormatInt(val, 10)
+		case time.Time:
+			res[i] = val.Format(time.RFC3339)
+		}
+	}
+	return res
+}
+
+type GetTransferExportJobByParam struct {
+	ID        null.Int64  `json:"id" schema:"id" query:"id"`
+	ExportID  null.String `json:"export_id" schema:"export_id" query:"export_id"`
+	Status    null.String `json:"status" schema:"status" query:"status"`
+	CreatedBy null.Int64  `json:"created_by" schema:"created_by" query:"created_by"`
+}
+
+func (g *GetTransferExportJobByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.ExportID.Valid {
+		res = append(res, qm.Where("export_id=?", g.ExportID.String))
+	}
+
+	if g.Status.Valid {
+		res = append(res, qm.Where("status=?", g.Status.String))
+	}
+
+	if g.CreatedBy.Valid {
+		res = append(res, qm.Where("created_by=?", g.CreatedBy.Int64))
+	}
+	return res
+}
+
+type TransferExport struct {
+	ID          int64     `json:"id"`
+	ExportID    string    `json:"export_id"`
+	Format      string    `json:"format"`
+	Columns     []string  `json:"columns"`
+	Filter      string    `json:"filter"`
+	Status      string    `json:"status"`
+	RowCount    int64     `json:"row_count"`
+	Error       string    `json:"error"`
+	FinishedAt  time.Time `json:"finished_at"`
+	DownloadURL string    `json:"download_url"`
+	BaseInformation
+}
+
+// TransformPSQLSingleTransferExport links the file of a completed export
+func TransformPSQLSingleTransferExport(v *entity.TransferExport) TransferExport {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(v.CreatedBy),
+		CreatedAt: v.CreatedAt,
+		UpdatedBy: int64(v.UpdatedBy),
+		UpdatedAt: v.UpdatedAt,
+		DeletedBy: int64(v.DeletedBy.Int),
+		DeletedAt: v.DeletedAt.Time,
+	}
+
+	res := TransferExport{
+		ID:              int64(v.ID),
+		ExportID:        v.ExportID,
+		Format:          v.Format,
+		Columns:         strings.Split(v.Columns, ","),
+		Filter:          string(v.Filter),
+		Status:          v.Status.String(),
+		RowCount:        int64(v.RowCount),
+		Error:           v.Error.String,
+		FinishedAt:      v.FinishedAt.Time,
+		BaseInformation: creationInfo,
+	}
+	if v.Status == entity.TransferexportstatusCompleted {
+		res.DownloadURL = fmt.Sprintf(TransferExportDownloadPath, v.ExportID)
+	}
+	return res
+}
@@ -0,0 +1,199 @@
+package model
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/clientresponse"
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/lucsky/cuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var DefaultTransferQuoteTTL time.Duration = 5 * time.Minute
+
+type CreateTransferQuote struct {
+	SourceCurrency      string `json:"source_currency" example:"USD"`
+	DestinationCurrency string `json:"destination_currency" example:"IDR"`
+	Amount              int64  `json:"amount" example:"1099"`
+	SourceBankID        int64  `json:"source_bank_id" example:"1"`
+	DestinationBankID   int64  `json:"destination_bank_id" example:"2"`
+}
+
+func (c *CreateTransferQuote) Validate() error {
+	if err := ValidateMoney(c.Amount, c.SourceCurrency); err != nil {
+		return err
+	}
+
+	if _, ok := CurrencyMinorUnits[c.DestinationCurrency]; !ok {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidTransferData, nil, "destination currency must be an uppercase ISO 4217 code")
+	}
+	return nil
+}
+
+// ToEntity locks the rate for ttl, the destination amount is rounded down to whole minor units and the fee is charged on top of the source amount
+func (c *CreateTransferQuote) ToEntity(apikey string, rate *big.Rat, feeBPS int64, ttl time.Duration) (entity.TransferQuote, error) {
+	destinationAmount, err := ConvertMinorUnits(c.Amount, c.SourceCurrency, c.DestinationCurrency, rate)
+	if err != nil {
+		return entity.TransferQuote{}, err
+	}
+
+	return entity.TransferQuote{
+		QuoteID:             cuid.New(),
+		APIKey:              apikey,
+		SourceCurrency:      c.SourceCurrency,
+		DestinationCurrency: c.DestinationCurrency,
+		SourceAmount:        c.Amount,
+		DestinationAmount:   destinationAmount,
+		Rate:                RatToDecimal(rate),
+		FeeAmount:           FeeFromBPS(c.Amount, feeBPS),
+		ExpiresAt:           time.Now().UTC().Add(ttl),
+	}, nil
+}
+
+// ConvertMinorUnits converts an amount between currencies with different minor unit digits
+func ConvertMinorUnits(amount int64, from string, to string, rate *big.Rat) (int64, error) {
+	res := new(big.Rat).Mul(big.NewRat(amount, 1), rate)
+	if shift := CurrencyMinorUnits[to] - CurrencyMinorUnits[from]; shift > 0 {
+		res.Mul(res, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(shift)), nil)))
+	} else if shift < 0 {
+		res.Quo(res, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-shift)), nil)))
+	}
+
+	converted := new(big.Int).Quo(res.Num(), res.Denom())
+	if !converted.IsInt64() {
+		return 0, errormsg.WrapErr(svcerr.BrickSVCInvalidTransferData, nil, "converted amount is too large")
+	}
+
+	if converted.Sign() <= 0 {
+		return 0, errormsg.WrapErr(svcerr.BrickSVCInvalidTransferData, nil, "amount is too small to convert")
+	}
+	return converted.Int64(), nil
+}
+
+// FeeFromBPS charges basis points of the amount, rounded up to whole minor units
+func FeeFromBPS(amount int64, bps int64) int64 {
+	if bps <= 0 {
+		return 0
+	}
+	fee := new(big.Int).Mul(big.NewInt(amount), big.NewInt(bps))
+	fee.Add(fee, big.NewInt(9999))
+	return fee.Quo(fee, big.NewInt(10000)).Int64()
+}
+
+// ApplyTransferQuote fills the amount of a transfer from its quote, an amount given explicitly must match the quoted one
+func ApplyTransferQuote(c *CreateTransfer, quote *entity.TransferQuote) error {
+	if quote.TransferJobID.Valid {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidQuote, nil, "quote already used")
+	}
+
+	if !quote.ExpiresAt.After(time.Now()) {
+		return errormsg.WrapErr(svcerr.BrickSVCQuoteExpired, nil, "quote expired at "+quote.ExpiresAt.Format(time.RFC3339))
+	}
+
+	if c.Amount == 0 && c.Currency == "" {
+		c.Amount = quote.SourceAmount
+		c.Currency = quote.SourceCurrency
+	}
+
+	if c.Amount != quote.SourceAmount || c.Currency != quote.SourceCurrency {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidQuote, nil, "amount and currency must match the quote")
+	}
+	return nil
+}
+
+type TransferConversion struct {
+	QuoteID             string `json:"quote_id"`
+	SourceAmount        int64  `json:"source_amount"`
+	SourceCurrency      string `json:"source_currency"`
+	DestinationAmount   int64  `json:"destination_amount"`
+	DestinationCurrency string `json:"destination_currency"`
+	Rate                string `json:"rate"`
+	FeeAmount           int64  `json:"fee_amount"`
+}
+
+func NewTransferConversion(quote *entity.TransferQuote) (null.JSON, error) {
+	res, err := jsoniter.Marshal(TransferConversion{
+		QuoteID:             quote.QuoteID,
+		SourceAmount:        quote.SourceAmount,
+		SourceCurrency:      quote.SourceCurrency,
+		DestinationAmount:   quote.DestinationAmount,
+		DestinationCurrency: quote.DestinationCurrency,
+		Rate:                FormatRate(quote.Rate),
+		FeeAmount:           quote.FeeAmount,
+	})
+	if err != nil {
+		return null.JSON{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+	return null.JSONFrom(res), nil
+}
+
+func TransformTransferConversion(v null.JSON) (*TransferConversion, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	var res TransferConversion
+	if err := v.Unmarshal(&res); err != nil {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+	return &res, nil
+}
+
+// SetTransferConversion tells the provider how much the destination receives when the job was created from a quote
+func SetTransferConversion(res *clientresponse.Transfer, v *entity.TransferJob) error {
+	conversion, err := TransformTransferConversion(v.Conversion)
+	if err != nil || conversion == nil {
+		return err
+	}
+	res.DestinationAmount = conversion.DestinationAmount
+	res.DestinationCurrency = conversion.DestinationCurrency
+	return nil
+}
+
+type GetTransferQuoteByParam struct {
+	QuoteID null.String `json:"quote_id" schema:"quote_id" query:"quote_id"`
+	APIKey  null.String `json:"api_key" schema:"api_key" query:"api_key"`
+}
+
+func (g *GetTransferQuoteByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.QuoteID.Valid {
+		res = append(res, qm.Where("quote_id=?", g.QuoteID.String))
+	}
+
+	if g.APIKey.Valid {
+		res = append(res, qm.Where("api_key=?", g.APIKey.String))
+	}
+	return res
+}
+
+type TransferQuote struct {
+	QuoteID             string    `json:"quote_id"`
+	SourceCurrency      string    `json:"source_currency"`
+	DestinationCurrency string    `json:"destination_currency"`
+	SourceAmount        int64     `json:"source_amount"`
+	DestinationAmount   int64     `json:"destination_amount"`
+	Rate                string    `json:"rate"`
+	FeeAmount           int64     `json:"fee_amount"`
+	TransferFeeAmount   int64     `json:"transfer_fee_amount"`
+	TotalAmount         int64     `json:"total_amount"`
+	ExpiresAt           time.Time `json:"expires_at"`
+}
+
+func TransformPSQLSingleTransferQuote(v *entity.TransferQuote) TransferQuote {
+	return TransferQuote{
+		QuoteID:             v.QuoteID,
+		SourceCurrency:      v.SourceCurrency,
+		DestinationCurrency: v.DestinationCurrency,
+		SourceAmount:        v.SourceAmount,
+		DestinationAmount:   v.DestinationAmount,
+		Rate:                FormatRate(v.Rate),
+		FeeAmount:           v.FeeAmount,
+		TotalAmount:         v.SourceAmount + v.FeeAmount,
+		ExpiresAt:           v.ExpiresAt,
+	}
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	TransferErrorClassNetwork   string = "network"
+	TransferErrorClassServer    string = "server"
+	TransferErrorClassRateLimit string = "rate_limit"
+	TransferErrorClassRejected  string = "rejected"
+)
+
+type TransferRetryPolicy struct {
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	BaseBackoff time.Duration `mapstructure:"base_backoff"`
+	MaxBackoff  time.Duration `mapstructure:"max_backoff"`
+}
+
+// DefaultTransferRetryPolicies is used for error classes missing from the configuration, a rejected transfer is never retried
+var DefaultTransferRetryPolicies = map[string]TransferRetryPolicy{
+	TransferErrorClassNetwork:   {MaxAttempts: 5, BaseBackoff: 30 * time.Second, MaxBackoff: 10 * time.Minute},
+	TransferErrorClassServer:    {MaxAttempts: 5, BaseBackoff: 1 * time.Minute, MaxBackoff: 30 * time.Minute},
+	TransferErrorClassRateLimit: {MaxAttempts: 8, BaseBackoff: 1 * time.Minute, MaxBackoff: 30 * time.Minute},
+	TransferErrorClassRejected:  {MaxAttempts: 1},
+}
+
+// DefaultOutboxRetryPolicy paces the relay of an outbox message that kafka keeps refusing before it is parked
+var DefaultOutboxRetryPolicy = TransferRetryPolicy{MaxAttempts: 10, BaseBackoff: 5 * time.Second, MaxBackoff: 10 * time.Minute}
+
+// ClassifyTransferError maps a provider submission error to the retry policy it falls under
+func ClassifyTransferError(err error) string {
+	switch errormsg.GetErrorData(err).Code {
+	case svcerr.CodeProviderUnreachable:
+		return TransferErrorClassNetwork
+	case svcerr.CodeProviderServerError:
+		return TransferErrorClassServer
+	case svcerr.CodeProviderRateLimited:
+		return TransferErrorClassRateLimit
+	default:
+		return TransferErrorClassRejected
+	}
+}
+
+// Backoff doubles the wait after every failed attempt, capped at the policy maximum
+func (p TransferRetryPolicy) Backoff(attempts int) time.Duration {
+	wait := p.BaseBackoff
+	for i := 1; i < attempts && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	return wait
+}
+
+// RecordTransferAttempt counts a failed submission on the job and reports whether another attempt is scheduled
+func RecordTransferAttempt(v *entity.TransferJob, policy TransferRetryPolicy, class string, err error) bool {
+	v.AttemptCount++
+	v.LastError = null.StringFrom(fmt.Sprintf("%s: %v", class, errormsg.GetErrorData(err).DebugError))
+	if v.AttemptCount >= policy.MaxAttempts {
+		v.NextAttemptAt = null.Time{}
+		return false
+	}
+
+	v.NextAttemptAt = null.TimeFrom(time.Now().UTC().Add(policy.Backoff(v.AttemptCount)))
+	return true
+}
@@ -0,0 +1,128 @@
+package model
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	// TransferStreamChannel is the redis channel the status changes of the jobs of an api key are published on,
+	// every replica subscribes to it so a stream can be served by any of them
+	TransferStreamChannel string = "streamTransfer:%s"
+	// TransferStreamEventCreated is the event of a new job, its first event has no from status
+	TransferStreamEventCreated string = "transfer.created"
+
+	DefaultTransferStreamHeartbeat   time.Duration = 15 * time.Second
+	DefaultTransferStreamReplayLimit int           = 500
+)
+
+// TransferStreamMessage is a status change of a job, published on the channel of its api key and read back from the
+// events of the job on resume
+type TransferStreamMessage struct {
+	EventID         int64       `boil:"event_id" json:"event_id"`
+	JobID           string      `boil:"job_id" json:"job_id"`
+	TransferBatchID null.Int64  `boil:"transfer_batch_id" json:"transfer_batch_id"`
+	FromStatus      null.String `boil:"from_status" json:"from_status"`
+	ToStatus        string      `boil:"to_status" json:"to_status"`
+	Reason          string      `boil:"reason" json:"reason"`
+	OccurredAt      time.Time   `boil:"created_at" json:"occurred_at"`
+}
+
+func NewTransferStreamMessage(transferJob *entity.TransferJob, event *entity.TransferJobEvent) TransferStreamMessage {
+	res := TransferStreamMessage{
+		EventID:    int64(event.ID),
+		JobID:      transferJob.JobID,
+		ToStatus:   event.ToStatus.String(),
+		Reason:     event.Reason,
+		OccurredAt: event.CreatedAt,
+	}
+	if transferJob.TransferBatchID.Valid {
+		res.TransferBatchID = null.Int64From(int64(transferJob.TransferBatchID.Int))
+	}
+	if event.FromStatus.Valid {
+		res.FromStatus = null.StringFrom(event.FromStatus.Val.String())
+	}
+	return res
+}
+
+// GetTransferStreamByParam narrows a stream to a job or a batch, the last event id resumes it after the event a client
+// saw last
+type GetTransferStreamByParam struct {
+	JobID       null.String `json:"job_id" schema:"job_id" query:"job_id"`
+	BatchID     null.String `json:"batch_id" schema:"batch_id" query:"batch_id"`
+	LastEventID null.Int64  `json:"last_event_id" schema:"last_event_id" query:"last_event_id"`
+
+	APIKey          string     `json:"-" schema:"-" query:"-"`
+	TransferBatchID null.Int64 `json:"-" schema:"-" query:"-"`
+	Limit           int        `json:"-" schema:"-" query:"-"`
+}
+
+// Match reports whether a published message belongs to the stream, the channel already scopes it to the api key
+func (g *GetTransferStreamByParam) Match(v *TransferStreamMessage) bool {
+	if g.JobID.Valid && v.JobID != g.JobID.String {
+		return false
+	}
+
+	if g.TransferBatchID.Valid && v.TransferBatchID != g.TransferBatchID {
+		return false
+	}
+	return true
+}
+
+// GetQuery reads the events after the last event id in the order they were recorded, the columns are aliased as a
+// joined select would otherwise name them after their table
+func (g *GetTransferStreamByParam) GetQuery() []qm.QueryMod {
+	res := []qm.QueryMod{
+		qm.Select(
+			"transfer_job_events.id AS event_id",
+			"transfer_jobs.job_id AS job_id",
+			"transfer_jobs.transfer_batch_id AS transfer_batch_id",
+			"transfer_job_events.from_status AS from_status",
+			"transfer_job_events.to_status AS to_status",
+			"transfer_job_events.reason AS reason",
+			"transfer_job_events.created_at AS created_at",
+		),
+		qm.InnerJoin("transfer_jobs ON transfer_jobs.id = transfer_job_events.transfer_job_id"),
+		qm.Where("transfer_jobs.api_key=?", g.APIKey),
+		qm.Where("transfer_job_events.id>?", g.LastEventID.Int64),
+	}
+
+	if g.JobID.Valid {
+		res = append(res, qm.Where("transfer_jobs.job_id=?", g.JobID.String))
+	}
+
+	if g.TransferBatchID.Valid {
+		res = append(res, qm.Where("transfer_jobs.transfer_batch_id=?", g.TransferBatchID.Int64))
+	}
+	return append(res, qm.OrderBy("transfer_job_events.id"), qm.Limit(g.Limit))
+}
+
+// TransferStreamEvent is what a subscriber receives, the id is the one to send back as Last-Event-ID
+type TransferStreamEvent struct {
+	ID         int64     `json:"id"`
+	Event      string    `json:"event"`
+	JobID      string    `json:"job_id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Reason     string    `json:"reason"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func TransformTransferStreamEvent(v *TransferStreamMessage) TransferStreamEvent {
+	event := WebhookEventTransferStatusChange
+	if !v.FromStatus.Valid {
+		event = TransferStreamEventCreated
+	}
+	return TransferStreamEvent{
+		ID:         v.EventID,
+		Event:      event,
+		JobID:      v.JobID,
+		FromStatus: v.FromStatus.String,
+		ToStatus:   v.ToStatus,
+		Reason:     v.Reason,
+		OccurredAt: v.OccurredAt,
+	}
+}
@@ -0,0 +1,187 @@
+package model
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	WebhookSignatureHeader           string = "X-Bricksvc-Signature"
+	WebhookTimestampHeader           string = "X-Bricksvc-Timestamp"
+	WebhookDeliveryHeader            string = "X-Bricksvc-Delivery"
+	WebhookEventTransferStatusChange string = "transfer.status_changed"
+
+	DefaultWebhookMaxAttempts int           = 8
+	DefaultWebhookBaseBackoff time.Duration = 10 * time.Second
+	DefaultWebhookMaxBackoff  time.Duration = 1 * time.Hour
+	DefaultWebhookTimeout     time.Duration = 10 * time.Second
+)
+
+type CreateWebhookEndpoint struct {
+	URL       string `json:"url"`
+	AccountID int64  `json:"-"`
+	CreatedBy int64  `json:"-"`
+}
+
+func (v *CreateWebhookEndpoint) Validate() error {
+	u, err := url.ParseRequestURI(v.URL)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidWebhookURL, err, "invalid url")
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidWebhookURL, nil, "invalid url scheme")
+	}
+	return nil
+}
+
+type GetWebhookEndpointByParam struct {
+	ID        null.Int64 `json:"id" schema:"id" query:"id"`
+	AccountID null.Int64 `json:"account_id" schema:"account_id" query:"account_id"`
+	IsActive  null.Bool  `json:"is_active" schema:"is_active" query:"is_active"`
+}
+
+func (g *GetWebhookEndpointByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.AccountID.Valid {
+		res = append(res, qm.Where("account_id=?", g.AccountID.Int64))
+	}
+
+	if g.IsActive.Valid {
+		res = append(res, qm.Where("is_active=?", g.IsActive.Bool))
+	}
+	return res
+}
+
+type WebhookEndpoint struct {
+	ID        int64  `json:"id"`
+	AccountID int64  `json:"account_id"`
+	URL       string `json:"url"`
+	Secret    string `json:"secret,omitempty"`
+	IsActive  bool   `json:"is_active"`
+	BaseInformation
+}
+
+func TransformPSQLSingleWebhookEndpoint(v *entity.WebhookEndpoint) WebhookEndpoint {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(v.CreatedBy),
+		CreatedAt: v.CreatedAt,
+		UpdatedBy: int64(v.UpdatedBy),
+		UpdatedAt: v.UpdatedAt,
+		DeletedBy: int64(v.DeletedBy.Int),
+		DeletedAt: v.DeletedAt.Time,
+	}
+
+	return WebhookEndpoint{
+		ID:              int64(v.ID),
+		AccountID:       int64(v.AccountID),
+		URL:             v.URL,
+		IsActive:        v.IsActive,
+		BaseInformation: creationInfo,
+	}
+}
+
+func TransformPSQLWebhookEndpoint(v *entity.WebhookEndpointSlice) []WebhookEndpoint {
+	var res []WebhookEndpoint
+	for _, e := range *v {
+		res = append(res, TransformPSQLSingleWebhookEndpoint(e))
+	}
+	return res
+}
+
+type WebhookTransferEvent struct {
+	Event      string    `json:"event"`
+	JobID      string    `json:"job_id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Reason     string    `json:"reason"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func NewWebhookDeliveries(endpoints entity.WebhookEndpointSlice, jobID string, event *entity.TransferJobEvent) (entity.WebhookDeliverySlice, error) {
+	var res entity.WebhookDeliverySlice
+	if len(endpoints) == 0 {
+		return res, nil
+	}
+
+	payload, err := jsoniter.Marshal(WebhookTransferEvent{
+		Event:      WebhookEventTransferStatusChange,
+		JobID:      jobID,
+		FromStatus: event.FromStatus.Val.String(),
+		ToStatus:   event.ToStatus.String(),
+		Reason:     event.Reason,
+		OccurredAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+
+	for _, v := range endpoints {
+		res = append(res, &entity.WebhookDelivery{
+			WebhookEndpointID: v.ID,
+			EventType:         WebhookEventTransferStatusChange,
+			Payload:           payload,
+			Status:            entity.WebhookdeliverystatusPending,
+			NextAttemptAt:     time.Now().UTC(),
+		})
+	}
+	return res, nil
+}
+
+type WebhookDelivery struct {
+	ID                int64     `json:"id"`
+	WebhookEndpointID int64     `json:"webhook_endpoint_id"`
+	EventType         string    `json:"event_type"`
+	Payload           string    `json:"payload"`
+	Status            string    `json:"status"`
+	Attempts          int64     `json:"attempts"`
+	NextAttemptAt     time.Time `json:"next_attempt_at"`
+	ResponseCode      int64     `json:"response_code"`
+	LastError         string    `json:"last_error"`
+	DeliveredAt       time.Time `json:"delivered_at"`
+	BaseInformation
+}
+
+func TransformPSQLSingleWebhookDelivery(v *entity.WebhookDelivery) WebhookDelivery {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(v.CreatedBy),
+		CreatedAt: v.CreatedAt,
+		UpdatedBy: int64(v.UpdatedBy),
+		UpdatedAt: v.UpdatedAt,
+		DeletedBy: int64(v.DeletedBy.Int),
+		DeletedAt: v.DeletedAt.Time,
+	}
+
+	return WebhookDelivery{
+		ID:                int64(v.ID),
+		WebhookEndpointID: int64(v.WebhookEndpointID),
+		EventType:         v.EventType,
+		Payload:           string(v.Payload),
+		Status:            v.Status.String(),
+		Attempts:          int64(v.Attempts),
+		NextAttemptAt:     v.NextAttemptAt,
+		ResponseCode:      int64(v.ResponseCode.Int),
+		LastError:         v.LastError.String,
+		DeliveredAt:       v.DeliveredAt.Time,
+		BaseInformation:   creationInfo,
+	}
+}
+
+func TransformPSQLWebhookDelivery(v *entity.WebhookDeliverySlice) []WebhookDelivery {
+	var res []WebhookDelivery
+	for _, d := range *v {
+		res = append(res, TransformPSQLSingleWebhookDelivery(d))
+	}
+	return res
+}
@@ -0,0 +1,168 @@
+package response
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SingleLedgerAccountResponse struct {
+	Response
+	Data model.LedgerAccount `json:"data"`
+}
+
+func (r *SingleLedgerAccountResponse) Transform(ctx *fiber.Ctx, log logger.LoggerInterface, code int, err error) error {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request().URI().String(),
+			RequestMethod: ctx.Method(),
+			RequestID:     ctx.Locals("requestid").(string),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.ErrorWithContext(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return ctx.Status(int(r.Response.Code)).JSON(r)
+}
+
+type LedgerAccountsResponse struct {
+	Response
+	Data       []model.LedgerAccount `json:"data"`
+	Pagination model.Pagination      `json:"pagination"`
+}
+
+func (r *LedgerAccountsResponse) Transform(ctx *fiber.Ctx, log logger.LoggerInterface, code int, err error) error {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request().URI().String(),
+			RequestMethod: ctx.Method(),
+			RequestID:     ctx.Locals("requestid").(string),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.ErrorWithContext(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []model.LedgerAccount{}
+	}
+
+	return ctx.Status(int(r.Response.Code)).JSON(r)
+}
+
+type LedgerEntriesResponse struct {
+	Response
+	Data       []model.LedgerEntry `json:"data"`
+	Pagination model.Pagination    `json:"pagination"`
+}
+
+func (r *LedgerEntriesResponse) Transform(ctx *fiber.Ctx, log logger.LoggerInterface, code int, err error) error {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request().URI().String(),
+			RequestMethod: ctx.Method(),
+			RequestID:     ctx.Locals("requestid").(string),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.ErrorWithContext(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []model.LedgerEntry{}
+	}
+
+	return ctx.Status(int(r.Response.Code)).JSON(r)
+}
+
+type LedgerInvariantResponse struct {
+	Response
+	Data []model.LedgerInvariant `json:"data"`
+}
+
+func (r *LedgerInvariantResponse) Transform(ctx *fiber.Ctx, log logger.LoggerInterface, code int, err error) error {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request().URI().String(),
+			RequestMethod: ctx.Method(),
+			RequestID:     ctx.Locals("requestid").(string),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.ErrorWithContext(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []model.LedgerInvariant{}
+	}
+
+	return ctx.Status(int(r.Response.Code)).JSON(r)
+}
+
+type LedgerBalanceResponse struct {
+	Response
+	Data []model.LedgerAccount `json:"data"`
+}
+
+func (r *LedgerBalanceResponse) Transform(ctx *fiber.Ctx, log logger.LoggerInterface, code int, err error) error {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request().URI().String(),
+			RequestMethod: ctx.Method(),
+			RequestID:     ctx.Locals("requestid").(string),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.ErrorWithContext(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []model.LedgerAccount{}
+	}
+
+	return ctx.Status(int(r.Response.Code)).JSON(r)
+}
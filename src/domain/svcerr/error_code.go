@@ -26,6 +26,36 @@ const (
 	CodeInvalidScope
 	CodeInvalidClientIDClientSecret
 	CodeinsufficientAmount
+	CodeIdempotencyKeyMismatch
+	CodeIdempotencyKeyInProgress
+	CodeInvalidStatusTransition
+	CodeInvalidWebhookURL
+	CodeInvalidTransferData
+	CodeInvalidTransferBatch
+	CodeInvalidRecurringTransfer
+	CodeProviderUnreachable
+	CodeProviderServerError
+	CodeProviderRateLimited
+	CodeProviderRejected
+	CodeInvalidFXRate
+	CodeFXRateNotFound
+	CodeInvalidQuote
+	CodeQuoteExpired
+	CodeInvalidFeeRule
+	CodeTransferLimitExceeded
+	CodeDailyAmountLimitExceeded
+	CodeDailyCountLimitExceeded
+	CodeInvalidAccountLimit
+	CodeSourceAccountNotFound
+	CodeDestinationAccountNotFound
+	CodeSameAccountTransfer
+	CodeUnbalancedLedger
+	CodeInvalidReconciliation
+	CodeInvalidSettlementReport
+	CodeInvalidProviderCallback
+	CodeInvalidCursor
+	CodeInvalidTransferExport
+	CodeTransferClaimed
 
 	CodeNotAuthorized = 401000
 	CodeNotFound      = 404000
@@ -53,6 +83,36 @@ var (
 	BrickSVCInvalidScope                = ErrMsg[CodeInvalidScope]
 	BrickSVCInvalidClientIDClientSecret = ErrMsg[CodeInvalidClientIDClientSecret]
 	BrickSVCCodeinsufficientAmount      = ErrMsg[CodeinsufficientAmount]
+	BrickSVCIdempotencyKeyMismatch      = ErrMsg[CodeIdempotencyKeyMismatch]
+	BrickSVCIdempotencyKeyInProgress    = ErrMsg[CodeIdempotencyKeyInProgress]
+	BrickSVCInvalidStatusTransition     = ErrMsg[CodeInvalidStatusTransition]
+	BrickSVCInvalidWebhookURL           = ErrMsg[CodeInvalidWebhookURL]
+	BrickSVCInvalidTransferData         = ErrMsg[CodeInvalidTransferData]
+	BrickSVCInvalidTransferBatch        = ErrMsg[CodeInvalidTransferBatch]
+	BrickSVCInvalidRecurringTransfer    = ErrMsg[CodeInvalidRecurringTransfer]
+	BrickSVCProviderUnreachable         = ErrMsg[CodeProviderUnreachable]
+	BrickSVCProviderServerError         = ErrMsg[CodeProviderServerError]
+	BrickSVCProviderRateLimited         = ErrMsg[CodeProviderRateLimited]
+	BrickSVCProviderRejected            = ErrMsg[CodeProviderRejected]
+	BrickSVCInvalidFXRate               = ErrMsg[CodeInvalidFXRate]
+	BrickSVCFXRateNotFound              = ErrMsg[CodeFXRateNotFound]
+	BrickSVCInvalidQuote                = ErrMsg[CodeInvalidQuote]
+	BrickSVCQuoteExpired                = ErrMsg[CodeQuoteExpired]
+	BrickSVCInvalidFeeRule              = ErrMsg[CodeInvalidFeeRule]
+	BrickSVCTransferLimitExceeded       = ErrMsg[CodeTransferLimitExceeded]
+	BrickSVCDailyAmountLimitExceeded    = ErrMsg[CodeDailyAmountLimitExceeded]
+	BrickSVCDailyCountLimitExceeded     = ErrMsg[CodeDailyCountLimitExceeded]
+	BrickSVCInvalidAccountLimit         = ErrMsg[CodeInvalidAccountLimit]
+	BrickSVCSourceAccountNotFound       = ErrMsg[CodeSourceAccountNotFound]
+	BrickSVCDestinationAccountNotFound  = ErrMsg[CodeDestinationAccountNotFound]
+	BrickSVCSameAccountTransfer         = ErrMsg[CodeSameAccountTransfer]
+	BrickSVCUnbalancedLedger            = ErrMsg[CodeUnbalancedLedger]
+	BrickSVCInvalidReconciliation       = ErrMsg[CodeInvalidReconciliation]
+	BrickSVCInvalidSettlementReport     = ErrMsg[CodeInvalidSettlementReport]
+	BrickSVCInvalidProviderCallback     = ErrMsg[CodeInvalidProviderCallback]
+	BrickSVCInvalidCursor               = ErrMsg[CodeInvalidCursor]
+	BrickSVCInvalidTransferExport       = ErrMsg[CodeInvalidTransferExport]
+	BrickSVCTransferClaimed             = ErrMsg[CodeTransferClaimed]
 )
 
 var ErrMsg = map[int]errormsg.Message{
@@ -216,4 +276,252 @@ var ErrMsg = map[int]errormsg.Message{
 			EN: "Client ID/Client Secret should not be empty",
 		},
 	},
+	CodeIdempotencyKeyMismatch: {
+		Code:       CodeIdempotencyKeyMismatch,
+		StatusCode: http.StatusUnprocessableEntity,
+		Message:    "Idempotency-Key sudah digunakan untuk permintaan yang berbeda!",
+		Translation: errormsg.Translation{
+			EN: "Idempotency-Key has already been used for a different request!",
+		},
+	},
+	CodeIdempotencyKeyInProgress: {
+		Code:       CodeIdempotencyKeyInProgress,
+		StatusCode: http.StatusConflict,
+		Message:    "Permintaan dengan Idempotency-Key ini sedang diproses!",
+		Translation: errormsg.Translation{
+			EN: "A request with this Idempotency-Key is still being processed!",
+		},
+	},
+	CodeInvalidStatusTransition: {
+		Code:       CodeInvalidStatusTransition,
+		StatusCode: http.StatusConflict,
+		Message:    "Perubahan status transfer tidak diizinkan!",
+		Translation: errormsg.Translation{
+			EN: "Transfer status change is not allowed!",
+		},
+	},
+	CodeInvalidWebhookURL: {
+		Code:       CodeInvalidWebhookURL,
+		StatusCode: http.StatusBadRequest,
+		Message:    "URL webhook tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid webhook URL!",
+		},
+	},
+	CodeInvalidTransferData: {
+		Code:       CodeInvalidTransferData,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Data transfer tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid transfer data!",
+		},
+	},
+	CodeInvalidTransferBatch: {
+		Code:       CodeInvalidTransferBatch,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Batch transfer kosong atau melebihi batas!",
+		Translation: errormsg.Translation{
+			EN: "Transfer batch is empty or exceeds the limit!",
+		},
+	},
+	CodeInvalidRecurringTransfer: {
+		Code:       CodeInvalidRecurringTransfer,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Jadwal transfer berulang tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid recurring transfer schedule!",
+		},
+	},
+	CodeProviderUnreachable: {
+		Code:       CodeProviderUnreachable,
+		StatusCode: http.StatusBadGateway,
+		Message:    "Penyedia transfer tidak dapat dihubungi!",
+		Translation: errormsg.Translation{
+			EN: "Transfer provider is unreachable!",
+		},
+	},
+	CodeProviderServerError: {
+		Code:       CodeProviderServerError,
+		StatusCode: http.StatusBadGateway,
+		Message:    "Penyedia transfer sedang mengalami gangguan!",
+		Translation: errormsg.Translation{
+			EN: "Transfer provider is experiencing an error!",
+		},
+	},
+	CodeProviderRateLimited: {
+		Code:       CodeProviderRateLimited,
+		StatusCode: http.StatusTooManyRequests,
+		Message:    "Permintaan ke penyedia transfer dibatasi!",
+		Translation: errormsg.Translation{
+			EN: "Transfer provider rate limit reached!",
+		},
+	},
+	CodeProviderRejected: {
+		Code:       CodeProviderRejected,
+		StatusCode: http.StatusUnprocessableEntity,
+		Message:    "Transfer ditolak oleh penyedia!",
+		Translation: errormsg.Translation{
+			EN: "Transfer rejected by provider!",
+		},
+	},
+	CodeInvalidFXRate: {
+		Code:       CodeInvalidFXRate,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Data kurs tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid exchange rate data!",
+		},
+	},
+	CodeFXRateNotFound: {
+		Code:       CodeFXRateNotFound,
+		StatusCode: http.StatusUnprocessableEntity,
+		Message:    "Kurs untuk pasangan mata uang tidak tersedia!",
+		Translation: errormsg.Translation{
+			EN: "Exchange rate for the currency pair is not available!",
+		},
+	},
+	CodeInvalidQuote: {
+		Code:       CodeInvalidQuote,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Penawaran kurs tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid quote!",
+		},
+	},
+	CodeQuoteExpired: {
+		Code:       CodeQuoteExpired,
+		StatusCode: http.StatusGone,
+		Message:    "Penawaran kurs sudah kedaluwarsa!",
+		Translation: errormsg.Translation{
+			EN: "Quote has expired!",
+		},
+	},
+	CodeInvalidFeeRule: {
+		Code:       CodeInvalidFeeRule,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Aturan biaya tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid fee rule!",
+		},
+	},
+	CodeTransferLimitExceeded: {
+		Code:       CodeTransferLimitExceeded,
+		StatusCode: http.StatusUnprocessableEntity,
+		Message:    "Nominal transfer melebihi batas per transaksi!",
+		Translation: errormsg.Translation{
+			EN: "Transfer amount exceeds the per transaction limit!",
+		},
+	},
+	CodeDailyAmountLimitExceeded: {
+		Code:       CodeDailyAmountLimitExceeded,
+		StatusCode: http.StatusUnprocessableEntity,
+		Message:    "Total transfer hari ini melebihi batas harian!",
+		Translation: errormsg.Translation{
+			EN: "Transfer exceeds the daily amount limit!",
+		},
+	},
+	CodeDailyCountLimitExceeded: {
+		Code:       CodeDailyCountLimitExceeded,
+		StatusCode: http.StatusTooManyRequests,
+		Message:    "Jumlah transfer hari ini melebihi batas harian!",
+		Translation: errormsg.Translation{
+			EN: "Transfer exceeds the daily count limit!",
+		},
+	},
+	CodeInvalidAccountLimit: {
+		Code:       CodeInvalidAccountLimit,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Data batas transfer akun tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid account limit data!",
+		},
+	},
+	CodeinsufficientAmount: {
+		Code:       CodeinsufficientAmount,
+		StatusCode: http.StatusUnprocessableEntity,
+		Message:    "Saldo rekening sumber tidak mencukupi!",
+		Translation: errormsg.Translation{
+			EN: "Insufficient source account balance!",
+		},
+	},
+	CodeSourceAccountNotFound: {
+		Code:       CodeSourceAccountNotFound,
+		StatusCode: http.StatusUnprocessableEntity,
+		Message:    "Rekening sumber tidak ditemukan!",
+		Translation: errormsg.Translation{
+			EN: "Source account not found!",
+		},
+	},
+	CodeDestinationAccountNotFound: {
+		Code:       CodeDestinationAccountNotFound,
+		StatusCode: http.StatusUnprocessableEntity,
+		Message:    "Rekening tujuan tidak ditemukan!",
+		Translation: errormsg.Translation{
+			EN: "Destination account not found!",
+		},
+	},
+	CodeSameAccountTransfer: {
+		Code:       CodeSameAccountTransfer,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Rekening sumber dan tujuan tidak boleh sama!",
+		Translation: errormsg.Translation{
+			EN: "Source and destination account must differ!",
+		},
+	},
+	CodeUnbalancedLedger: {
+		Code:       CodeUnbalancedLedger,
+		StatusCode: http.StatusInternalServerError,
+		Message:    "Jurnal tidak seimbang!",
+		Translation: errormsg.Translation{
+			EN: "Unbalanced ledger!",
+		},
+	},
+	CodeInvalidReconciliation: {
+		Code:       CodeInvalidReconciliation,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Data rekonsiliasi tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid reconciliation data!",
+		},
+	},
+	CodeInvalidSettlementReport: {
+		Code:       CodeInvalidSettlementReport,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Parameter laporan settlement tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid settlement report parameter!",
+		},
+	},
+	CodeInvalidProviderCallback: {
+		Code:       CodeInvalidProviderCallback,
+		StatusCode: http.StatusUnauthorized,
+		Message:    "Callback provider tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid provider callback!",
+		},
+	},
+	CodeInvalidCursor: {
+		Code:       CodeInvalidCursor,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Cursor halaman tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid page cursor!",
+		},
+	},
+	CodeInvalidTransferExport: {
+		Code:       CodeInvalidTransferExport,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Ekspor transfer tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid transfer export!",
+		},
+	},
+	CodeTransferClaimed: {
+		Code:       CodeTransferClaimed,
+		StatusCode: http.StatusConflict,
+		Message:    "Transfer sedang diproses oleh proses lain!",
+		Translation: errormsg.Translation{
+			EN: "Transfer is being processed by another worker!",
+		},
+	},
 }
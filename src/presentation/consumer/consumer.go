@@ -13,6 +13,7 @@ type ConsumerHandlerInterface struct {
 type Consumer struct {
 	Conf     Conf
 	Consumer kafkalib.ConsumerInterface
+	Producer kafkalib.ProducerInterface
 	Usecase  usecase.UsecaseInterface
 }
 
@@ -21,13 +22,16 @@ type Conf struct {
 }
 
 func (c *Consumer) Serve(cHandler ConsumerHandlerInterface) error {
-	c.Consumer.Handler(c.Conf.Payment.TransferTopic, cHandler.payment.Transfer)
+	c.Consumer.RetryHandler(c.Conf.Payment.TransferTopic, c.Conf.Payment.Retry, c.Producer, cHandler.payment.Transfer)
+	if c.Conf.Payment.Retry.DeadLetterTopic != "" {
+		c.Consumer.Handler(c.Conf.Payment.Retry.DeadLetterTopic, cHandler.payment.DeadLetter)
+	}
 	return c.Consumer.Serve()
 }
 
 func New(consumer *Consumer) error {
 	handlers := ConsumerHandlerInterface{
-		payment.New(consumer.Conf.Payment, consumer.Usecase.Transfer),
+		payment.New(consumer.Conf.Payment, consumer.Usecase.Transfer, consumer.Usecase.DeadLetter),
 	}
 	return consumer.Serve(handlers)
 }
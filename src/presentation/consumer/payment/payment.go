@@ -5,29 +5,36 @@ import (
 	"strings"
 
 	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/usecase/deadletter"
 	"github.com/achwanyusuf/bricksvc/src/usecase/transfer"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
 	"github.com/achwanyusuf/bricksvc/utils/kafkalib"
 	"github.com/achwanyusuf/bricksvc/utils/logger"
 	jsoniter "github.com/json-iterator/go"
 )
 
 type Payment struct {
-	conf     Conf
-	transfer transfer.TransferInterface
+	conf       Conf
+	transfer   transfer.TransferInterface
+	deadLetter deadletter.DeadLetterInterface
 }
 
 type Conf struct {
-	TransferTopic string `mapstructure:"transfer_topic"`
+	TransferTopic string         `mapstructure:"transfer_topic"`
+	Retry         kafkalib.Retry `mapstructure:"retry"`
 }
 
 type PaymentInterface interface {
 	Transfer(req *kafkalib.Request, resp *kafkalib.Response)
+	DeadLetter(req *kafkalib.Request, resp *kafkalib.Response)
 }
 
-func New(conf Conf, transfer transfer.TransferInterface) PaymentInterface {
+func New(conf Conf, transfer transfer.TransferInterface, deadLetter deadletter.DeadLetterInterface) PaymentInterface {
 	return &Payment{
-		conf:     conf,
-		transfer: transfer,
+		conf:       conf,
+		transfer:   transfer,
+		deadLetter: deadLetter,
 	}
 }
 
@@ -36,13 +43,41 @@ func (p *Payment) Transfer(req *kafkalib.Request, resp *kafkalib.Response) {
 		data model.CreateTransfer
 		ctx  = context.Background()
 	)
-	if err := jsoniter.Unmarshal([]byte(req.Value), &data); err == nil {
-		key := strings.Split(req.Key, "pubTransfer:")
-		if e := p.transfer.Create(ctx, key[1], data); e != nil {
-			logger.Log.Error(e)
-		}
-	} else {
+	defer resp.Commit()
+
+	// a message that cannot be parsed will never succeed, skip the retry topics
+	if err := jsoniter.Unmarshal([]byte(req.Value), &data); err != nil {
 		logger.Log.Error(err)
+		resp.DeadLetter(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal"))
+		return
+	}
+
+	key := strings.Split(req.Key, "pubTransfer:")
+	if len(key) < 2 {
+		err := errormsg.WrapErr(svcerr.BrickSVCBadRequest, nil, "invalid message key "+req.Key)
+		logger.Log.Error(errormsg.WriteErr(err))
+		resp.DeadLetter(err)
+		return
+	}
+
+	if err := p.transfer.Create(ctx, key[1], data); err != nil {
+		logger.Log.Error(errormsg.WriteErr(err))
+		resp.Retry(err)
+	}
+}
+
+func (p *Payment) DeadLetter(req *kafkalib.Request, resp *kafkalib.Response) {
+	err := p.deadLetter.Store(context.Background(), model.CreateDeadLetterMessage{
+		Topic:     req.Topic,
+		Partition: req.Partition,
+		Offset:    req.Offset,
+		Key:       req.Key,
+		Value:     req.Value,
+		Header:    req.Header,
+	})
+	if err != nil {
+		logger.Log.Error(errormsg.WriteErr(err))
+		return
 	}
 	resp.Commit()
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/achwanyusuf/bricksvc/utils/httpserver"
 	"github.com/achwanyusuf/bricksvc/utils/logger"
 	"github.com/gofiber/fiber/v2"
+	"github.com/volatiletech/null/v8"
 )
 
 type Account struct {
@@ -174,6 +175,9 @@ func (a *Account) UpdateCurrentAccount(ctx *fiber.Ctx) error {
 	}
 	userData := httpserver.GetUserData(ctx)
 	updateData.UpdateBy = userData.ID
+	if userData.Scope != model.SuperAdminScope {
+		updateData.Plan = null.String{}
+	}
 	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
 	if err != nil {
 		return response.Transform(ctx, a.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "invalid id"))
@@ -304,6 +308,9 @@ func (a *Account) Create(ctx *fiber.Ctx) error {
 // @Param sort_by query string false "sort result by attributes"
 // @Param page query int false " "
 // @Param limit query int false " "
+// @Param cursor query string false "next_cursor or prev_cursor of the previous page, switches the list to cursor pagination"
+// @Param pagination query string false "offset (default) or cursor" Enums(offset, cursor)
+// @Param with_total query bool false "include an estimated total in cursor pagination"
 // @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
 // @Success 200 {object} response.AccountsResponse
 // @Success 400 {object} response.AccountsResponse
@@ -400,6 +407,7 @@ func (a *Account) UpdateByID(ctx *fiber.Ctx) error {
 	scope := userData.Scope
 	if scope != model.SuperAdminScope {
 		id = userData.ID
+		updateData.Plan = null.String{}
 	}
 	result, err := a.account.UpdateByID(ctx.Context(), id, updateData)
 	if err != nil {
@@ -0,0 +1,140 @@
+package accountlimit
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/response"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/usecase/accountlimit"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/httpserver"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+type AccountLimit struct {
+	log          logger.LoggerInterface
+	accountLimit accountlimit.AccountLimitInterface
+	conf         Conf
+}
+
+type Conf struct{}
+
+type AccountLimitInterface interface {
+	GetByAccountID(ctx *fiber.Ctx) error
+	Upsert(ctx *fiber.Ctx) error
+	DeleteByAccountID(ctx *fiber.Ctx) error
+}
+
+func New(conf Conf, log *logger.LoggerInterface, a accountlimit.AccountLimitInterface) AccountLimitInterface {
+	return &AccountLimit{
+		conf:         conf,
+		log:          *log,
+		accountLimit: a,
+	}
+}
+
+// Get Account Limits godoc
+// @Summary Get account transfer limits
+// @Description get the transfer limits of the account per currency with the usage of the current UTC day, is_default marks limits taken from the configuration
+// @Tags account-limit
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "account id"
+// @Success 200 {object} response.AccountLimitsResponse
+// @Success 400 {object} response.AccountLimitsResponse
+// @Success 401 {object} response.AccountLimitsResponse
+// @Success 404 {object} response.AccountLimitsResponse
+// @Success 500 {object} response.AccountLimitsResponse
+// @Router /account/{id}/limit [get]
+func (a *AccountLimit) GetByAccountID(ctx *fiber.Ctx) error {
+	var (
+		response response.AccountLimitsResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, a.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+
+	result, err := a.accountLimit.GetByAccountID(ctx.Context(), id)
+	if err != nil {
+		return response.Transform(ctx, a.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, a.log, http.StatusOK, nil)
+}
+
+// Upsert Account Limit godoc
+// @Summary Override account transfer limit
+// @Description set the transfer limits of the account in a currency, amounts are minor units and 0 is unlimited
+// @Tags account-limit
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "account id"
+// @Param data body model.UpsertAccountLimit true "Account Limit Data"
+// @Success 200 {object} response.SingleAccountLimitResponse
+// @Success 400 {object} response.SingleAccountLimitResponse
+// @Success 401 {object} response.SingleAccountLimitResponse
+// @Success 404 {object} response.SingleAccountLimitResponse
+// @Success 500 {object} response.SingleAccountLimitResponse
+// @Router /account/{id}/limit [put]
+func (a *AccountLimit) Upsert(ctx *fiber.Ctx) error {
+	var (
+		limitData model.UpsertAccountLimit
+		response  response.SingleAccountLimitResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, a.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+
+	if err := ctx.BodyParser(&limitData); err != nil {
+		return response.Transform(ctx, a.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body"))
+	}
+	userData := httpserver.GetUserData(ctx)
+
+	result, err := a.accountLimit.Upsert(ctx.Context(), id, limitData, userData.ID)
+	if err != nil {
+		return response.Transform(ctx, a.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, a.log, http.StatusOK, nil)
+}
+
+// Delete Account Limit godoc
+// @Summary Remove account transfer limit override
+// @Description remove the override of the currency, the account is limited by the configured default again
+// @Tags account-limit
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "account id"
+// @Param currency path string true "ISO 4217 currency code"
+// @Success 200 {object} response.EmptyResponse
+// @Success 400 {object} response.EmptyResponse
+// @Success 404 {object} response.EmptyResponse
+// @Success 500 {object} response.EmptyResponse
+// @Router /account/{id}/limit/{currency} [delete]
+func (a *AccountLimit) DeleteByAccountID(ctx *fiber.Ctx) error {
+	var (
+		response response.EmptyResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, a.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+
+	if err := a.accountLimit.DeleteByAccountID(ctx.Context(), id, ctx.Params("currency")); err != nil {
+		return response.Transform(ctx, a.log, http.StatusOK, err)
+	}
+
+	return response.Transform(ctx, a.log, http.StatusOK, nil)
+}
@@ -88,6 +88,9 @@ func (a *AccountRole) Create(ctx *fiber.Ctx) error {
 // @Param sort_by query string false "sort result by attributes"
 // @Param page query int false " "
 // @Param limit query int false " "
+// @Param cursor query string false "next_cursor or prev_cursor of the previous page, switches the list to cursor pagination"
+// @Param pagination query string false "offset (default) or cursor" Enums(offset, cursor)
+// @Param with_total query bool false "include an estimated total in cursor pagination"
 // @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
 // @Success 200 {object} response.AccountRolesResponse
 // @Success 400 {object} response.AccountRolesResponse
@@ -0,0 +1,65 @@
+package callback
+
+import (
+	"net/http"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/response"
+	"github.com/achwanyusuf/bricksvc/src/usecase/transfer"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Callback struct {
+	log      logger.LoggerInterface
+	transfer transfer.TransferInterface
+	conf     Conf
+}
+
+type Conf struct{}
+
+type CallbackInterface interface {
+	Provider(ctx *fiber.Ctx) error
+}
+
+func New(conf Conf, log *logger.LoggerInterface, t transfer.TransferInterface) CallbackInterface {
+	return &Callback{
+		conf:     conf,
+		log:      *log,
+		transfer: t,
+	}
+}
+
+// Provider Callback godoc
+// @Summary Receive provider status callback
+// @Description apply the transfer status pushed by the provider, the request is signed with X-Provider-Signature, sha256= followed by the hex HMAC-SHA256 of X-Provider-Timestamp, a dot and the raw body, using the provider callback secret
+// @Tags callback
+// @Accept json
+// @Produce json
+// @Param provider path string true "provider name"
+// @Param X-Provider-Timestamp header string true "unix timestamp of the callback"
+// @Param X-Provider-Signature header string true "signature of the callback"
+// @Param data body clientresponse.Transfer true "Provider Transaction"
+// @Success 200 {object} response.ProviderCallbackResponse
+// @Success 400 {object} response.ProviderCallbackResponse
+// @Success 401 {object} response.ProviderCallbackResponse
+// @Success 404 {object} response.ProviderCallbackResponse
+// @Success 500 {object} response.ProviderCallbackResponse
+// @Router /callback/provider/{provider} [post]
+func (c *Callback) Provider(ctx *fiber.Ctx) error {
+	var (
+		response response.ProviderCallbackResponse
+	)
+	result, err := c.transfer.ProviderCallback(ctx.Context(), ctx.Params("provider"), model.ProviderCallback{
+		Timestamp: ctx.Get(model.ProviderTimestampHeader),
+		Signature: ctx.Get(model.ProviderSignatureHeader),
+		Body:      ctx.Body(),
+	})
+	if err != nil {
+		return response.Transform(ctx, c.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, c.log, http.StatusOK, nil)
+}
@@ -0,0 +1,141 @@
+package deadletter
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/response"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/usecase/deadletter"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/httpserver"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeadLetter struct {
+	log        logger.LoggerInterface
+	deadLetter deadletter.DeadLetterInterface
+	conf       Conf
+}
+
+type Conf struct{}
+
+type DeadLetterInterface interface {
+	Read(ctx *fiber.Ctx) error
+	GetByID(ctx *fiber.Ctx) error
+	Replay(ctx *fiber.Ctx) error
+}
+
+func New(conf Conf, log *logger.LoggerInterface, d deadletter.DeadLetterInterface) DeadLetterInterface {
+	return &DeadLetter{
+		conf:       conf,
+		log:        *log,
+		deadLetter: d,
+	}
+}
+
+// Get Dead Letter Messages godoc
+// @Summary Get dead letter messages
+// @Description get kafka messages that exhausted their retry topics
+// @Tags dead-letter
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param original_topic query string false "search by the topic the message was consumed from"
+// @Param status query string false "search by status" Enums(pending, replayed)
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Success 200 {object} response.DeadLetterMessagesResponse
+// @Success 400 {object} response.DeadLetterMessagesResponse
+// @Success 401 {object} response.DeadLetterMessagesResponse
+// @Success 500 {object} response.DeadLetterMessagesResponse
+// @Router /dead-letter [get]
+func (d *DeadLetter) Read(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetDeadLetterMessagesByParam
+		response response.DeadLetterMessagesResponse
+	)
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, d.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+
+	result, pagination, err := d.deadLetter.GetByParam(ctx.Context(), param)
+	if err != nil {
+		return response.Transform(ctx, d.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+	response.Pagination = pagination
+
+	return response.Transform(ctx, d.log, http.StatusOK, nil)
+}
+
+// Get Dead Letter Message By ID godoc
+// @Summary Get dead letter message by id
+// @Description get a dead letter message with the failure headers it was forwarded with
+// @Tags dead-letter
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "dead letter message id"
+// @Success 200 {object} response.SingleDeadLetterMessageResponse
+// @Success 400 {object} response.SingleDeadLetterMessageResponse
+// @Success 401 {object} response.SingleDeadLetterMessageResponse
+// @Success 404 {object} response.SingleDeadLetterMessageResponse
+// @Success 500 {object} response.SingleDeadLetterMessageResponse
+// @Router /dead-letter/{id} [get]
+func (d *DeadLetter) GetByID(ctx *fiber.Ctx) error {
+	var (
+		response response.SingleDeadLetterMessageResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, d.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+
+	result, err := d.deadLetter.GetByID(ctx.Context(), id)
+	if err != nil {
+		return response.Transform(ctx, d.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, d.log, http.StatusOK, nil)
+}
+
+// Replay Dead Letter Message godoc
+// @Summary Replay dead letter message
+// @Description publish a dead letter message back onto its original topic, a message can only be replayed once
+// @Tags dead-letter
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "dead letter message id"
+// @Success 200 {object} response.SingleDeadLetterMessageResponse
+// @Success 400 {object} response.SingleDeadLetterMessageResponse
+// @Success 401 {object} response.SingleDeadLetterMessageResponse
+// @Success 409 {object} response.SingleDeadLetterMessageResponse
+// @Success 500 {object} response.SingleDeadLetterMessageResponse
+// @Router /dead-letter/{id}/replay [post]
+func (d *DeadLetter) Replay(ctx *fiber.Ctx) error {
+	var (
+		response response.SingleDeadLetterMessageResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, d.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+	userData := httpserver.GetUserData(ctx)
+
+	result, err := d.deadLetter.Replay(ctx.Context(), id, userData.ID)
+	if err != nil {
+		return response.Transform(ctx, d.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, d.log, http.StatusOK, nil)
+}
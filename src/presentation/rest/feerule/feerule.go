@@ -0,0 +1,214 @@
+package feerule
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/response"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/usecase/feerule"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/httpserver"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+type FeeRule struct {
+	log     logger.LoggerInterface
+	feeRule feerule.FeeRuleInterface
+	conf    Conf
+}
+
+type Conf struct{}
+
+type FeeRuleInterface interface {
+	Create(ctx *fiber.Ctx) error
+	Read(ctx *fiber.Ctx) error
+	GetByID(ctx *fiber.Ctx) error
+	UpdateByID(ctx *fiber.Ctx) error
+	DeleteByID(ctx *fiber.Ctx) error
+}
+
+func New(conf Conf, log *logger.LoggerInterface, f feerule.FeeRuleInterface) FeeRuleInterface {
+	return &FeeRule{
+		conf:    conf,
+		log:     *log,
+		feeRule: f,
+	}
+}
+
+// Create Fee Rule godoc
+// @Summary Create fee rule
+// @Description create a transfer fee rule, empty source_bank_id, destination_bank_id or plan match any value and the most specific matching rule prices the transfer
+// @Tags fee-rule
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.CreateFeeRule true "Fee Rule Data"
+// @Success 200 {object} response.SingleFeeRuleResponse
+// @Success 400 {object} response.SingleFeeRuleResponse
+// @Success 401 {object} response.SingleFeeRuleResponse
+// @Success 500 {object} response.SingleFeeRuleResponse
+// @Router /fee-rule [post]
+func (f *FeeRule) Create(ctx *fiber.Ctx) error {
+	var (
+		ruleData model.CreateFeeRule
+		response response.SingleFeeRuleResponse
+	)
+	if err := ctx.BodyParser(&ruleData); err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body"))
+	}
+	userData := httpserver.GetUserData(ctx)
+
+	result, err := f.feeRule.Insert(ctx.Context(), ruleData, userData.ID)
+	if err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, f.log, http.StatusOK, nil)
+}
+
+// Get Fee Rules godoc
+// @Summary Get fee rules
+// @Description get the transfer fee rules
+// @Tags fee-rule
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param source_bank_id query int false "search by source bank id"
+// @Param destination_bank_id query int false "search by destination bank id"
+// @Param plan query string false "search by account plan"
+// @Param currency query string false "search by currency"
+// @Param fee_type query string false "search by fee type" Enums(flat, percentage, capped)
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Success 200 {object} response.FeeRulesResponse
+// @Success 400 {object} response.FeeRulesResponse
+// @Success 401 {object} response.FeeRulesResponse
+// @Success 500 {object} response.FeeRulesResponse
+// @Router /fee-rule [get]
+func (f *FeeRule) Read(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetFeeRulesByParam
+		response response.FeeRulesResponse
+	)
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+
+	result, pagination, err := f.feeRule.GetByParam(ctx.Context(), param)
+	if err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+	response.Pagination = pagination
+
+	return response.Transform(ctx, f.log, http.StatusOK, nil)
+}
+
+// Get Fee Rule By ID godoc
+// @Summary Get fee rule by id
+// @Description get a transfer fee rule
+// @Tags fee-rule
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "fee rule id"
+// @Success 200 {object} response.SingleFeeRuleResponse
+// @Success 400 {object} response.SingleFeeRuleResponse
+// @Success 401 {object} response.SingleFeeRuleResponse
+// @Success 404 {object} response.SingleFeeRuleResponse
+// @Success 500 {object} response.SingleFeeRuleResponse
+// @Router /fee-rule/{id} [get]
+func (f *FeeRule) GetByID(ctx *fiber.Ctx) error {
+	var (
+		response response.SingleFeeRuleResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+
+	result, err := f.feeRule.GetByID(ctx.Context(), id)
+	if err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, f.log, http.StatusOK, nil)
+}
+
+// Update Fee Rule By ID godoc
+// @Summary Update fee rule by id
+// @Description replace a transfer fee rule, transfers already created keep the fee they were charged
+// @Tags fee-rule
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "fee rule id"
+// @Param data body model.CreateFeeRule true "Fee Rule Data"
+// @Success 200 {object} response.SingleFeeRuleResponse
+// @Success 400 {object} response.SingleFeeRuleResponse
+// @Success 401 {object} response.SingleFeeRuleResponse
+// @Success 404 {object} response.SingleFeeRuleResponse
+// @Success 500 {object} response.SingleFeeRuleResponse
+// @Router /fee-rule/{id} [put]
+func (f *FeeRule) UpdateByID(ctx *fiber.Ctx) error {
+	var (
+		ruleData model.CreateFeeRule
+		response response.SingleFeeRuleResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+
+	if err := ctx.BodyParser(&ruleData); err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body"))
+	}
+	userData := httpserver.GetUserData(ctx)
+
+	result, err := f.feeRule.UpdateByID(ctx.Context(), id, ruleData, userData.ID)
+	if err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, f.log, http.StatusOK, nil)
+}
+
+// Delete Fee Rule godoc
+// @Summary Delete fee rule
+// @Description delete a transfer fee rule, transfers matching no rule are free
+// @Tags fee-rule
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "fee rule id"
+// @Success 200 {object} response.EmptyResponse
+// @Success 400 {object} response.EmptyResponse
+// @Success 500 {object} response.EmptyResponse
+// @Router /fee-rule/{id} [delete]
+func (f *FeeRule) DeleteByID(ctx *fiber.Ctx) error {
+	var (
+		response response.EmptyResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+	userData := httpserver.GetUserData(ctx)
+
+	if err := f.feeRule.DeleteByID(ctx.Context(), id, userData.ID); err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, err)
+	}
+
+	return response.Transform(ctx, f.log, http.StatusOK, nil)
+}
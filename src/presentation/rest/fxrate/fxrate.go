@@ -0,0 +1,170 @@
+package fxrate
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/response"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/usecase/fxrate"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/httpserver"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+type FXRate struct {
+	log    logger.LoggerInterface
+	fxRate fxrate.FXRateInterface
+	conf   Conf
+}
+
+type Conf struct{}
+
+type FXRateInterface interface {
+	Upsert(ctx *fiber.Ctx) error
+	Read(ctx *fiber.Ctx) error
+	GetByID(ctx *fiber.Ctx) error
+	DeleteByID(ctx *fiber.Ctx) error
+}
+
+func New(conf Conf, log *logger.LoggerInterface, f fxrate.FXRateInterface) FXRateInterface {
+	return &FXRate{
+		conf:   conf,
+		log:    *log,
+		fxRate: f,
+	}
+}
+
+// Upsert FX Rate godoc
+// @Summary Set fx rate
+// @Description create or replace the rate of a currency pair, one unit of base currency is worth rate units of quote currency and fee_bps is charged on converted transfers
+// @Tags fx-rate
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.CreateFXRate true "FX Rate Data"
+// @Success 200 {object} response.SingleFXRateResponse
+// @Success 400 {object} response.SingleFXRateResponse
+// @Success 401 {object} response.SingleFXRateResponse
+// @Success 500 {object} response.SingleFXRateResponse
+// @Router /fx-rate [post]
+func (f *FXRate) Upsert(ctx *fiber.Ctx) error {
+	var (
+		rateData model.CreateFXRate
+		response response.SingleFXRateResponse
+	)
+	if err := ctx.BodyParser(&rateData); err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body"))
+	}
+	userData := httpserver.GetUserData(ctx)
+
+	result, err := f.fxRate.Upsert(ctx.Context(), rateData, userData.ID)
+	if err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, f.log, http.StatusOK, nil)
+}
+
+// Get FX Rates godoc
+// @Summary Get fx rates
+// @Description get the rates of currency pairs, loaded from the rates file or set through the api
+// @Tags fx-rate
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param base_currency query string false "search by base currency"
+// @Param quote_currency query string false "search by quote currency"
+// @Param source query string false "search by source" Enums(file, api)
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Success 200 {object} response.FXRatesResponse
+// @Success 400 {object} response.FXRatesResponse
+// @Success 401 {object} response.FXRatesResponse
+// @Success 500 {object} response.FXRatesResponse
+// @Router /fx-rate [get]
+func (f *FXRate) Read(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetFXRatesByParam
+		response response.FXRatesResponse
+	)
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+
+	result, pagination, err := f.fxRate.GetByParam(ctx.Context(), param)
+	if err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+	response.Pagination = pagination
+
+	return response.Transform(ctx, f.log, http.StatusOK, nil)
+}
+
+// Get FX Rate By ID godoc
+// @Summary Get fx rate by id
+// @Description get the rate of a currency pair
+// @Tags fx-rate
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "fx rate id"
+// @Success 200 {object} response.SingleFXRateResponse
+// @Success 400 {object} response.SingleFXRateResponse
+// @Success 401 {object} response.SingleFXRateResponse
+// @Success 404 {object} response.SingleFXRateResponse
+// @Success 500 {object} response.SingleFXRateResponse
+// @Router /fx-rate/{id} [get]
+func (f *FXRate) GetByID(ctx *fiber.Ctx) error {
+	var (
+		response response.SingleFXRateResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+
+	result, err := f.fxRate.GetByID(ctx.Context(), id)
+	if err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, f.log, http.StatusOK, nil)
+}
+
+// Delete FX Rate godoc
+// @Summary Delete fx rate
+// @Description delete the rate of a currency pair, quotes already issued keep their locked rate
+// @Tags fx-rate
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "fx rate id"
+// @Success 200 {object} response.EmptyResponse
+// @Success 400 {object} response.EmptyResponse
+// @Success 500 {object} response.EmptyResponse
+// @Router /fx-rate/{id} [delete]
+func (f *FXRate) DeleteByID(ctx *fiber.Ctx) error {
+	var (
+		response response.EmptyResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+
+	if err := f.fxRate.DeleteByID(ctx.Context(), id); err != nil {
+		return response.Transform(ctx, f.log, http.StatusOK, err)
+	}
+
+	return response.Transform(ctx, f.log, http.StatusOK, nil)
+}
@@ -0,0 +1,209 @@
+package ledger
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/response"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/usecase/ledger"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Ledger struct {
+	log    logger.LoggerInterface
+	ledger ledger.LedgerInterface
+	conf   Conf
+}
+
+type Conf struct{}
+
+type LedgerInterface interface {
+	GetBalance(ctx *fiber.Ctx) error
+	Read(ctx *fiber.Ctx) error
+	GetByID(ctx *fiber.Ctx) error
+	GetEntries(ctx *fiber.Ctx) error
+	CheckInvariant(ctx *fiber.Ctx) error
+}
+
+func New(conf Conf, log *logger.LoggerInterface, l ledger.LedgerInterface) LedgerInterface {
+	return &Ledger{
+		conf:   conf,
+		log:    *log,
+		ledger: l,
+	}
+}
+
+// Get Ledger Balance godoc
+// @Summary Get merchant ledger balance
+// @Description get the ledger balance of the api key per currency in minor units, transfers and fees are debited once submitted and credited back when they fail
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Security APIKey
+// @Success 200 {object} response.LedgerBalanceResponse
+// @Success 400 {object} response.LedgerBalanceResponse
+// @Success 401 {object} response.LedgerBalanceResponse
+// @Success 500 {object} response.LedgerBalanceResponse
+// @Router /ledger/balance [get]
+func (l *Ledger) GetBalance(ctx *fiber.Ctx) error {
+	var (
+		header   model.Header
+		response response.LedgerBalanceResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, l.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+
+	result, err := l.ledger.GetBalance(ctx.Context(), header.APIKey)
+	if err != nil {
+		return response.Transform(ctx, l.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, l.log, http.StatusOK, nil)
+}
+
+// Get Ledger Accounts godoc
+// @Summary Get ledger accounts
+// @Description get the ledger accounts with their debit, credit and balance totals
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param kind query string false "search by account kind" Enums(merchant, clearing, settlement, fee_revenue)
+// @Param api_key query string false "search by merchant api key"
+// @Param currency query string false "search by currency"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Success 200 {object} response.LedgerAccountsResponse
+// @Success 400 {object} response.LedgerAccountsResponse
+// @Success 401 {object} response.LedgerAccountsResponse
+// @Success 500 {object} response.LedgerAccountsResponse
+// @Router /ledger/account [get]
+func (l *Ledger) Read(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetLedgerAccountsByParam
+		response response.LedgerAccountsResponse
+	)
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, l.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+
+	result, pagination, err := l.ledger.GetByParam(ctx.Context(), param)
+	if err != nil {
+		return response.Transform(ctx, l.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+	response.Pagination = pagination
+
+	return response.Transform(ctx, l.log, http.StatusOK, nil)
+}
+
+// Get Ledger Account By ID godoc
+// @Summary Get ledger account by id
+// @Description get a ledger account with its debit, credit and balance totals
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "ledger account id"
+// @Success 200 {object} response.SingleLedgerAccountResponse
+// @Success 400 {object} response.SingleLedgerAccountResponse
+// @Success 401 {object} response.SingleLedgerAccountResponse
+// @Success 404 {object} response.SingleLedgerAccountResponse
+// @Success 500 {object} response.SingleLedgerAccountResponse
+// @Router /ledger/account/{id} [get]
+func (l *Ledger) GetByID(ctx *fiber.Ctx) error {
+	var (
+		response response.SingleLedgerAccountResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, l.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+
+	result, err := l.ledger.GetByID(ctx.Context(), id)
+	if err != nil {
+		return response.Transform(ctx, l.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, l.log, http.StatusOK, nil)
+}
+
+// Get Ledger Entries godoc
+// @Summary Get ledger account entries
+// @Description get the entries booked on a ledger account, newest first
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "ledger account id"
+// @Param type query string false "search by transaction type" Enums(transfer, fee, settlement, reversal, refund)
+// @Param transfer_job_id query int false "search by transfer job id"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Success 200 {object} response.LedgerEntriesResponse
+// @Success 400 {object} response.LedgerEntriesResponse
+// @Success 401 {object} response.LedgerEntriesResponse
+// @Success 404 {object} response.LedgerEntriesResponse
+// @Success 500 {object} response.LedgerEntriesResponse
+// @Router /ledger/account/{id}/entry [get]
+func (l *Ledger) GetEntries(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetLedgerEntriesByParam
+		response response.LedgerEntriesResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, l.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, l.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+	param.LedgerAccountID = id
+
+	result, pagination, err := l.ledger.GetEntries(ctx.Context(), param)
+	if err != nil {
+		return response.Transform(ctx, l.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+	response.Pagination = pagination
+
+	return response.Transform(ctx, l.log, http.StatusOK, nil)
+}
+
+// Check Ledger Invariant godoc
+// @Summary Check ledger invariant
+// @Description sum the ledger entries per currency, balanced is false when debits and credits differ or a transaction does not balance on its own
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Success 200 {object} response.LedgerInvariantResponse
+// @Success 400 {object} response.LedgerInvariantResponse
+// @Success 401 {object} response.LedgerInvariantResponse
+// @Success 500 {object} response.LedgerInvariantResponse
+// @Router /ledger/invariant [get]
+func (l *Ledger) CheckInvariant(ctx *fiber.Ctx) error {
+	var (
+		response response.LedgerInvariantResponse
+	)
+	result, err := l.ledger.CheckInvariant(ctx.Context())
+	if err != nil {
+		return response.Transform(ctx, l.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, l.log, http.StatusOK, nil)
+}
@@ -0,0 +1,200 @@
+package reconciliation
+
+import (
+	"net/http"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/response"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/usecase/reconciliation"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/httpserver"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Reconciliation struct {
+	log            logger.LoggerInterface
+	reconciliation reconciliation.ReconciliationInterface
+	conf           Conf
+}
+
+type Conf struct{}
+
+type ReconciliationInterface interface {
+	Create(ctx *fiber.Ctx) error
+	Read(ctx *fiber.Ctx) error
+	GetByReportID(ctx *fiber.Ctx) error
+	GetDiscrepancies(ctx *fiber.Ctx) error
+	ExportCSV(ctx *fiber.Ctx) error
+}
+
+func New(conf Conf, log *logger.LoggerInterface, r reconciliation.ReconciliationInterface) ReconciliationInterface {
+	return &Reconciliation{
+		conf:           conf,
+		log:            *log,
+		reconciliation: r,
+	}
+}
+
+// Create Reconciliation godoc
+// @Summary Reconcile transfers with the provider
+// @Description start reconciling the transfer jobs of the period against the provider transaction list, the report is returned running and completes in the background
+// @Tags reconciliation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.CreateReconciliation true "Reconciliation Period"
+// @Success 200 {object} response.SingleReconciliationReportResponse
+// @Success 400 {object} response.SingleReconciliationReportResponse
+// @Success 401 {object} response.SingleReconciliationReportResponse
+// @Success 500 {object} response.SingleReconciliationReportResponse
+// @Router /reconciliation [post]
+func (r *Reconciliation) Create(ctx *fiber.Ctx) error {
+	var (
+		period   model.CreateReconciliation
+		response response.SingleReconciliationReportResponse
+	)
+	if err := ctx.BodyParser(&period); err != nil {
+		return response.Transform(ctx, r.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body"))
+	}
+	userData := httpserver.GetUserData(ctx)
+
+	result, err := r.reconciliation.Create(ctx.Context(), period, userData.ID)
+	if err != nil {
+		return response.Transform(ctx, r.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, r.log, http.StatusOK, nil)
+}
+
+// Get Reconciliation Reports godoc
+// @Summary Get reconciliation reports
+// @Description get the reconciliation reports with their totals
+// @Tags reconciliation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param status query string false "search by report status" Enums(running, completed, failed)
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Success 200 {object} response.ReconciliationReportsResponse
+// @Success 400 {object} response.ReconciliationReportsResponse
+// @Success 401 {object} response.ReconciliationReportsResponse
+// @Success 500 {object} response.ReconciliationReportsResponse
+// @Router /reconciliation [get]
+func (r *Reconciliation) Read(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetReconciliationReportsByParam
+		response response.ReconciliationReportsResponse
+	)
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, r.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+
+	result, pagination, err := r.reconciliation.GetByParam(ctx.Context(), param)
+	if err != nil {
+		return response.Transform(ctx, r.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+	response.Pagination = pagination
+
+	return response.Transform(ctx, r.log, http.StatusOK, nil)
+}
+
+// Get Reconciliation Report godoc
+// @Summary Get reconciliation report
+// @Description get a reconciliation report with its totals
+// @Tags reconciliation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param report_id path string true "report id"
+// @Success 200 {object} response.SingleReconciliationReportResponse
+// @Success 400 {object} response.SingleReconciliationReportResponse
+// @Success 401 {object} response.SingleReconciliationReportResponse
+// @Success 404 {object} response.SingleReconciliationReportResponse
+// @Success 500 {object} response.SingleReconciliationReportResponse
+// @Router /reconciliation/{report_id} [get]
+func (r *Reconciliation) GetByReportID(ctx *fiber.Ctx) error {
+	var (
+		response response.SingleReconciliationReportResponse
+	)
+	result, err := r.reconciliation.GetByReportID(ctx.Context(), ctx.Params("report_id"))
+	if err != nil {
+		return response.Transform(ctx, r.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, r.log, http.StatusOK, nil)
+}
+
+// Get Reconciliation Discrepancies godoc
+// @Summary Get reconciliation discrepancies
+// @Description get the records missing on either side, the amount mismatches and the status mismatches found by the report
+// @Tags reconciliation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param report_id path string true "report id"
+// @Param type query string false "search by discrepancy type" Enums(missing_on_provider, missing_locally, amount_mismatch, status_mismatch)
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Success 200 {object} response.ReconciliationDiscrepanciesResponse
+// @Success 400 {object} response.ReconciliationDiscrepanciesResponse
+// @Success 401 {object} response.ReconciliationDiscrepanciesResponse
+// @Success 404 {object} response.ReconciliationDiscrepanciesResponse
+// @Success 500 {object} response.ReconciliationDiscrepanciesResponse
+// @Router /reconciliation/{report_id}/discrepancy [get]
+func (r *Reconciliation) GetDiscrepancies(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetReconciliationDiscrepanciesByParam
+		response response.ReconciliationDiscrepanciesResponse
+	)
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, r.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+
+	result, pagination, err := r.reconciliation.GetDiscrepancies(ctx.Context(), ctx.Params("report_id"), param)
+	if err != nil {
+		return response.Transform(ctx, r.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+	response.Pagination = pagination
+
+	return response.Transform(ctx, r.log, http.StatusOK, nil)
+}
+
+// Export Reconciliation Report godoc
+// @Summary Export reconciliation report
+// @Description download the discrepancies of a completed report as CSV
+// @Tags reconciliation
+// @Accept json
+// @Produce text/csv
+// @Security OAuth2Password
+// @Param report_id path string true "report id"
+// @Success 200 {string} string "csv file"
+// @Success 400 {object} response.SingleReconciliationReportResponse
+// @Success 401 {object} response.SingleReconciliationReportResponse
+// @Success 404 {object} response.SingleReconciliationReportResponse
+// @Success 500 {object} response.SingleReconciliationReportResponse
+// @Router /reconciliation/{report_id}/export [get]
+func (r *Reconciliation) ExportCSV(ctx *fiber.Ctx) error {
+	var (
+		response response.SingleReconciliationReportResponse
+	)
+	reportID := ctx.Params("report_id")
+	if err := r.reconciliation.ExportCSV(ctx.Context(), reportID, ctx); err != nil {
+		return response.Transform(ctx, r.log, http.StatusOK, err)
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/csv")
+	ctx.Set(fiber.HeaderContentDisposition, `attachment; filename="reconciliation-`+reportID+`.csv"`)
+	return ctx.SendStatus(http.StatusOK)
+}
@@ -3,13 +3,25 @@ package rest
 import (
 	"github.com/achwanyusuf/bricksvc/src/domain/model"
 	"github.com/achwanyusuf/bricksvc/src/presentation/rest/account"
+	"github.com/achwanyusuf/bricksvc/src/presentation/rest/accountlimit"
 	"github.com/achwanyusuf/bricksvc/src/presentation/rest/accountrole"
 	"github.com/achwanyusuf/bricksvc/src/presentation/rest/bank"
+	"github.com/achwanyusuf/bricksvc/src/presentation/rest/callback"
+	"github.com/achwanyusuf/bricksvc/src/presentation/rest/deadletter"
+	"github.com/achwanyusuf/bricksvc/src/presentation/rest/feerule"
+	"github.com/achwanyusuf/bricksvc/src/presentation/rest/fxrate"
+	"github.com/achwanyusuf/bricksvc/src/presentation/rest/ledger"
+	"github.com/achwanyusuf/bricksvc/src/presentation/rest/reconciliation"
 	"github.com/achwanyusuf/bricksvc/src/presentation/rest/role"
+	"github.com/achwanyusuf/bricksvc/src/presentation/rest/settlement"
 	"github.com/achwanyusuf/bricksvc/src/presentation/rest/transfer"
+	"github.com/achwanyusuf/bricksvc/src/presentation/rest/transferexport"
+	"github.com/achwanyusuf/bricksvc/src/presentation/rest/transferstream"
+	"github.com/achwanyusuf/bricksvc/src/presentation/rest/webhook"
 	"github.com/achwanyusuf/bricksvc/src/usecase"
 	"github.com/achwanyusuf/bricksvc/utils/httpserver"
 	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/contrib/websocket"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -21,20 +33,42 @@ type Rest struct {
 }
 
 type Config struct {
-	Account     account.Conf     `mapstructure:"account"`
-	Role        role.Conf        `mapstructure:"role"`
-	AccountRole accountrole.Conf `mapstructure:"account_role"`
-	Bank        bank.Conf        `mapstructure:"bank"`
-	Transfer    transfer.Conf    `mapstructure:"transfer"`
-	TokenSecret string           `mapstructure:"token_secret"`
+	Account        account.Conf        `mapstructure:"account"`
+	Role           role.Conf           `mapstructure:"role"`
+	AccountRole    accountrole.Conf    `mapstructure:"account_role"`
+	Bank           bank.Conf           `mapstructure:"bank"`
+	Transfer       transfer.Conf       `mapstructure:"transfer"`
+	Webhook        webhook.Conf        `mapstructure:"webhook"`
+	DeadLetter     deadletter.Conf     `mapstructure:"dead_letter"`
+	FXRate         fxrate.Conf         `mapstructure:"fx_rate"`
+	FeeRule        feerule.Conf        `mapstructure:"fee_rule"`
+	AccountLimit   accountlimit.Conf   `mapstructure:"account_limit"`
+	Ledger         ledger.Conf         `mapstructure:"ledger"`
+	Reconciliation reconciliation.Conf `mapstructure:"reconciliation"`
+	Settlement     settlement.Conf     `mapstructure:"settlement"`
+	Callback       callback.Conf       `mapstructure:"callback"`
+	TransferExport transferexport.Conf `mapstructure:"transfer_export"`
+	TransferStream transferstream.Conf `mapstructure:"transfer_stream"`
+	TokenSecret    string              `mapstructure:"token_secret"`
 }
 
 type RestInterface struct {
-	Account     account.AccountInterface
-	Role        role.RoleInterface
-	AccountRole accountrole.AccountRoleInterface
-	Bank        bank.BankInterface
-	Transfer    transfer.TransferInterface
+	Account        account.AccountInterface
+	Role           role.RoleInterface
+	AccountRole    accountrole.AccountRoleInterface
+	Bank           bank.BankInterface
+	Transfer       transfer.TransferInterface
+	Webhook        webhook.WebhookInterface
+	DeadLetter     deadletter.DeadLetterInterface
+	FXRate         fxrate.FXRateInterface
+	FeeRule        feerule.FeeRuleInterface
+	AccountLimit   accountlimit.AccountLimitInterface
+	Ledger         ledger.LedgerInterface
+	Reconciliation reconciliation.ReconciliationInterface
+	Settlement     settlement.SettlementInterface
+	Callback       callback.CallbackInterface
+	TransferExport transferexport.TransferExportInterface
+	TransferStream transferstream.TransferStreamInterface
 }
 
 func New(r *Rest) *RestInterface {
@@ -44,6 +78,17 @@ func New(r *Rest) *RestInterface {
 		accountrole.New(r.Conf.AccountRole, r.Log, r.Usecase.AccountRole),
 		bank.New(r.Conf.Bank, r.Log, r.Usecase.Bank),
 		transfer.New(r.Conf.Transfer, r.Log, r.Usecase.Transfer),
+		webhook.New(r.Conf.Webhook, r.Log, r.Usecase.Webhook),
+		deadletter.New(r.Conf.DeadLetter, r.Log, r.Usecase.DeadLetter),
+		fxrate.New(r.Conf.FXRate, r.Log, r.Usecase.FXRate),
+		feerule.New(r.Conf.FeeRule, r.Log, r.Usecase.FeeRule),
+		accountlimit.New(r.Conf.AccountLimit, r.Log, r.Usecase.AccountLimit),
+		ledger.New(r.Conf.Ledger, r.Log, r.Usecase.Ledger),
+		reconciliation.New(r.Conf.Reconciliation, r.Log, r.Usecase.Reconciliation),
+		settlement.New(r.Conf.Settlement, r.Log, r.Usecase.Settlement),
+		callback.New(r.Conf.Callback, r.Log, r.Usecase.Transfer),
+		transferexport.New(r.Conf.TransferExport, r.Log, r.Usecase.TransferExport),
+		transferstream.New(r.Conf.TransferStream, r.Log, r.Usecase.TransferStream),
 	}
 }
 
@@ -74,6 +119,66 @@ func (r *Rest) Serve(handler *RestInterface) {
 
 	api.Get("/bank", handler.Bank.GetBankAccount)
 	api.Post("/transfer", handler.Transfer.Transfer)
-	api.Get("/transfer", handler.Transfer.Read)
-	api.Get("/transfer/:job_id", handler.Transfer.GetByID)
+	api.Post("/transfer/quote", handler.Transfer.Quote)
+	api.Post("/transfer/batch", handler.Transfer.Batch)
+	api.Get("/transfer/batch/:batch_id", httpserver.ProtectedOrAPIKey(r.Conf.TokenSecret, model.APIKeyHeader), handler.Transfer.GetBatchByID)
+	api.Get("/transfer", httpserver.ProtectedOrAPIKey(r.Conf.TokenSecret, model.APIKeyHeader), handler.Transfer.Read)
+	api.Get("/transfer/scheduled", handler.Transfer.GetScheduled)
+	api.Get("/transfer/stream", handler.TransferStream.Stream)
+	api.Get("/transfer/stream/ws", handler.TransferStream.Upgrade, websocket.New(handler.TransferStream.WebSocket))
+	api.Get("/transfer/export", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.TransferExport.Export)
+	api.Post("/transfer/export", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.TransferExport.Create)
+	api.Get("/transfer/export/:export_id", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.TransferExport.GetByExportID)
+	api.Get("/transfer/export/:export_id/download", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.TransferExport.Download)
+	api.Post("/transfer/recurring", handler.Transfer.CreateRecurring)
+	api.Get("/transfer/recurring", handler.Transfer.GetRecurring)
+	api.Get("/transfer/recurring/:mandate_id", handler.Transfer.GetRecurringByID)
+	api.Post("/transfer/recurring/:mandate_id/pause", handler.Transfer.PauseRecurring)
+	api.Post("/transfer/recurring/:mandate_id/resume", handler.Transfer.ResumeRecurring)
+	api.Get("/transfer/:job_id", httpserver.ProtectedOrAPIKey(r.Conf.TokenSecret, model.APIKeyHeader), handler.Transfer.GetByID)
+	api.Put("/transfer/:job_id", handler.Transfer.Amend)
+	api.Post("/transfer/:job_id/cancel", handler.Transfer.Cancel)
+	api.Get("/transfer/:job_id/events", httpserver.ProtectedOrAPIKey(r.Conf.TokenSecret, model.APIKeyHeader), handler.Transfer.GetEvents)
+
+	api.Post("/webhook", httpserver.Protected(r.Conf.TokenSecret), handler.Webhook.Create)
+	api.Get("/webhook", httpserver.Protected(r.Conf.TokenSecret), handler.Webhook.Read)
+	api.Delete("/webhook/:id", httpserver.Protected(r.Conf.TokenSecret), handler.Webhook.DeleteByID)
+	api.Get("/webhook/:id/deliveries", httpserver.Protected(r.Conf.TokenSecret), handler.Webhook.GetDeliveries)
+	api.Post("/webhook/delivery/:id/redeliver", httpserver.Protected(r.Conf.TokenSecret), handler.Webhook.Redeliver)
+
+	api.Get("/dead-letter", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.DeadLetter.Read)
+	api.Get("/dead-letter/:id", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.DeadLetter.GetByID)
+	api.Post("/dead-letter/:id/replay", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.DeadLetter.Replay)
+
+	api.Post("/fx-rate", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.FXRate.Upsert)
+	api.Get("/fx-rate", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.FXRate.Read)
+	api.Get("/fx-rate/:id", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.FXRate.GetByID)
+	api.Delete("/fx-rate/:id", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.FXRate.DeleteByID)
+
+	api.Post("/fee-rule", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.FeeRule.Create)
+	api.Get("/fee-rule", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.FeeRule.Read)
+	api.Get("/fee-rule/:id", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.FeeRule.GetByID)
+	api.Put("/fee-rule/:id", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.FeeRule.UpdateByID)
+	api.Delete("/fee-rule/:id", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.FeeRule.DeleteByID)
+
+	api.Get("/account/:id/limit", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.AccountLimit.GetByAccountID)
+	api.Put("/account/:id/limit", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.AccountLimit.Upsert)
+	api.Delete("/account/:id/limit/:currency", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.AccountLimit.DeleteByAccountID)
+
+	api.Get("/ledger/balance", handler.Ledger.GetBalance)
+	api.Get("/ledger/account", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.Ledger.Read)
+	api.Get("/ledger/account/:id", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.Ledger.GetByID)
+	api.Get("/ledger/account/:id/entry", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.Ledger.GetEntries)
+	api.Get("/ledger/invariant", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.Ledger.CheckInvariant)
+
+	api.Post("/reconciliation", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.Reconciliation.Create)
+	api.Get("/reconciliation", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.Reconciliation.Read)
+	api.Get("/reconciliation/:report_id", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.Reconciliation.GetByReportID)
+	api.Get("/reconciliation/:report_id/discrepancy", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.Reconciliation.GetDiscrepancies)
+	api.Get("/reconciliation/:report_id/export", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.Reconciliation.ExportCSV)
+
+	api.Get("/settlement", httpserver.Protected(r.Conf.TokenSecret), httpserver.ValidateScope([]string{model.SuperAdminScope}), handler.Settlement.Read)
+	api.Get("/settlement/statement", handler.Settlement.Statement)
+
+	api.Post("/callback/provider/:provider", handler.Callback.Provider)
 }
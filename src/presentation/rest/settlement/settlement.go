@@ -0,0 +1,120 @@
+package settlement
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/response"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/usecase/settlement"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Settlement struct {
+	log        logger.LoggerInterface
+	settlement settlement.SettlementInterface
+	conf       Conf
+}
+
+type Conf struct{}
+
+type SettlementInterface interface {
+	Read(ctx *fiber.Ctx) error
+	Statement(ctx *fiber.Ctx) error
+}
+
+func New(conf Conf, log *logger.LoggerInterface, s settlement.SettlementInterface) SettlementInterface {
+	return &Settlement{
+		conf:       conf,
+		log:        *log,
+		settlement: s,
+	}
+}
+
+// Get Settlement Report godoc
+// @Summary Get settlement report
+// @Description download the transfer jobs totaled per api key, UTC day of creation, status and currency as CSV or NDJSON, amounts and fees are in minor units
+// @Tags settlement
+// @Accept json
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Security OAuth2Password
+// @Param api_key query string false "search by api key"
+// @Param status query string false "search by transfer status" Enums(pending, processing, success, failed)
+// @Param from query string false "first day of the report, YYYY-MM-DD, default yesterday"
+// @Param to query string false "last day of the report, YYYY-MM-DD, default from"
+// @Param format query string false "report format" Enums(csv, ndjson)
+// @Success 200 {string} string "report file"
+// @Success 400 {object} response.SettlementReportResponse
+// @Success 401 {object} response.SettlementReportResponse
+// @Success 500 {object} response.SettlementReportResponse
+// @Router /settlement [get]
+func (s *Settlement) Read(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetSettlementReportByParam
+		response response.SettlementReportResponse
+	)
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, s.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+
+	if err := s.settlement.Validate(ctx.Context(), &param); err != nil {
+		return response.Transform(ctx, s.log, http.StatusOK, err)
+	}
+
+	return s.stream(ctx, param)
+}
+
+// Get Settlement Statement godoc
+// @Summary Get settlement statement
+// @Description download the settlement report of the api key as CSV or NDJSON, amounts and fees are in minor units
+// @Tags settlement
+// @Accept json
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Security APIKey
+// @Param status query string false "search by transfer status" Enums(pending, processing, success, failed)
+// @Param from query string false "first day of the report, YYYY-MM-DD, default yesterday"
+// @Param to query string false "last day of the report, YYYY-MM-DD, default from"
+// @Param format query string false "report format" Enums(csv, ndjson)
+// @Success 200 {string} string "report file"
+// @Success 400 {object} response.SettlementReportResponse
+// @Success 401 {object} response.SettlementReportResponse
+// @Success 500 {object} response.SettlementReportResponse
+// @Router /settlement/statement [get]
+func (s *Settlement) Statement(ctx *fiber.Ctx) error {
+	var (
+		header   model.Header
+		param    model.GetSettlementReportByParam
+		response response.SettlementReportResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, s.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, s.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+
+	if err := s.settlement.ValidateStatement(ctx.Context(), header.APIKey, &param); err != nil {
+		return response.Transform(ctx, s.log, http.StatusOK, err)
+	}
+
+	return s.stream(ctx, param)
+}
+
+// stream sends the report as it is read, the status is already sent so a failure midway only cuts the file short and is logged
+func (s *Settlement) stream(ctx *fiber.Ctx, param model.GetSettlementReportByParam) error {
+	ctx.Set(fiber.HeaderContentType, model.SettlementContentTypes[param.Format])
+	ctx.Set(fiber.HeaderContentDisposition, `attachment; filename="`+param.FileName()+`"`)
+	ctx.Status(http.StatusOK).Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := s.settlement.Export(context.Background(), &param, w); err != nil {
+			logger.Log.Error(errormsg.WriteErr(err))
+		}
+	})
+	return nil
+}
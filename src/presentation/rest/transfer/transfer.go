@@ -1,13 +1,16 @@
 package transfer
 
 import (
+	"bytes"
 	"net/http"
+	"strings"
 
 	"github.com/achwanyusuf/bricksvc/src/domain/model"
 	"github.com/achwanyusuf/bricksvc/src/domain/response"
 	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
 	"github.com/achwanyusuf/bricksvc/src/usecase/transfer"
 	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/httpserver"
 	"github.com/achwanyusuf/bricksvc/utils/logger"
 	"github.com/gofiber/fiber/v2"
 )
@@ -26,8 +29,20 @@ type Conf struct {
 
 type TransferInterface interface {
 	Transfer(ctx *fiber.Ctx) error
+	Quote(ctx *fiber.Ctx) error
+	Batch(ctx *fiber.Ctx) error
+	GetBatchByID(ctx *fiber.Ctx) error
 	Read(ctx *fiber.Ctx) error
 	GetByID(ctx *fiber.Ctx) error
+	GetEvents(ctx *fiber.Ctx) error
+	GetScheduled(ctx *fiber.Ctx) error
+	Amend(ctx *fiber.Ctx) error
+	Cancel(ctx *fiber.Ctx) error
+	CreateRecurring(ctx *fiber.Ctx) error
+	GetRecurring(ctx *fiber.Ctx) error
+	GetRecurringByID(ctx *fiber.Ctx) error
+	PauseRecurring(ctx *fiber.Ctx) error
+	ResumeRecurring(ctx *fiber.Ctx) error
 }
 
 func New(conf Conf, log *logger.LoggerInterface, t transfer.TransferInterface) TransferInterface {
@@ -40,15 +55,17 @@ func New(conf Conf, log *logger.LoggerInterface, t transfer.TransferInterface) T
 
 // Create Transfer Data godoc
 // @Summary Create Transfer data
-// @Description create transfer bank
+// @Description create transfer bank, amount is an integer of minor units of the ISO 4217 currency (1099 IDR is Rp 10,99), pass quote_id from /transfer/quote to send it converted at the locked rate, both accounts are checked with the bank first and the source balance must cover the amount
 // @Tags transfer
 // @Accept json
 // @Produce json
 // @Security APIKey
+// @Param Idempotency-Key header string false "Unique key to safely retry the same transfer request"
 // @Param data body model.CreateTransfer true "Create Transfer Data"
 // @Success 200 {object} response.SingleTransferJobResponse
 // @Success 400 {object} response.SingleTransferJobResponse
 // @Success 401 {object} response.SingleTransferJobResponse
+// @Success 422 {object} response.SingleTransferJobResponse
 // @Success 500 {object} response.SingleTransferJobResponse
 // @Router /transfer [post]
 func (t *Transfer) Transfer(ctx *fiber.Ctx) error {
@@ -66,7 +83,7 @@ func (t *Transfer) Transfer(ctx *fiber.Ctx) error {
 		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body"))
 	}
 
-	result, err := t.transfer.Transfer(ctx.Context(), createTransfer, header.APIKey)
+	result, err := t.transfer.Transfer(ctx.Context(), createTransfer, header.APIKey, header.IdempotencyKey)
 	if err != nil {
 		return response.Transform(ctx, t.log, http.StatusCreated, err)
 	}
@@ -76,6 +93,141 @@ func (t *Transfer) Transfer(ctx *fiber.Ctx) error {
 	return response.Transform(ctx, t.log, http.StatusCreated, nil)
 }
 
+// Create Transfer Quote godoc
+// @Summary Create Transfer quote
+// @Description lock the fx rate and fee for converting amount from source to destination currency, pass the quote_id to create transfer before expires_at, transfer_fee_amount previews the fee of the matching fee rule for the given banks and is priced again when the transfer is created
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security APIKey
+// @Param data body model.CreateTransferQuote true "Create Transfer Quote Data"
+// @Success 201 {object} response.SingleTransferQuoteResponse
+// @Success 400 {object} response.SingleTransferQuoteResponse
+// @Success 401 {object} response.SingleTransferQuoteResponse
+// @Success 422 {object} response.SingleTransferQuoteResponse
+// @Success 500 {object} response.SingleTransferQuoteResponse
+// @Router /transfer/quote [post]
+func (t *Transfer) Quote(ctx *fiber.Ctx) error {
+	var (
+		createQuote model.CreateTransferQuote
+		header      model.Header
+		response    response.SingleTransferQuoteResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, t.log, http.StatusCreated, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+
+	if err := ctx.BodyParser(&createQuote); err != nil {
+		return response.Transform(ctx, t.log, http.StatusCreated, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body"))
+	}
+
+	result, err := t.transfer.Quote(ctx.Context(), createQuote, header.APIKey)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusCreated, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, t.log, http.StatusCreated, nil)
+}
+
+// Create Transfer Batch godoc
+// @Summary Create Transfer batch
+// @Description create many transfers at once from a json array or a csv file with header source_bank_account,destination_bank_account,source_bank_id,destination_bank_id,amount,currency,transaction_time where amount is in minor units, invalid rows are reported without blocking valid ones
+// @Tags transfer
+// @Accept json,text/csv,mpfd
+// @Produce json
+// @Security APIKey
+// @Param data body []model.CreateTransfer false "Create Transfer Batch Data"
+// @Param file formData file false "Transfer batch csv"
+// @Success 201 {object} response.SingleTransferBatchResponse
+// @Success 400 {object} response.SingleTransferBatchResponse
+// @Success 401 {object} response.SingleTransferBatchResponse
+// @Success 500 {object} response.SingleTransferBatchResponse
+// @Router /transfer/batch [post]
+func (t *Transfer) Batch(ctx *fiber.Ctx) error {
+	var (
+		header   model.Header
+		rows     []model.CreateTransferBatchRow
+		response response.SingleTransferBatchResponse
+		err      error
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, t.log, http.StatusCreated, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+
+	contentType := ctx.Get(fiber.HeaderContentType)
+	switch {
+	case strings.HasPrefix(contentType, fiber.MIMEMultipartForm):
+		file, err := ctx.FormFile("file")
+		if err != nil {
+			return response.Transform(ctx, t.log, http.StatusCreated, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get file"))
+		}
+		f, err := file.Open()
+		if err != nil {
+			return response.Transform(ctx, t.log, http.StatusCreated, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error open file"))
+		}
+		defer f.Close()
+		rows, err = model.ParseTransferBatchCSV(f)
+		if err != nil {
+			return response.Transform(ctx, t.log, http.StatusCreated, err)
+		}
+	case strings.HasPrefix(contentType, "text/csv"):
+		rows, err = model.ParseTransferBatchCSV(bytes.NewReader(ctx.Body()))
+		if err != nil {
+			return response.Transform(ctx, t.log, http.StatusCreated, err)
+		}
+	default:
+		var createTransfers []model.CreateTransfer
+		if err := ctx.BodyParser(&createTransfers); err != nil {
+			return response.Transform(ctx, t.log, http.StatusCreated, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body"))
+		}
+		rows = model.TransformCreateTransferBatchRows(createTransfers)
+	}
+
+	result, err := t.transfer.Batch(ctx.Context(), rows, header.APIKey)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusCreated, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, t.log, http.StatusCreated, nil)
+}
+
+// Get Transfer Batch godoc
+// @Summary Get Transfer batch
+// @Description get transfer batch status, per row errors and child jobs
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Security APIKey
+// @Param batch_id path string true "get by batch id"
+// @Success 200 {object} response.SingleTransferBatchResponse
+// @Success 400 {object} response.SingleTransferBatchResponse
+// @Success 401 {object} response.SingleTransferBatchResponse
+// @Success 404 {object} response.SingleTransferBatchResponse
+// @Success 500 {object} response.SingleTransferBatchResponse
+// @Router /transfer/batch/{batch_id} [get]
+func (t *Transfer) GetBatchByID(ctx *fiber.Ctx) error {
+	var (
+		header   model.Header
+		response response.SingleTransferBatchResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+	result, err := t.transfer.GetBatchByID(ctx.Context(), reader(ctx, &header), ctx.Params("batch_id"))
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, t.log, http.StatusOK, nil)
+}
+
 // Get Transfer Data godoc
 // @Summary get Transfer data
 // @Description get transfer bank
@@ -83,16 +235,33 @@ func (t *Transfer) Transfer(ctx *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Security OAuth2Password
+// @Security APIKey
 // @Param id query int false "search by id"
 // @Param job_id query string false "search by job id"
-// @Param api_key query string false "search by api key"
+// @Param api_key query string false "search by api key, only applied for a super admin"
 // @Param status query string false "search by status"
+// @Param reference query string false "search by merchant reference"
+// @Param amount_gte query int false "search by minimum amount in minor units"
+// @Param amount_lte query int false "search by maximum amount in minor units"
+// @Param source_bank_id query int false "search by source bank id"
+// @Param destination_bank_id query int false "search by destination bank id"
+// @Param source_bank_account query string false "search by source bank account"
+// @Param destination_bank_account query string false "search by destination bank account"
+// @Param bank_account query string false "search by source or destination bank account"
+// @Param created_at_gt query string false "search created after, RFC 3339"
+// @Param created_at_lt query string false "search created before, RFC 3339"
+// @Param updated_at_gt query string false "search updated after, RFC 3339"
+// @Param updated_at_lt query string false "search updated before, RFC 3339"
 // @Param sort_by query string false "sort result by attributes"
 // @Param page query int false " "
 // @Param limit query int false " "
+// @Param cursor query string false "next_cursor or prev_cursor of the previous page, switches the list to cursor pagination"
+// @Param pagination query string false "offset (default) or cursor" Enums(offset, cursor)
+// @Param with_total query bool false "include an estimated total in cursor pagination"
 // @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
 // @Success 200 {object} response.TransferJobsResponse
 // @Success 400 {object} response.TransferJobsResponse
+// @Success 401 {object} response.TransferJobsResponse
 // @Success 500 {object} response.TransferJobsResponse
 // @Router /transfer [get]
 func (t *Transfer) Read(ctx *fiber.Ctx) error {
@@ -107,7 +276,7 @@ func (t *Transfer) Read(ctx *fiber.Ctx) error {
 	if err := ctx.QueryParser(&param); err != nil {
 		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
 	}
-	roles, pagination, err := t.transfer.GetByParam(ctx.Context(), header.CacheControl, param)
+	roles, pagination, err := t.transfer.GetByParam(ctx.Context(), header.CacheControl, reader(ctx, &header), param)
 	if err != nil {
 		return response.Transform(ctx, t.log, http.StatusOK, err)
 	}
@@ -125,10 +294,12 @@ func (t *Transfer) Read(ctx *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Security OAuth2Password
+// @Security APIKey
 // @Param job_id path string true "get by job id"
 // @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
 // @Success 200 {object} response.SingleTransferJobResponse
 // @Success 400 {object} response.SingleTransferJobResponse
+// @Success 401 {object} response.SingleTransferJobResponse
 // @Success 500 {object} response.SingleTransferJobResponse
 // @Router /transfer/{job_id} [get]
 func (t *Transfer) GetByID(ctx *fiber.Ctx) error {
@@ -140,7 +311,298 @@ func (t *Transfer) GetByID(ctx *fiber.Ctx) error {
 		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
 	}
 	id := ctx.Params("job_id")
-	result, err := t.transfer.GetByJobID(ctx.Context(), header.CacheControl, id)
+	result, err := t.transfer.GetByJobID(ctx.Context(), header.CacheControl, reader(ctx, &header), id)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, t.log, http.StatusOK, nil)
+}
+
+// Get Transfer Job Events godoc
+// @Summary Get Transfer Job Events
+// @Description get status history of a transfer job
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Security APIKey
+// @Param job_id path string true "get by job id"
+// @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
+// @Success 200 {object} response.TransferJobEventsResponse
+// @Success 400 {object} response.TransferJobEventsResponse
+// @Success 401 {object} response.TransferJobEventsResponse
+// @Success 500 {object} response.TransferJobEventsResponse
+// @Router /transfer/{job_id}/events [get]
+func (t *Transfer) GetEvents(ctx *fiber.Ctx) error {
+	var (
+		header   model.Header
+		response response.TransferJobEventsResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+	id := ctx.Params("job_id")
+	result, err := t.transfer.GetEventsByJobID(ctx.Context(), header.CacheControl, reader(ctx, &header), id)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, t.log, http.StatusOK, nil)
+}
+
+// Get Scheduled Transfer godoc
+// @Summary Get scheduled Transfer data
+// @Description get future dated transfers of the api key that are not released yet
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security APIKey
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Param cursor query string false "next_cursor or prev_cursor of the previous page, switches the list to cursor pagination"
+// @Param pagination query string false "offset (default) or cursor" Enums(offset, cursor)
+// @Param with_total query bool false "include an estimated total in cursor pagination"
+// @Success 200 {object} response.TransferJobsResponse
+// @Success 400 {object} response.TransferJobsResponse
+// @Success 401 {object} response.TransferJobsResponse
+// @Success 500 {object} response.TransferJobsResponse
+// @Router /transfer/scheduled [get]
+func (t *Transfer) GetScheduled(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetTransferJobsByParam
+		header   model.Header
+		response response.TransferJobsResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+	result, pagination, err := t.transfer.GetScheduled(ctx.Context(), header.APIKey, param)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+	response.Pagination = pagination
+
+	return response.Transform(ctx, t.log, http.StatusOK, nil)
+}
+
+// Amend Scheduled Transfer godoc
+// @Summary Amend scheduled Transfer
+// @Description update a scheduled transfer before it is released
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security APIKey
+// @Param job_id path string true "job id"
+// @Param data body model.UpdateTransfer true "Update Transfer Data"
+// @Success 200 {object} response.SingleTransferJobResponse
+// @Success 400 {object} response.SingleTransferJobResponse
+// @Success 401 {object} response.SingleTransferJobResponse
+// @Success 409 {object} response.SingleTransferJobResponse
+// @Success 422 {object} response.SingleTransferJobResponse
+// @Success 500 {object} response.SingleTransferJobResponse
+// @Router /transfer/{job_id} [put]
+func (t *Transfer) Amend(ctx *fiber.Ctx) error {
+	var (
+		updateTransfer model.UpdateTransfer
+		header         model.Header
+		response       response.SingleTransferJobResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+
+	if err := ctx.BodyParser(&updateTransfer); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body"))
+	}
+
+	result, err := t.transfer.Amend(ctx.Context(), ctx.Params("job_id"), header.APIKey, updateTransfer)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, t.log, http.StatusOK, nil)
+}
+
+// Cancel Transfer godoc
+// @Summary Cancel Transfer
+// @Description cancel a scheduled transfer, or a pending transfer that has not been submitted to the provider yet
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security APIKey
+// @Param job_id path string true "job id"
+// @Success 200 {object} response.SingleTransferJobResponse
+// @Success 400 {object} response.SingleTransferJobResponse
+// @Success 401 {object} response.SingleTransferJobResponse
+// @Success 409 {object} response.SingleTransferJobResponse
+// @Success 500 {object} response.SingleTransferJobResponse
+// @Router /transfer/{job_id}/cancel [post]
+func (t *Transfer) Cancel(ctx *fiber.Ctx) error {
+	var (
+		header   model.Header
+		response response.SingleTransferJobResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+
+	result, err := t.transfer.Cancel(ctx.Context(), ctx.Params("job_id"), header.APIKey)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, t.log, http.StatusOK, nil)
+}
+
+// Create Recurring Transfer godoc
+// @Summary Create recurring Transfer mandate
+// @Description create a mandate that generates a transfer job on every occurrence of a cron schedule
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security APIKey
+// @Param data body model.CreateRecurringTransfer true "Recurring Transfer Data"
+// @Success 201 {object} response.SingleRecurringTransferResponse
+// @Success 400 {object} response.SingleRecurringTransferResponse
+// @Success 401 {object} response.SingleRecurringTransferResponse
+// @Success 422 {object} response.SingleRecurringTransferResponse
+// @Success 500 {object} response.SingleRecurringTransferResponse
+// @Router /transfer/recurring [post]
+func (t *Transfer) CreateRecurring(ctx *fiber.Ctx) error {
+	var (
+		createRecurring model.CreateRecurringTransfer
+		header          model.Header
+		response        response.SingleRecurringTransferResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, t.log, http.StatusCreated, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+
+	if err := ctx.BodyParser(&createRecurring); err != nil {
+		return response.Transform(ctx, t.log, http.StatusCreated, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body"))
+	}
+
+	result, err := t.transfer.CreateRecurring(ctx.Context(), createRecurring, header.APIKey)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusCreated, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, t.log, http.StatusCreated, nil)
+}
+
+// Get Recurring Transfer godoc
+// @Summary Get recurring Transfer mandates
+// @Description get recurring transfer mandates of the api key
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security APIKey
+// @Param status query string false "mandate status" Enums(active, paused, completed)
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Success 200 {object} response.RecurringTransfersResponse
+// @Success 400 {object} response.RecurringTransfersResponse
+// @Success 401 {object} response.RecurringTransfersResponse
+// @Success 500 {object} response.RecurringTransfersResponse
+// @Router /transfer/recurring [get]
+func (t *Transfer) GetRecurring(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetRecurringTransfersByParam
+		header   model.Header
+		response response.RecurringTransfersResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+	result, pagination, err := t.transfer.GetRecurring(ctx.Context(), header.APIKey, param)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+	response.Pagination = pagination
+
+	return response.Transform(ctx, t.log, http.StatusOK, nil)
+}
+
+// Get Recurring Transfer By ID godoc
+// @Summary Get recurring Transfer mandate by mandate id
+// @Description get a recurring transfer mandate with the jobs it generated
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security APIKey
+// @Param mandate_id path string true "mandate id"
+// @Success 200 {object} response.SingleRecurringTransferResponse
+// @Success 400 {object} response.SingleRecurringTransferResponse
+// @Success 401 {object} response.SingleRecurringTransferResponse
+// @Success 404 {object} response.SingleRecurringTransferResponse
+// @Success 500 {object} response.SingleRecurringTransferResponse
+// @Router /transfer/recurring/{mandate_id} [get]
+func (t *Transfer) GetRecurringByID(ctx *fiber.Ctx) error {
+	var (
+		header   model.Header
+		response response.SingleRecurringTransferResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+
+	result, err := t.transfer.GetRecurringByID(ctx.Context(), ctx.Params("mandate_id"), header.APIKey)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, t.log, http.StatusOK, nil)
+}
+
+// Pause Recurring Transfer godoc
+// @Summary Pause recurring Transfer mandate
+// @Description stop an active mandate from generating transfer jobs
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security APIKey
+// @Param mandate_id path string true "mandate id"
+// @Success 200 {object} response.SingleRecurringTransferResponse
+// @Success 400 {object} response.SingleRecurringTransferResponse
+// @Success 401 {object} response.SingleRecurringTransferResponse
+// @Success 409 {object} response.SingleRecurringTransferResponse
+// @Success 500 {object} response.SingleRecurringTransferResponse
+// @Router /transfer/recurring/{mandate_id}/pause [post]
+func (t *Transfer) PauseRecurring(ctx *fiber.Ctx) error {
+	var (
+		header   model.Header
+		response response.SingleRecurringTransferResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+
+	result, err := t.transfer.PauseRecurring(ctx.Context(), ctx.Params("mandate_id"), header.APIKey)
 	if err != nil {
 		return response.Transform(ctx, t.log, http.StatusOK, err)
 	}
@@ -149,3 +611,50 @@ func (t *Transfer) GetByID(ctx *fiber.Ctx) error {
 
 	return response.Transform(ctx, t.log, http.StatusOK, nil)
 }
+
+// Resume Recurring Transfer godoc
+// @Summary Resume recurring Transfer mandate
+// @Description resume a paused mandate from its next occurrence, missed occurrences are skipped
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security APIKey
+// @Param mandate_id path string true "mandate id"
+// @Success 200 {object} response.SingleRecurringTransferResponse
+// @Success 400 {object} response.SingleRecurringTransferResponse
+// @Success 401 {object} response.SingleRecurringTransferResponse
+// @Success 409 {object} response.SingleRecurringTransferResponse
+// @Success 500 {object} response.SingleRecurringTransferResponse
+// @Router /transfer/recurring/{mandate_id}/resume [post]
+func (t *Transfer) ResumeRecurring(ctx *fiber.Ctx) error {
+	var (
+		header   model.Header
+		response response.SingleRecurringTransferResponse
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header"))
+	}
+
+	result, err := t.transfer.ResumeRecurring(ctx.Context(), ctx.Params("mandate_id"), header.APIKey)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, t.log, http.StatusOK, nil)
+}
+
+// reader is who reads transfer jobs, the token when one was given and the api key otherwise
+func reader(ctx *fiber.Ctx, header *model.Header) model.TransferReader {
+	if httpserver.HasUserData(ctx) {
+		userData := httpserver.GetUserData(ctx)
+		return model.TransferReader{
+			AccountID: userData.ID,
+			Scope:     userData.Scope,
+		}
+	}
+	return model.TransferReader{
+		APIKey: header.APIKey,
+	}
+}
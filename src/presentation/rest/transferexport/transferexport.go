@@ -0,0 +1,187 @@
+package transferexport
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/response"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/usecase/transferexport"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/httpserver"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TransferExport struct {
+	log            logger.LoggerInterface
+	transferExport transferexport.TransferExportInterface
+	conf           Conf
+}
+
+type Conf struct{}
+
+type TransferExportInterface interface {
+	Export(ctx *fiber.Ctx) error
+	Create(ctx *fiber.Ctx) error
+	GetByExportID(ctx *fiber.Ctx) error
+	Download(ctx *fiber.Ctx) error
+}
+
+func New(conf Conf, log *logger.LoggerInterface, t transferexport.TransferExportInterface) TransferExportInterface {
+	return &TransferExport{
+		conf:           conf,
+		log:            *log,
+		transferExport: t,
+	}
+}
+
+// Export Transfer Data godoc
+// @Summary Export transfer data
+// @Description stream the transfer jobs matching the filters of the transfer list as CSV or NDJSON in id order, amounts are in minor units
+// @Tags transfer
+// @Accept json
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Security OAuth2Password
+// @Param format query string false "export format" Enums(csv, ndjson)
+// @Param columns query string false "comma separated columns, default every column" example(job_id,status,amount,currency)
+// @Param api_key query string false "search by api key"
+// @Param status query string false "search by status"
+// @Param reference query string false "search by merchant reference"
+// @Param amount_gte query int false "search by minimum amount in minor units"
+// @Param amount_lte query int false "search by maximum amount in minor units"
+// @Param source_bank_id query int false "search by source bank id"
+// @Param destination_bank_id query int false "search by destination bank id"
+// @Param source_bank_account query string false "search by source bank account"
+// @Param destination_bank_account query string false "search by destination bank account"
+// @Param bank_account query string false "search by source or destination bank account"
+// @Param created_at_gt query string false "search created after, RFC 3339"
+// @Param created_at_lt query string false "search created before, RFC 3339"
+// @Param updated_at_gt query string false "search updated after, RFC 3339"
+// @Param updated_at_lt query string false "search updated before, RFC 3339"
+// @Success 200 {string} string "export file"
+// @Success 400 {object} response.SingleTransferExportResponse
+// @Success 401 {object} response.SingleTransferExportResponse
+// @Success 500 {object} response.SingleTransferExportResponse
+// @Router /transfer/export [get]
+func (t *TransferExport) Export(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetTransferExportByParam
+		response response.SingleTransferExportResponse
+	)
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+
+	if err := t.transferExport.Validate(ctx.Context(), &param); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	// the status is already sent once rows flow, a failure midway only cuts the file short and is logged
+	ctx.Set(fiber.HeaderContentType, model.SettlementContentTypes[param.Format])
+	ctx.Set(fiber.HeaderContentDisposition, `attachment; filename="`+param.FileName(time.Now())+`"`)
+	ctx.Status(http.StatusOK).Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if _, err := t.transferExport.Export(context.Background(), &param, w); err != nil {
+			logger.Log.Error(errormsg.WriteErr(err))
+		}
+	})
+	return nil
+}
+
+// Create Transfer Export godoc
+// @Summary Create transfer export job
+// @Description write the export in the background, the job links its file in download_url once completed
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.GetTransferExportByParam true "Export format, columns and transfer filters"
+// @Success 200 {object} response.SingleTransferExportResponse
+// @Success 400 {object} response.SingleTransferExportResponse
+// @Success 401 {object} response.SingleTransferExportResponse
+// @Success 500 {object} response.SingleTransferExportResponse
+// @Router /transfer/export [post]
+func (t *TransferExport) Create(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetTransferExportByParam
+		response response.SingleTransferExportResponse
+	)
+	if err := ctx.BodyParser(&param); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body"))
+	}
+	userData := httpserver.GetUserData(ctx)
+
+	result, err := t.transferExport.Create(ctx.Context(), param, userData.ID)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, t.log, http.StatusOK, nil)
+}
+
+// Get Transfer Export godoc
+// @Summary Get transfer export job
+// @Description get the status of an export job and the link to its file once completed
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param export_id path string true "export id"
+// @Success 200 {object} response.SingleTransferExportResponse
+// @Success 400 {object} response.SingleTransferExportResponse
+// @Success 401 {object} response.SingleTransferExportResponse
+// @Success 404 {object} response.SingleTransferExportResponse
+// @Success 500 {object} response.SingleTransferExportResponse
+// @Router /transfer/export/{export_id} [get]
+func (t *TransferExport) GetByExportID(ctx *fiber.Ctx) error {
+	var (
+		response response.SingleTransferExportResponse
+	)
+	result, err := t.transferExport.GetByExportID(ctx.Context(), ctx.Params("export_id"))
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, t.log, http.StatusOK, nil)
+}
+
+// Download Transfer Export godoc
+// @Summary Download transfer export
+// @Description download the file of a completed export job
+// @Tags transfer
+// @Accept json
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Security OAuth2Password
+// @Param export_id path string true "export id"
+// @Success 200 {string} string "export file"
+// @Success 400 {object} response.SingleTransferExportResponse
+// @Success 401 {object} response.SingleTransferExportResponse
+// @Success 404 {object} response.SingleTransferExportResponse
+// @Success 500 {object} response.SingleTransferExportResponse
+// @Router /transfer/export/{export_id}/download [get]
+func (t *TransferExport) Download(ctx *fiber.Ctx) error {
+	var (
+		response response.SingleTransferExportResponse
+	)
+	result, path, err := t.transferExport.GetFile(ctx.Context(), ctx.Params("export_id"))
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	if err := ctx.SendFile(path); err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error send file"))
+	}
+
+	ctx.Set(fiber.HeaderContentType, model.SettlementContentTypes[result.Format])
+	ctx.Set(fiber.HeaderContentDisposition, `attachment; filename="transfers-`+result.ExportID+`.`+result.Format+`"`)
+	return nil
+}
@@ -0,0 +1,232 @@
+package transferstream
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/response"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/usecase/transferstream"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/volatiletech/null/v8"
+)
+
+// localsParam carries the opened stream from the upgrade check to the websocket handler
+const localsParam string = "transferStream"
+
+// writeWait bounds a heartbeat ping on a client that stopped reading
+var writeWait time.Duration = 10 * time.Second
+
+type TransferStream struct {
+	log            logger.LoggerInterface
+	transferStream transferstream.TransferStreamInterface
+	conf           Conf
+}
+
+type Conf struct{}
+
+type TransferStreamInterface interface {
+	Stream(ctx *fiber.Ctx) error
+	Upgrade(ctx *fiber.Ctx) error
+	WebSocket(conn *websocket.Conn)
+}
+
+func New(conf Conf, log *logger.LoggerInterface, t transferstream.TransferStreamInterface) TransferStreamInterface {
+	return &TransferStream{
+		conf:           conf,
+		log:            *log,
+		transferStream: t,
+	}
+}
+
+// Stream Transfer Status godoc
+// @Summary Stream transfer status changes
+// @Description push the creation and status changes of the jobs of the api key as server-sent events, send the id of the last event received as Last-Event-ID to resume after it
+// @Tags transfer
+// @Accept json
+// @Produce text/event-stream
+// @Security APIKey
+// @Param job_id query string false "only the changes of this job"
+// @Param batch_id query string false "only the changes of the jobs of this batch"
+// @Param Last-Event-ID header int false "resume after this event id"
+// @Param last_event_id query int false "resume after this event id when the header cannot be set"
+// @Success 200 {object} response.TransferStreamEventResponse "stream of transfer.created and transfer.status_changed events, the data is the event"
+// @Success 400 {object} response.TransferStreamEventResponse
+// @Success 401 {object} response.TransferStreamEventResponse
+// @Success 404 {object} response.TransferStreamEventResponse
+// @Success 500 {object} response.TransferStreamEventResponse
+// @Router /transfer/stream [get]
+func (t *TransferStream) Stream(ctx *fiber.Ctx) error {
+	var (
+		response response.TransferStreamEventResponse
+	)
+	param, err := t.open(ctx)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+	requestCtx := ctx.Status(http.StatusOK).Context()
+	conn := requestCtx.Conn()
+	requestCtx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		// flushing sends the headers so the client sees the stream open before the first event
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		// the request context is only done on shutdown, a disconnect is noticed by watching the connection
+		streamCtx, cancel := context.WithCancel(requestCtx)
+		defer cancel()
+		stop := watchClose(conn, cancel)
+		defer stop()
+
+		err := t.transferStream.Stream(streamCtx, &param, func(v *model.TransferStreamEvent) error {
+			if v == nil {
+				if _, err := w.WriteString(": heartbeat\n\n"); err != nil {
+					return err
+				}
+				return w.Flush()
+			}
+
+			data, err := jsoniter.Marshal(v)
+			if err != nil {
+				return err
+			}
+
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", v.ID, v.Event, data); err != nil {
+				return err
+			}
+			return w.Flush()
+		})
+		if err != nil {
+			logger.Log.Error(errormsg.WriteErr(err))
+		}
+	})
+	return nil
+}
+
+// watchClose cancels a server-sent events stream as soon as its client disconnects, the client sends nothing after its
+// request so a read on the connection only returns once it is closed. The returned stop unblocks the read when the stream
+// ends first, a connection the client wrote to is closed since the bytes read are lost to the server.
+func watchClose(conn net.Conn, cancel context.CancelFunc) func() {
+	var read int
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer cancel()
+		read, _ = conn.Read(make([]byte, 1))
+	}()
+
+	return func() {
+		conn.SetReadDeadline(time.Now())
+		<-done
+		if read > 0 {
+			conn.Close()
+			return
+		}
+		conn.SetReadDeadline(time.Time{})
+	}
+}
+
+// Upgrade Transfer Stream godoc
+// @Summary Stream transfer status changes over websocket
+// @Description push the creation and status changes of the jobs of the api key as json text messages, pass the id of the last event received as last_event_id to resume after it
+// @Tags transfer
+// @Accept json
+// @Produce json
+// @Security APIKey
+// @Param job_id query string false "only the changes of this job"
+// @Param batch_id query string false "only the changes of the jobs of this batch"
+// @Param last_event_id query int false "resume after this event id"
+// @Success 101 {object} model.TransferStreamEvent "switching protocols, every message is an event"
+// @Success 400 {object} response.TransferStreamEventResponse
+// @Success 401 {object} response.TransferStreamEventResponse
+// @Success 404 {object} response.TransferStreamEventResponse
+// @Router /transfer/stream/ws [get]
+func (t *TransferStream) Upgrade(ctx *fiber.Ctx) error {
+	var (
+		response response.TransferStreamEventResponse
+	)
+	if !websocket.IsWebSocketUpgrade(ctx) {
+		return response.Transform(ctx, t.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, fiber.ErrUpgradeRequired, "websocket upgrade required"))
+	}
+
+	param, err := t.open(ctx)
+	if err != nil {
+		return response.Transform(ctx, t.log, http.StatusOK, err)
+	}
+
+	ctx.Locals(localsParam, &param)
+	return ctx.Next()
+}
+
+func (t *TransferStream) WebSocket(conn *websocket.Conn) {
+	param, ok := conn.Locals(localsParam).(*model.GetTransferStreamByParam)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the client only sends control frames, reading them is how its close is noticed
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err := t.transferStream.Stream(ctx, param, func(v *model.TransferStreamEvent) error {
+		if v == nil {
+			return conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait))
+		}
+		return conn.WriteJSON(v)
+	})
+	if err != nil {
+		logger.Log.Error(errormsg.WriteErr(err))
+		conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, ""), time.Now().Add(writeWait))
+	}
+}
+
+// open reads the filters of a stream and checks them against the api key, the Last-Event-ID header wins over the query
+func (t *TransferStream) open(ctx *fiber.Ctx) (model.GetTransferStreamByParam, error) {
+	var (
+		param  model.GetTransferStreamByParam
+		header model.Header
+	)
+	if err := ctx.ReqHeaderParser(&header); err != nil {
+		return param, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal header")
+	}
+	if err := ctx.QueryParser(&param); err != nil {
+		return param, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query")
+	}
+
+	if header.LastEventID != "" {
+		id, err := strconv.ParseInt(header.LastEventID, 10, 64)
+		if err != nil {
+			return param, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "invalid Last-Event-ID")
+		}
+		param.LastEventID = null.Int64From(id)
+	}
+
+	if err := t.transferStream.Open(ctx.Context(), header.APIKey, &param); err != nil {
+		return param, err
+	}
+	return param, nil
+}
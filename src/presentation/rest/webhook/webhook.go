@@ -0,0 +1,220 @@
+package webhook
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/response"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/usecase/webhook"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/httpserver"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/volatiletech/null/v8"
+)
+
+type Webhook struct {
+	log     logger.LoggerInterface
+	webhook webhook.WebhookInterface
+	conf    Conf
+}
+
+type Conf struct {
+	TokenSecret string `mapstructure:"token_secret"`
+}
+
+type WebhookInterface interface {
+	Create(ctx *fiber.Ctx) error
+	Read(ctx *fiber.Ctx) error
+	DeleteByID(ctx *fiber.Ctx) error
+	GetDeliveries(ctx *fiber.Ctx) error
+	Redeliver(ctx *fiber.Ctx) error
+}
+
+func New(conf Conf, log *logger.LoggerInterface, w webhook.WebhookInterface) WebhookInterface {
+	return &Webhook{
+		conf:    conf,
+		log:     *log,
+		webhook: w,
+	}
+}
+
+// ownerID limits non super admin accounts to their own webhook endpoints
+func ownerID(ctx *fiber.Ctx) null.Int64 {
+	userData := httpserver.GetUserData(ctx)
+	if userData.Scope == model.SuperAdminScope {
+		return null.Int64{}
+	}
+	return null.Int64From(userData.ID)
+}
+
+// Create Webhook Endpoint godoc
+// @Summary Register webhook endpoint
+// @Description register url to receive signed transfer status notifications, the signing secret is only returned once
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.CreateWebhookEndpoint true "Create Webhook Endpoint Data"
+// @Success 201 {object} response.SingleWebhookEndpointResponse
+// @Success 400 {object} response.SingleWebhookEndpointResponse
+// @Success 401 {object} response.SingleWebhookEndpointResponse
+// @Success 500 {object} response.SingleWebhookEndpointResponse
+// @Router /webhook [post]
+func (w *Webhook) Create(ctx *fiber.Ctx) error {
+	var (
+		endpointData model.CreateWebhookEndpoint
+		response     response.SingleWebhookEndpointResponse
+	)
+
+	if err := ctx.BodyParser(&endpointData); err != nil {
+		return response.Transform(ctx, w.log, http.StatusCreated, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal body"))
+	}
+	userData := httpserver.GetUserData(ctx)
+
+	endpointData.AccountID = userData.ID
+	endpointData.CreatedBy = userData.ID
+	result, err := w.webhook.Create(ctx.Context(), endpointData)
+	if err != nil {
+		return response.Transform(ctx, w.log, http.StatusCreated, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, w.log, http.StatusCreated, nil)
+}
+
+// Get Webhook Endpoints godoc
+// @Summary Get webhook endpoints
+// @Description get registered webhook endpoints of current account
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param account_id query int false "search by account id, super admin only"
+// @Param is_active query bool false "search by active flag"
+// @Success 200 {object} response.WebhookEndpointsResponse
+// @Success 400 {object} response.WebhookEndpointsResponse
+// @Success 500 {object} response.WebhookEndpointsResponse
+// @Router /webhook [get]
+func (w *Webhook) Read(ctx *fiber.Ctx) error {
+	var (
+		param    model.GetWebhookEndpointByParam
+		response response.WebhookEndpointsResponse
+	)
+	if err := ctx.QueryParser(&param); err != nil {
+		return response.Transform(ctx, w.log, http.StatusOK, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal query"))
+	}
+	if owner := ownerID(ctx); owner.Valid {
+		param.AccountID = owner
+	}
+
+	result, err := w.webhook.GetByParam(ctx.Context(), param)
+	if err != nil {
+		return response.Transform(ctx, w.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, w.log, http.StatusOK, nil)
+}
+
+// Delete Webhook Endpoint godoc
+// @Summary Delete webhook endpoint
+// @Description delete webhook endpoint, pending deliveries to it are abandoned
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "webhook endpoint id"
+// @Success 200 {object} response.EmptyResponse
+// @Success 400 {object} response.EmptyResponse
+// @Success 500 {object} response.EmptyResponse
+// @Router /webhook/{id} [delete]
+func (w *Webhook) DeleteByID(ctx *fiber.Ctx) error {
+	var (
+		response response.EmptyResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, w.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+	userData := httpserver.GetUserData(ctx)
+	err = w.webhook.DeleteByID(ctx.Context(), userData.ID, model.GetWebhookEndpointByParam{
+		ID:        null.Int64From(id),
+		AccountID: ownerID(ctx),
+	})
+	if err != nil {
+		return response.Transform(ctx, w.log, http.StatusOK, err)
+	}
+
+	return response.Transform(ctx, w.log, http.StatusOK, nil)
+}
+
+// Get Webhook Deliveries godoc
+// @Summary Get webhook deliveries
+// @Description get latest delivery log of a webhook endpoint
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "webhook endpoint id"
+// @Param limit query int false " "
+// @Success 200 {object} response.WebhookDeliveriesResponse
+// @Success 400 {object} response.WebhookDeliveriesResponse
+// @Success 500 {object} response.WebhookDeliveriesResponse
+// @Router /webhook/{id}/deliveries [get]
+func (w *Webhook) GetDeliveries(ctx *fiber.Ctx) error {
+	var (
+		response response.WebhookDeliveriesResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, w.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+	result, err := w.webhook.GetDeliveries(ctx.Context(), model.GetWebhookEndpointByParam{
+		ID:        null.Int64From(id),
+		AccountID: ownerID(ctx),
+	}, int64(ctx.QueryInt("limit")))
+	if err != nil {
+		return response.Transform(ctx, w.log, http.StatusOK, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, w.log, http.StatusOK, nil)
+}
+
+// Redeliver Webhook godoc
+// @Summary Redeliver webhook
+// @Description queue a new delivery with the same payload as an earlier delivery
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path int true "webhook delivery id"
+// @Success 201 {object} response.SingleWebhookDeliveryResponse
+// @Success 400 {object} response.SingleWebhookDeliveryResponse
+// @Success 404 {object} response.SingleWebhookDeliveryResponse
+// @Success 500 {object} response.SingleWebhookDeliveryResponse
+// @Router /webhook/delivery/{id}/redeliver [post]
+func (w *Webhook) Redeliver(ctx *fiber.Ctx) error {
+	var (
+		response response.SingleWebhookDeliveryResponse
+	)
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return response.Transform(ctx, w.log, http.StatusCreated, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+	}
+	userData := httpserver.GetUserData(ctx)
+	result, err := w.webhook.Redeliver(ctx.Context(), userData.ID, id, ownerID(ctx))
+	if err != nil {
+		return response.Transform(ctx, w.log, http.StatusCreated, err)
+	}
+
+	response.Data = result
+
+	return response.Transform(ctx, w.log, http.StatusCreated, nil)
+}
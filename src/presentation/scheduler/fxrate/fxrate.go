@@ -0,0 +1,41 @@
+package fxrate
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/usecase/fxrate"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+)
+
+type FXRate struct {
+	conf   Conf
+	fxRate fxrate.FXRateInterface
+}
+
+type Conf struct {
+	LoadRates LoadRates `mapstructure:"load_rates"`
+}
+
+type LoadRates struct {
+	Name     string        `mapstructure:"name"`
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type FXRateInterface interface {
+	LoadRates()
+}
+
+func New(conf Conf, fxRate fxrate.FXRateInterface) FXRateInterface {
+	return &FXRate{
+		conf:   conf,
+		fxRate: fxRate,
+	}
+}
+
+func (f *FXRate) LoadRates() {
+	if err := f.fxRate.LoadFile(context.Background()); err != nil {
+		logger.Log.Error(errormsg.WriteErr(err))
+	}
+}
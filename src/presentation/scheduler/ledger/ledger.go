@@ -0,0 +1,41 @@
+package ledger
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/usecase/ledger"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+)
+
+type Ledger struct {
+	conf   Conf
+	ledger ledger.LedgerInterface
+}
+
+type Conf struct {
+	CheckInvariant CheckInvariant `mapstructure:"check_invariant"`
+}
+
+type CheckInvariant struct {
+	Name     string        `mapstructure:"name"`
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type LedgerInterface interface {
+	CheckInvariant()
+}
+
+func New(conf Conf, ledger ledger.LedgerInterface) LedgerInterface {
+	return &Ledger{
+		conf:   conf,
+		ledger: ledger,
+	}
+}
+
+func (l *Ledger) CheckInvariant() {
+	if _, err := l.ledger.CheckInvariant(context.Background()); err != nil {
+		logger.Log.Error(errormsg.WriteErr(err))
+	}
+}
@@ -0,0 +1,41 @@
+package reconciliation
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/usecase/reconciliation"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+)
+
+type Reconciliation struct {
+	conf           Conf
+	reconciliation reconciliation.ReconciliationInterface
+}
+
+type Conf struct {
+	ReconcileDaily ReconcileDaily `mapstructure:"reconcile_daily"`
+}
+
+type ReconcileDaily struct {
+	Name     string        `mapstructure:"name"`
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type ReconciliationInterface interface {
+	ReconcileDaily()
+}
+
+func New(conf Conf, reconciliation reconciliation.ReconciliationInterface) ReconciliationInterface {
+	return &Reconciliation{
+		conf:           conf,
+		reconciliation: reconciliation,
+	}
+}
+
+func (r *Reconciliation) ReconcileDaily() {
+	if err := r.reconciliation.ReconcileDaily(context.Background()); err != nil {
+		logger.Log.Error(errormsg.WriteErr(err))
+	}
+}
@@ -1,13 +1,23 @@
 package scheduler
 
 import (
+	"github.com/achwanyusuf/bricksvc/src/presentation/scheduler/fxrate"
+	"github.com/achwanyusuf/bricksvc/src/presentation/scheduler/ledger"
+	"github.com/achwanyusuf/bricksvc/src/presentation/scheduler/reconciliation"
+	"github.com/achwanyusuf/bricksvc/src/presentation/scheduler/settlement"
 	"github.com/achwanyusuf/bricksvc/src/presentation/scheduler/transfer"
+	"github.com/achwanyusuf/bricksvc/src/presentation/scheduler/webhook"
 	"github.com/achwanyusuf/bricksvc/src/usecase"
 	"github.com/achwanyusuf/bricksvc/utils/schedulerengine"
 )
 
 type SchedulerHandlerInterface struct {
-	Transfer transfer.TransferInterface
+	Transfer       transfer.TransferInterface
+	Webhook        webhook.WebhookInterface
+	FXRate         fxrate.FXRateInterface
+	Ledger         ledger.LedgerInterface
+	Reconciliation reconciliation.ReconciliationInterface
+	Settlement     settlement.SettlementInterface
 }
 
 type Scheduler struct {
@@ -17,16 +27,35 @@ type Scheduler struct {
 }
 
 type Conf struct {
-	Transfer transfer.Conf `mapstructure:"transfer"`
+	Transfer       transfer.Conf       `mapstructure:"transfer"`
+	Webhook        webhook.Conf        `mapstructure:"webhook"`
+	FXRate         fxrate.Conf         `mapstructure:"fx_rate"`
+	Ledger         ledger.Conf         `mapstructure:"ledger"`
+	Reconciliation reconciliation.Conf `mapstructure:"reconciliation"`
+	Settlement     settlement.Conf     `mapstructure:"settlement"`
 }
 
 func (s *Scheduler) Serve(sHandler SchedulerHandlerInterface) {
 	s.Scheduler.Schedule(s.Conf.Transfer.GetTransferCallback.Name, s.Conf.Transfer.GetTransferCallback.Interval, sHandler.Transfer.Transfer)
+	s.Scheduler.Schedule(s.Conf.Transfer.RelayOutbox.Name, s.Conf.Transfer.RelayOutbox.Interval, sHandler.Transfer.RelayOutbox)
+	s.Scheduler.Schedule(s.Conf.Transfer.ReleaseScheduled.Name, s.Conf.Transfer.ReleaseScheduled.Interval, sHandler.Transfer.ReleaseScheduled)
+	s.Scheduler.Schedule(s.Conf.Transfer.MaterializeRecurring.Name, s.Conf.Transfer.MaterializeRecurring.Interval, sHandler.Transfer.MaterializeRecurring)
+	s.Scheduler.Schedule(s.Conf.Transfer.RetrySubmission.Name, s.Conf.Transfer.RetrySubmission.Interval, sHandler.Transfer.RetrySubmission)
+	s.Scheduler.Schedule(s.Conf.Webhook.Deliver.Name, s.Conf.Webhook.Deliver.Interval, sHandler.Webhook.Deliver)
+	s.Scheduler.Schedule(s.Conf.FXRate.LoadRates.Name, s.Conf.FXRate.LoadRates.Interval, sHandler.FXRate.LoadRates)
+	s.Scheduler.Schedule(s.Conf.Ledger.CheckInvariant.Name, s.Conf.Ledger.CheckInvariant.Interval, sHandler.Ledger.CheckInvariant)
+	s.Scheduler.Schedule(s.Conf.Reconciliation.ReconcileDaily.Name, s.Conf.Reconciliation.ReconcileDaily.Interval, sHandler.Reconciliation.ReconcileDaily)
+	s.Scheduler.Schedule(s.Conf.Settlement.WriteDaily.Name, s.Conf.Settlement.WriteDaily.Interval, sHandler.Settlement.WriteDaily)
 }
 
 func New(scheduler *Scheduler) {
 	handlers := SchedulerHandlerInterface{
-		Transfer: transfer.New(scheduler.Conf.Transfer, scheduler.Usecase.Transfer),
+		Transfer:       transfer.New(scheduler.Conf.Transfer, scheduler.Usecase.Transfer),
+		Webhook:        webhook.New(scheduler.Conf.Webhook, scheduler.Usecase.Webhook),
+		FXRate:         fxrate.New(scheduler.Conf.FXRate, scheduler.Usecase.FXRate),
+		Ledger:         ledger.New(scheduler.Conf.Ledger, scheduler.Usecase.Ledger),
+		Reconciliation: reconciliation.New(scheduler.Conf.Reconciliation, scheduler.Usecase.Reconciliation),
+		Settlement:     settlement.New(scheduler.Conf.Settlement, scheduler.Usecase.Settlement),
 	}
 	scheduler.Serve(handlers)
 }
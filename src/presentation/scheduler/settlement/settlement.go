@@ -0,0 +1,41 @@
+package settlement
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/usecase/settlement"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+)
+
+type Settlement struct {
+	conf       Conf
+	settlement settlement.SettlementInterface
+}
+
+type Conf struct {
+	WriteDaily WriteDaily `mapstructure:"write_daily"`
+}
+
+type WriteDaily struct {
+	Name     string        `mapstructure:"name"`
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type SettlementInterface interface {
+	WriteDaily()
+}
+
+func New(conf Conf, settlement settlement.SettlementInterface) SettlementInterface {
+	return &Settlement{
+		conf:       conf,
+		settlement: settlement,
+	}
+}
+
+func (s *Settlement) WriteDaily() {
+	if err := s.settlement.WriteDaily(context.Background()); err != nil {
+		logger.Log.Error(errormsg.WriteErr(err))
+	}
+}
@@ -16,10 +16,39 @@ type Transfer struct {
 }
 
 type Conf struct {
-	GetTransferCallback GetTransferCallback `mapstructure:"get_transfer_callback"`
+	GetTransferCallback  GetTransferCallback  `mapstructure:"get_transfer_callback"`
+	RelayOutbox          RelayOutbox          `mapstructure:"relay_outbox"`
+	ReleaseScheduled     ReleaseScheduled     `mapstructure:"release_scheduled"`
+	MaterializeRecurring MaterializeRecurring `mapstructure:"materialize_recurring"`
+	RetrySubmission      RetrySubmission      `mapstructure:"retry_submission"`
 }
 
 type GetTransferCallback struct {
+	Name          string        `mapstructure:"name"`
+	Interval      time.Duration `mapstructure:"interval"`
+	Limit         int64         `mapstructure:"limit"`
+	FallbackAfter time.Duration `mapstructure:"fallback_after"`
+}
+
+type RelayOutbox struct {
+	Name     string        `mapstructure:"name"`
+	Interval time.Duration `mapstructure:"interval"`
+	Limit    int64         `mapstructure:"limit"`
+}
+
+type ReleaseScheduled struct {
+	Name     string        `mapstructure:"name"`
+	Interval time.Duration `mapstructure:"interval"`
+	Limit    int64         `mapstructure:"limit"`
+}
+
+type MaterializeRecurring struct {
+	Name     string        `mapstructure:"name"`
+	Interval time.Duration `mapstructure:"interval"`
+	Limit    int64         `mapstructure:"limit"`
+}
+
+type RetrySubmission struct {
 	Name     string        `mapstructure:"name"`
 	Interval time.Duration `mapstructure:"interval"`
 	Limit    int64         `mapstructure:"limit"`
@@ -27,6 +56,10 @@ type GetTransferCallback struct {
 
 type TransferInterface interface {
 	Transfer()
+	RelayOutbox()
+	ReleaseScheduled()
+	MaterializeRecurring()
+	RetrySubmission()
 }
 
 func New(conf Conf, transfer transfer.TransferInterface) TransferInterface {
@@ -38,12 +71,33 @@ func New(conf Conf, transfer transfer.TransferInterface) TransferInterface {
 
 func (t *Transfer) Transfer() {
 	tNow := time.Now()
-	t.transfer.ProccessGetCallback(context.Background(), &model.GetTransferJobsByParam{
-		GetTransferJobByParam: model.GetTransferJobByParam{
-			Status:      null.StringFrom(entity.TransferstatusPending.String()),
-			CreatedAtGT: null.TimeFrom(time.Date(tNow.Year(), tNow.Month(), tNow.Day(), 0, 0, 0, 0, time.UTC)),
-			CreatedAtLT: null.TimeFrom(time.Date(tNow.Year(), tNow.Month(), tNow.Day()+1, 0, 0, 0, 0, time.UTC)),
-		},
-		Limit: t.conf.GetTransferCallback.Limit,
-	})
+	for _, status := range []entity.Transferstatus{entity.TransferstatusPending, entity.TransferstatusProcessing} {
+		param := &model.GetTransferJobsByParam{
+			GetTransferJobByParam: model.GetTransferJobByParam{
+				Status: null.StringFrom(status.String()),
+			},
+			Limit: t.conf.GetTransferCallback.Limit,
+		}
+		// jobs updated recently are left to the provider callback, polling only picks up the ones it missed
+		if t.conf.GetTransferCallback.FallbackAfter > 0 {
+			param.UpdatedAtLT = null.TimeFrom(tNow.UTC().Add(-t.conf.GetTransferCallback.FallbackAfter))
+		}
+		t.transfer.ProccessGetCallback(context.Background(), param)
+	}
+}
+
+func (t *Transfer) RelayOutbox() {
+	t.transfer.RelayOutbox(context.Background(), t.conf.RelayOutbox.Limit)
+}
+
+func (t *Transfer) ReleaseScheduled() {
+	t.transfer.ReleaseScheduled(context.Background(), t.conf.ReleaseScheduled.Limit)
+}
+
+func (t *Transfer) MaterializeRecurring() {
+	t.transfer.MaterializeRecurring(context.Background(), t.conf.MaterializeRecurring.Limit)
+}
+
+func (t *Transfer) RetrySubmission() {
+	t.transfer.RetrySubmission(context.Background(), t.conf.RetrySubmission.Limit)
 }
@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/usecase/webhook"
+)
+
+type Webhook struct {
+	conf    Conf
+	webhook webhook.WebhookInterface
+}
+
+type Conf struct {
+	Deliver Deliver `mapstructure:"deliver"`
+}
+
+type Deliver struct {
+	Name     string        `mapstructure:"name"`
+	Interval time.Duration `mapstructure:"interval"`
+	Limit    int64         `mapstructure:"limit"`
+}
+
+type WebhookInterface interface {
+	Deliver()
+}
+
+func New(conf Conf, webhook webhook.WebhookInterface) WebhookInterface {
+	return &Webhook{
+		conf:    conf,
+		webhook: webhook,
+	}
+}
+
+func (w *Webhook) Deliver() {
+	w.webhook.Deliver(context.Background(), w.conf.Deliver.Limit)
+}
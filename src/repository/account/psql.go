@@ -102,6 +102,15 @@ func (a *Account) deletePSQL(ctx context.Context, account *entity.Account, id in
 
 func (a *Account) getByParamPSQL(ctx context.Context, param *model.GetAccountsByParam) (entity.AccountSlice, model.Pagination, error) {
 	var totalPages int64 = 1
+	useCursor, err := param.UseCursor(param.Page, param.OrderBy)
+	if err != nil {
+		return entity.AccountSlice{}, model.Pagination{}, err
+	}
+
+	if useCursor {
+		return a.getByCursorPSQL(ctx, param)
+	}
+
 	if param.Limit == 0 {
 		param.Limit = int64(a.Conf.DefaultPageLimit)
 	}
@@ -125,7 +134,7 @@ func (a *Account) getByParamPSQL(ctx context.Context, param *model.GetAccountsBy
 		return accounts, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get accounts")
 	}
 	if count > 0 {
-		totalPages = (count / param.Limit) + 1
+		totalPages = model.TotalPages(count, param.Limit)
 	}
 	return accounts, model.Pagination{
 		CurrentPage:     param.Page,
@@ -135,3 +144,33 @@ func (a *Account) getByParamPSQL(ctx context.Context, param *model.GetAccountsBy
 		SortBy:          param.OrderBy.String,
 	}, nil
 }
+
+// getByCursorPSQL reads the page after or before the cursor without counting, the total is estimated when asked for
+func (a *Account) getByCursorPSQL(ctx context.Context, param *model.GetAccountsByParam) (entity.AccountSlice, model.Pagination, error) {
+	if param.Limit == 0 {
+		param.Limit = int64(a.Conf.DefaultPageLimit)
+	}
+
+	qr := param.GetQuery()
+	cursorQr, cursor, err := param.CursorQuery(param.Limit)
+	if err != nil {
+		return entity.AccountSlice{}, model.Pagination{}, err
+	}
+
+	accounts, err := entity.Accounts(append(qr, cursorQr...)...).All(ctx, a.DB)
+	if err != nil {
+		return accounts, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get accounts")
+	}
+
+	accounts, pagination := model.CursorPage(&param.CursorParam, cursor, accounts, param.Limit, func(v *entity.Account) model.Cursor {
+		return model.Cursor{CreatedAt: v.CreatedAt, ID: int64(v.ID)}
+	})
+	if param.WithTotal {
+		count, err := model.EstimateCount(ctx, a.DB, entity.Accounts(qr...).Query)
+		if err != nil {
+			return accounts, model.Pagination{}, err
+		}
+		pagination.EstimatedTotal = null.Int64From(count)
+	}
+	return accounts, pagination, nil
+}
@@ -0,0 +1,140 @@
+package accountlimit
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/volatiletech/null/v8"
+
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+type AccountLimit struct {
+	DB    *sql.DB
+	Redis *goredislib.Client
+	Conf  Conf
+}
+
+type Conf struct {
+	Default map[string]model.TransferLimit `mapstructure:"default"`
+}
+
+type AccountLimitInterface interface {
+	Upsert(ctx context.Context, data *entity.AccountLimit) error
+	GetSingleByParam(ctx context.Context, param *model.GetAccountLimitByParam) (entity.AccountLimit, error)
+	GetLimit(ctx context.Context, accountID int, currency string) (entity.AccountLimit, error)
+	GetLimits(ctx context.Context, accountID int) (entity.AccountLimitSlice, error)
+	Delete(ctx context.Context, v *entity.AccountLimit) error
+	GetUsage(ctx context.Context, accountID int, apikey string, currency string, at time.Time) (model.TransferLimitUsage, error)
+	Reserve(ctx context.Context, apikey string, limit *entity.AccountLimit, amount int64, at time.Time) error
+	Release(ctx context.Context, accountID int, currency string, amount int64, at time.Time) error
+}
+
+func New(conf Conf, db *sql.DB, rds *goredislib.Client) AccountLimitInterface {
+	// viper lowercases map keys, currency codes are uppercase
+	defaults := make(map[string]model.TransferLimit, len(conf.Default))
+	for currency, limit := range conf.Default {
+		defaults[strings.ToUpper(currency)] = limit
+	}
+	conf.Default = defaults
+
+	return &AccountLimit{
+		DB:    db,
+		Redis: rds,
+		Conf:  conf,
+	}
+}
+
+// Upsert overrides the limit of the account in the currency
+func (a *AccountLimit) Upsert(ctx context.Context, data *entity.AccountLimit) error {
+	return a.upsertPSQL(ctx, data)
+}
+
+func (a *AccountLimit) GetSingleByParam(ctx context.Context, param *model.GetAccountLimitByParam) (entity.AccountLimit, error) {
+	return a.getSingleByParamPSQL(ctx, param)
+}
+
+// GetLimit returns the override of the account, or the configured default of the currency without an id
+func (a *AccountLimit) GetLimit(ctx context.Context, accountID int, currency string) (entity.AccountLimit, error) {
+	res, err := a.getSingleByParamPSQL(ctx, &model.GetAccountLimitByParam{
+		AccountID: null.Int64From(int64(accountID)),
+		Currency:  null.StringFrom(currency),
+	})
+	if err == nil {
+		return res, nil
+	}
+
+	if errormsg.GetErrorData(err).Code != svcerr.CodeNotFound {
+		return res, err
+	}
+	return a.defaultLimit(accountID, currency), nil
+}
+
+// GetLimits returns the overrides of the account merged with the defaults of the other configured currencies
+func (a *AccountLimit) GetLimits(ctx context.Context, accountID int) (entity.AccountLimitSlice, error) {
+	res, err := a.getByAccountIDPSQL(ctx, accountID)
+	if err != nil {
+		return res, err
+	}
+
+	overridden := make(map[string]bool, len(res))
+	for _, v := range res {
+		overridden[v.Currency] = true
+	}
+	for currency := range a.Conf.Default {
+		if !overridden[currency] {
+			limit := a.defaultLimit(accountID, currency)
+			res = append(res, &limit)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Currency < res[j].Currency
+	})
+	return res, nil
+}
+
+// Delete removes the override so the account falls back to the default limit
+func (a *AccountLimit) Delete(ctx context.Context, v *entity.AccountLimit) error {
+	return a.deletePSQL(ctx, v)
+}
+
+func (a *AccountLimit) GetUsage(ctx context.Context, accountID int, apikey string, currency string, at time.Time) (model.TransferLimitUsage, error) {
+	key := model.AccountLimitUsageKey(accountID, currency, at)
+	if err := a.seedUsageRedis(ctx, key, apikey, currency, at); err != nil {
+		return model.TransferLimitUsage{}, err
+	}
+	return a.getUsageRedis(ctx, key)
+}
+
+// Reserve counts the amount against the daily limits of the day of at, the check and the increment are a single redis script so concurrent transfers cannot both pass the last slot
+func (a *AccountLimit) Reserve(ctx context.Context, apikey string, limit *entity.AccountLimit, amount int64, at time.Time) error {
+	key := model.AccountLimitUsageKey(limit.AccountID, limit.Currency, at)
+	if err := a.seedUsageRedis(ctx, key, apikey, limit.Currency, at); err != nil {
+		return err
+	}
+	return a.reserveRedis(ctx, key, limit, amount)
+}
+
+// Release gives back a reservation whose transfer was not stored
+func (a *AccountLimit) Release(ctx context.Context, accountID int, currency string, amount int64, at time.Time) error {
+	return a.releaseRedis(ctx, model.AccountLimitUsageKey(accountID, currency, at), amount)
+}
+
+func (a *AccountLimit) defaultLimit(accountID int, currency string) entity.AccountLimit {
+	limit := a.Conf.Default[currency]
+	return entity.AccountLimit{
+		AccountID:         accountID,
+		Currency:          currency,
+		MaxPerTransaction: limit.MaxPerTransaction,
+		DailyAmount:       limit.DailyAmount,
+		DailyCount:        int(limit.DailyCount),
+	}
+}
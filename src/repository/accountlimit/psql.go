@@ -0,0 +1,107 @@
+package accountlimit
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (a *AccountLimit) upsertPSQL(ctx context.Context, data *entity.AccountLimit) error {
+	tx, err := a.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = data.Upsert(ctx, tx, true, []string{
+		entity.AccountLimitColumns.AccountID,
+		entity.AccountLimitColumns.Currency,
+	}, boil.Whitelist(
+		entity.AccountLimitColumns.MaxPerTransaction,
+		entity.AccountLimitColumns.DailyAmount,
+		entity.AccountLimitColumns.DailyCount,
+		entity.AccountLimitColumns.UpdatedBy,
+		entity.AccountLimitColumns.UpdatedAt,
+	), boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error upsert")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (a *AccountLimit) getSingleByParamPSQL(ctx context.Context, param *model.GetAccountLimitByParam) (entity.AccountLimit, error) {
+	var res entity.AccountLimit
+	limit, err := entity.AccountLimits(param.GetQuery()...).One(ctx, a.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "error get accountLimits")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get accountLimits")
+	}
+
+	return *limit, nil
+}
+
+func (a *AccountLimit) getByAccountIDPSQL(ctx context.Context, accountID int) (entity.AccountLimitSlice, error) {
+	limits, err := entity.AccountLimits(
+		qm.Where("account_id=?", accountID),
+		qm.OrderBy("currency"),
+	).All(ctx, a.DB)
+	if err != nil {
+		return limits, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get accountLimits")
+	}
+	return limits, nil
+}
+
+func (a *AccountLimit) deletePSQL(ctx context.Context, v *entity.AccountLimit) error {
+	tx, err := a.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	// the currency is unique per account, a soft deleted row would block overriding it again
+	_, err = v.Delete(ctx, tx, true)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorDelete, err, "error delete")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+// getUsagePSQL rebuilds the usage of the day from the stored jobs, every job created that day counts whatever its status
+func (a *AccountLimit) getUsagePSQL(ctx context.Context, apikey string, currency string, at time.Time) (model.TransferLimitUsage, error) {
+	var res model.TransferLimitUsage
+	start, end := model.AccountLimitDay(at)
+	err := entity.TransferJobs(
+		qm.Select("COALESCE(SUM((payload->>'amount')::bigint), 0) AS amount", "COUNT(*) AS count"),
+		qm.Where("api_key=?", apikey),
+		qm.Where("payload->>'currency'=?", currency),
+		qm.Where("created_at>=?", start),
+		qm.Where("created_at<?", end),
+	).Bind(ctx, a.DB, &res)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transfer usage")
+	}
+	return res, nil
+}
@@ -0,0 +1,99 @@
+package accountlimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+const (
+	usageFieldAmount string = "amount"
+	usageFieldCount  string = "count"
+)
+
+// reserveScript returns 1 when the daily amount and 2 when the daily count would be exceeded, nothing is counted then
+var reserveScript = goredislib.NewScript(`
+local amount = tonumber(redis.call('HGET', KEYS[1], 'amount') or '0')
+local count = tonumber(redis.call('HGET', KEYS[1], 'count') or '0')
+local add = tonumber(ARGV[1])
+if tonumber(ARGV[2]) > 0 and amount + add > tonumber(ARGV[2]) then
+	return 1
+end
+if tonumber(ARGV[3]) > 0 and count + 1 > tonumber(ARGV[3]) then
+	return 2
+end
+redis.call('HINCRBY', KEYS[1], 'amount', add)
+redis.call('HINCRBY', KEYS[1], 'count', 1)
+redis.call('EXPIRE', KEYS[1], ARGV[4])
+return 0
+`)
+
+// seedUsageRedis starts a missing counter from the jobs already stored, set only if absent so a concurrent reservation is not overwritten
+func (a *AccountLimit) seedUsageRedis(ctx context.Context, key string, apikey string, currency string, at time.Time) error {
+	exists, err := a.Redis.Exists(ctx, key).Result()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get transfer usage")
+	}
+	if exists > 0 {
+		return nil
+	}
+
+	usage, err := a.getUsagePSQL(ctx, apikey, currency, at)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.Redis.TxPipelined(ctx, func(pipe goredislib.Pipeliner) error {
+		pipe.HSetNX(ctx, key, usageFieldAmount, usage.Amount)
+		pipe.HSetNX(ctx, key, usageFieldCount, usage.Count)
+		pipe.Expire(ctx, key, model.AccountLimitUsageRetention)
+		return nil
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error set transfer usage")
+	}
+	return nil
+}
+
+func (a *AccountLimit) getUsageRedis(ctx context.Context, key string) (model.TransferLimitUsage, error) {
+	var res model.TransferLimitUsage
+	err := a.Redis.HMGet(ctx, key, usageFieldAmount, usageFieldCount).Scan(&res)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get transfer usage")
+	}
+	return res, nil
+}
+
+func (a *AccountLimit) reserveRedis(ctx context.Context, key string, limit *entity.AccountLimit, amount int64) error {
+	res, err := reserveScript.Run(ctx, a.Redis, []string{key}, amount, limit.DailyAmount, limit.DailyCount, int64(model.AccountLimitUsageRetention.Seconds())).Int()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error reserve transfer usage")
+	}
+
+	switch res {
+	case 1:
+		return errormsg.WrapErr(svcerr.BrickSVCDailyAmountLimitExceeded, nil, fmt.Sprintf("daily limit of %d %s reached", limit.DailyAmount, limit.Currency))
+	case 2:
+		return errormsg.WrapErr(svcerr.BrickSVCDailyCountLimitExceeded, nil, fmt.Sprintf("daily limit of %d transfers in %s reached", limit.DailyCount, limit.Currency))
+	}
+	return nil
+}
+
+func (a *AccountLimit) releaseRedis(ctx context.Context, key string, amount int64) error {
+	_, err := a.Redis.TxPipelined(ctx, func(pipe goredislib.Pipeliner) error {
+		pipe.HIncrBy(ctx, key, usageFieldAmount, -amount)
+		pipe.HIncrBy(ctx, key, usageFieldCount, -1)
+		return nil
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error release transfer usage")
+	}
+	return nil
+}
@@ -102,6 +102,15 @@ func (a *AccountRole) deletePSQL(ctx context.Context, account *entity.AccountRol
 
 func (a *AccountRole) getByParamPSQL(ctx context.Context, param *model.GetAccountRolesByParam) (entity.AccountRoleSlice, model.Pagination, error) {
 	var totalPages int64 = 1
+	useCursor, err := param.UseCursor(param.Page, param.OrderBy)
+	if err != nil {
+		return entity.AccountRoleSlice{}, model.Pagination{}, err
+	}
+
+	if useCursor {
+		return a.getByCursorPSQL(ctx, param)
+	}
+
 	if param.Limit == 0 {
 		param.Limit = int64(a.Conf.DefaultPageLimit)
 	}
@@ -125,7 +134,7 @@ func (a *AccountRole) getByParamPSQL(ctx context.Context, param *model.GetAccoun
 		return accounts, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get accounts")
 	}
 	if count > 0 {
-		totalPages = (count / param.Limit) + 1
+		totalPages = model.TotalPages(count, param.Limit)
 	}
 	return accounts, model.Pagination{
 		CurrentPage:     param.Page,
@@ -135,3 +144,33 @@ func (a *AccountRole) getByParamPSQL(ctx context.Context, param *model.GetAccoun
 		SortBy:          param.OrderBy.String,
 	}, nil
 }
+
+// getByCursorPSQL reads the page after or before the cursor without counting, the total is estimated when asked for
+func (a *AccountRole) getByCursorPSQL(ctx context.Context, param *model.GetAccountRolesByParam) (entity.AccountRoleSlice, model.Pagination, error) {
+	if param.Limit == 0 {
+		param.Limit = int64(a.Conf.DefaultPageLimit)
+	}
+
+	qr := param.GetQuery()
+	cursorQr, cursor, err := param.CursorQuery(param.Limit)
+	if err != nil {
+		return entity.AccountRoleSlice{}, model.Pagination{}, err
+	}
+
+	accountRoles, err := entity.AccountRoles(append(qr, cursorQr...)...).All(ctx, a.DB)
+	if err != nil {
+		return accountRoles, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get accountRoles")
+	}
+
+	accountRoles, pagination := model.CursorPage(&param.CursorParam, cursor, accountRoles, param.Limit, func(v *entity.AccountRole) model.Cursor {
+		return model.Cursor{CreatedAt: v.CreatedAt, ID: int64(v.ID)}
+	})
+	if param.WithTotal {
+		count, err := model.EstimateCount(ctx, a.DB, entity.AccountRoles(qr...).Query)
+		if err != nil {
+			return accountRoles, model.Pagination{}, err
+		}
+		pagination.EstimatedTotal = null.Int64From(count)
+	}
+	return accountRoles, pagination, nil
+}
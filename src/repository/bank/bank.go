@@ -75,5 +75,5 @@ func (b *Bank) GetBankAccount(ctx context.Context, cacheControl string, v model.
 			return res, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error set redis")
 		}
 	}
-	return res, nil
+	return res, err
 }
@@ -35,5 +35,9 @@ func (b *Bank) getBankAccountHTTPClient(v model.GetBankAccount) (clientresponse.
 		return clientresponse.BankAccount{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
 	}
 
+	if len(bankAccount) == 0 {
+		return clientresponse.BankAccount{}, errormsg.WrapErr(svcerr.BrickSVCNotFound, nil, "bank account not found")
+	}
+
 	return bankAccount[0], nil
 }
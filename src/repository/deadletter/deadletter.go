@@ -0,0 +1,52 @@
+package deadletter
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/utils/kafkalib"
+)
+
+type DeadLetter struct {
+	DB    *sql.DB
+	Conf  Conf
+	Kafka kafkalib.ProducerInterface
+}
+
+type Conf struct {
+	DefaultPageLimit int `mapstructure:"page_limit"`
+}
+
+type DeadLetterInterface interface {
+	Insert(ctx context.Context, data *entity.DeadLetterMessage) error
+	GetSingleByParam(ctx context.Context, param *model.GetDeadLetterMessageByParam) (entity.DeadLetterMessage, error)
+	GetByParam(ctx context.Context, param *model.GetDeadLetterMessagesByParam) (entity.DeadLetterMessageSlice, model.Pagination, error)
+	Replay(ctx context.Context, v *entity.DeadLetterMessage, replayedBy int64) error
+}
+
+func New(conf Conf, db *sql.DB, kafka kafkalib.ProducerInterface) DeadLetterInterface {
+	return &DeadLetter{
+		DB:    db,
+		Conf:  conf,
+		Kafka: kafka,
+	}
+}
+
+func (d *DeadLetter) Insert(ctx context.Context, data *entity.DeadLetterMessage) error {
+	return d.insertPSQL(ctx, data)
+}
+
+func (d *DeadLetter) GetSingleByParam(ctx context.Context, param *model.GetDeadLetterMessageByParam) (entity.DeadLetterMessage, error) {
+	return d.getSingleByParamPSQL(ctx, param)
+}
+
+func (d *DeadLetter) GetByParam(ctx context.Context, param *model.GetDeadLetterMessagesByParam) (entity.DeadLetterMessageSlice, model.Pagination, error) {
+	return d.getByParamPSQL(ctx, param)
+}
+
+// Replay publishes the message back onto its original topic, without the retry headers so it starts over from the first tier
+func (d *DeadLetter) Replay(ctx context.Context, v *entity.DeadLetterMessage, replayedBy int64) error {
+	return d.replayPSQL(ctx, v, replayedBy)
+}
@@ -0,0 +1,17 @@
+package deadletter
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+)
+
+func (d *DeadLetter) publishKafka(ctx context.Context, data *entity.DeadLetterMessage) error {
+	_, err := d.Kafka.Publish(ctx, data.OriginalTopic, []byte(data.MessageKey), []byte(data.Payload))
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package deadletter
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (d *DeadLetter) insertPSQL(ctx context.Context, data *entity.DeadLetterMessage) error {
+	tx, err := d.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	// the dead letter topic is consumed at-least-once, a redelivered message is stored once
+	err = data.Upsert(ctx, tx, false, []string{
+		entity.DeadLetterMessageColumns.Topic,
+		entity.DeadLetterMessageColumns.Partition,
+		entity.DeadLetterMessageColumns.MessageOffset,
+	}, boil.None(), boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (d *DeadLetter) getSingleByParamPSQL(ctx context.Context, param *model.GetDeadLetterMessageByParam) (entity.DeadLetterMessage, error) {
+	var res entity.DeadLetterMessage
+	message, err := entity.DeadLetterMessages(param.GetQuery()...).One(ctx, d.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "error get deadLetterMessages")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get deadLetterMessages")
+	}
+
+	return *message, nil
+}
+
+func (d *DeadLetter) getByParamPSQL(ctx context.Context, param *model.GetDeadLetterMessagesByParam) (entity.DeadLetterMessageSlice, model.Pagination, error) {
+	var totalPages int64 = 1
+	if param.Limit == 0 {
+		param.Limit = int64(d.Conf.DefaultPageLimit)
+	}
+
+	if param.Page == 0 {
+		param.Page = 1
+	}
+
+	qr := param.GetQuery()
+	count, err := entity.DeadLetterMessages(qr...).Count(ctx, d.DB)
+	if err != nil {
+		return entity.DeadLetterMessageSlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error count data")
+	}
+	qr = append(qr, qm.Offset(int((param.Page-1)*param.Limit)))
+	qr = append(qr, qm.Limit(int(param.Limit)))
+	messages, err := entity.DeadLetterMessages(qr...).All(ctx, d.DB)
+	if err != nil {
+		return messages, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get deadLetterMessages")
+	}
+	if count > 0 {
+		totalPages = model.TotalPages(count, param.Limit)
+	}
+	return messages, model.Pagination{
+		CurrentPage:     param.Page,
+		CurrentElements: int64(len(messages)),
+		TotalElements:   count,
+		TotalPages:      totalPages,
+		SortBy:          param.OrderBy.String,
+	}, nil
+}
+
+func (d *DeadLetter) replayPSQL(ctx context.Context, v *entity.DeadLetterMessage, replayedBy int64) error {
+	tx, err := d.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	current, err := entity.DeadLetterMessages(qm.Where("id=?", v.ID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get deadLetterMessages")
+	}
+
+	if current.Status != entity.DeadletterstatusPending {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidStatusTransition, nil, "message already replayed")
+	}
+
+	// the row stays locked while publishing so concurrent replays cannot publish twice
+	err = d.publishKafka(ctx, current)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	current.Status = entity.DeadletterstatusReplayed
+	current.ReplayedAt = null.TimeFrom(time.Now().UTC())
+	current.UpdatedBy = int(replayedBy)
+	_, err = current.Update(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	*v = *current
+	return nil
+}
@@ -0,0 +1,59 @@
+package feerule
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+)
+
+type FeeRule struct {
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct {
+	DefaultPageLimit int `mapstructure:"page_limit"`
+}
+
+type FeeRuleInterface interface {
+	Insert(ctx context.Context, data *entity.FeeRule) error
+	GetSingleByParam(ctx context.Context, param *model.GetFeeRuleByParam) (entity.FeeRule, error)
+	GetByParam(ctx context.Context, param *model.GetFeeRulesByParam) (entity.FeeRuleSlice, model.Pagination, error)
+	Match(ctx context.Context, param *model.MatchFeeRule) (entity.FeeRule, error)
+	Update(ctx context.Context, v *entity.FeeRule) error
+	Delete(ctx context.Context, v *entity.FeeRule, id int64) error
+}
+
+func New(conf Conf, db *sql.DB) FeeRuleInterface {
+	return &FeeRule{
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+func (f *FeeRule) Insert(ctx context.Context, data *entity.FeeRule) error {
+	return f.insertPSQL(ctx, data)
+}
+
+func (f *FeeRule) GetSingleByParam(ctx context.Context, param *model.GetFeeRuleByParam) (entity.FeeRule, error) {
+	return f.getSingleByParamPSQL(ctx, param)
+}
+
+func (f *FeeRule) GetByParam(ctx context.Context, param *model.GetFeeRulesByParam) (entity.FeeRuleSlice, model.Pagination, error) {
+	return f.getByParamPSQL(ctx, param)
+}
+
+// Match returns the most specific rule for the transfer, a rule naming more of bank and plan wins over a wildcard one
+func (f *FeeRule) Match(ctx context.Context, param *model.MatchFeeRule) (entity.FeeRule, error) {
+	return f.matchPSQL(ctx, param)
+}
+
+func (f *FeeRule) Update(ctx context.Context, v *entity.FeeRule) error {
+	return f.updatePSQL(ctx, v)
+}
+
+func (f *FeeRule) Delete(ctx context.Context, v *entity.FeeRule, id int64) error {
+	return f.deletePSQL(ctx, v, id)
+}
@@ -0,0 +1,146 @@
+package feerule
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (f *FeeRule) insertPSQL(ctx context.Context, data *entity.FeeRule) error {
+	tx, err := f.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = data.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (f *FeeRule) getSingleByParamPSQL(ctx context.Context, param *model.GetFeeRuleByParam) (entity.FeeRule, error) {
+	var res entity.FeeRule
+	rule, err := entity.FeeRules(param.GetQuery()...).One(ctx, f.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "error get feeRules")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get feeRules")
+	}
+
+	return *rule, nil
+}
+
+func (f *FeeRule) getByParamPSQL(ctx context.Context, param *model.GetFeeRulesByParam) (entity.FeeRuleSlice, model.Pagination, error) {
+	var totalPages int64 = 1
+	if param.Limit == 0 {
+		param.Limit = int64(f.Conf.DefaultPageLimit)
+	}
+
+	if param.Page == 0 {
+		param.Page = 1
+	}
+
+	qr := param.GetQuery()
+	count, err := entity.FeeRules(qr...).Count(ctx, f.DB)
+	if err != nil {
+		return entity.FeeRuleSlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error count data")
+	}
+	qr = append(qr, qm.Offset(int((param.Page-1)*param.Limit)))
+	qr = append(qr, qm.Limit(int(param.Limit)))
+	rules, err := entity.FeeRules(qr...).All(ctx, f.DB)
+	if err != nil {
+		return rules, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get feeRules")
+	}
+	if count > 0 {
+		totalPages = model.TotalPages(count, param.Limit)
+	}
+	return rules, model.Pagination{
+		CurrentPage:     param.Page,
+		CurrentElements: int64(len(rules)),
+		TotalElements:   count,
+		TotalPages:      totalPages,
+		SortBy:          param.OrderBy.String,
+	}, nil
+}
+
+func (f *FeeRule) matchPSQL(ctx context.Context, param *model.MatchFeeRule) (entity.FeeRule, error) {
+	var res entity.FeeRule
+	rule, err := entity.FeeRules(param.GetQuery()...).One(ctx, f.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "no fee rule matches the transfer")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get feeRules")
+	}
+
+	return *rule, nil
+}
+
+func (f *FeeRule) updatePSQL(ctx context.Context, v *entity.FeeRule) error {
+	tx, err := f.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	_, err = v.Update(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (f *FeeRule) deletePSQL(ctx context.Context, v *entity.FeeRule, id int64) error {
+	tx, err := f.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	// rules stay soft deleted so transfer jobs keep pointing to the rule that priced them
+	_, err = v.Delete(ctx, tx, false)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error delete")
+	}
+
+	v.DeletedBy = null.NewInt(int(id), true)
+	_, err = v.Update(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
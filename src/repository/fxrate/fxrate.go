@@ -0,0 +1,55 @@
+package fxrate
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+)
+
+type FXRate struct {
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct {
+	DefaultPageLimit int `mapstructure:"page_limit"`
+}
+
+type FXRateInterface interface {
+	Upsert(ctx context.Context, data *entity.FXRate) error
+	GetSingleByParam(ctx context.Context, param *model.GetFXRateByParam) (entity.FXRate, error)
+	GetByParam(ctx context.Context, param *model.GetFXRatesByParam) (entity.FXRateSlice, model.Pagination, error)
+	GetPair(ctx context.Context, base string, quote string) (entity.FXRate, error)
+	Delete(ctx context.Context, v *entity.FXRate) error
+}
+
+func New(conf Conf, db *sql.DB) FXRateInterface {
+	return &FXRate{
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+// Upsert stores the rate of the currency pair, replacing the previous rate of the same pair
+func (f *FXRate) Upsert(ctx context.Context, data *entity.FXRate) error {
+	return f.upsertPSQL(ctx, data)
+}
+
+func (f *FXRate) GetSingleByParam(ctx context.Context, param *model.GetFXRateByParam) (entity.FXRate, error) {
+	return f.getSingleByParamPSQL(ctx, param)
+}
+
+func (f *FXRate) GetByParam(ctx context.Context, param *model.GetFXRatesByParam) (entity.FXRateSlice, model.Pagination, error) {
+	return f.getByParamPSQL(ctx, param)
+}
+
+// GetPair returns the rate stored for base to quote, or for quote to base when only the inverse pair is known
+func (f *FXRate) GetPair(ctx context.Context, base string, quote string) (entity.FXRate, error) {
+	return f.getPairPSQL(ctx, base, quote)
+}
+
+func (f *FXRate) Delete(ctx context.Context, v *entity.FXRate) error {
+	return f.deletePSQL(ctx, v)
+}
@@ -0,0 +1,129 @@
+package fxrate
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (f *FXRate) upsertPSQL(ctx context.Context, data *entity.FXRate) error {
+	tx, err := f.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = data.Upsert(ctx, tx, true, []string{
+		entity.FXRateColumns.BaseCurrency,
+		entity.FXRateColumns.QuoteCurrency,
+	}, boil.Whitelist(
+		entity.FXRateColumns.Rate,
+		entity.FXRateColumns.FeeBPS,
+		entity.FXRateColumns.Source,
+		entity.FXRateColumns.UpdatedBy,
+		entity.FXRateColumns.UpdatedAt,
+	), boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error upsert")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (f *FXRate) getSingleByParamPSQL(ctx context.Context, param *model.GetFXRateByParam) (entity.FXRate, error) {
+	var res entity.FXRate
+	rate, err := entity.FXRates(param.GetQuery()...).One(ctx, f.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "error get fxRates")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get fxRates")
+	}
+
+	return *rate, nil
+}
+
+func (f *FXRate) getByParamPSQL(ctx context.Context, param *model.GetFXRatesByParam) (entity.FXRateSlice, model.Pagination, error) {
+	var totalPages int64 = 1
+	if param.Limit == 0 {
+		param.Limit = int64(f.Conf.DefaultPageLimit)
+	}
+
+	if param.Page == 0 {
+		param.Page = 1
+	}
+
+	qr := param.GetQuery()
+	count, err := entity.FXRates(qr...).Count(ctx, f.DB)
+	if err != nil {
+		return entity.FXRateSlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error count data")
+	}
+	qr = append(qr, qm.Offset(int((param.Page-1)*param.Limit)))
+	qr = append(qr, qm.Limit(int(param.Limit)))
+	rates, err := entity.FXRates(qr...).All(ctx, f.DB)
+	if err != nil {
+		return rates, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get fxRates")
+	}
+	if count > 0 {
+		totalPages = model.TotalPages(count, param.Limit)
+	}
+	return rates, model.Pagination{
+		CurrentPage:     param.Page,
+		CurrentElements: int64(len(rates)),
+		TotalElements:   count,
+		TotalPages:      totalPages,
+		SortBy:          param.OrderBy.String,
+	}, nil
+}
+
+func (f *FXRate) getPairPSQL(ctx context.Context, base string, quote string) (entity.FXRate, error) {
+	var res entity.FXRate
+	// the pair stored in the asked direction wins over its inverse
+	rate, err := entity.FXRates(
+		qm.Where("(base_currency=? AND quote_currency=?) OR (base_currency=? AND quote_currency=?)", base, quote, quote, base),
+		qm.OrderBy("base_currency=? DESC", base),
+	).One(ctx, f.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCFXRateNotFound, nil, "no rate for "+base+"/"+quote)
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get fxRates")
+	}
+
+	return *rate, nil
+}
+
+func (f *FXRate) deletePSQL(ctx context.Context, v *entity.FXRate) error {
+	tx, err := f.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	// the pair is unique, a soft deleted row would block adding the pair again
+	_, err = v.Delete(ctx, tx, true)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorDelete, err, "error delete")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Ledger struct {
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct {
+	DefaultPageLimit int `mapstructure:"page_limit"`
+}
+
+type LedgerInterface interface {
+	Post(ctx context.Context, exec boil.ContextExecutor, postings []model.LedgerPosting) error
+	GetSingleByParam(ctx context.Context, param *model.GetLedgerAccountByParam) (model.LedgerAccountBalance, error)
+	GetByParam(ctx context.Context, param *model.GetLedgerAccountsByParam) ([]model.LedgerAccountBalance, model.Pagination, error)
+	GetEntriesByParam(ctx context.Context, param *model.GetLedgerEntriesByParam) ([]model.LedgerEntry, model.Pagination, error)
+	CheckInvariant(ctx context.Context) ([]model.LedgerInvariant, error)
+}
+
+func New(conf Conf, db *sql.DB) LedgerInterface {
+	return &Ledger{
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+// Post books the postings with exec, the caller passes its transaction so the entries commit or roll back with the change they record
+func (l *Ledger) Post(ctx context.Context, exec boil.ContextExecutor, postings []model.LedgerPosting) error {
+	return l.postPSQL(ctx, exec, postings)
+}
+
+func (l *Ledger) GetSingleByParam(ctx context.Context, param *model.GetLedgerAccountByParam) (model.LedgerAccountBalance, error) {
+	return l.getSingleByParamPSQL(ctx, param)
+}
+
+func (l *Ledger) GetByParam(ctx context.Context, param *model.GetLedgerAccountsByParam) ([]model.LedgerAccountBalance, model.Pagination, error) {
+	return l.getByParamPSQL(ctx, param)
+}
+
+func (l *Ledger) GetEntriesByParam(ctx context.Context, param *model.GetLedgerEntriesByParam) ([]model.LedgerEntry, model.Pagination, error) {
+	return l.getEntriesByParamPSQL(ctx, param)
+}
+
+// CheckInvariant sums the entries per currency, the ledger is balanced when debits equal credits in every transaction
+func (l *Ledger) CheckInvariant(ctx context.Context) ([]model.LedgerInvariant, error) {
+	res, err := l.checkInvariantPSQL(ctx)
+	if err != nil {
+		return res, err
+	}
+	for i := range res {
+		res[i].Balanced = res[i].Debit == res[i].Credit && res[i].UnbalancedTransactions == 0
+	}
+	return res, nil
+}
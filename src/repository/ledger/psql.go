@@ -0,0 +1,250 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// invariantQuery balances every transaction on its own before summing the currency, so two wrong transactions cannot hide each other
+var invariantQuery string = `SELECT t.currency,
+	COALESCE(SUM(e.debit), 0) AS debit,
+	COALESCE(SUM(e.credit), 0) AS credit,
+	COUNT(*) AS transactions,
+	COUNT(*) FILTER (WHERE e.debit <> e.credit) AS unbalanced_transactions
+FROM ledger_transactions t
+INNER JOIN (
+	SELECT ledger_transaction_id,
+		SUM(amount) FILTER (WHERE direction = 'debit') AS debit,
+		SUM(amount) FILTER (WHERE direction = 'credit') AS credit
+	FROM ledger_entries
+	GROUP BY ledger_transaction_id
+) e ON e.ledger_transaction_id = t.id
+GROUP BY t.currency
+ORDER BY t.currency`
+
+func ledgerAccountKey(kind entity.Ledgeraccountkind, apikey string, currency string) string {
+	return kind.String() + ":" + apikey + ":" + currency
+}
+
+func balanceQuery(qr []qm.QueryMod) []qm.QueryMod {
+	return append([]qm.QueryMod{
+		qm.Select(
+			"ledger_accounts.id",
+			"ledger_accounts.kind",
+			"ledger_accounts.api_key",
+			"ledger_accounts.currency",
+			"COALESCE(SUM(ledger_entries.amount) FILTER (WHERE ledger_entries.direction = 'debit'), 0) AS debit",
+			"COALESCE(SUM(ledger_entries.amount) FILTER (WHERE ledger_entries.direction = 'credit'), 0) AS credit",
+			"ledger_accounts.created_at",
+			"ledger_accounts.updated_at",
+		),
+		qm.LeftOuterJoin("ledger_entries ON ledger_entries.ledger_account_id = ledger_accounts.id"),
+		qm.GroupBy("ledger_accounts.id"),
+	}, qr...)
+}
+
+func (l *Ledger) postPSQL(ctx context.Context, exec boil.ContextExecutor, postings []model.LedgerPosting) error {
+	accounts := map[string]int{}
+	for i := range postings {
+		p := &postings[i]
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		if origin, ok := model.LedgerPostingOrigin[p.Type]; ok && p.TransferJobID.Valid {
+			booked, err := entity.LedgerTransactions(qm.Where("transfer_job_id=?", p.TransferJobID.Int), qm.Where("type=?", origin)).Exists(ctx, exec)
+			if err != nil {
+				return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get ledgerTransactions")
+			}
+			// nothing left the merchant for this job, so there is nothing to settle or give back
+			if !booked {
+				continue
+			}
+		}
+
+		// accounts are created in a fixed order so two first postings cannot wait on each other
+		lines := make([]model.LedgerLine, len(p.Lines))
+		copy(lines, p.Lines)
+		sort.SliceStable(lines, func(a, b int) bool {
+			return ledgerAccountKey(lines[a].Kind, lines[a].APIKey, "") < ledgerAccountKey(lines[b].Kind, lines[b].APIKey, "")
+		})
+		for _, line := range lines {
+			key := ledgerAccountKey(line.Kind, line.APIKey, p.Currency)
+			if _, ok := accounts[key]; ok {
+				continue
+			}
+			account, err := l.getOrCreateAccountPSQL(ctx, exec, line.Kind, line.APIKey, p.Currency)
+			if err != nil {
+				return err
+			}
+			accounts[key] = account.ID
+		}
+
+		transaction := p.ToEntity()
+		if err := transaction.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert ledger transaction")
+		}
+
+		for _, line := range p.Lines {
+			entry := entity.LedgerEntry{
+				LedgerTransactionID: transaction.ID,
+				LedgerAccountID:     accounts[ledgerAccountKey(line.Kind, line.APIKey, p.Currency)],
+				Direction:           line.Direction,
+				Amount:              line.Amount,
+			}
+			if err := entry.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert ledger entry")
+			}
+		}
+	}
+	return nil
+}
+
+func (l *Ledger) getOrCreateAccountPSQL(ctx context.Context, exec boil.ContextExecutor, kind entity.Ledgeraccountkind, apikey string, currency string) (entity.LedgerAccount, error) {
+	qr := []qm.QueryMod{
+		qm.Where("kind=?", kind),
+		qm.Where("api_key=?", apikey),
+		qm.Where("currency=?", currency),
+	}
+	account, err := entity.LedgerAccounts(qr...).One(ctx, exec)
+	if err == nil {
+		return *account, nil
+	}
+
+	if err != sql.ErrNoRows {
+		return entity.LedgerAccount{}, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get ledgerAccounts")
+	}
+
+	account = &entity.LedgerAccount{
+		Kind:     kind,
+		APIKey:   apikey,
+		Currency: currency,
+	}
+	err = account.Upsert(ctx, exec, false, []string{entity.LedgerAccountColumns.Kind, entity.LedgerAccountColumns.APIKey, entity.LedgerAccountColumns.Currency}, boil.None(), boil.Infer())
+	if err != nil {
+		return entity.LedgerAccount{}, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert ledger account")
+	}
+
+	if account.ID != 0 {
+		return *account, nil
+	}
+
+	// another transaction created the account first
+	account, err = entity.LedgerAccounts(qr...).One(ctx, exec)
+	if err != nil {
+		return entity.LedgerAccount{}, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get ledgerAccounts")
+	}
+	return *account, nil
+}
+
+func (l *Ledger) getSingleByParamPSQL(ctx context.Context, param *model.GetLedgerAccountByParam) (model.LedgerAccountBalance, error) {
+	var res model.LedgerAccountBalance
+	err := entity.LedgerAccounts(balanceQuery(param.GetQuery())...).Bind(ctx, l.DB, &res)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "error get ledgerAccounts")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get ledgerAccounts")
+	}
+
+	return res, nil
+}
+
+func (l *Ledger) getByParamPSQL(ctx context.Context, param *model.GetLedgerAccountsByParam) ([]model.LedgerAccountBalance, model.Pagination, error) {
+	var totalPages int64 = 1
+	var res []model.LedgerAccountBalance
+	if param.Limit == 0 {
+		param.Limit = int64(l.Conf.DefaultPageLimit)
+	}
+
+	if param.Page == 0 {
+		param.Page = 1
+	}
+
+	qr := param.GetQuery()
+	count, err := entity.LedgerAccounts(qr...).Count(ctx, l.DB)
+	if err != nil {
+		return res, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error count data")
+	}
+	qr = balanceQuery(qr)
+	qr = append(qr, qm.OrderBy("ledger_accounts.kind, ledger_accounts.api_key, ledger_accounts.currency"))
+	qr = append(qr, qm.Offset(int((param.Page-1)*param.Limit)))
+	qr = append(qr, qm.Limit(int(param.Limit)))
+	err = entity.LedgerAccounts(qr...).Bind(ctx, l.DB, &res)
+	if err != nil {
+		return res, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get ledgerAccounts")
+	}
+	if count > 0 {
+		totalPages = model.TotalPages(count, param.Limit)
+	}
+	return res, model.Pagination{
+		CurrentPage:     param.Page,
+		CurrentElements: int64(len(res)),
+		TotalElements:   count,
+		TotalPages:      totalPages,
+	}, nil
+}
+
+func (l *Ledger) getEntriesByParamPSQL(ctx context.Context, param *model.GetLedgerEntriesByParam) ([]model.LedgerEntry, model.Pagination, error) {
+	var totalPages int64 = 1
+	var res []model.LedgerEntry
+	if param.Limit == 0 {
+		param.Limit = int64(l.Conf.DefaultPageLimit)
+	}
+
+	if param.Page == 0 {
+		param.Page = 1
+	}
+
+	qr := param.GetQuery()
+	count, err := entity.LedgerEntries(qr...).Count(ctx, l.DB)
+	if err != nil {
+		return res, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error count data")
+	}
+	qr = append(qr, qm.Select(
+		"ledger_entries.id",
+		"ledger_transactions.transaction_id",
+		"ledger_transactions.type",
+		"ledger_transactions.transfer_job_id",
+		"ledger_transactions.description",
+		"ledger_entries.direction",
+		"ledger_entries.amount",
+		"ledger_transactions.currency",
+		"ledger_entries.created_at",
+	))
+	qr = append(qr, qm.OrderBy("ledger_entries.id DESC"))
+	qr = append(qr, qm.Offset(int((param.Page-1)*param.Limit)))
+	qr = append(qr, qm.Limit(int(param.Limit)))
+	err = entity.LedgerEntries(qr...).Bind(ctx, l.DB, &res)
+	if err != nil {
+		return res, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get ledgerEntries")
+	}
+	if count > 0 {
+		totalPages = model.TotalPages(count, param.Limit)
+	}
+	return res, model.Pagination{
+		CurrentPage:     param.Page,
+		CurrentElements: int64(len(res)),
+		TotalElements:   count,
+		TotalPages:      totalPages,
+	}, nil
+}
+
+func (l *Ledger) checkInvariantPSQL(ctx context.Context) ([]model.LedgerInvariant, error) {
+	var res []model.LedgerInvariant
+	err := queries.Raw(invariantQuery).Bind(ctx, l.DB, &res)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error check ledger invariant")
+	}
+	return res, nil
+}
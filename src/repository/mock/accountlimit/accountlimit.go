@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/repository/accountlimit/accountlimit.go
+
+// Package mock_accountlimit is a generated GoMock package.
+package mock_accountlimit
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/achwanyusuf/bricksvc/src/domain/entity"
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAccountLimitInterface is a mock of AccountLimitInterface interface.
+type MockAccountLimitInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountLimitInterfaceMockRecorder
+}
+
+// MockAccountLimitInterfaceMockRecorder is the mock recorder for MockAccountLimitInterface.
+type MockAccountLimitInterfaceMockRecorder struct {
+	mock *MockAccountLimitInterface
+}
+
+// NewMockAccountLimitInterface creates a new mock instance.
+func NewMockAccountLimitInterface(ctrl *gomock.Controller) *MockAccountLimitInterface {
+	mock := &MockAccountLimitInterface{ctrl: ctrl}
+	mock.recorder = &MockAccountLimitInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountLimitInterface) EXPECT() *MockAccountLimitInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAccountLimitInterface) Delete(ctx context.Context, v *entity.AccountLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAccountLimitInterfaceMockRecorder) Delete(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccountLimitInterface)(nil).Delete), ctx, v)
+}
+
+// GetLimit mocks base method.
+func (m *MockAccountLimitInterface) GetLimit(ctx context.Context, accountID int, currency string) (entity.AccountLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLimit", ctx, accountID, currency)
+	ret0, _ := ret[0].(entity.AccountLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLimit indicates an expected call of GetLimit.
+func (mr *MockAccountLimitInterfaceMockRecorder) GetLimit(ctx, accountID, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimit", reflect.TypeOf((*MockAccountLimitInterface)(nil).GetLimit), ctx, accountID, currency)
+}
+
+// GetLimits mocks base method.
+func (m *MockAccountLimitInterface) GetLimits(ctx context.Context, accountID int) (entity.AccountLimitSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLimits", ctx, accountID)
+	ret0, _ := ret[0].(entity.AccountLimitSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLimits indicates an expected call of GetLimits.
+func (mr *MockAccountLimitInterfaceMockRecorder) GetLimits(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimits", reflect.TypeOf((*MockAccountLimitInterface)(nil).GetLimits), ctx, accountID)
+}
+
+// GetSingleByParam mocks base method.
+func (m *MockAccountLimitInterface) GetSingleByParam(ctx context.Context, param *model.GetAccountLimitByParam) (entity.AccountLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSingleByParam", ctx, param)
+	ret0, _ := ret[0].(entity.AccountLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSingleByParam indicates an expected call of GetSingleByParam.
+func (mr *MockAccountLimitInterfaceMockRecorder) GetSingleByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleByParam", reflect.TypeOf((*MockAccountLimitInterface)(nil).GetSingleByParam), ctx, param)
+}
+
+// GetUsage mocks base method.
+func (m *MockAccountLimitInterface) GetUsage(ctx context.Context, accountID int, apikey, currency string, at time.Time) (model.TransferLimitUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, accountID, apikey, currency, at)
+	ret0, _ := ret[0].(model.TransferLimitUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockAccountLimitInterfaceMockRecorder) GetUsage(ctx, accountID, apikey, currency, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockAccountLimitInterface)(nil).GetUsage), ctx, accountID, apikey, currency, at)
+}
+
+// Release mocks base method.
+func (m *MockAccountLimitInterface) Release(ctx context.Context, accountID int, currency string, amount int64, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, accountID, currency, amount, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockAccountLimitInterfaceMockRecorder) Release(ctx, accountID, currency, amount, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockAccountLimitInterface)(nil).Release), ctx, accountID, currency, amount, at)
+}
+
+// Reserve mocks base method.
+func (m *MockAccountLimitInterface) Reserve(ctx context.Context, apikey string, limit *entity.AccountLimit, amount int64, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, apikey, limit, amount, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockAccountLimitInterfaceMockRecorder) Reserve(ctx, apikey, limit, amount, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockAccountLimitInterface)(nil).Reserve), ctx, apikey, limit, amount, at)
+}
+
+// Upsert mocks base method.
+func (m *MockAccountLimitInterface) Upsert(ctx context.Context, data *entity.AccountLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockAccountLimitInterfaceMockRecorder) Upsert(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockAccountLimitInterface)(nil).Upsert), ctx, data)
+}
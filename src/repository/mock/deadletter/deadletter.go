@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/repository/deadletter/deadletter.go
+
+// Package mock_deadletter is a generated GoMock package.
+package mock_deadletter
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/achwanyusuf/bricksvc/src/domain/entity"
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDeadLetterInterface is a mock of DeadLetterInterface interface.
+type MockDeadLetterInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterInterfaceMockRecorder
+}
+
+// MockDeadLetterInterfaceMockRecorder is the mock recorder for MockDeadLetterInterface.
+type MockDeadLetterInterfaceMockRecorder struct {
+	mock *MockDeadLetterInterface
+}
+
+// NewMockDeadLetterInterface creates a new mock instance.
+func NewMockDeadLetterInterface(ctrl *gomock.Controller) *MockDeadLetterInterface {
+	mock := &MockDeadLetterInterface{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterInterface) EXPECT() *MockDeadLetterInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetByParam mocks base method.
+func (m *MockDeadLetterInterface) GetByParam(ctx context.Context, param *model.GetDeadLetterMessagesByParam) (entity.DeadLetterMessageSlice, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, param)
+	ret0, _ := ret[0].(entity.DeadLetterMessageSlice)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockDeadLetterInterfaceMockRecorder) GetByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockDeadLetterInterface)(nil).GetByParam), ctx, param)
+}
+
+// GetSingleByParam mocks base method.
+func (m *MockDeadLetterInterface) GetSingleByParam(ctx context.Context, param *model.GetDeadLetterMessageByParam) (entity.DeadLetterMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSingleByParam", ctx, param)
+	ret0, _ := ret[0].(entity.DeadLetterMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSingleByParam indicates an expected call of GetSingleByParam.
+func (mr *MockDeadLetterInterfaceMockRecorder) GetSingleByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleByParam", reflect.TypeOf((*MockDeadLetterInterface)(nil).GetSingleByParam), ctx, param)
+}
+
+// Insert mocks base method.
+func (m *MockDeadLetterInterface) Insert(ctx context.Context, data *entity.DeadLetterMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockDeadLetterInterfaceMockRecorder) Insert(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockDeadLetterInterface)(nil).Insert), ctx, data)
+}
+
+// Replay mocks base method.
+func (m *MockDeadLetterInterface) Replay(ctx context.Context, v *entity.DeadLetterMessage, replayedBy int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replay", ctx, v, replayedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replay indicates an expected call of Replay.
+func (mr *MockDeadLetterInterfaceMockRecorder) Replay(ctx, v, replayedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockDeadLetterInterface)(nil).Replay), ctx, v, replayedBy)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/repository/feerule/feerule.go
+
+// Package mock_feerule is a generated GoMock package.
+package mock_feerule
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/achwanyusuf/bricksvc/src/domain/entity"
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFeeRuleInterface is a mock of FeeRuleInterface interface.
+type MockFeeRuleInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeRuleInterfaceMockRecorder
+}
+
+// MockFeeRuleInterfaceMockRecorder is the mock recorder for MockFeeRuleInterface.
+type MockFeeRuleInterfaceMockRecorder struct {
+	mock *MockFeeRuleInterface
+}
+
+// NewMockFeeRuleInterface creates a new mock instance.
+func NewMockFeeRuleInterface(ctrl *gomock.Controller) *MockFeeRuleInterface {
+	mock := &MockFeeRuleInterface{ctrl: ctrl}
+	mock.recorder = &MockFeeRuleInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeRuleInterface) EXPECT() *MockFeeRuleInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockFeeRuleInterface) Delete(ctx context.Context, v *entity.FeeRule, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, v, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFeeRuleInterfaceMockRecorder) Delete(ctx, v, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFeeRuleInterface)(nil).Delete), ctx, v, id)
+}
+
+// GetByParam mocks base method.
+func (m *MockFeeRuleInterface) GetByParam(ctx context.Context, param *model.GetFeeRulesByParam) (entity.FeeRuleSlice, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, param)
+	ret0, _ := ret[0].(entity.FeeRuleSlice)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockFeeRuleInterfaceMockRecorder) GetByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockFeeRuleInterface)(nil).GetByParam), ctx, param)
+}
+
+// GetSingleByParam mocks base method.
+func (m *MockFeeRuleInterface) GetSingleByParam(ctx context.Context, param *model.GetFeeRuleByParam) (entity.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSingleByParam", ctx, param)
+	ret0, _ := ret[0].(entity.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSingleByParam indicates an expected call of GetSingleByParam.
+func (mr *MockFeeRuleInterfaceMockRecorder) GetSingleByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleByParam", reflect.TypeOf((*MockFeeRuleInterface)(nil).GetSingleByParam), ctx, param)
+}
+
+// Insert mocks base method.
+func (m *MockFeeRuleInterface) Insert(ctx context.Context, data *entity.FeeRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockFeeRuleInterfaceMockRecorder) Insert(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockFeeRuleInterface)(nil).Insert), ctx, data)
+}
+
+// Match mocks base method.
+func (m *MockFeeRuleInterface) Match(ctx context.Context, param *model.MatchFeeRule) (entity.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Match", ctx, param)
+	ret0, _ := ret[0].(entity.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Match indicates an expected call of Match.
+func (mr *MockFeeRuleInterfaceMockRecorder) Match(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Match", reflect.TypeOf((*MockFeeRuleInterface)(nil).Match), ctx, param)
+}
+
+// Update mocks base method.
+func (m *MockFeeRuleInterface) Update(ctx context.Context, v *entity.FeeRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockFeeRuleInterfaceMockRecorder) Update(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFeeRuleInterface)(nil).Update), ctx, v)
+}
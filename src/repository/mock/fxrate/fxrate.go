@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/repository/fxrate/fxrate.go
+
+// Package mock_fxrate is a generated GoMock package.
+package mock_fxrate
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/achwanyusuf/bricksvc/src/domain/entity"
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFXRateInterface is a mock of FXRateInterface interface.
+type MockFXRateInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFXRateInterfaceMockRecorder
+}
+
+// MockFXRateInterfaceMockRecorder is the mock recorder for MockFXRateInterface.
+type MockFXRateInterfaceMockRecorder struct {
+	mock *MockFXRateInterface
+}
+
+// NewMockFXRateInterface creates a new mock instance.
+func NewMockFXRateInterface(ctrl *gomock.Controller) *MockFXRateInterface {
+	mock := &MockFXRateInterface{ctrl: ctrl}
+	mock.recorder = &MockFXRateInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFXRateInterface) EXPECT() *MockFXRateInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockFXRateInterface) Delete(ctx context.Context, v *entity.FXRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFXRateInterfaceMockRecorder) Delete(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFXRateInterface)(nil).Delete), ctx, v)
+}
+
+// GetByParam mocks base method.
+func (m *MockFXRateInterface) GetByParam(ctx context.Context, param *model.GetFXRatesByParam) (entity.FXRateSlice, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, param)
+	ret0, _ := ret[0].(entity.FXRateSlice)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockFXRateInterfaceMockRecorder) GetByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockFXRateInterface)(nil).GetByParam), ctx, param)
+}
+
+// GetPair mocks base method.
+func (m *MockFXRateInterface) GetPair(ctx context.Context, base, quote string) (entity.FXRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPair", ctx, base, quote)
+	ret0, _ := ret[0].(entity.FXRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPair indicates an expected call of GetPair.
+func (mr *MockFXRateInterfaceMockRecorder) GetPair(ctx, base, quote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPair", reflect.TypeOf((*MockFXRateInterface)(nil).GetPair), ctx, base, quote)
+}
+
+// GetSingleByParam mocks base method.
+func (m *MockFXRateInterface) GetSingleByParam(ctx context.Context, param *model.GetFXRateByParam) (entity.FXRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSingleByParam", ctx, param)
+	ret0, _ := ret[0].(entity.FXRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSingleByParam indicates an expected call of GetSingleByParam.
+func (mr *MockFXRateInterfaceMockRecorder) GetSingleByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleByParam", reflect.TypeOf((*MockFXRateInterface)(nil).GetSingleByParam), ctx, param)
+}
+
+// Upsert mocks base method.
+func (m *MockFXRateInterface) Upsert(ctx context.Context, data *entity.FXRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockFXRateInterfaceMockRecorder) Upsert(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockFXRateInterface)(nil).Upsert), ctx, data)
+}
@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/repository/ledger/ledger.go
+
+// Package mock_ledger is a generated GoMock package.
+package mock_ledger
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+	boil "github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// MockLedgerInterface is a mock of LedgerInterface interface.
+type MockLedgerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerInterfaceMockRecorder
+}
+
+// MockLedgerInterfaceMockRecorder is the mock recorder for MockLedgerInterface.
+type MockLedgerInterfaceMockRecorder struct {
+	mock *MockLedgerInterface
+}
+
+// NewMockLedgerInterface creates a new mock instance.
+func NewMockLedgerInterface(ctrl *gomock.Controller) *MockLedgerInterface {
+	mock := &MockLedgerInterface{ctrl: ctrl}
+	mock.recorder = &MockLedgerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerInterface) EXPECT() *MockLedgerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CheckInvariant mocks base method.
+func (m *MockLedgerInterface) CheckInvariant(ctx context.Context) ([]model.LedgerInvariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckInvariant", ctx)
+	ret0, _ := ret[0].([]model.LedgerInvariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckInvariant indicates an expected call of CheckInvariant.
+func (mr *MockLedgerInterfaceMockRecorder) CheckInvariant(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInvariant", reflect.TypeOf((*MockLedgerInterface)(nil).CheckInvariant), ctx)
+}
+
+// GetByParam mocks base method.
+func (m *MockLedgerInterface) GetByParam(ctx context.Context, param *model.GetLedgerAccountsByParam) ([]model.LedgerAccountBalance, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, param)
+	ret0, _ := ret[0].([]model.LedgerAccountBalance)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockLedgerInterfaceMockRecorder) GetByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockLedgerInterface)(nil).GetByParam), ctx, param)
+}
+
+// GetEntriesByParam mocks base method.
+func (m *MockLedgerInterface) GetEntriesByParam(ctx context.Context, param *model.GetLedgerEntriesByParam) ([]model.LedgerEntry, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntriesByParam", ctx, param)
+	ret0, _ := ret[0].([]model.LedgerEntry)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEntriesByParam indicates an expected call of GetEntriesByParam.
+func (mr *MockLedgerInterfaceMockRecorder) GetEntriesByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntriesByParam", reflect.TypeOf((*MockLedgerInterface)(nil).GetEntriesByParam), ctx, param)
+}
+
+// GetSingleByParam mocks base method.
+func (m *MockLedgerInterface) GetSingleByParam(ctx context.Context, param *model.GetLedgerAccountByParam) (model.LedgerAccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSingleByParam", ctx, param)
+	ret0, _ := ret[0].(model.LedgerAccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSingleByParam indicates an expected call of GetSingleByParam.
+func (mr *MockLedgerInterfaceMockRecorder) GetSingleByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleByParam", reflect.TypeOf((*MockLedgerInterface)(nil).GetSingleByParam), ctx, param)
+}
+
+// Post mocks base method.
+func (m *MockLedgerInterface) Post(ctx context.Context, exec boil.ContextExecutor, postings []model.LedgerPosting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, exec, postings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockLedgerInterfaceMockRecorder) Post(ctx, exec, postings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockLedgerInterface)(nil).Post), ctx, exec, postings)
+}
@@ -0,0 +1,171 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/repository/reconciliation/reconciliation.go
+
+// Package mock_reconciliation is a generated GoMock package.
+package mock_reconciliation
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/achwanyusuf/bricksvc/src/domain/entity"
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReconciliationInterface is a mock of ReconciliationInterface interface.
+type MockReconciliationInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationInterfaceMockRecorder
+}
+
+// MockReconciliationInterfaceMockRecorder is the mock recorder for MockReconciliationInterface.
+type MockReconciliationInterfaceMockRecorder struct {
+	mock *MockReconciliationInterface
+}
+
+// NewMockReconciliationInterface creates a new mock instance.
+func NewMockReconciliationInterface(ctrl *gomock.Controller) *MockReconciliationInterface {
+	mock := &MockReconciliationInterface{ctrl: ctrl}
+	mock.recorder = &MockReconciliationInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliationInterface) EXPECT() *MockReconciliationInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockReconciliationInterface) Complete(ctx context.Context, v *entity.ReconciliationReport, discrepancies entity.ReconciliationDiscrepancySlice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, v, discrepancies)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockReconciliationInterfaceMockRecorder) Complete(ctx, v, discrepancies interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockReconciliationInterface)(nil).Complete), ctx, v, discrepancies)
+}
+
+// EachDiscrepancy mocks base method.
+func (m *MockReconciliationInterface) EachDiscrepancy(ctx context.Context, reportID int, fn func(*entity.ReconciliationDiscrepancy) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EachDiscrepancy", ctx, reportID, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EachDiscrepancy indicates an expected call of EachDiscrepancy.
+func (mr *MockReconciliationInterfaceMockRecorder) EachDiscrepancy(ctx, reportID, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EachDiscrepancy", reflect.TypeOf((*MockReconciliationInterface)(nil).EachDiscrepancy), ctx, reportID, fn)
+}
+
+// GetByParam mocks base method.
+func (m *MockReconciliationInterface) GetByParam(ctx context.Context, param *model.GetReconciliationReportsByParam) (entity.ReconciliationReportSlice, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, param)
+	ret0, _ := ret[0].(entity.ReconciliationReportSlice)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockReconciliationInterfaceMockRecorder) GetByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockReconciliationInterface)(nil).GetByParam), ctx, param)
+}
+
+// GetDiscrepanciesByParam mocks base method.
+func (m *MockReconciliationInterface) GetDiscrepanciesByParam(ctx context.Context, param *model.GetReconciliationDiscrepanciesByParam) (entity.ReconciliationDiscrepancySlice, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscrepanciesByParam", ctx, param)
+	ret0, _ := ret[0].(entity.ReconciliationDiscrepancySlice)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDiscrepanciesByParam indicates an expected call of GetDiscrepanciesByParam.
+func (mr *MockReconciliationInterfaceMockRecorder) GetDiscrepanciesByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscrepanciesByParam", reflect.TypeOf((*MockReconciliationInterface)(nil).GetDiscrepanciesByParam), ctx, param)
+}
+
+// GetJobsByProviderID mocks base method.
+func (m *MockReconciliationInterface) GetJobsByProviderID(ctx context.Context, ids []string) (entity.TransferJobSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobsByProviderID", ctx, ids)
+	ret0, _ := ret[0].(entity.TransferJobSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobsByProviderID indicates an expected call of GetJobsByProviderID.
+func (mr *MockReconciliationInterfaceMockRecorder) GetJobsByProviderID(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobsByProviderID", reflect.TypeOf((*MockReconciliationInterface)(nil).GetJobsByProviderID), ctx, ids)
+}
+
+// GetSingleByParam mocks base method.
+func (m *MockReconciliationInterface) GetSingleByParam(ctx context.Context, param *model.GetReconciliationReportByParam) (entity.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSingleByParam", ctx, param)
+	ret0, _ := ret[0].(entity.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSingleByParam indicates an expected call of GetSingleByParam.
+func (mr *MockReconciliationInterfaceMockRecorder) GetSingleByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleByParam", reflect.TypeOf((*MockReconciliationInterface)(nil).GetSingleByParam), ctx, param)
+}
+
+// GetSubmittedJobs mocks base method.
+func (m *MockReconciliationInterface) GetSubmittedJobs(ctx context.Context, start, end time.Time) (entity.TransferJobSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubmittedJobs", ctx, start, end)
+	ret0, _ := ret[0].(entity.TransferJobSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubmittedJobs indicates an expected call of GetSubmittedJobs.
+func (mr *MockReconciliationInterfaceMockRecorder) GetSubmittedJobs(ctx, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmittedJobs", reflect.TypeOf((*MockReconciliationInterface)(nil).GetSubmittedJobs), ctx, start, end)
+}
+
+// Insert mocks base method.
+func (m *MockReconciliationInterface) Insert(ctx context.Context, v *entity.ReconciliationReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockReconciliationInterfaceMockRecorder) Insert(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockReconciliationInterface)(nil).Insert), ctx, v)
+}
+
+// Update mocks base method.
+func (m *MockReconciliationInterface) Update(ctx context.Context, v *entity.ReconciliationReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockReconciliationInterfaceMockRecorder) Update(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockReconciliationInterface)(nil).Update), ctx, v)
+}
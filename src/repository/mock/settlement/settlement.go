@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/repository/settlement/settlement.go
+
+// Package mock_settlement is a generated GoMock package.
+package mock_settlement
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSettlementInterface is a mock of SettlementInterface interface.
+type MockSettlementInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSettlementInterfaceMockRecorder
+}
+
+// MockSettlementInterfaceMockRecorder is the mock recorder for MockSettlementInterface.
+type MockSettlementInterfaceMockRecorder struct {
+	mock *MockSettlementInterface
+}
+
+// NewMockSettlementInterface creates a new mock instance.
+func NewMockSettlementInterface(ctrl *gomock.Controller) *MockSettlementInterface {
+	mock := &MockSettlementInterface{ctrl: ctrl}
+	mock.recorder = &MockSettlementInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSettlementInterface) EXPECT() *MockSettlementInterfaceMockRecorder {
+	return m.recorder
+}
+
+// EachRow mocks base method.
+func (m *MockSettlementInterface) EachRow(ctx context.Context, param *model.GetSettlementReportByParam, fn func(*model.SettlementRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EachRow", ctx, param, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EachRow indicates an expected call of EachRow.
+func (mr *MockSettlementInterfaceMockRecorder) EachRow(ctx, param, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EachRow", reflect.TypeOf((*MockSettlementInterface)(nil).EachRow), ctx, param, fn)
+}
@@ -7,6 +7,7 @@ package mock_transfer
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	clientresponse "github.com/achwanyusuf/bricksvc/src/domain/clientresponse"
 	entity "github.com/achwanyusuf/bricksvc/src/domain/entity"
@@ -37,6 +38,50 @@ func (m *MockTransferInterface) EXPECT() *MockTransferInterfaceMockRecorder {
 	return m.recorder
 }
 
+// Amend mocks base method.
+func (m *MockTransferInterface) Amend(ctx context.Context, v *entity.TransferJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amend", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Amend indicates an expected call of Amend.
+func (mr *MockTransferInterfaceMockRecorder) Amend(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amend", reflect.TypeOf((*MockTransferInterface)(nil).Amend), ctx, v)
+}
+
+// Claim mocks base method.
+func (m *MockTransferInterface) Claim(ctx context.Context, param *model.GetTransferJobsByParam, lease time.Duration) (entity.TransferJobSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, param, lease)
+	ret0, _ := ret[0].(entity.TransferJobSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockTransferInterfaceMockRecorder) Claim(ctx, param, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockTransferInterface)(nil).Claim), ctx, param, lease)
+}
+
+// ClaimSubmission mocks base method.
+func (m *MockTransferInterface) ClaimSubmission(ctx context.Context, v *entity.TransferJob, lease time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimSubmission", ctx, v, lease)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimSubmission indicates an expected call of ClaimSubmission.
+func (mr *MockTransferInterfaceMockRecorder) ClaimSubmission(ctx, v, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimSubmission", reflect.TypeOf((*MockTransferInterface)(nil).ClaimSubmission), ctx, v, lease)
+}
+
 // Delete mocks base method.
 func (m *MockTransferInterface) Delete(ctx context.Context, v *entity.TransferJob, id int64, isHardDelete bool) error {
 	m.ctrl.T.Helper()
@@ -51,6 +96,36 @@ func (mr *MockTransferInterfaceMockRecorder) Delete(ctx, v, id, isHardDelete int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTransferInterface)(nil).Delete), ctx, v, id, isHardDelete)
 }
 
+// DeleteIdempotency mocks base method.
+func (m *MockTransferInterface) DeleteIdempotency(ctx context.Context, apikey, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIdempotency", ctx, apikey, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIdempotency indicates an expected call of DeleteIdempotency.
+func (mr *MockTransferInterfaceMockRecorder) DeleteIdempotency(ctx, apikey, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdempotency", reflect.TypeOf((*MockTransferInterface)(nil).DeleteIdempotency), ctx, apikey, key)
+}
+
+// GetBatch mocks base method.
+func (m *MockTransferInterface) GetBatch(ctx context.Context, batchID string) (entity.TransferBatch, entity.TransferJobSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBatch", ctx, batchID)
+	ret0, _ := ret[0].(entity.TransferBatch)
+	ret1, _ := ret[1].(entity.TransferJobSlice)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBatch indicates an expected call of GetBatch.
+func (mr *MockTransferInterfaceMockRecorder) GetBatch(ctx, batchID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatch", reflect.TypeOf((*MockTransferInterface)(nil).GetBatch), ctx, batchID)
+}
+
 // GetByParam mocks base method.
 func (m *MockTransferInterface) GetByParam(ctx context.Context, cacheControl string, param *model.GetTransferJobsByParam) (entity.TransferJobSlice, model.Pagination, error) {
 	m.ctrl.T.Helper()
@@ -67,6 +142,52 @@ func (mr *MockTransferInterfaceMockRecorder) GetByParam(ctx, cacheControl, param
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockTransferInterface)(nil).GetByParam), ctx, cacheControl, param)
 }
 
+// GetEvents mocks base method.
+func (m *MockTransferInterface) GetEvents(ctx context.Context, transferJobID int) (entity.TransferJobEventSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", ctx, transferJobID)
+	ret0, _ := ret[0].(entity.TransferJobEventSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockTransferInterfaceMockRecorder) GetEvents(ctx, transferJobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockTransferInterface)(nil).GetEvents), ctx, transferJobID)
+}
+
+// GetIdempotency mocks base method.
+func (m *MockTransferInterface) GetIdempotency(ctx context.Context, apikey, key string) (model.TransferIdempotency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotency", ctx, apikey, key)
+	ret0, _ := ret[0].(model.TransferIdempotency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotency indicates an expected call of GetIdempotency.
+func (mr *MockTransferInterfaceMockRecorder) GetIdempotency(ctx, apikey, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotency", reflect.TypeOf((*MockTransferInterface)(nil).GetIdempotency), ctx, apikey, key)
+}
+
+// GetRecurringByParam mocks base method.
+func (m *MockTransferInterface) GetRecurringByParam(ctx context.Context, param *model.GetRecurringTransfersByParam) (entity.RecurringTransferSlice, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurringByParam", ctx, param)
+	ret0, _ := ret[0].(entity.RecurringTransferSlice)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRecurringByParam indicates an expected call of GetRecurringByParam.
+func (mr *MockTransferInterfaceMockRecorder) GetRecurringByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurringByParam", reflect.TypeOf((*MockTransferInterface)(nil).GetRecurringByParam), ctx, param)
+}
+
 // GetSingleByParam mocks base method.
 func (m *MockTransferInterface) GetSingleByParam(ctx context.Context, cacheControl string, param *model.GetTransferJobByParam) (entity.TransferJob, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +203,36 @@ func (mr *MockTransferInterfaceMockRecorder) GetSingleByParam(ctx, cacheControl,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleByParam", reflect.TypeOf((*MockTransferInterface)(nil).GetSingleByParam), ctx, cacheControl, param)
 }
 
+// GetSingleQuoteByParam mocks base method.
+func (m *MockTransferInterface) GetSingleQuoteByParam(ctx context.Context, param *model.GetTransferQuoteByParam) (entity.TransferQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSingleQuoteByParam", ctx, param)
+	ret0, _ := ret[0].(entity.TransferQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSingleQuoteByParam indicates an expected call of GetSingleQuoteByParam.
+func (mr *MockTransferInterfaceMockRecorder) GetSingleQuoteByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleQuoteByParam", reflect.TypeOf((*MockTransferInterface)(nil).GetSingleQuoteByParam), ctx, param)
+}
+
+// GetSingleRecurringByParam mocks base method.
+func (m *MockTransferInterface) GetSingleRecurringByParam(ctx context.Context, param *model.GetRecurringTransferByParam) (entity.RecurringTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSingleRecurringByParam", ctx, param)
+	ret0, _ := ret[0].(entity.RecurringTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSingleRecurringByParam indicates an expected call of GetSingleRecurringByParam.
+func (mr *MockTransferInterfaceMockRecorder) GetSingleRecurringByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleRecurringByParam", reflect.TypeOf((*MockTransferInterface)(nil).GetSingleRecurringByParam), ctx, param)
+}
+
 // GetTransferByID mocks base method.
 func (m *MockTransferInterface) GetTransferByID(id string) (clientresponse.Transfer, error) {
 	m.ctrl.T.Helper()
@@ -98,17 +249,60 @@ func (mr *MockTransferInterfaceMockRecorder) GetTransferByID(id interface{}) *go
 }
 
 // Insert mocks base method.
-func (m *MockTransferInterface) Insert(ctx context.Context, data *entity.TransferJob) error {
+func (m *MockTransferInterface) Insert(ctx context.Context, data *entity.TransferJob, event *entity.TransferJobEvent, quote *entity.TransferQuote) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Insert", ctx, data)
+	ret := m.ctrl.Call(m, "Insert", ctx, data, event, quote)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Insert indicates an expected call of Insert.
-func (mr *MockTransferInterfaceMockRecorder) Insert(ctx, data interface{}) *gomock.Call {
+func (mr *MockTransferInterfaceMockRecorder) Insert(ctx, data, event, quote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTransferInterface)(nil).Insert), ctx, data, event, quote)
+}
+
+// InsertBatch mocks base method.
+func (m *MockTransferInterface) InsertBatch(ctx context.Context, batch *entity.TransferBatch, data entity.TransferJobSlice, event *entity.TransferJobEvent) (entity.TransferJobEventSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertBatch", ctx, batch, data, event)
+	ret0, _ := ret[0].(entity.TransferJobEventSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertBatch indicates an expected call of InsertBatch.
+func (mr *MockTransferInterfaceMockRecorder) InsertBatch(ctx, batch, data, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBatch", reflect.TypeOf((*MockTransferInterface)(nil).InsertBatch), ctx, batch, data, event)
+}
+
+// InsertQuote mocks base method.
+func (m *MockTransferInterface) InsertQuote(ctx context.Context, v *entity.TransferQuote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertQuote", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertQuote indicates an expected call of InsertQuote.
+func (mr *MockTransferInterfaceMockRecorder) InsertQuote(ctx, v interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTransferInterface)(nil).Insert), ctx, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertQuote", reflect.TypeOf((*MockTransferInterface)(nil).InsertQuote), ctx, v)
+}
+
+// InsertRecurring mocks base method.
+func (m *MockTransferInterface) InsertRecurring(ctx context.Context, v *entity.RecurringTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertRecurring", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertRecurring indicates an expected call of InsertRecurring.
+func (mr *MockTransferInterfaceMockRecorder) InsertRecurring(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRecurring", reflect.TypeOf((*MockTransferInterface)(nil).InsertRecurring), ctx, v)
 }
 
 // InsertTransfer mocks base method.
@@ -126,6 +320,137 @@ func (mr *MockTransferInterfaceMockRecorder) InsertTransfer(ctx, data interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTransfer", reflect.TypeOf((*MockTransferInterface)(nil).InsertTransfer), ctx, data)
 }
 
+// ListTransfers mocks base method.
+func (m *MockTransferInterface) ListTransfers(ctx context.Context, start, end time.Time) ([]clientresponse.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfers", ctx, start, end)
+	ret0, _ := ret[0].([]clientresponse.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockTransferInterfaceMockRecorder) ListTransfers(ctx, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockTransferInterface)(nil).ListTransfers), ctx, start, end)
+}
+
+// MaterializeRecurring mocks base method.
+func (m *MockTransferInterface) MaterializeRecurring(ctx context.Context, v *entity.RecurringTransfer, data *entity.TransferJob, event *entity.TransferJobEvent) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaterializeRecurring", ctx, v, data, event)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaterializeRecurring indicates an expected call of MaterializeRecurring.
+func (mr *MockTransferInterfaceMockRecorder) MaterializeRecurring(ctx, v, data, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaterializeRecurring", reflect.TypeOf((*MockTransferInterface)(nil).MaterializeRecurring), ctx, v, data, event)
+}
+
+// RecordAttempt mocks base method.
+func (m *MockTransferInterface) RecordAttempt(ctx context.Context, v *entity.TransferJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAttempt", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAttempt indicates an expected call of RecordAttempt.
+func (mr *MockTransferInterfaceMockRecorder) RecordAttempt(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAttempt", reflect.TypeOf((*MockTransferInterface)(nil).RecordAttempt), ctx, v)
+}
+
+// RelayOutbox mocks base method.
+func (m *MockTransferInterface) RelayOutbox(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayOutbox", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayOutbox indicates an expected call of RelayOutbox.
+func (mr *MockTransferInterfaceMockRecorder) RelayOutbox(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayOutbox", reflect.TypeOf((*MockTransferInterface)(nil).RelayOutbox), ctx, limit)
+}
+
+// Release mocks base method.
+func (m *MockTransferInterface) Release(ctx context.Context, v *entity.TransferJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockTransferInterfaceMockRecorder) Release(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockTransferInterface)(nil).Release), ctx, v)
+}
+
+// Requeue mocks base method.
+func (m *MockTransferInterface) Requeue(ctx context.Context, v *entity.TransferJob) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Requeue", ctx, v)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Requeue indicates an expected call of Requeue.
+func (mr *MockTransferInterfaceMockRecorder) Requeue(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requeue", reflect.TypeOf((*MockTransferInterface)(nil).Requeue), ctx, v)
+}
+
+// SetIdempotency mocks base method.
+func (m *MockTransferInterface) SetIdempotency(ctx context.Context, apikey, key string, data model.TransferIdempotency) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIdempotency", ctx, apikey, key, data)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetIdempotency indicates an expected call of SetIdempotency.
+func (mr *MockTransferInterfaceMockRecorder) SetIdempotency(ctx, apikey, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIdempotency", reflect.TypeOf((*MockTransferInterface)(nil).SetIdempotency), ctx, apikey, key, data)
+}
+
+// SetRecurringStatus mocks base method.
+func (m *MockTransferInterface) SetRecurringStatus(ctx context.Context, v *entity.RecurringTransfer, from entity.Recurringstatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRecurringStatus", ctx, v, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRecurringStatus indicates an expected call of SetRecurringStatus.
+func (mr *MockTransferInterfaceMockRecorder) SetRecurringStatus(ctx, v, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecurringStatus", reflect.TypeOf((*MockTransferInterface)(nil).SetRecurringStatus), ctx, v, from)
+}
+
+// Transition mocks base method.
+func (m *MockTransferInterface) Transition(ctx context.Context, v *entity.TransferJob, event *entity.TransferJobEvent, deliveries entity.WebhookDeliverySlice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transition", ctx, v, event, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transition indicates an expected call of Transition.
+func (mr *MockTransferInterfaceMockRecorder) Transition(ctx, v, event, deliveries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transition", reflect.TypeOf((*MockTransferInterface)(nil).Transition), ctx, v, event, deliveries)
+}
+
 // Update mocks base method.
 func (m *MockTransferInterface) Update(ctx context.Context, v *entity.TransferJob) error {
 	m.ctrl.T.Helper()
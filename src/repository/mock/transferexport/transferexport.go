@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/repository/transferexport/transferexport.go
+
+// Package mock_transferexport is a generated GoMock package.
+package mock_transferexport
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/achwanyusuf/bricksvc/src/domain/entity"
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTransferExportInterface is a mock of TransferExportInterface interface.
+type MockTransferExportInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferExportInterfaceMockRecorder
+}
+
+// MockTransferExportInterfaceMockRecorder is the mock recorder for MockTransferExportInterface.
+type MockTransferExportInterfaceMockRecorder struct {
+	mock *MockTransferExportInterface
+}
+
+// NewMockTransferExportInterface creates a new mock instance.
+func NewMockTransferExportInterface(ctrl *gomock.Controller) *MockTransferExportInterface {
+	mock := &MockTransferExportInterface{ctrl: ctrl}
+	mock.recorder = &MockTransferExportInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferExportInterface) EXPECT() *MockTransferExportInterfaceMockRecorder {
+	return m.recorder
+}
+
+// EachJob mocks base method.
+func (m *MockTransferExportInterface) EachJob(ctx context.Context, param *model.GetTransferExportByParam, fn func(*entity.TransferJob) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EachJob", ctx, param, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EachJob indicates an expected call of EachJob.
+func (mr *MockTransferExportInterfaceMockRecorder) EachJob(ctx, param, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EachJob", reflect.TypeOf((*MockTransferExportInterface)(nil).EachJob), ctx, param, fn)
+}
+
+// GetSingleByParam mocks base method.
+func (m *MockTransferExportInterface) GetSingleByParam(ctx context.Context, param *model.GetTransferExportJobByParam) (entity.TransferExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSingleByParam", ctx, param)
+	ret0, _ := ret[0].(entity.TransferExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSingleByParam indicates an expected call of GetSingleByParam.
+func (mr *MockTransferExportInterfaceMockRecorder) GetSingleByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSingleByParam", reflect.TypeOf((*MockTransferExportInterface)(nil).GetSingleByParam), ctx, param)
+}
+
+// Insert mocks base method.
+func (m *MockTransferExportInterface) Insert(ctx context.Context, v *entity.TransferExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockTransferExportInterfaceMockRecorder) Insert(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTransferExportInterface)(nil).Insert), ctx, v)
+}
+
+// Update mocks base method.
+func (m *MockTransferExportInterface) Update(ctx context.Context, v *entity.TransferExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTransferExportInterfaceMockRecorder) Update(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransferExportInterface)(nil).Update), ctx, v)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/repository/transferstream/transferstream.go
+
+// Package mock_transferstream is a generated GoMock package.
+package mock_transferstream
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTransferStreamInterface is a mock of TransferStreamInterface interface.
+type MockTransferStreamInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferStreamInterfaceMockRecorder
+}
+
+// MockTransferStreamInterfaceMockRecorder is the mock recorder for MockTransferStreamInterface.
+type MockTransferStreamInterfaceMockRecorder struct {
+	mock *MockTransferStreamInterface
+}
+
+// NewMockTransferStreamInterface creates a new mock instance.
+func NewMockTransferStreamInterface(ctrl *gomock.Controller) *MockTransferStreamInterface {
+	mock := &MockTransferStreamInterface{ctrl: ctrl}
+	mock.recorder = &MockTransferStreamInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferStreamInterface) EXPECT() *MockTransferStreamInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetEventsAfter mocks base method.
+func (m *MockTransferStreamInterface) GetEventsAfter(ctx context.Context, param *model.GetTransferStreamByParam) ([]model.TransferStreamMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsAfter", ctx, param)
+	ret0, _ := ret[0].([]model.TransferStreamMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsAfter indicates an expected call of GetEventsAfter.
+func (mr *MockTransferStreamInterfaceMockRecorder) GetEventsAfter(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsAfter", reflect.TypeOf((*MockTransferStreamInterface)(nil).GetEventsAfter), ctx, param)
+}
+
+// Publish mocks base method.
+func (m *MockTransferStreamInterface) Publish(ctx context.Context, apikey string, v *model.TransferStreamMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, apikey, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockTransferStreamInterfaceMockRecorder) Publish(ctx, apikey, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockTransferStreamInterface)(nil).Publish), ctx, apikey, v)
+}
+
+// Subscribe mocks base method.
+func (m *MockTransferStreamInterface) Subscribe(ctx context.Context, apikey string) (<-chan model.TransferStreamMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, apikey)
+	ret0, _ := ret[0].(<-chan model.TransferStreamMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockTransferStreamInterfaceMockRecorder) Subscribe(ctx, apikey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockTransferStreamInterface)(nil).Subscribe), ctx, apikey)
+}
@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/repository/webhook/webhook.go
+
+// Package mock_webhook is a generated GoMock package.
+package mock_webhook
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/achwanyusuf/bricksvc/src/domain/entity"
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWebhookInterface is a mock of WebhookInterface interface.
+type MockWebhookInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookInterfaceMockRecorder
+}
+
+// MockWebhookInterfaceMockRecorder is the mock recorder for MockWebhookInterface.
+type MockWebhookInterfaceMockRecorder struct {
+	mock *MockWebhookInterface
+}
+
+// NewMockWebhookInterface creates a new mock instance.
+func NewMockWebhookInterface(ctrl *gomock.Controller) *MockWebhookInterface {
+	mock := &MockWebhookInterface{ctrl: ctrl}
+	mock.recorder = &MockWebhookInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookInterface) EXPECT() *MockWebhookInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteEndpoint mocks base method.
+func (m *MockWebhookInterface) DeleteEndpoint(ctx context.Context, v *entity.WebhookEndpoint, id int64, isHardDelete bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEndpoint", ctx, v, id, isHardDelete)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEndpoint indicates an expected call of DeleteEndpoint.
+func (mr *MockWebhookInterfaceMockRecorder) DeleteEndpoint(ctx, v, id, isHardDelete interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpoint", reflect.TypeOf((*MockWebhookInterface)(nil).DeleteEndpoint), ctx, v, id, isHardDelete)
+}
+
+// Deliver mocks base method.
+func (m *MockWebhookInterface) Deliver(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockWebhookInterfaceMockRecorder) Deliver(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockWebhookInterface)(nil).Deliver), ctx, limit)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockWebhookInterface) GetDeliveries(ctx context.Context, webhookEndpointID, limit int64) (entity.WebhookDeliverySlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, webhookEndpointID, limit)
+	ret0, _ := ret[0].(entity.WebhookDeliverySlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockWebhookInterfaceMockRecorder) GetDeliveries(ctx, webhookEndpointID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockWebhookInterface)(nil).GetDeliveries), ctx, webhookEndpointID, limit)
+}
+
+// GetDelivery mocks base method.
+func (m *MockWebhookInterface) GetDelivery(ctx context.Context, id int64) (entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", ctx, id)
+	ret0, _ := ret[0].(entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelivery indicates an expected call of GetDelivery.
+func (mr *MockWebhookInterfaceMockRecorder) GetDelivery(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockWebhookInterface)(nil).GetDelivery), ctx, id)
+}
+
+// GetEndpoint mocks base method.
+func (m *MockWebhookInterface) GetEndpoint(ctx context.Context, param *model.GetWebhookEndpointByParam) (entity.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEndpoint", ctx, param)
+	ret0, _ := ret[0].(entity.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEndpoint indicates an expected call of GetEndpoint.
+func (mr *MockWebhookInterfaceMockRecorder) GetEndpoint(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndpoint", reflect.TypeOf((*MockWebhookInterface)(nil).GetEndpoint), ctx, param)
+}
+
+// GetEndpoints mocks base method.
+func (m *MockWebhookInterface) GetEndpoints(ctx context.Context, param *model.GetWebhookEndpointByParam) (entity.WebhookEndpointSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEndpoints", ctx, param)
+	ret0, _ := ret[0].(entity.WebhookEndpointSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEndpoints indicates an expected call of GetEndpoints.
+func (mr *MockWebhookInterfaceMockRecorder) GetEndpoints(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndpoints", reflect.TypeOf((*MockWebhookInterface)(nil).GetEndpoints), ctx, param)
+}
+
+// InsertDelivery mocks base method.
+func (m *MockWebhookInterface) InsertDelivery(ctx context.Context, data *entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertDelivery", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertDelivery indicates an expected call of InsertDelivery.
+func (mr *MockWebhookInterfaceMockRecorder) InsertDelivery(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDelivery", reflect.TypeOf((*MockWebhookInterface)(nil).InsertDelivery), ctx, data)
+}
+
+// InsertEndpoint mocks base method.
+func (m *MockWebhookInterface) InsertEndpoint(ctx context.Context, data *entity.WebhookEndpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertEndpoint", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertEndpoint indicates an expected call of InsertEndpoint.
+func (mr *MockWebhookInterfaceMockRecorder) InsertEndpoint(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertEndpoint", reflect.TypeOf((*MockWebhookInterface)(nil).InsertEndpoint), ctx, data)
+}
@@ -0,0 +1,225 @@
+package reconciliation
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (r *Reconciliation) insertPSQL(ctx context.Context, v *entity.ReconciliationReport) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = v.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (r *Reconciliation) getSingleByParamPSQL(ctx context.Context, param *model.GetReconciliationReportByParam) (entity.ReconciliationReport, error) {
+	var res entity.ReconciliationReport
+	report, err := entity.ReconciliationReports(param.GetQuery()...).One(ctx, r.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "error get reconciliationReports")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get reconciliationReports")
+	}
+
+	return *report, nil
+}
+
+func (r *Reconciliation) getByParamPSQL(ctx context.Context, param *model.GetReconciliationReportsByParam) (entity.ReconciliationReportSlice, model.Pagination, error) {
+	var totalPages int64 = 1
+	if param.Limit == 0 {
+		param.Limit = int64(r.Conf.DefaultPageLimit)
+	}
+
+	if param.Page == 0 {
+		param.Page = 1
+	}
+
+	qr := param.GetQuery()
+	count, err := entity.ReconciliationReports(qr...).Count(ctx, r.DB)
+	if err != nil {
+		return entity.ReconciliationReportSlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error count data")
+	}
+	qr = append(qr, qm.Offset(int((param.Page-1)*param.Limit)))
+	qr = append(qr, qm.Limit(int(param.Limit)))
+	reports, err := entity.ReconciliationReports(qr...).All(ctx, r.DB)
+	if err != nil {
+		return reports, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get reconciliationReports")
+	}
+	if count > 0 {
+		totalPages = model.TotalPages(count, param.Limit)
+	}
+	return reports, model.Pagination{
+		CurrentPage:     param.Page,
+		CurrentElements: int64(len(reports)),
+		TotalElements:   count,
+		TotalPages:      totalPages,
+		SortBy:          param.OrderBy.String,
+	}, nil
+}
+
+func (r *Reconciliation) updatePSQL(ctx context.Context, v *entity.ReconciliationReport) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	_, err = v.Update(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (r *Reconciliation) completePSQL(ctx context.Context, v *entity.ReconciliationReport, discrepancies entity.ReconciliationDiscrepancySlice) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	_, err = v.Update(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update")
+	}
+
+	for _, d := range discrepancies {
+		d.ReconciliationReportID = v.ID
+		if err := d.Insert(ctx, tx, boil.Infer()); err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert discrepancy")
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (r *Reconciliation) getDiscrepanciesByParamPSQL(ctx context.Context, param *model.GetReconciliationDiscrepanciesByParam) (entity.ReconciliationDiscrepancySlice, model.Pagination, error) {
+	var totalPages int64 = 1
+	if param.Limit == 0 {
+		param.Limit = int64(r.Conf.DefaultPageLimit)
+	}
+
+	if param.Page == 0 {
+		param.Page = 1
+	}
+
+	qr := param.GetQuery()
+	count, err := entity.ReconciliationDiscrepancies(qr...).Count(ctx, r.DB)
+	if err != nil {
+		return entity.ReconciliationDiscrepancySlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error count data")
+	}
+	qr = append(qr, qm.OrderBy("id"))
+	qr = append(qr, qm.Offset(int((param.Page-1)*param.Limit)))
+	qr = append(qr, qm.Limit(int(param.Limit)))
+	discrepancies, err := entity.ReconciliationDiscrepancies(qr...).All(ctx, r.DB)
+	if err != nil {
+		return discrepancies, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get reconciliationDiscrepancies")
+	}
+	if count > 0 {
+		totalPages = model.TotalPages(count, param.Limit)
+	}
+	return discrepancies, model.Pagination{
+		CurrentPage:     param.Page,
+		CurrentElements: int64(len(discrepancies)),
+		TotalElements:   count,
+		TotalPages:      totalPages,
+	}, nil
+}
+
+func (r *Reconciliation) eachDiscrepancyPSQL(ctx context.Context, reportID int, fn func(v *entity.ReconciliationDiscrepancy) error) error {
+	batchSize := r.Conf.ExportBatchSize
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+
+	lastID := 0
+	for {
+		discrepancies, err := entity.ReconciliationDiscrepancies(
+			qm.Where("reconciliation_report_id=?", reportID),
+			qm.Where("id>?", lastID),
+			qm.OrderBy("id"),
+			qm.Limit(batchSize),
+		).All(ctx, r.DB)
+		if err != nil {
+			return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get reconciliationDiscrepancies")
+		}
+
+		for _, d := range discrepancies {
+			if err := fn(d); err != nil {
+				return err
+			}
+			lastID = d.ID
+		}
+
+		if len(discrepancies) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (r *Reconciliation) getSubmittedJobsPSQL(ctx context.Context, start time.Time, end time.Time) (entity.TransferJobSlice, error) {
+	jobs, err := entity.TransferJobs(
+		qm.Where("created_at>=?", start),
+		qm.Where("created_at<?", end),
+		qm.Where("COALESCE(payload->>'id', '')<>''"),
+		qm.OrderBy("id"),
+	).All(ctx, r.DB)
+	if err != nil {
+		return jobs, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferJobs")
+	}
+	return jobs, nil
+}
+
+func (r *Reconciliation) getJobsByProviderIDPSQL(ctx context.Context, ids []string) (entity.TransferJobSlice, error) {
+	if len(ids) == 0 {
+		return entity.TransferJobSlice{}, nil
+	}
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	jobs, err := entity.TransferJobs(qm.WhereIn("payload->>'id' IN ?", args...)).All(ctx, r.DB)
+	if err != nil {
+		return jobs, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferJobs")
+	}
+	return jobs, nil
+}
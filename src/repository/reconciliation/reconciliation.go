@@ -0,0 +1,79 @@
+package reconciliation
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+)
+
+type Reconciliation struct {
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct {
+	DefaultPageLimit int `mapstructure:"page_limit"`
+	ExportBatchSize  int `mapstructure:"export_batch_size"`
+}
+
+type ReconciliationInterface interface {
+	Insert(ctx context.Context, v *entity.ReconciliationReport) error
+	GetSingleByParam(ctx context.Context, param *model.GetReconciliationReportByParam) (entity.ReconciliationReport, error)
+	GetByParam(ctx context.Context, param *model.GetReconciliationReportsByParam) (entity.ReconciliationReportSlice, model.Pagination, error)
+	Update(ctx context.Context, v *entity.ReconciliationReport) error
+	Complete(ctx context.Context, v *entity.ReconciliationReport, discrepancies entity.ReconciliationDiscrepancySlice) error
+	GetDiscrepanciesByParam(ctx context.Context, param *model.GetReconciliationDiscrepanciesByParam) (entity.ReconciliationDiscrepancySlice, model.Pagination, error)
+	EachDiscrepancy(ctx context.Context, reportID int, fn func(v *entity.ReconciliationDiscrepancy) error) error
+	GetSubmittedJobs(ctx context.Context, start time.Time, end time.Time) (entity.TransferJobSlice, error)
+	GetJobsByProviderID(ctx context.Context, ids []string) (entity.TransferJobSlice, error)
+}
+
+func New(conf Conf, db *sql.DB) ReconciliationInterface {
+	return &Reconciliation{
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+func (r *Reconciliation) Insert(ctx context.Context, v *entity.ReconciliationReport) error {
+	return r.insertPSQL(ctx, v)
+}
+
+func (r *Reconciliation) GetSingleByParam(ctx context.Context, param *model.GetReconciliationReportByParam) (entity.ReconciliationReport, error) {
+	return r.getSingleByParamPSQL(ctx, param)
+}
+
+func (r *Reconciliation) GetByParam(ctx context.Context, param *model.GetReconciliationReportsByParam) (entity.ReconciliationReportSlice, model.Pagination, error) {
+	return r.getByParamPSQL(ctx, param)
+}
+
+func (r *Reconciliation) Update(ctx context.Context, v *entity.ReconciliationReport) error {
+	return r.updatePSQL(ctx, v)
+}
+
+// Complete stores the report totals and its discrepancies together, a report is never completed with part of its findings
+func (r *Reconciliation) Complete(ctx context.Context, v *entity.ReconciliationReport, discrepancies entity.ReconciliationDiscrepancySlice) error {
+	return r.completePSQL(ctx, v, discrepancies)
+}
+
+func (r *Reconciliation) GetDiscrepanciesByParam(ctx context.Context, param *model.GetReconciliationDiscrepanciesByParam) (entity.ReconciliationDiscrepancySlice, model.Pagination, error) {
+	return r.getDiscrepanciesByParamPSQL(ctx, param)
+}
+
+// EachDiscrepancy walks the discrepancies of the report in id order, batch by batch, so an export does not load the whole report
+func (r *Reconciliation) EachDiscrepancy(ctx context.Context, reportID int, fn func(v *entity.ReconciliationDiscrepancy) error) error {
+	return r.eachDiscrepancyPSQL(ctx, reportID, fn)
+}
+
+// GetSubmittedJobs returns the jobs created in the period that hold a provider id
+func (r *Reconciliation) GetSubmittedJobs(ctx context.Context, start time.Time, end time.Time) (entity.TransferJobSlice, error) {
+	return r.getSubmittedJobsPSQL(ctx, start, end)
+}
+
+// GetJobsByProviderID looks provider records up outside the period, a job created late in the day may be dated by the provider on the next one
+func (r *Reconciliation) GetJobsByProviderID(ctx context.Context, ids []string) (entity.TransferJobSlice, error) {
+	return r.getJobsByProviderIDPSQL(ctx, ids)
+}
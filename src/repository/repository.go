@@ -4,10 +4,20 @@ import (
 	"database/sql"
 
 	"github.com/achwanyusuf/bricksvc/src/repository/account"
+	"github.com/achwanyusuf/bricksvc/src/repository/accountlimit"
 	"github.com/achwanyusuf/bricksvc/src/repository/accountrole"
 	"github.com/achwanyusuf/bricksvc/src/repository/bank"
+	"github.com/achwanyusuf/bricksvc/src/repository/deadletter"
+	"github.com/achwanyusuf/bricksvc/src/repository/feerule"
+	"github.com/achwanyusuf/bricksvc/src/repository/fxrate"
+	"github.com/achwanyusuf/bricksvc/src/repository/ledger"
+	"github.com/achwanyusuf/bricksvc/src/repository/reconciliation"
 	"github.com/achwanyusuf/bricksvc/src/repository/role"
+	"github.com/achwanyusuf/bricksvc/src/repository/settlement"
 	"github.com/achwanyusuf/bricksvc/src/repository/transfer"
+	"github.com/achwanyusuf/bricksvc/src/repository/transferexport"
+	"github.com/achwanyusuf/bricksvc/src/repository/transferstream"
+	"github.com/achwanyusuf/bricksvc/src/repository/webhook"
 	"github.com/achwanyusuf/bricksvc/utils/kafkalib"
 	goredislib "github.com/redis/go-redis/v9"
 )
@@ -20,27 +30,58 @@ type Repository struct {
 }
 
 type Config struct {
-	Account     account.Conf     `mapstructure:"account"`
-	Role        role.Conf        `mapstructure:"role"`
-	AccountRole accountrole.Conf `mapstructure:"account_role"`
-	Bank        bank.Conf        `mapstructure:"bank"`
-	Transfer    transfer.Conf    `mapstructure:"transfer"`
+	Account        account.Conf        `mapstructure:"account"`
+	Role           role.Conf           `mapstructure:"role"`
+	AccountRole    accountrole.Conf    `mapstructure:"account_role"`
+	Bank           bank.Conf           `mapstructure:"bank"`
+	Transfer       transfer.Conf       `mapstructure:"transfer"`
+	Webhook        webhook.Conf        `mapstructure:"webhook"`
+	DeadLetter     deadletter.Conf     `mapstructure:"dead_letter"`
+	FXRate         fxrate.Conf         `mapstructure:"fx_rate"`
+	FeeRule        feerule.Conf        `mapstructure:"fee_rule"`
+	AccountLimit   accountlimit.Conf   `mapstructure:"account_limit"`
+	Ledger         ledger.Conf         `mapstructure:"ledger"`
+	Reconciliation reconciliation.Conf `mapstructure:"reconciliation"`
+	Settlement     settlement.Conf     `mapstructure:"settlement"`
+	TransferExport transferexport.Conf `mapstructure:"transfer_export"`
+	TransferStream transferstream.Conf `mapstructure:"transfer_stream"`
 }
 
 type RepositoryInterface struct {
-	Account     account.AccountInterface
-	Role        role.RoleInterface
-	AccountRole accountrole.AccountRoleInterface
-	Bank        bank.BankInterface
-	Transfer    transfer.TransferInterface
+	Account        account.AccountInterface
+	Role           role.RoleInterface
+	AccountRole    accountrole.AccountRoleInterface
+	Bank           bank.BankInterface
+	Transfer       transfer.TransferInterface
+	Webhook        webhook.WebhookInterface
+	DeadLetter     deadletter.DeadLetterInterface
+	FXRate         fxrate.FXRateInterface
+	FeeRule        feerule.FeeRuleInterface
+	AccountLimit   accountlimit.AccountLimitInterface
+	Ledger         ledger.LedgerInterface
+	Reconciliation reconciliation.ReconciliationInterface
+	Settlement     settlement.SettlementInterface
+	TransferExport transferexport.TransferExportInterface
+	TransferStream transferstream.TransferStreamInterface
 }
 
 func New(d *Repository) *RepositoryInterface {
+	ldg := ledger.New(d.Conf.Ledger, d.DB)
 	return &RepositoryInterface{
 		account.New(d.Conf.Account, d.DB, d.Redis),
 		role.New(d.Conf.Role, d.DB, d.Redis),
 		accountrole.New(d.Conf.AccountRole, d.DB, d.Redis),
 		bank.New(d.Conf.Bank, d.Redis),
-		transfer.New(d.Conf.Transfer, d.DB, d.Redis, d.Kafka),
+		transfer.New(d.Conf.Transfer, d.DB, d.Redis, d.Kafka, ldg),
+		webhook.New(d.Conf.Webhook, d.DB),
+		deadletter.New(d.Conf.DeadLetter, d.DB, d.Kafka),
+		fxrate.New(d.Conf.FXRate, d.DB),
+		feerule.New(d.Conf.FeeRule, d.DB),
+		accountlimit.New(d.Conf.AccountLimit, d.DB, d.Redis),
+		ldg,
+		reconciliation.New(d.Conf.Reconciliation, d.DB),
+		settlement.New(d.Conf.Settlement, d.DB),
+		transferexport.New(d.Conf.TransferExport, d.DB),
+		transferstream.New(d.Conf.TransferStream, d.DB, d.Redis),
 	}
 }
@@ -102,6 +102,15 @@ func (r *Role) deletePSQL(ctx context.Context, account *entity.Role, id int64, i
 
 func (r *Role) getByParamPSQL(ctx context.Context, param *model.GetRolesByParam) (entity.RoleSlice, model.Pagination, error) {
 	var totalPages int64 = 1
+	useCursor, err := param.UseCursor(param.Page, param.OrderBy)
+	if err != nil {
+		return entity.RoleSlice{}, model.Pagination{}, err
+	}
+
+	if useCursor {
+		return r.getByCursorPSQL(ctx, param)
+	}
+
 	if param.Limit == 0 {
 		param.Limit = int64(r.Conf.DefaultPageLimit)
 	}
@@ -125,7 +134,7 @@ func (r *Role) getByParamPSQL(ctx context.Context, param *model.GetRolesByParam)
 		return accounts, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get accounts")
 	}
 	if count > 0 {
-		totalPages = (count / param.Limit) + 1
+		totalPages = model.TotalPages(count, param.Limit)
 	}
 	return accounts, model.Pagination{
 		CurrentPage:     param.Page,
@@ -135,3 +144,33 @@ func (r *Role) getByParamPSQL(ctx context.Context, param *model.GetRolesByParam)
 		SortBy:          param.OrderBy.String,
 	}, nil
 }
+
+// getByCursorPSQL reads the page after or before the cursor without counting, the total is estimated when asked for
+func (r *Role) getByCursorPSQL(ctx context.Context, param *model.GetRolesByParam) (entity.RoleSlice, model.Pagination, error) {
+	if param.Limit == 0 {
+		param.Limit = int64(r.Conf.DefaultPageLimit)
+	}
+
+	qr := param.GetQuery()
+	cursorQr, cursor, err := param.CursorQuery(param.Limit)
+	if err != nil {
+		return entity.RoleSlice{}, model.Pagination{}, err
+	}
+
+	roles, err := entity.Roles(append(qr, cursorQr...)...).All(ctx, r.DB)
+	if err != nil {
+		return roles, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get roles")
+	}
+
+	roles, pagination := model.CursorPage(&param.CursorParam, cursor, roles, param.Limit, func(v *entity.Role) model.Cursor {
+		return model.Cursor{CreatedAt: v.CreatedAt, ID: int64(v.ID)}
+	})
+	if param.WithTotal {
+		count, err := model.EstimateCount(ctx, r.DB, entity.Roles(qr...).Query)
+		if err != nil {
+			return roles, model.Pagination{}, err
+		}
+		pagination.EstimatedTotal = null.Int64From(count)
+	}
+	return roles, pagination, nil
+}
@@ -0,0 +1,36 @@
+package settlement
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+)
+
+func (s *Settlement) eachRowPSQL(ctx context.Context, param *model.GetSettlementReportByParam, fn func(v *model.SettlementRow) error) error {
+	rows, err := entity.TransferJobs(param.GetQuery()...).QueryContext(ctx, s.DB)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get settlement report")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row model.SettlementRow
+		if err := rows.Scan(&row.APIKey, &row.Day, &row.Status, &row.Currency, &row.Count, &row.Amount, &row.Fee); err != nil {
+			return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error scan settlement report")
+		}
+		row.APIKey = model.MaskAPIKey(row.APIKey)
+		row.Total = row.Amount + row.Fee
+
+		if err := fn(&row); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get settlement report")
+	}
+	return nil
+}
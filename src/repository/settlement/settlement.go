@@ -0,0 +1,31 @@
+package settlement
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+)
+
+type Settlement struct {
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct{}
+
+type SettlementInterface interface {
+	EachRow(ctx context.Context, param *model.GetSettlementReportByParam, fn func(v *model.SettlementRow) error) error
+}
+
+func New(conf Conf, db *sql.DB) SettlementInterface {
+	return &Settlement{
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+// EachRow aggregates the transfer jobs of the period and hands fn one row at a time as they are read from the database
+func (s *Settlement) EachRow(ctx context.Context, param *model.GetSettlementReportByParam, fn func(v *model.SettlementRow) error) error {
+	return s.eachRowPSQL(ctx, param, fn)
+}
@@ -3,6 +3,10 @@ package transfer
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/achwanyusuf/bricksvc/src/domain/clientresponse"
 	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
@@ -29,8 +33,20 @@ func (t *Transfer) insertTransferHTTPClient(ctx context.Context, data clientresp
 		return clientresponse.Transfer{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, errors.Join(errs...), "status unauthorized")
 	}
 
-	if err != nil {
-		return clientresponse.Transfer{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, errors.Join(errs...))
+	if len(errs) > 0 {
+		return clientresponse.Transfer{}, errormsg.WrapErr(svcerr.BrickSVCProviderUnreachable, errors.Join(errs...))
+	}
+
+	if statusCode == fiber.StatusTooManyRequests {
+		return clientresponse.Transfer{}, errormsg.WrapErr(svcerr.BrickSVCProviderRateLimited, nil, "status too many requests")
+	}
+
+	if statusCode >= fiber.StatusInternalServerError {
+		return clientresponse.Transfer{}, errormsg.WrapErr(svcerr.BrickSVCProviderServerError, nil, fmt.Sprintf("status %d", statusCode))
+	}
+
+	if statusCode >= fiber.StatusBadRequest {
+		return clientresponse.Transfer{}, errormsg.WrapErr(svcerr.BrickSVCProviderRejected, nil, fmt.Sprintf("status %d: %s", statusCode, body))
 	}
 
 	if err := jsoniter.Unmarshal(body, &transfer); err != nil {
@@ -62,3 +78,44 @@ func (t *Transfer) getTransferHTTPClient(id string) (clientresponse.Transfer, er
 
 	return transfer, nil
 }
+
+func (t *Transfer) listTransferHTTPClient(start time.Time, end time.Time, page int, limit int) ([]clientresponse.Transfer, error) {
+	var transfers []clientresponse.Transfer
+	query := url.Values{}
+	query.Set("from", start.UTC().Format(time.RFC3339))
+	query.Set("to", end.UTC().Format(time.RFC3339))
+	query.Set("page", strconv.Itoa(page))
+	query.Set("limit", strconv.Itoa(limit))
+	client := fiber.Get(t.Conf.ListTransactionURL + "?" + query.Encode())
+	statusCode, body, errs := client.Bytes()
+	// the provider answers a page past the last one with not found
+	if statusCode == fiber.StatusNotFound {
+		return transfers, nil
+	}
+
+	if statusCode == fiber.StatusUnauthorized {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, errors.Join(errs...), "status unauthorized")
+	}
+
+	if len(errs) > 0 {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCProviderUnreachable, errors.Join(errs...))
+	}
+
+	if statusCode == fiber.StatusTooManyRequests {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCProviderRateLimited, nil, "status too many requests")
+	}
+
+	if statusCode >= fiber.StatusInternalServerError {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCProviderServerError, nil, fmt.Sprintf("status %d", statusCode))
+	}
+
+	if statusCode >= fiber.StatusBadRequest {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCProviderRejected, nil, fmt.Sprintf("status %d: %s", statusCode, body))
+	}
+
+	if err := jsoniter.Unmarshal(body, &transfers); err != nil {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+
+	return transfers, nil
+}
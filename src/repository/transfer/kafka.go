@@ -10,13 +10,19 @@ import (
 	"github.com/achwanyusuf/bricksvc/utils/errormsg"
 )
 
-func (t *Transfer) insertKafka(ctx context.Context, data *entity.TransferJob) error {
-	key := fmt.Sprintf(model.TransferKey, data.JobID)
-	dt, err := data.Payload.MarshalJSON()
-	if err != nil {
-		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+func (t *Transfer) newOutboxMessage(data *entity.TransferJob) entity.OutboxMessage {
+	return entity.OutboxMessage{
+		Topic:      t.Conf.TransferTopic,
+		MessageKey: fmt.Sprintf(model.TransferKey, data.JobID),
+		Payload:    data.Payload,
+		Status:     entity.OutboxstatusPending,
+		CreatedBy:  data.CreatedBy,
+		UpdatedBy:  data.UpdatedBy,
 	}
-	_, err = t.Kafka.Publish(ctx, t.Conf.TransferTopic, []byte(key), dt)
+}
+
+func (t *Transfer) publishKafka(ctx context.Context, data *entity.OutboxMessage) error {
+	_, err := t.Kafka.Publish(ctx, data.Topic, []byte(data.MessageKey), data.Payload)
 	if err != nil {
 		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
 	}
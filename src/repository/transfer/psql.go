@@ -3,6 +3,8 @@ package transfer
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/achwanyusuf/bricksvc/src/domain/entity"
 	"github.com/achwanyusuf/bricksvc/src/domain/model"
@@ -14,12 +16,38 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
-func (t *Transfer) insertPSQL(ctx context.Context, data *entity.TransferJob) error {
+func (t *Transfer) insertPSQL(ctx context.Context, data *entity.TransferJob, event *entity.TransferJobEvent, outbox *entity.OutboxMessage, quote *entity.TransferQuote) error {
 	tx, err := t.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
 	}
 
+	var currentQuote *entity.TransferQuote
+	if quote != nil {
+		// the quote stays locked until the job holding it is committed, so it is only used once
+		currentQuote, err = entity.TransferQuotes(qm.Where("id=?", quote.ID), qm.For("UPDATE")).One(ctx, tx)
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferQuotes")
+		}
+
+		if currentQuote.TransferJobID.Valid {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return errormsg.WrapErr(svcerr.BrickSVCInvalidQuote, nil, "quote already used")
+		}
+
+		if !currentQuote.ExpiresAt.After(time.Now()) {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return errormsg.WrapErr(svcerr.BrickSVCQuoteExpired, nil, "quote expired at "+currentQuote.ExpiresAt.Format(time.RFC3339))
+		}
+	}
+
 	err = data.Insert(ctx, tx, boil.Infer())
 	if err != nil {
 		if errRollback := tx.Rollback(); errRollback != nil {
@@ -27,6 +55,37 @@ func (t *Transfer) insertPSQL(ctx context.Context, data *entity.TransferJob) err
 		}
 		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert")
 	}
+
+	if currentQuote != nil {
+		currentQuote.TransferJobID = null.IntFrom(data.ID)
+		_, err = currentQuote.Update(ctx, tx, boil.Whitelist(entity.TransferQuoteColumns.TransferJobID, entity.TransferQuoteColumns.UpdatedAt))
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update quote")
+		}
+		*quote = *currentQuote
+	}
+
+	event.TransferJobID = data.ID
+	err = event.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert event")
+	}
+
+	if outbox != nil {
+		err = outbox.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert outbox")
+		}
+	}
 	err = tx.Commit()
 	if err != nil {
 		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
@@ -34,6 +93,84 @@ func (t *Transfer) insertPSQL(ctx context.Context, data *entity.TransferJob) err
 	return nil
 }
 
+func (t *Transfer) insertBatchPSQL(ctx context.Context, batch *entity.TransferBatch, data entity.TransferJobSlice, event *entity.TransferJobEvent) (entity.TransferJobEventSlice, error) {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = batch.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return nil, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert batch")
+	}
+
+	events := make(entity.TransferJobEventSlice, 0, len(data))
+	for _, v := range data {
+		v.TransferBatchID = null.IntFrom(batch.ID)
+		err = v.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return nil, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert")
+		}
+
+		jobEvent := *event
+		jobEvent.TransferJobID = v.ID
+		jobEvent.ToStatus = v.Status
+		err = jobEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return nil, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert event")
+		}
+		events = append(events, &jobEvent)
+
+		if v.Status != entity.TransferstatusPending {
+			continue
+		}
+
+		outbox := t.newOutboxMessage(v)
+		err = outbox.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return nil, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert outbox")
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return events, nil
+}
+
+func (t *Transfer) getBatchPSQL(ctx context.Context, batchID string) (entity.TransferBatch, entity.TransferJobSlice, error) {
+	var res entity.TransferBatch
+	batch, err := entity.TransferBatches(qm.Where("batch_id=?", batchID)).One(ctx, t.DB)
+	if err == sql.ErrNoRows {
+		return res, entity.TransferJobSlice{}, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "error get transferBatches")
+	}
+
+	if err != nil {
+		return res, entity.TransferJobSlice{}, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferBatches")
+	}
+
+	transferJobs, err := entity.TransferJobs(
+		qm.Where("transfer_batch_id=?", batch.ID),
+		qm.OrderBy("id"),
+	).All(ctx, t.DB)
+	if err != nil {
+		return *batch, transferJobs, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferJobs")
+	}
+	return *batch, transferJobs, nil
+}
+
 func (t *Transfer) getSingleByParamPSQL(ctx context.Context, param *model.GetTransferJobByParam) (entity.TransferJob, error) {
 	var res entity.TransferJob
 	qr := param.GetQuery()
@@ -69,6 +206,142 @@ func (t *Transfer) updatePSQL(ctx context.Context, transferJob *entity.TransferJ
 	return nil
 }
 
+func (t *Transfer) transitionPSQL(ctx context.Context, transferJob *entity.TransferJob, event *entity.TransferJobEvent, deliveries entity.WebhookDeliverySlice) error {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	current, err := entity.TransferJobs(qm.Where("id=?", transferJob.ID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferJobs")
+	}
+
+	// validate against the locked row so concurrent workers cannot apply conflicting transitions
+	if err = model.ValidateTransferTransition(current.Status, event.ToStatus); err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	// a pending job can only be cancelled while no consumer has claimed it for, or submitted it to, the provider
+	if event.ToStatus == entity.TransferstatusCancelled && (model.IsTransferClaimed(current, time.Now().UTC()) || model.IsTransferSubmitted(current)) {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidStatusTransition, nil, "transfer already submitted to provider")
+	}
+
+	event.TransferJobID = transferJob.ID
+	event.FromStatus = entity.NullTransferstatusFrom(current.Status)
+	transferJob.Status = event.ToStatus
+	_, err = transferJob.Update(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update")
+	}
+
+	err = event.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert event")
+	}
+
+	// the money movement of the transition is booked with it, a job never changes status without its ledger entries
+	postings, err := model.NewTransferLedgerPostings(transferJob, current.Status, event.ToStatus)
+	if err == nil {
+		err = t.Ledger.Post(ctx, tx, postings)
+	}
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	// a job entering pending is dispatched through the outbox in the same transaction
+	if event.ToStatus == entity.TransferstatusPending {
+		outbox := t.newOutboxMessage(transferJob)
+		if err := outbox.Insert(ctx, tx, boil.Infer()); err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert outbox")
+		}
+	}
+
+	for _, v := range deliveries {
+		v.TransferJobEventID = event.ID
+		if err := v.Insert(ctx, tx, boil.Infer()); err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert webhook delivery")
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (t *Transfer) amendPSQL(ctx context.Context, transferJob *entity.TransferJob) error {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	current, err := entity.TransferJobs(qm.Where("id=?", transferJob.ID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferJobs")
+	}
+
+	// the release scheduler may have picked the job up since it was read
+	if current.Status != entity.TransferstatusScheduled {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidStatusTransition, nil, "only scheduled transfer can be amended")
+	}
+
+	transferJob.Status = current.Status
+	_, err = transferJob.Update(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (t *Transfer) getEventsPSQL(ctx context.Context, transferJobID int) (entity.TransferJobEventSlice, error) {
+	events, err := entity.TransferJobEvents(
+		qm.Where("transfer_job_id=?", transferJobID),
+		qm.OrderBy("id"),
+	).All(ctx, t.DB)
+	if err != nil {
+		return events, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferJobEvents")
+	}
+	return events, nil
+}
+
 func (t *Transfer) deletePSQL(ctx context.Context, transferJob *entity.TransferJob, id int64, isHardDelete bool) error {
 	tx, err := t.DB.BeginTx(ctx, nil)
 	if err != nil {
@@ -102,6 +375,15 @@ func (t *Transfer) deletePSQL(ctx context.Context, transferJob *entity.TransferJ
 
 func (t *Transfer) getByParamPSQL(ctx context.Context, param *model.GetTransferJobsByParam) (entity.TransferJobSlice, model.Pagination, error) {
 	var totalPages int64 = 1
+	useCursor, err := param.UseCursor(param.Page, param.OrderBy)
+	if err != nil {
+		return entity.TransferJobSlice{}, model.Pagination{}, err
+	}
+
+	if useCursor {
+		return t.getByCursorPSQL(ctx, param)
+	}
+
 	if param.Limit == 0 {
 		param.Limit = int64(t.Conf.DefaultPageLimit)
 	}
@@ -125,7 +407,7 @@ func (t *Transfer) getByParamPSQL(ctx context.Context, param *model.GetTransferJ
 		return transferJobs, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get transferJobs")
 	}
 	if count > 0 {
-		totalPages = (count / param.Limit) + 1
+		totalPages = model.TotalPages(count, param.Limit)
 	}
 	return transferJobs, model.Pagination{
 		CurrentPage:     param.Page,
@@ -135,3 +417,539 @@ func (t *Transfer) getByParamPSQL(ctx context.Context, param *model.GetTransferJ
 		SortBy:          param.OrderBy.String,
 	}, nil
 }
+
+// getByCursorPSQL reads the page after or before the cursor without counting, the total is estimated when asked for
+func (t *Transfer) getByCursorPSQL(ctx context.Context, param *model.GetTransferJobsByParam) (entity.TransferJobSlice, model.Pagination, error) {
+	if param.Limit == 0 {
+		param.Limit = int64(t.Conf.DefaultPageLimit)
+	}
+
+	qr := param.GetQuery()
+	cursorQr, cursor, err := param.CursorQuery(param.Limit)
+	if err != nil {
+		return entity.TransferJobSlice{}, model.Pagination{}, err
+	}
+
+	transferJobs, err := entity.TransferJobs(append(qr, cursorQr...)...).All(ctx, t.DB)
+	if err != nil {
+		return transferJobs, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get transferJobs")
+	}
+
+	transferJobs, pagination := model.CursorPage(&param.CursorParam, cursor, transferJobs, param.Limit, func(v *entity.TransferJob) model.Cursor {
+		return model.Cursor{CreatedAt: v.CreatedAt, ID: int64(v.ID)}
+	})
+	if param.WithTotal {
+		count, err := model.EstimateCount(ctx, t.DB, entity.TransferJobs(qr...).Query)
+		if err != nil {
+			return transferJobs, model.Pagination{}, err
+		}
+		pagination.EstimatedTotal = null.Int64From(count)
+	}
+	return transferJobs, pagination, nil
+}
+
+func (t *Transfer) relayOutboxPSQL(ctx context.Context, limit int) (int, error) {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	now := time.Now().UTC()
+	outboxes, err := entity.OutboxMessages(
+		qm.Where("status=?", entity.OutboxstatusPending),
+		qm.Where("(next_attempt_at IS NULL OR next_attempt_at<=?)", now),
+		qm.OrderBy("id"),
+		qm.Limit(limit),
+		qm.For("UPDATE SKIP LOCKED"),
+	).All(ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return 0, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get outbox")
+	}
+
+	sent := 0
+	for _, v := range outboxes {
+		v.Attempts++
+		if err := t.publishKafka(ctx, v); err != nil {
+			v.LastError = null.StringFrom(errormsg.WriteErr(err))
+			v.NextAttemptAt = null.TimeFrom(now.Add(t.Conf.OutboxRetry.Backoff(v.Attempts)))
+			if v.Attempts >= t.Conf.OutboxRetry.MaxAttempts {
+				if err := t.parkOutboxPSQL(ctx, tx, v); err != nil {
+					if errRollback := tx.Rollback(); errRollback != nil {
+						logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+					}
+					return 0, err
+				}
+			}
+		} else {
+			v.Status = entity.OutboxstatusSent
+			v.SentAt = null.TimeFrom(time.Now().UTC())
+			v.LastError = null.String{}
+			sent++
+		}
+
+		if _, err := v.Update(ctx, tx, boil.Infer()); err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return 0, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update outbox")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return sent, nil
+}
+
+// parkOutboxPSQL stops relaying a message out of attempts and keeps it as a dead letter, replaying it publishes it to
+// its topic again
+func (t *Transfer) parkOutboxPSQL(ctx context.Context, tx *sql.Tx, v *entity.OutboxMessage) error {
+	deadLetter, err := model.NewOutboxDeadLetterMessage(v)
+	if err != nil {
+		return err
+	}
+
+	if err := deadLetter.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert dead letter")
+	}
+	v.Status = entity.OutboxstatusParked
+	v.NextAttemptAt = null.Time{}
+	return nil
+}
+
+func (t *Transfer) claimPSQL(ctx context.Context, param *model.GetTransferJobsByParam, lease time.Duration) (entity.TransferJobSlice, error) {
+	if param.Limit == 0 {
+		param.Limit = int64(t.Conf.DefaultPageLimit)
+	}
+
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return entity.TransferJobSlice{}, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	now := time.Now().UTC()
+	qr := append(param.GetQuery(),
+		qm.Where("(lease_expires_at IS NULL OR lease_expires_at<?)", now),
+		qm.OrderBy("id"),
+		qm.Limit(int(param.Limit)),
+		qm.For("UPDATE SKIP LOCKED"),
+	)
+	transferJobs, err := entity.TransferJobs(qr...).All(ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return entity.TransferJobSlice{}, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferJobs")
+	}
+
+	if len(transferJobs) == 0 {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, errRollback, "error rollback"))
+		}
+		return transferJobs, nil
+	}
+
+	ids := make([]interface{}, len(transferJobs))
+	for i, v := range transferJobs {
+		ids[i] = v.ID
+		v.LeaseOwner = null.StringFrom(t.Owner)
+		v.LeaseExpiresAt = null.TimeFrom(now.Add(lease))
+	}
+
+	_, err = entity.TransferJobs(qm.WhereIn("id IN ?", ids...)).UpdateAll(ctx, tx, entity.M{
+		entity.TransferJobColumns.LeaseOwner:     t.Owner,
+		entity.TransferJobColumns.LeaseExpiresAt: now.Add(lease),
+	})
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return entity.TransferJobSlice{}, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error lease transferJobs")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return entity.TransferJobSlice{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return transferJobs, nil
+}
+
+// claimSubmissionPSQL leases a pending job to this replica before it is sent to the provider, the job is skipped when it
+// was submitted or waits for a retry, and reported as claimed while another worker holds an unexpired lease on it
+func (t *Transfer) claimSubmissionPSQL(ctx context.Context, transferJob *entity.TransferJob, lease time.Duration) (bool, error) {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	current, err := entity.TransferJobs(qm.Where("id=?", transferJob.ID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferJobs")
+	}
+
+	now := time.Now().UTC()
+	if current.Status != entity.TransferstatusPending || model.IsTransferSubmitted(current) || current.NextAttemptAt.Valid {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, errRollback, "error rollback"))
+		}
+		return false, nil
+	}
+
+	// the poller or another consumer holds the job, it still has to be submitted once they let it go
+	if model.IsTransferClaimed(current, now) {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, errRollback, "error rollback"))
+		}
+		return false, errormsg.WrapErr(svcerr.BrickSVCTransferClaimed, nil, fmt.Sprintf("transfer %s is leased by %s", current.JobID, current.LeaseOwner.String))
+	}
+
+	current.LeaseOwner = null.StringFrom(t.Owner)
+	current.LeaseExpiresAt = null.TimeFrom(now.Add(lease))
+	_, err = current.Update(ctx, tx, boil.Whitelist(
+		entity.TransferJobColumns.LeaseOwner,
+		entity.TransferJobColumns.LeaseExpiresAt,
+	))
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error lease transferJobs")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	*transferJob = *current
+	return true, nil
+}
+
+func (t *Transfer) releasePSQL(ctx context.Context, v *entity.TransferJob) error {
+	_, err := entity.TransferJobs(
+		qm.Where("id=?", v.ID),
+		qm.Where("lease_owner=?", t.Owner),
+	).UpdateAll(ctx, t.DB, entity.M{
+		entity.TransferJobColumns.LeaseOwner:     nil,
+		entity.TransferJobColumns.LeaseExpiresAt: nil,
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error release transferJobs")
+	}
+	v.LeaseOwner = null.String{}
+	v.LeaseExpiresAt = null.Time{}
+	return nil
+}
+
+func (t *Transfer) insertRecurringPSQL(ctx context.Context, v *entity.RecurringTransfer) error {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = v.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (t *Transfer) getSingleRecurringByParamPSQL(ctx context.Context, param *model.GetRecurringTransferByParam) (entity.RecurringTransfer, error) {
+	var res entity.RecurringTransfer
+	qr := param.GetQuery()
+	recurringTransfer, err := entity.RecurringTransfers(qr...).One(ctx, t.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "error get recurringTransfers")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get recurringTransfers")
+	}
+
+	return *recurringTransfer, nil
+}
+
+func (t *Transfer) getRecurringByParamPSQL(ctx context.Context, param *model.GetRecurringTransfersByParam) (entity.RecurringTransferSlice, model.Pagination, error) {
+	var totalPages int64 = 1
+	if param.Limit == 0 {
+		param.Limit = int64(t.Conf.DefaultPageLimit)
+	}
+
+	if param.Page == 0 {
+		param.Page = 1
+	}
+
+	qr := param.GetQuery()
+	count, err := entity.RecurringTransfers(qr...).Count(ctx, t.DB)
+	if err != nil {
+		return entity.RecurringTransferSlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error count data")
+	}
+	qr = append(qr, qm.Offset(int((param.Page-1)*param.Limit)))
+	qr = append(qr, qm.Limit(int(param.Limit)))
+	recurringTransfers, err := entity.RecurringTransfers(qr...).All(ctx, t.DB)
+	if err != nil {
+		return recurringTransfers, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get recurringTransfers")
+	}
+	if count > 0 {
+		totalPages = model.TotalPages(count, param.Limit)
+	}
+	return recurringTransfers, model.Pagination{
+		CurrentPage:     param.Page,
+		CurrentElements: int64(len(recurringTransfers)),
+		TotalElements:   count,
+		TotalPages:      totalPages,
+		SortBy:          param.OrderBy.String,
+	}, nil
+}
+
+func (t *Transfer) setRecurringStatusPSQL(ctx context.Context, v *entity.RecurringTransfer, from entity.Recurringstatus) error {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	current, err := entity.RecurringTransfers(qm.Where("id=?", v.ID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get recurringTransfers")
+	}
+
+	if current.Status != from {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidStatusTransition, nil, fmt.Sprintf("recurring transfer is %s", current.Status))
+	}
+
+	_, err = v.Update(ctx, tx, boil.Whitelist(
+		entity.RecurringTransferColumns.Status,
+		entity.RecurringTransferColumns.NextRunAt,
+		entity.RecurringTransferColumns.UpdatedBy,
+		entity.RecurringTransferColumns.UpdatedAt,
+	))
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (t *Transfer) materializeRecurringPSQL(ctx context.Context, v *entity.RecurringTransfer, data *entity.TransferJob, event *entity.TransferJobEvent, outbox *entity.OutboxMessage) (bool, error) {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	current, err := entity.RecurringTransfers(qm.Where("id=?", v.ID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get recurringTransfers")
+	}
+
+	// another run, a pause or a resume changed the mandate since it was read
+	if current.Status != entity.RecurringstatusActive || !current.NextRunAt.Time.Equal(v.NextRunAt.Time) {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return false, nil
+	}
+
+	data.RecurringTransferID = null.IntFrom(current.ID)
+	err = data.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert")
+	}
+
+	event.TransferJobID = data.ID
+	err = event.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert event")
+	}
+
+	if outbox != nil {
+		err = outbox.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert outbox")
+		}
+	}
+
+	// occurrences missed while the scheduler was down are skipped rather than sent in a burst
+	current.Occurrences++
+	current.LastRunAt = current.NextRunAt
+	err = model.ScheduleNextRecurringRun(current, time.Now().UTC())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return false, err
+	}
+
+	_, err = current.Update(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update recurringTransfers")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return false, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	*v = *current
+	return true, nil
+}
+
+func (t *Transfer) recordAttemptPSQL(ctx context.Context, transferJob *entity.TransferJob) error {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	current, err := entity.TransferJobs(qm.Where("id=?", transferJob.ID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferJobs")
+	}
+
+	// the job may have been cancelled while the provider call was in flight
+	if current.Status != entity.TransferstatusPending {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidStatusTransition, nil, fmt.Sprintf("transfer is %s", current.Status))
+	}
+
+	_, err = transferJob.Update(ctx, tx, boil.Whitelist(
+		entity.TransferJobColumns.AttemptCount,
+		entity.TransferJobColumns.NextAttemptAt,
+		entity.TransferJobColumns.LastError,
+		entity.TransferJobColumns.UpdatedAt,
+	))
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (t *Transfer) requeuePSQL(ctx context.Context, transferJob *entity.TransferJob, outbox *entity.OutboxMessage) (bool, error) {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	current, err := entity.TransferJobs(qm.Where("id=?", transferJob.ID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferJobs")
+	}
+
+	if current.Status != entity.TransferstatusPending || !current.NextAttemptAt.Valid || current.NextAttemptAt.Time.After(time.Now()) {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return false, nil
+	}
+
+	current.NextAttemptAt = null.Time{}
+	_, err = current.Update(ctx, tx, boil.Whitelist(
+		entity.TransferJobColumns.NextAttemptAt,
+		entity.TransferJobColumns.UpdatedAt,
+	))
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update")
+	}
+
+	err = outbox.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return false, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert outbox")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return false, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	*transferJob = *current
+	return true, nil
+}
+
+func (t *Transfer) insertQuotePSQL(ctx context.Context, v *entity.TransferQuote) error {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = v.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert quote")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (t *Transfer) getSingleQuoteByParamPSQL(ctx context.Context, param *model.GetTransferQuoteByParam) (entity.TransferQuote, error) {
+	var res entity.TransferQuote
+	quote, err := entity.TransferQuotes(param.GetQuery()...).One(ctx, t.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "error get transferQuotes")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferQuotes")
+	}
+
+	return *quote, nil
+}
@@ -59,3 +59,24 @@ func (t *Transfer) getByParamPaginationRedis(ctx context.Context, key string) (m
 	}
 	return res, nil
 }
+
+func (t *Transfer) setNXRedis(ctx context.Context, key string, data string) (bool, error) {
+	expTime := t.Conf.IdempotencyRetention
+	if t.Conf.IdempotencyRetention == 0 {
+		expTime = model.DefaultIdempotencyRetention
+	}
+	return t.Redis.SetNX(ctx, key, data, expTime).Result()
+}
+
+func (t *Transfer) getIdempotencyRedis(ctx context.Context, key string) (model.TransferIdempotency, error) {
+	var res model.TransferIdempotency
+	data, err := t.Redis.Get(ctx, key).Result()
+	if err != nil {
+		return res, err
+	}
+	err = jsoniter.Unmarshal([]byte(data), &res)
+	if err != nil {
+		return res, err
+	}
+	return res, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/achwanyusuf/bricksvc/src/domain/entity"
 	"github.com/achwanyusuf/bricksvc/src/domain/model"
 	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/repository/ledger"
 	"github.com/achwanyusuf/bricksvc/utils/errormsg"
 	"github.com/achwanyusuf/bricksvc/utils/kafkalib"
 	jsoniter "github.com/json-iterator/go"
@@ -17,44 +18,160 @@ import (
 )
 
 type Transfer struct {
-	DB    *sql.DB
-	Redis *goredislib.Client
-	Conf  Conf
-	Kafka kafkalib.ProducerInterface
+	DB     *sql.DB
+	Redis  *goredislib.Client
+	Conf   Conf
+	Kafka  kafkalib.ProducerInterface
+	Ledger ledger.LedgerInterface
+	Owner  string
 }
 
 type Conf struct {
-	DefaultPageLimit     int           `mapstructure:"page_limit"`
-	RedisExpirationTime  time.Duration `mapstructure:"expiration_time"`
-	TransferTopic        string        `mapstructure:"transfer_topic"`
-	CreateTransactionURL string        `mapstructure:"create_transaction_url"`
-	GetTransactionURL    string        `mapstructure:"get_transaction_url"`
+	DefaultPageLimit     int                       `mapstructure:"page_limit"`
+	RedisExpirationTime  time.Duration             `mapstructure:"expiration_time"`
+	TransferTopic        string                    `mapstructure:"transfer_topic"`
+	CreateTransactionURL string                    `mapstructure:"create_transaction_url"`
+	GetTransactionURL    string                    `mapstructure:"get_transaction_url"`
+	ListTransactionURL   string                    `mapstructure:"list_transaction_url"`
+	ListTransactionLimit int                       `mapstructure:"list_transaction_limit"`
+	IdempotencyRetention time.Duration             `mapstructure:"idempotency_retention"`
+	OutboxRetry          model.TransferRetryPolicy `mapstructure:"outbox_retry"`
 }
 
 type TransferInterface interface {
-	Insert(ctx context.Context, data *entity.TransferJob) error
+	Insert(ctx context.Context, data *entity.TransferJob, event *entity.TransferJobEvent, quote *entity.TransferQuote) error
+	InsertBatch(ctx context.Context, batch *entity.TransferBatch, data entity.TransferJobSlice, event *entity.TransferJobEvent) (entity.TransferJobEventSlice, error)
+	GetBatch(ctx context.Context, batchID string) (entity.TransferBatch, entity.TransferJobSlice, error)
 	GetSingleByParam(ctx context.Context, cacheControl string, param *model.GetTransferJobByParam) (entity.TransferJob, error)
 	Update(ctx context.Context, v *entity.TransferJob) error
+	Amend(ctx context.Context, v *entity.TransferJob) error
+	Transition(ctx context.Context, v *entity.TransferJob, event *entity.TransferJobEvent, deliveries entity.WebhookDeliverySlice) error
+	GetEvents(ctx context.Context, transferJobID int) (entity.TransferJobEventSlice, error)
 	Delete(ctx context.Context, v *entity.TransferJob, id int64, isHardDelete bool) error
 	GetByParam(ctx context.Context, cacheControl string, param *model.GetTransferJobsByParam) (entity.TransferJobSlice, model.Pagination, error)
 	InsertTransfer(ctx context.Context, data clientresponse.Transfer) (clientresponse.Transfer, error)
 	GetTransferByID(id string) (clientresponse.Transfer, error)
+	ListTransfers(ctx context.Context, start time.Time, end time.Time) ([]clientresponse.Transfer, error)
+	SetIdempotency(ctx context.Context, apikey string, key string, data model.TransferIdempotency) (bool, error)
+	GetIdempotency(ctx context.Context, apikey string, key string) (model.TransferIdempotency, error)
+	DeleteIdempotency(ctx context.Context, apikey string, key string) error
+	RelayOutbox(ctx context.Context, limit int) (int, error)
+	RecordAttempt(ctx context.Context, v *entity.TransferJob) error
+	Requeue(ctx context.Context, v *entity.TransferJob) (bool, error)
+	Claim(ctx context.Context, param *model.GetTransferJobsByParam, lease time.Duration) (entity.TransferJobSlice, error)
+	ClaimSubmission(ctx context.Context, v *entity.TransferJob, lease time.Duration) (bool, error)
+	Release(ctx context.Context, v *entity.TransferJob) error
+	InsertRecurring(ctx context.Context, v *entity.RecurringTransfer) error
+	GetSingleRecurringByParam(ctx context.Context, param *model.GetRecurringTransferByParam) (entity.RecurringTransfer, error)
+	GetRecurringByParam(ctx context.Context, param *model.GetRecurringTransfersByParam) (entity.RecurringTransferSlice, model.Pagination, error)
+	SetRecurringStatus(ctx context.Context, v *entity.RecurringTransfer, from entity.Recurringstatus) error
+	MaterializeRecurring(ctx context.Context, v *entity.RecurringTransfer, data *entity.TransferJob, event *entity.TransferJobEvent) (bool, error)
+	InsertQuote(ctx context.Context, v *entity.TransferQuote) error
+	GetSingleQuoteByParam(ctx context.Context, param *model.GetTransferQuoteByParam) (entity.TransferQuote, error)
 }
 
-func New(conf Conf, db *sql.DB, rds *goredislib.Client, kafka kafkalib.ProducerInterface) TransferInterface {
+func New(conf Conf, db *sql.DB, rds *goredislib.Client, kafka kafkalib.ProducerInterface, ldg ledger.LedgerInterface) TransferInterface {
+	if conf.OutboxRetry.MaxAttempts == 0 {
+		conf.OutboxRetry = model.DefaultOutboxRetryPolicy
+	}
 	return &Transfer{
-		DB:    db,
-		Redis: rds,
-		Conf:  conf,
-		Kafka: kafka,
+		DB:     db,
+		Redis:  rds,
+		Conf:   conf,
+		Kafka:  kafka,
+		Ledger: ldg,
+		Owner:  model.NewTransferLeaseOwner(),
+	}
+}
+
+// Insert stores the job, a quote given is consumed by the job and must still be unused and unexpired
+func (t *Transfer) Insert(ctx context.Context, data *entity.TransferJob, event *entity.TransferJobEvent, quote *entity.TransferQuote) error {
+	// scheduled jobs are published once released, not on creation
+	if data.Status != entity.TransferstatusPending {
+		return t.insertPSQL(ctx, data, event, nil, quote)
+	}
+	outbox := t.newOutboxMessage(data)
+	return t.insertPSQL(ctx, data, event, &outbox, quote)
+}
+
+// InsertBatch stores the jobs of the batch with a copy of the event each, the stored events are returned in the order of the jobs
+func (t *Transfer) InsertBatch(ctx context.Context, batch *entity.TransferBatch, data entity.TransferJobSlice, event *entity.TransferJobEvent) (entity.TransferJobEventSlice, error) {
+	return t.insertBatchPSQL(ctx, batch, data, event)
+}
+
+func (t *Transfer) GetBatch(ctx context.Context, batchID string) (entity.TransferBatch, entity.TransferJobSlice, error) {
+	return t.getBatchPSQL(ctx, batchID)
+}
+
+func (t *Transfer) RelayOutbox(ctx context.Context, limit int) (int, error) {
+	return t.relayOutboxPSQL(ctx, limit)
+}
+
+func (t *Transfer) RecordAttempt(ctx context.Context, transferJob *entity.TransferJob) error {
+	return t.recordAttemptPSQL(ctx, transferJob)
+}
+
+// Requeue publishes a job whose retry fell due again, it reports false when the job no longer waits for a retry
+func (t *Transfer) Requeue(ctx context.Context, transferJob *entity.TransferJob) (bool, error) {
+	outbox := t.newOutboxMessage(transferJob)
+	return t.requeuePSQL(ctx, transferJob, &outbox)
+}
+
+// Claim leases the jobs matching param to this replica, rows locked or leased by another replica are skipped so no job is processed twice
+func (t *Transfer) Claim(ctx context.Context, param *model.GetTransferJobsByParam, lease time.Duration) (entity.TransferJobSlice, error) {
+	if lease <= 0 {
+		lease = model.DefaultTransferLease
 	}
+	return t.claimPSQL(ctx, param, lease)
 }
 
-func (t *Transfer) Insert(ctx context.Context, data *entity.TransferJob) error {
-	if err := t.insertKafka(ctx, data); err != nil {
-		return err
+// ClaimSubmission leases a pending job before it is sent to the provider. It returns false when there is nothing to
+// submit, the job left pending, was submitted already or waits for its retry, and the caller drops the message then. A
+// job another worker holds returns a BrickSVCTransferClaimed error, the caller retries the message later.
+func (t *Transfer) ClaimSubmission(ctx context.Context, v *entity.TransferJob, lease time.Duration) (bool, error) {
+	if lease <= 0 {
+		lease = model.DefaultTransferLease
 	}
-	return t.insertPSQL(ctx, data)
+	return t.claimSubmissionPSQL(ctx, v, lease)
+}
+
+// Release gives the job back before its lease expires, a lease taken over by another replica is left alone
+func (t *Transfer) Release(ctx context.Context, v *entity.TransferJob) error {
+	return t.releasePSQL(ctx, v)
+}
+
+func (t *Transfer) InsertRecurring(ctx context.Context, v *entity.RecurringTransfer) error {
+	return t.insertRecurringPSQL(ctx, v)
+}
+
+func (t *Transfer) GetSingleRecurringByParam(ctx context.Context, param *model.GetRecurringTransferByParam) (entity.RecurringTransfer, error) {
+	return t.getSingleRecurringByParamPSQL(ctx, param)
+}
+
+func (t *Transfer) GetRecurringByParam(ctx context.Context, param *model.GetRecurringTransfersByParam) (entity.RecurringTransferSlice, model.Pagination, error) {
+	return t.getRecurringByParamPSQL(ctx, param)
+}
+
+func (t *Transfer) SetRecurringStatus(ctx context.Context, v *entity.RecurringTransfer, from entity.Recurringstatus) error {
+	return t.setRecurringStatusPSQL(ctx, v, from)
+}
+
+// MaterializeRecurring creates the job of the mandate occurrence due at v.NextRunAt, it reports false when the occurrence was already taken
+func (t *Transfer) MaterializeRecurring(ctx context.Context, v *entity.RecurringTransfer, data *entity.TransferJob, event *entity.TransferJobEvent) (bool, error) {
+	var outbox *entity.OutboxMessage
+	if data.Status == entity.TransferstatusPending {
+		msg := t.newOutboxMessage(data)
+		outbox = &msg
+	}
+	return t.materializeRecurringPSQL(ctx, v, data, event, outbox)
+}
+
+func (t *Transfer) InsertQuote(ctx context.Context, v *entity.TransferQuote) error {
+	return t.insertQuotePSQL(ctx, v)
+}
+
+func (t *Transfer) GetSingleQuoteByParam(ctx context.Context, param *model.GetTransferQuoteByParam) (entity.TransferQuote, error) {
+	return t.getSingleQuoteByParamPSQL(ctx, param)
 }
 
 func (t *Transfer) InsertTransfer(ctx context.Context, data clientresponse.Transfer) (clientresponse.Transfer, error) {
@@ -101,13 +218,25 @@ func (t *Transfer) GetSingleByParam(ctx context.Context, cacheControl string, pa
 			return res, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error set redis")
 		}
 	}
-	return res, nil
+	return res, err
 }
 
 func (t *Transfer) Update(ctx context.Context, transferJob *entity.TransferJob) error {
 	return t.updatePSQL(ctx, transferJob)
 }
 
+func (t *Transfer) Amend(ctx context.Context, transferJob *entity.TransferJob) error {
+	return t.amendPSQL(ctx, transferJob)
+}
+
+func (t *Transfer) Transition(ctx context.Context, transferJob *entity.TransferJob, event *entity.TransferJobEvent, deliveries entity.WebhookDeliverySlice) error {
+	return t.transitionPSQL(ctx, transferJob, event, deliveries)
+}
+
+func (t *Transfer) GetEvents(ctx context.Context, transferJobID int) (entity.TransferJobEventSlice, error) {
+	return t.getEventsPSQL(ctx, transferJobID)
+}
+
 func (t *Transfer) Delete(ctx context.Context, transferJob *entity.TransferJob, id int64, isHardDelete bool) error {
 	return t.deletePSQL(ctx, transferJob, id, isHardDelete)
 }
@@ -178,3 +307,60 @@ func (t *Transfer) GetByParam(ctx context.Context, cacheControl string, param *m
 func (t *Transfer) GetTransferByID(id string) (clientresponse.Transfer, error) {
 	return t.getTransferHTTPClient(id)
 }
+
+// ListTransfers pages through the provider records dated inside the period until the provider returns a short page
+func (t *Transfer) ListTransfers(ctx context.Context, start time.Time, end time.Time) ([]clientresponse.Transfer, error) {
+	var res []clientresponse.Transfer
+	limit := t.Conf.ListTransactionLimit
+	if limit <= 0 {
+		limit = 100
+	}
+	for page := 1; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return res, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+		}
+
+		records, err := t.listTransferHTTPClient(start, end, page, limit)
+		if err != nil {
+			return res, err
+		}
+
+		for i := range records {
+			if model.InReconciliationPeriod(&records[i], start, end) {
+				res = append(res, records[i])
+			}
+		}
+
+		if len(records) < limit {
+			return res, nil
+		}
+	}
+}
+
+func (t *Transfer) SetIdempotency(ctx context.Context, apikey string, key string, data model.TransferIdempotency) (bool, error) {
+	dataStr, err := jsoniter.Marshal(&data)
+	if err != nil {
+		return false, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error marshal idempotency")
+	}
+	ok, err := t.setNXRedis(ctx, fmt.Sprintf(model.IdempotencyTransferKey, apikey, key), string(dataStr))
+	if err != nil {
+		return false, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error set redis")
+	}
+	return ok, nil
+}
+
+func (t *Transfer) GetIdempotency(ctx context.Context, apikey string, key string) (model.TransferIdempotency, error) {
+	res, err := t.getIdempotencyRedis(ctx, fmt.Sprintf(model.IdempotencyTransferKey, apikey, key))
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get redis")
+	}
+	return res, nil
+}
+
+func (t *Transfer) DeleteIdempotency(ctx context.Context, apikey string, key string) error {
+	_, err := t.Redis.Del(ctx, fmt.Sprintf(model.IdempotencyTransferKey, apikey, key)).Result()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error delete redis")
+	}
+	return nil
+}
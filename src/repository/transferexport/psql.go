@@ -0,0 +1,119 @@
+package transferexport
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+func (t *TransferExport) insertPSQL(ctx context.Context, v *entity.TransferExport) error {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = v.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (t *TransferExport) getSingleByParamPSQL(ctx context.Context, param *model.GetTransferExportJobByParam) (entity.TransferExport, error) {
+	var res entity.TransferExport
+	export, err := entity.TransferExports(param.GetQuery()...).One(ctx, t.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "error get transferExports")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferExports")
+	}
+
+	return *export, nil
+}
+
+func (t *TransferExport) updatePSQL(ctx context.Context, v *entity.TransferExport) error {
+	tx, err := t.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	_, err = v.Update(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+// eachJobPSQL declares the cursor in a read only transaction, the cursor lives until the transaction ends
+func (t *TransferExport) eachJobPSQL(ctx context.Context, param *model.GetTransferExportByParam, fn func(v *entity.TransferJob) error) error {
+	tx, err := t.DB.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	query, args := queries.BuildQuery(entity.TransferJobs(param.GetQuery()...).Query)
+	_, err = tx.ExecContext(ctx, "DECLARE transfer_export NO SCROLL CURSOR FOR "+strings.TrimSuffix(query, ";"), args...)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error declare cursor")
+	}
+
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM transfer_export", t.Conf.FetchSize)
+	for {
+		var transferJobs entity.TransferJobSlice
+		if err = queries.Raw(fetch).Bind(ctx, tx, &transferJobs); err != nil {
+			err = errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error fetch cursor")
+			break
+		}
+
+		for _, v := range transferJobs {
+			if err = fn(v); err != nil {
+				break
+			}
+		}
+
+		if err != nil || len(transferJobs) < t.Conf.FetchSize {
+			break
+		}
+	}
+
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package transferexport
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+)
+
+type TransferExport struct {
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct {
+	FetchSize int `mapstructure:"fetch_size"`
+}
+
+type TransferExportInterface interface {
+	Insert(ctx context.Context, v *entity.TransferExport) error
+	GetSingleByParam(ctx context.Context, param *model.GetTransferExportJobByParam) (entity.TransferExport, error)
+	Update(ctx context.Context, v *entity.TransferExport) error
+	EachJob(ctx context.Context, param *model.GetTransferExportByParam, fn func(v *entity.TransferJob) error) error
+}
+
+func New(conf Conf, db *sql.DB) TransferExportInterface {
+	if conf.FetchSize <= 0 {
+		conf.FetchSize = model.DefaultTransferExportFetchSize
+	}
+	return &TransferExport{
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+func (t *TransferExport) Insert(ctx context.Context, v *entity.TransferExport) error {
+	return t.insertPSQL(ctx, v)
+}
+
+func (t *TransferExport) GetSingleByParam(ctx context.Context, param *model.GetTransferExportJobByParam) (entity.TransferExport, error) {
+	return t.getSingleByParamPSQL(ctx, param)
+}
+
+func (t *TransferExport) Update(ctx context.Context, v *entity.TransferExport) error {
+	return t.updatePSQL(ctx, v)
+}
+
+// EachJob reads the matching transfer jobs through a server-side cursor, fetch_size rows at a time, and hands fn one job at a time
+func (t *TransferExport) EachJob(ctx context.Context, param *model.GetTransferExportByParam, fn func(v *entity.TransferJob) error) error {
+	return t.eachJobPSQL(ctx, param, fn)
+}
@@ -0,0 +1,19 @@
+package transferstream
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+)
+
+func (t *TransferStream) getEventsAfterPSQL(ctx context.Context, param *model.GetTransferStreamByParam) ([]model.TransferStreamMessage, error) {
+	var res []model.TransferStreamMessage
+	err := entity.TransferJobEvents(param.GetQuery()...).Bind(ctx, t.DB, &res)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get transferJobEvents")
+	}
+	return res, nil
+}
@@ -0,0 +1,64 @@
+package transferstream
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func (t *TransferStream) publishRedis(ctx context.Context, apikey string, v *model.TransferStreamMessage) error {
+	data, err := jsoniter.Marshal(v)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error marshal transfer stream message")
+	}
+
+	if err := t.Redis.Publish(ctx, fmt.Sprintf(model.TransferStreamChannel, apikey), data).Err(); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error publish transfer stream message")
+	}
+	return nil
+}
+
+func (t *TransferStream) subscribeRedis(ctx context.Context, apikey string) (<-chan model.TransferStreamMessage, error) {
+	pubsub := t.Redis.Subscribe(ctx, fmt.Sprintf(model.TransferStreamChannel, apikey))
+	// wait for the confirmation, a message published before it would not be received
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error subscribe transfer stream")
+	}
+
+	res := make(chan model.TransferStreamMessage)
+	go func() {
+		defer close(res)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				var v model.TransferStreamMessage
+				if err := jsoniter.UnmarshalFromString(msg.Payload, &v); err != nil {
+					logger.Log.Warn(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshal transfer stream message")))
+					continue
+				}
+
+				select {
+				case res <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return res, nil
+}
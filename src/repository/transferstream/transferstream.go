@@ -0,0 +1,56 @@
+package transferstream
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+type TransferStream struct {
+	DB    *sql.DB
+	Redis *goredislib.Client
+	Conf  Conf
+}
+
+type Conf struct {
+	ReplayLimit int `mapstructure:"replay_limit"`
+}
+
+type TransferStreamInterface interface {
+	Publish(ctx context.Context, apikey string, v *model.TransferStreamMessage) error
+	Subscribe(ctx context.Context, apikey string) (<-chan model.TransferStreamMessage, error)
+	GetEventsAfter(ctx context.Context, param *model.GetTransferStreamByParam) ([]model.TransferStreamMessage, error)
+}
+
+func New(conf Conf, db *sql.DB, rds *goredislib.Client) TransferStreamInterface {
+	if conf.ReplayLimit <= 0 {
+		conf.ReplayLimit = model.DefaultTransferStreamReplayLimit
+	}
+	return &TransferStream{
+		DB:    db,
+		Redis: rds,
+		Conf:  conf,
+	}
+}
+
+// Publish sends a status change to the subscribers of the api key on every replica, nobody listening is not an error
+func (t *TransferStream) Publish(ctx context.Context, apikey string, v *model.TransferStreamMessage) error {
+	return t.publishRedis(ctx, apikey, v)
+}
+
+// Subscribe returns the status changes published for the api key from the moment it returns until ctx is done,
+// the channel is closed once the subscription ends
+func (t *TransferStream) Subscribe(ctx context.Context, apikey string) (<-chan model.TransferStreamMessage, error) {
+	return t.subscribeRedis(ctx, apikey)
+}
+
+// GetEventsAfter reads up to replay_limit recorded status changes after the last event id of the param
+func (t *TransferStream) GetEventsAfter(ctx context.Context, param *model.GetTransferStreamByParam) ([]model.TransferStreamMessage, error) {
+	if param.Limit <= 0 || param.Limit > t.Conf.ReplayLimit {
+		param.Limit = t.Conf.ReplayLimit
+	}
+	return t.getEventsAfterPSQL(ctx, param)
+}
@@ -0,0 +1,41 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/hash"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (w *Webhook) sendHTTPClient(endpoint *entity.WebhookEndpoint, delivery *entity.WebhookDelivery) (int, error) {
+	timeout := w.Conf.Timeout
+	if timeout == 0 {
+		timeout = model.DefaultWebhookTimeout
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	client := fiber.Post(endpoint.URL)
+	client.Timeout(timeout)
+	client.ContentType("application/json")
+	client.Set(model.WebhookTimestampHeader, timestamp)
+	client.Set(model.WebhookDeliveryHeader, strconv.Itoa(delivery.ID))
+	client.Set(model.WebhookSignatureHeader, "sha256="+hash.HMAC(timestamp+"."+string(delivery.Payload), endpoint.Secret))
+	client.Body(delivery.Payload)
+	statusCode, _, errs := client.Bytes()
+	if len(errs) > 0 {
+		return statusCode, errormsg.WrapErr(svcerr.BrickSVCBadRequest, errors.Join(errs...), "error send webhook")
+	}
+
+	if statusCode < fiber.StatusOK || statusCode >= fiber.StatusMultipleChoices {
+		return statusCode, errormsg.WrapErr(svcerr.BrickSVCBadRequest, fmt.Errorf("unexpected status code %d", statusCode), "error send webhook")
+	}
+
+	return statusCode, nil
+}
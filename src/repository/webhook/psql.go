@@ -0,0 +1,208 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (w *Webhook) insertEndpointPSQL(ctx context.Context, data *entity.WebhookEndpoint) error {
+	tx, err := w.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = data.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (w *Webhook) getEndpointPSQL(ctx context.Context, param *model.GetWebhookEndpointByParam) (entity.WebhookEndpoint, error) {
+	var res entity.WebhookEndpoint
+	endpoint, err := entity.WebhookEndpoints(param.GetQuery()...).One(ctx, w.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "error get webhookEndpoints")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get webhookEndpoints")
+	}
+
+	return *endpoint, nil
+}
+
+func (w *Webhook) getEndpointsPSQL(ctx context.Context, param *model.GetWebhookEndpointByParam) (entity.WebhookEndpointSlice, error) {
+	qr := append(param.GetQuery(), qm.OrderBy("id"))
+	endpoints, err := entity.WebhookEndpoints(qr...).All(ctx, w.DB)
+	if err != nil {
+		return endpoints, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get webhookEndpoints")
+	}
+	return endpoints, nil
+}
+
+func (w *Webhook) deleteEndpointPSQL(ctx context.Context, endpoint *entity.WebhookEndpoint, id int64, isHardDelete bool) error {
+	tx, err := w.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	_, err = endpoint.Delete(ctx, tx, isHardDelete)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error delete")
+	}
+
+	if !isHardDelete {
+		endpoint.DeletedBy = null.NewInt(int(id), true)
+		endpoint.IsActive = false
+		_, err = endpoint.Update(ctx, tx, boil.Infer())
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update")
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (w *Webhook) insertDeliveryPSQL(ctx context.Context, data *entity.WebhookDelivery) error {
+	tx, err := w.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = data.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.BrickSVCPSQLErrorInsert, err, "error insert")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (w *Webhook) getDeliveryPSQL(ctx context.Context, id int64) (entity.WebhookDelivery, error) {
+	var res entity.WebhookDelivery
+	delivery, err := entity.WebhookDeliveries(
+		qm.Where("id=?", id),
+		qm.Load(entity.WebhookDeliveryRels.WebhookEndpoint),
+	).One(ctx, w.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "error get webhookDeliveries")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get webhookDeliveries")
+	}
+
+	return *delivery, nil
+}
+
+func (w *Webhook) getDeliveriesPSQL(ctx context.Context, webhookEndpointID int64, limit int64) (entity.WebhookDeliverySlice, error) {
+	deliveries, err := entity.WebhookDeliveries(
+		qm.Where("webhook_endpoint_id=?", webhookEndpointID),
+		qm.OrderBy("id DESC"),
+		qm.Limit(int(limit)),
+	).All(ctx, w.DB)
+	if err != nil {
+		return deliveries, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get webhookDeliveries")
+	}
+	return deliveries, nil
+}
+
+func (w *Webhook) deliverPSQL(ctx context.Context, limit int) (int, error) {
+	maxAttempts := w.Conf.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = model.DefaultWebhookMaxAttempts
+	}
+
+	tx, err := w.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	deliveries, err := entity.WebhookDeliveries(
+		qm.Where("status=?", entity.WebhookdeliverystatusPending),
+		qm.Where("next_attempt_at<=?", time.Now().UTC()),
+		qm.Load(entity.WebhookDeliveryRels.WebhookEndpoint),
+		qm.OrderBy("next_attempt_at, id"),
+		qm.Limit(limit),
+		qm.For("UPDATE SKIP LOCKED"),
+	).All(ctx, tx)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return 0, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorGet, err, "error get webhookDeliveries")
+	}
+
+	delivered := 0
+	for _, v := range deliveries {
+		if v.R == nil || v.R.WebhookEndpoint == nil || !v.R.WebhookEndpoint.IsActive {
+			v.Status = entity.WebhookdeliverystatusFailed
+			v.LastError = null.StringFrom("webhook endpoint is no longer active")
+		} else {
+			v.Attempts++
+			statusCode, err := w.sendHTTPClient(v.R.WebhookEndpoint, v)
+			if statusCode > 0 {
+				v.ResponseCode = null.IntFrom(statusCode)
+			}
+
+			if err == nil {
+				v.Status = entity.WebhookdeliverystatusSuccess
+				v.DeliveredAt = null.TimeFrom(time.Now().UTC())
+				v.LastError = null.String{}
+				delivered++
+			} else {
+				v.LastError = null.StringFrom(errormsg.WriteErr(err))
+				if v.Attempts >= maxAttempts {
+					v.Status = entity.WebhookdeliverystatusFailed
+				} else {
+					v.NextAttemptAt = time.Now().UTC().Add(w.backoff(v.Attempts))
+				}
+			}
+		}
+
+		if _, err := v.Update(ctx, tx, boil.Infer()); err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				logger.Log.Warn(errormsg.WrapErr(svcerr.BrickSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return 0, errormsg.WrapErr(svcerr.BrickSVCPSQLErrorUpdate, err, "error update webhookDeliveries")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error commit")
+	}
+	return delivered, nil
+}
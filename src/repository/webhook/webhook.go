@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+)
+
+type Webhook struct {
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct {
+	DefaultPageLimit int           `mapstructure:"page_limit"`
+	MaxAttempts      int           `mapstructure:"max_attempts"`
+	BaseBackoff      time.Duration `mapstructure:"base_backoff"`
+	MaxBackoff       time.Duration `mapstructure:"max_backoff"`
+	Timeout          time.Duration `mapstructure:"timeout"`
+}
+
+type WebhookInterface interface {
+	InsertEndpoint(ctx context.Context, data *entity.WebhookEndpoint) error
+	GetEndpoint(ctx context.Context, param *model.GetWebhookEndpointByParam) (entity.WebhookEndpoint, error)
+	GetEndpoints(ctx context.Context, param *model.GetWebhookEndpointByParam) (entity.WebhookEndpointSlice, error)
+	DeleteEndpoint(ctx context.Context, v *entity.WebhookEndpoint, id int64, isHardDelete bool) error
+	InsertDelivery(ctx context.Context, data *entity.WebhookDelivery) error
+	GetDelivery(ctx context.Context, id int64) (entity.WebhookDelivery, error)
+	GetDeliveries(ctx context.Context, webhookEndpointID int64, limit int64) (entity.WebhookDeliverySlice, error)
+	Deliver(ctx context.Context, limit int) (int, error)
+}
+
+func New(conf Conf, db *sql.DB) WebhookInterface {
+	return &Webhook{
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+func (w *Webhook) InsertEndpoint(ctx context.Context, data *entity.WebhookEndpoint) error {
+	return w.insertEndpointPSQL(ctx, data)
+}
+
+func (w *Webhook) GetEndpoint(ctx context.Context, param *model.GetWebhookEndpointByParam) (entity.WebhookEndpoint, error) {
+	return w.getEndpointPSQL(ctx, param)
+}
+
+func (w *Webhook) GetEndpoints(ctx context.Context, param *model.GetWebhookEndpointByParam) (entity.WebhookEndpointSlice, error) {
+	return w.getEndpointsPSQL(ctx, param)
+}
+
+func (w *Webhook) DeleteEndpoint(ctx context.Context, v *entity.WebhookEndpoint, id int64, isHardDelete bool) error {
+	return w.deleteEndpointPSQL(ctx, v, id, isHardDelete)
+}
+
+func (w *Webhook) InsertDelivery(ctx context.Context, data *entity.WebhookDelivery) error {
+	return w.insertDeliveryPSQL(ctx, data)
+}
+
+func (w *Webhook) GetDelivery(ctx context.Context, id int64) (entity.WebhookDelivery, error) {
+	return w.getDeliveryPSQL(ctx, id)
+}
+
+func (w *Webhook) GetDeliveries(ctx context.Context, webhookEndpointID int64, limit int64) (entity.WebhookDeliverySlice, error) {
+	if limit == 0 {
+		limit = int64(w.Conf.DefaultPageLimit)
+	}
+	return w.getDeliveriesPSQL(ctx, webhookEndpointID, limit)
+}
+
+func (w *Webhook) Deliver(ctx context.Context, limit int) (int, error) {
+	return w.deliverPSQL(ctx, limit)
+}
+
+// backoff doubles the wait after every failed attempt, capped at the configured maximum
+func (w *Webhook) backoff(attempts int) time.Duration {
+	base := w.Conf.BaseBackoff
+	if base == 0 {
+		base = model.DefaultWebhookBaseBackoff
+	}
+	max := w.Conf.MaxBackoff
+	if max == 0 {
+		max = model.DefaultWebhookMaxBackoff
+	}
+
+	wait := base
+	for i := 1; i < attempts && wait < max; i++ {
+		wait *= 2
+	}
+	if wait > max {
+		wait = max
+	}
+	return wait
+}
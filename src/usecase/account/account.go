@@ -171,11 +171,14 @@ func (a *Account) UpdateByID(ctx context.Context, id int64, v model.UpdateAccoun
 		return model.Account{}, err
 	}
 
-	if v.Name == account.Name {
+	if v.Name == account.Name && (!v.Plan.Valid || v.Plan.String == account.Plan) {
 		return model.TransformPSQLSingleAccount(&account), nil
 	}
 
 	account.Name = v.Name
+	if v.Plan.Valid {
+		account.Plan = v.Plan.String
+	}
 	account.UpdatedBy = int(v.UpdateBy)
 
 	err = a.account.Update(ctx, &account)
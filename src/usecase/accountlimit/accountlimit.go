@@ -0,0 +1,109 @@
+package accountlimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/repository/account"
+	"github.com/achwanyusuf/bricksvc/src/repository/accountlimit"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/null/v8"
+)
+
+type AccountLimit struct {
+	log          logger.LoggerInterface
+	conf         Conf
+	account      account.AccountInterface
+	accountLimit accountlimit.AccountLimitInterface
+}
+
+type Conf struct{}
+
+type AccountLimitInterface interface {
+	GetByAccountID(ctx context.Context, accountID int64) ([]model.AccountLimit, error)
+	Upsert(ctx context.Context, accountID int64, v model.UpsertAccountLimit, updatedBy int64) (model.AccountLimit, error)
+	DeleteByAccountID(ctx context.Context, accountID int64, currency string) error
+}
+
+func New(conf Conf, logger *logger.LoggerInterface, account account.AccountInterface, accountLimit accountlimit.AccountLimitInterface) AccountLimitInterface {
+	return &AccountLimit{
+		conf:         conf,
+		log:          *logger,
+		account:      account,
+		accountLimit: accountLimit,
+	}
+}
+
+// GetByAccountID returns the limits of every configured or overridden currency with the usage of the current day
+func (a *AccountLimit) GetByAccountID(ctx context.Context, accountID int64) ([]model.AccountLimit, error) {
+	acc, err := a.getAccount(ctx, accountID)
+	if err != nil {
+		return []model.AccountLimit{}, err
+	}
+
+	limits, err := a.accountLimit.GetLimits(ctx, acc.ID)
+	if err != nil {
+		return []model.AccountLimit{}, err
+	}
+
+	now := time.Now().UTC()
+	res := []model.AccountLimit{}
+	for _, v := range limits {
+		usage, err := a.accountLimit.GetUsage(ctx, acc.ID, acc.APIKey.String, v.Currency, now)
+		if err != nil {
+			return []model.AccountLimit{}, err
+		}
+		res = append(res, model.TransformPSQLSingleAccountLimit(v, usage))
+	}
+	return res, nil
+}
+
+func (a *AccountLimit) Upsert(ctx context.Context, accountID int64, v model.UpsertAccountLimit, updatedBy int64) (model.AccountLimit, error) {
+	acc, err := a.getAccount(ctx, accountID)
+	if err != nil {
+		return model.AccountLimit{}, err
+	}
+
+	data, err := v.ToEntity(int64(acc.ID))
+	if err != nil {
+		return model.AccountLimit{}, err
+	}
+	data.CreatedBy = int(updatedBy)
+	data.UpdatedBy = int(updatedBy)
+
+	if err := a.accountLimit.Upsert(ctx, &data); err != nil {
+		return model.AccountLimit{}, err
+	}
+
+	usage, err := a.accountLimit.GetUsage(ctx, acc.ID, acc.APIKey.String, data.Currency, time.Now().UTC())
+	if err != nil {
+		return model.AccountLimit{}, err
+	}
+	return model.TransformPSQLSingleAccountLimit(&data, usage), nil
+}
+
+// DeleteByAccountID removes the override of the currency, the account is limited by the configured default again
+func (a *AccountLimit) DeleteByAccountID(ctx context.Context, accountID int64, currency string) error {
+	limit, err := a.accountLimit.GetSingleByParam(ctx, &model.GetAccountLimitByParam{
+		AccountID: null.Int64From(accountID),
+		Currency:  null.StringFrom(currency),
+	})
+	if err != nil {
+		return err
+	}
+	return a.accountLimit.Delete(ctx, &limit)
+}
+
+func (a *AccountLimit) getAccount(ctx context.Context, accountID int64) (entity.Account, error) {
+	acc, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.Int64From(accountID),
+	})
+	if err != nil {
+		return entity.Account{}, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "account not found")
+	}
+	return acc, nil
+}
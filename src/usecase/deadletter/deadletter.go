@@ -0,0 +1,73 @@
+package deadletter
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/repository/deadletter"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/null/v8"
+)
+
+type DeadLetter struct {
+	log        logger.LoggerInterface
+	conf       Conf
+	deadLetter deadletter.DeadLetterInterface
+}
+
+type Conf struct{}
+
+type DeadLetterInterface interface {
+	Store(ctx context.Context, v model.CreateDeadLetterMessage) error
+	GetByParam(ctx context.Context, v model.GetDeadLetterMessagesByParam) ([]model.DeadLetterMessage, model.Pagination, error)
+	GetByID(ctx context.Context, id int64) (model.DeadLetterMessage, error)
+	Replay(ctx context.Context, id int64, replayedBy int64) (model.DeadLetterMessage, error)
+}
+
+func New(conf Conf, logger *logger.LoggerInterface, deadLetter deadletter.DeadLetterInterface) DeadLetterInterface {
+	return &DeadLetter{
+		conf:       conf,
+		log:        *logger,
+		deadLetter: deadLetter,
+	}
+}
+
+func (d *DeadLetter) Store(ctx context.Context, v model.CreateDeadLetterMessage) error {
+	data, err := v.ToEntity()
+	if err != nil {
+		return err
+	}
+	return d.deadLetter.Insert(ctx, &data)
+}
+
+func (d *DeadLetter) GetByParam(ctx context.Context, v model.GetDeadLetterMessagesByParam) ([]model.DeadLetterMessage, model.Pagination, error) {
+	messages, pagination, err := d.deadLetter.GetByParam(ctx, &v)
+	if err != nil {
+		return []model.DeadLetterMessage{}, pagination, err
+	}
+	return model.TransformPSQLDeadLetterMessage(&messages), pagination, nil
+}
+
+func (d *DeadLetter) GetByID(ctx context.Context, id int64) (model.DeadLetterMessage, error) {
+	message, err := d.deadLetter.GetSingleByParam(ctx, &model.GetDeadLetterMessageByParam{
+		ID: null.Int64From(id),
+	})
+	if err != nil {
+		return model.DeadLetterMessage{}, err
+	}
+	return model.TransformPSQLSingleDeadLetterMessage(&message), nil
+}
+
+func (d *DeadLetter) Replay(ctx context.Context, id int64, replayedBy int64) (model.DeadLetterMessage, error) {
+	message, err := d.deadLetter.GetSingleByParam(ctx, &model.GetDeadLetterMessageByParam{
+		ID: null.Int64From(id),
+	})
+	if err != nil {
+		return model.DeadLetterMessage{}, err
+	}
+
+	if err := d.deadLetter.Replay(ctx, &message, replayedBy); err != nil {
+		return model.DeadLetterMessage{}, err
+	}
+	return model.TransformPSQLSingleDeadLetterMessage(&message), nil
+}
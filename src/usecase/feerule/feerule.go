@@ -0,0 +1,97 @@
+package feerule
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/repository/feerule"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/null/v8"
+)
+
+type FeeRule struct {
+	log     logger.LoggerInterface
+	conf    Conf
+	feeRule feerule.FeeRuleInterface
+}
+
+type Conf struct{}
+
+type FeeRuleInterface interface {
+	Insert(ctx context.Context, v model.CreateFeeRule, createdBy int64) (model.FeeRule, error)
+	GetByParam(ctx context.Context, v model.GetFeeRulesByParam) ([]model.FeeRule, model.Pagination, error)
+	GetByID(ctx context.Context, id int64) (model.FeeRule, error)
+	UpdateByID(ctx context.Context, id int64, v model.CreateFeeRule, updatedBy int64) (model.FeeRule, error)
+	DeleteByID(ctx context.Context, id int64, deletedBy int64) error
+}
+
+func New(conf Conf, logger *logger.LoggerInterface, feeRule feerule.FeeRuleInterface) FeeRuleInterface {
+	return &FeeRule{
+		conf:    conf,
+		log:     *logger,
+		feeRule: feeRule,
+	}
+}
+
+func (f *FeeRule) Insert(ctx context.Context, v model.CreateFeeRule, createdBy int64) (model.FeeRule, error) {
+	var data entity.FeeRule
+	if err := v.FillEntity(&data); err != nil {
+		return model.FeeRule{}, err
+	}
+	data.CreatedBy = int(createdBy)
+	data.UpdatedBy = int(createdBy)
+
+	if err := f.feeRule.Insert(ctx, &data); err != nil {
+		return model.FeeRule{}, err
+	}
+	return model.TransformPSQLSingleFeeRule(&data), nil
+}
+
+func (f *FeeRule) GetByParam(ctx context.Context, v model.GetFeeRulesByParam) ([]model.FeeRule, model.Pagination, error) {
+	rules, pagination, err := f.feeRule.GetByParam(ctx, &v)
+	if err != nil {
+		return []model.FeeRule{}, pagination, err
+	}
+	return model.TransformPSQLFeeRule(&rules), pagination, nil
+}
+
+func (f *FeeRule) GetByID(ctx context.Context, id int64) (model.FeeRule, error) {
+	rule, err := f.feeRule.GetSingleByParam(ctx, &model.GetFeeRuleByParam{
+		ID: null.Int64From(id),
+	})
+	if err != nil {
+		return model.FeeRule{}, err
+	}
+	return model.TransformPSQLSingleFeeRule(&rule), nil
+}
+
+// UpdateByID replaces the rule, jobs already priced by it keep their stored fee
+func (f *FeeRule) UpdateByID(ctx context.Context, id int64, v model.CreateFeeRule, updatedBy int64) (model.FeeRule, error) {
+	rule, err := f.feeRule.GetSingleByParam(ctx, &model.GetFeeRuleByParam{
+		ID: null.Int64From(id),
+	})
+	if err != nil {
+		return model.FeeRule{}, err
+	}
+
+	if err := v.FillEntity(&rule); err != nil {
+		return model.FeeRule{}, err
+	}
+	rule.UpdatedBy = int(updatedBy)
+
+	if err := f.feeRule.Update(ctx, &rule); err != nil {
+		return model.FeeRule{}, err
+	}
+	return model.TransformPSQLSingleFeeRule(&rule), nil
+}
+
+func (f *FeeRule) DeleteByID(ctx context.Context, id int64, deletedBy int64) error {
+	rule, err := f.feeRule.GetSingleByParam(ctx, &model.GetFeeRuleByParam{
+		ID: null.Int64From(id),
+	})
+	if err != nil {
+		return err
+	}
+	return f.feeRule.Delete(ctx, &rule, deletedBy)
+}
@@ -0,0 +1,136 @@
+package fxrate
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/repository/fxrate"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/volatiletech/null/v8"
+)
+
+type FXRate struct {
+	log    logger.LoggerInterface
+	conf   Conf
+	fxRate fxrate.FXRateInterface
+
+	mu          sync.Mutex
+	fileModTime time.Time
+}
+
+type Conf struct {
+	RatesFile string `mapstructure:"rates_file"`
+}
+
+type FXRateInterface interface {
+	Upsert(ctx context.Context, v model.CreateFXRate, updatedBy int64) (model.FXRate, error)
+	GetByParam(ctx context.Context, v model.GetFXRatesByParam) ([]model.FXRate, model.Pagination, error)
+	GetByID(ctx context.Context, id int64) (model.FXRate, error)
+	DeleteByID(ctx context.Context, id int64) error
+	LoadFile(ctx context.Context) error
+}
+
+func New(conf Conf, logger *logger.LoggerInterface, fxRate fxrate.FXRateInterface) FXRateInterface {
+	return &FXRate{
+		conf:   conf,
+		log:    *logger,
+		fxRate: fxRate,
+	}
+}
+
+func (f *FXRate) Upsert(ctx context.Context, v model.CreateFXRate, updatedBy int64) (model.FXRate, error) {
+	data, err := v.ToEntity(model.FXRateSourceAPI)
+	if err != nil {
+		return model.FXRate{}, err
+	}
+	data.CreatedBy = int(updatedBy)
+	data.UpdatedBy = int(updatedBy)
+
+	if err := f.fxRate.Upsert(ctx, &data); err != nil {
+		return model.FXRate{}, err
+	}
+	return model.TransformPSQLSingleFXRate(&data), nil
+}
+
+func (f *FXRate) GetByParam(ctx context.Context, v model.GetFXRatesByParam) ([]model.FXRate, model.Pagination, error) {
+	rates, pagination, err := f.fxRate.GetByParam(ctx, &v)
+	if err != nil {
+		return []model.FXRate{}, pagination, err
+	}
+	return model.TransformPSQLFXRate(&rates), pagination, nil
+}
+
+func (f *FXRate) GetByID(ctx context.Context, id int64) (model.FXRate, error) {
+	rate, err := f.fxRate.GetSingleByParam(ctx, &model.GetFXRateByParam{
+		ID: null.Int64From(id),
+	})
+	if err != nil {
+		return model.FXRate{}, err
+	}
+	return model.TransformPSQLSingleFXRate(&rate), nil
+}
+
+func (f *FXRate) DeleteByID(ctx context.Context, id int64) error {
+	rate, err := f.fxRate.GetSingleByParam(ctx, &model.GetFXRateByParam{
+		ID: null.Int64From(id),
+	})
+	if err != nil {
+		return err
+	}
+	return f.fxRate.Delete(ctx, &rate)
+}
+
+// LoadFile upserts the rates of the configured json file, the file is only read again once it has been modified
+func (f *FXRate) LoadFile(ctx context.Context) error {
+	if f.conf.RatesFile == "" {
+		return nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.conf.RatesFile)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFXRate, err, "error stat rates file")
+	}
+	if info.ModTime().Equal(f.fileModTime) {
+		return nil
+	}
+
+	body, err := os.ReadFile(f.conf.RatesFile)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFXRate, err, "error read rates file")
+	}
+
+	var rates []model.CreateFXRate
+	if err := jsoniter.Unmarshal(body, &rates); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidFXRate, err, "error unmarshal rates file")
+	}
+
+	// a single invalid rate rejects the whole file so a half applied rate sheet is never quoted
+	for _, v := range rates {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range rates {
+		rate, err := v.ToEntity(model.FXRateSourceFile)
+		if err != nil {
+			return err
+		}
+		if err := f.fxRate.Upsert(ctx, &rate); err != nil {
+			return err
+		}
+	}
+
+	f.fileModTime = info.ModTime()
+	logger.Log.Info("fx rates loaded from " + f.conf.RatesFile)
+	return nil
+}
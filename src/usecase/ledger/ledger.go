@@ -0,0 +1,102 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/repository/account"
+	"github.com/achwanyusuf/bricksvc/src/repository/ledger"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/null/v8"
+)
+
+type Ledger struct {
+	log     logger.LoggerInterface
+	conf    Conf
+	account account.AccountInterface
+	ledger  ledger.LedgerInterface
+}
+
+type Conf struct{}
+
+type LedgerInterface interface {
+	GetBalance(ctx context.Context, apikey string) ([]model.LedgerAccount, error)
+	GetByParam(ctx context.Context, v model.GetLedgerAccountsByParam) ([]model.LedgerAccount, model.Pagination, error)
+	GetByID(ctx context.Context, id int64) (model.LedgerAccount, error)
+	GetEntries(ctx context.Context, v model.GetLedgerEntriesByParam) ([]model.LedgerEntry, model.Pagination, error)
+	CheckInvariant(ctx context.Context) ([]model.LedgerInvariant, error)
+}
+
+func New(conf Conf, logger *logger.LoggerInterface, account account.AccountInterface, ledger ledger.LedgerInterface) LedgerInterface {
+	return &Ledger{
+		conf:    conf,
+		log:     *logger,
+		account: account,
+		ledger:  ledger,
+	}
+}
+
+// GetBalance returns the merchant account of the api key in every currency it has moved money in
+func (l *Ledger) GetBalance(ctx context.Context, apikey string) ([]model.LedgerAccount, error) {
+	acc, err := l.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+		APIKey: null.StringFrom(apikey),
+	})
+	if err != nil {
+		return []model.LedgerAccount{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
+	}
+
+	res, _, err := l.ledger.GetByParam(ctx, &model.GetLedgerAccountsByParam{
+		GetLedgerAccountByParam: model.GetLedgerAccountByParam{
+			Kind:   null.StringFrom(entity.LedgeraccountkindMerchant.String()),
+			APIKey: acc.APIKey,
+		},
+		Limit: int64(len(model.CurrencyMinorUnits)),
+	})
+	if err != nil {
+		return []model.LedgerAccount{}, err
+	}
+	return model.TransformLedgerAccount(res), nil
+}
+
+func (l *Ledger) GetByParam(ctx context.Context, v model.GetLedgerAccountsByParam) ([]model.LedgerAccount, model.Pagination, error) {
+	res, pagination, err := l.ledger.GetByParam(ctx, &v)
+	if err != nil {
+		return []model.LedgerAccount{}, model.Pagination{}, err
+	}
+	return model.TransformLedgerAccount(res), pagination, nil
+}
+
+func (l *Ledger) GetByID(ctx context.Context, id int64) (model.LedgerAccount, error) {
+	res, err := l.ledger.GetSingleByParam(ctx, &model.GetLedgerAccountByParam{
+		ID: null.Int64From(id),
+	})
+	if err != nil {
+		return model.LedgerAccount{}, err
+	}
+	return model.TransformSingleLedgerAccount(&res), nil
+}
+
+func (l *Ledger) GetEntries(ctx context.Context, v model.GetLedgerEntriesByParam) ([]model.LedgerEntry, model.Pagination, error) {
+	if _, err := l.GetByID(ctx, v.LedgerAccountID); err != nil {
+		return []model.LedgerEntry{}, model.Pagination{}, err
+	}
+	return l.ledger.GetEntriesByParam(ctx, &v)
+}
+
+// CheckInvariant reports the totals per currency and logs every currency whose debits and credits differ
+func (l *Ledger) CheckInvariant(ctx context.Context) ([]model.LedgerInvariant, error) {
+	res, err := l.ledger.CheckInvariant(ctx)
+	if err != nil {
+		return []model.LedgerInvariant{}, err
+	}
+	for _, v := range res {
+		if !v.Balanced {
+			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCUnbalancedLedger, nil, fmt.Sprintf("%s debits %d but credits %d, %d of %d transactions unbalanced", v.Currency, v.Debit, v.Credit, v.UnbalancedTransactions, v.Transactions))))
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/accountlimit/accountlimit.go
+
+// Package mock_accountlimit is a generated GoMock package.
+package mock_accountlimit
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAccountLimitInterface is a mock of AccountLimitInterface interface.
+type MockAccountLimitInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountLimitInterfaceMockRecorder
+}
+
+// MockAccountLimitInterfaceMockRecorder is the mock recorder for MockAccountLimitInterface.
+type MockAccountLimitInterfaceMockRecorder struct {
+	mock *MockAccountLimitInterface
+}
+
+// NewMockAccountLimitInterface creates a new mock instance.
+func NewMockAccountLimitInterface(ctrl *gomock.Controller) *MockAccountLimitInterface {
+	mock := &MockAccountLimitInterface{ctrl: ctrl}
+	mock.recorder = &MockAccountLimitInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountLimitInterface) EXPECT() *MockAccountLimitInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteByAccountID mocks base method.
+func (m *MockAccountLimitInterface) DeleteByAccountID(ctx context.Context, accountID int64, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByAccountID", ctx, accountID, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByAccountID indicates an expected call of DeleteByAccountID.
+func (mr *MockAccountLimitInterfaceMockRecorder) DeleteByAccountID(ctx, accountID, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByAccountID", reflect.TypeOf((*MockAccountLimitInterface)(nil).DeleteByAccountID), ctx, accountID, currency)
+}
+
+// GetByAccountID mocks base method.
+func (m *MockAccountLimitInterface) GetByAccountID(ctx context.Context, accountID int64) ([]model.AccountLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccountID", ctx, accountID)
+	ret0, _ := ret[0].([]model.AccountLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAccountID indicates an expected call of GetByAccountID.
+func (mr *MockAccountLimitInterfaceMockRecorder) GetByAccountID(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockAccountLimitInterface)(nil).GetByAccountID), ctx, accountID)
+}
+
+// Upsert mocks base method.
+func (m *MockAccountLimitInterface) Upsert(ctx context.Context, accountID int64, v model.UpsertAccountLimit, updatedBy int64) (model.AccountLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, accountID, v, updatedBy)
+	ret0, _ := ret[0].(model.AccountLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockAccountLimitInterfaceMockRecorder) Upsert(ctx, accountID, v, updatedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockAccountLimitInterface)(nil).Upsert), ctx, accountID, v, updatedBy)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/deadletter/deadletter.go
+
+// Package mock_deadletter is a generated GoMock package.
+package mock_deadletter
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDeadLetterInterface is a mock of DeadLetterInterface interface.
+type MockDeadLetterInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterInterfaceMockRecorder
+}
+
+// MockDeadLetterInterfaceMockRecorder is the mock recorder for MockDeadLetterInterface.
+type MockDeadLetterInterfaceMockRecorder struct {
+	mock *MockDeadLetterInterface
+}
+
+// NewMockDeadLetterInterface creates a new mock instance.
+func NewMockDeadLetterInterface(ctrl *gomock.Controller) *MockDeadLetterInterface {
+	mock := &MockDeadLetterInterface{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterInterface) EXPECT() *MockDeadLetterInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockDeadLetterInterface) GetByID(ctx context.Context, id int64) (model.DeadLetterMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(model.DeadLetterMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockDeadLetterInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockDeadLetterInterface)(nil).GetByID), ctx, id)
+}
+
+// GetByParam mocks base method.
+func (m *MockDeadLetterInterface) GetByParam(ctx context.Context, v model.GetDeadLetterMessagesByParam) ([]model.DeadLetterMessage, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, v)
+	ret0, _ := ret[0].([]model.DeadLetterMessage)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockDeadLetterInterfaceMockRecorder) GetByParam(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockDeadLetterInterface)(nil).GetByParam), ctx, v)
+}
+
+// Replay mocks base method.
+func (m *MockDeadLetterInterface) Replay(ctx context.Context, id, replayedBy int64) (model.DeadLetterMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replay", ctx, id, replayedBy)
+	ret0, _ := ret[0].(model.DeadLetterMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Replay indicates an expected call of Replay.
+func (mr *MockDeadLetterInterfaceMockRecorder) Replay(ctx, id, replayedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockDeadLetterInterface)(nil).Replay), ctx, id, replayedBy)
+}
+
+// Store mocks base method.
+func (m *MockDeadLetterInterface) Store(ctx context.Context, v model.CreateDeadLetterMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Store", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Store indicates an expected call of Store.
+func (mr *MockDeadLetterInterfaceMockRecorder) Store(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockDeadLetterInterface)(nil).Store), ctx, v)
+}
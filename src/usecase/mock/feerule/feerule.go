@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/feerule/feerule.go
+
+// Package mock_feerule is a generated GoMock package.
+package mock_feerule
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFeeRuleInterface is a mock of FeeRuleInterface interface.
+type MockFeeRuleInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeRuleInterfaceMockRecorder
+}
+
+// MockFeeRuleInterfaceMockRecorder is the mock recorder for MockFeeRuleInterface.
+type MockFeeRuleInterfaceMockRecorder struct {
+	mock *MockFeeRuleInterface
+}
+
+// NewMockFeeRuleInterface creates a new mock instance.
+func NewMockFeeRuleInterface(ctrl *gomock.Controller) *MockFeeRuleInterface {
+	mock := &MockFeeRuleInterface{ctrl: ctrl}
+	mock.recorder = &MockFeeRuleInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeRuleInterface) EXPECT() *MockFeeRuleInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteByID mocks base method.
+func (m *MockFeeRuleInterface) DeleteByID(ctx context.Context, id, deletedBy int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id, deletedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockFeeRuleInterfaceMockRecorder) DeleteByID(ctx, id, deletedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockFeeRuleInterface)(nil).DeleteByID), ctx, id, deletedBy)
+}
+
+// GetByID mocks base method.
+func (m *MockFeeRuleInterface) GetByID(ctx context.Context, id int64) (model.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(model.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockFeeRuleInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockFeeRuleInterface)(nil).GetByID), ctx, id)
+}
+
+// GetByParam mocks base method.
+func (m *MockFeeRuleInterface) GetByParam(ctx context.Context, v model.GetFeeRulesByParam) ([]model.FeeRule, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, v)
+	ret0, _ := ret[0].([]model.FeeRule)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockFeeRuleInterfaceMockRecorder) GetByParam(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockFeeRuleInterface)(nil).GetByParam), ctx, v)
+}
+
+// Insert mocks base method.
+func (m *MockFeeRuleInterface) Insert(ctx context.Context, v model.CreateFeeRule, createdBy int64) (model.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, v, createdBy)
+	ret0, _ := ret[0].(model.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockFeeRuleInterfaceMockRecorder) Insert(ctx, v, createdBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockFeeRuleInterface)(nil).Insert), ctx, v, createdBy)
+}
+
+// UpdateByID mocks base method.
+func (m *MockFeeRuleInterface) UpdateByID(ctx context.Context, id int64, v model.CreateFeeRule, updatedBy int64) (model.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByID", ctx, id, v, updatedBy)
+	ret0, _ := ret[0].(model.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateByID indicates an expected call of UpdateByID.
+func (mr *MockFeeRuleInterfaceMockRecorder) UpdateByID(ctx, id, v, updatedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MockFeeRuleInterface)(nil).UpdateByID), ctx, id, v, updatedBy)
+}
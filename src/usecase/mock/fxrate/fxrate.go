@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/fxrate/fxrate.go
+
+// Package mock_fxrate is a generated GoMock package.
+package mock_fxrate
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFXRateInterface is a mock of FXRateInterface interface.
+type MockFXRateInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFXRateInterfaceMockRecorder
+}
+
+// MockFXRateInterfaceMockRecorder is the mock recorder for MockFXRateInterface.
+type MockFXRateInterfaceMockRecorder struct {
+	mock *MockFXRateInterface
+}
+
+// NewMockFXRateInterface creates a new mock instance.
+func NewMockFXRateInterface(ctrl *gomock.Controller) *MockFXRateInterface {
+	mock := &MockFXRateInterface{ctrl: ctrl}
+	mock.recorder = &MockFXRateInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFXRateInterface) EXPECT() *MockFXRateInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteByID mocks base method.
+func (m *MockFXRateInterface) DeleteByID(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockFXRateInterfaceMockRecorder) DeleteByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockFXRateInterface)(nil).DeleteByID), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockFXRateInterface) GetByID(ctx context.Context, id int64) (model.FXRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(model.FXRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockFXRateInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockFXRateInterface)(nil).GetByID), ctx, id)
+}
+
+// GetByParam mocks base method.
+func (m *MockFXRateInterface) GetByParam(ctx context.Context, v model.GetFXRatesByParam) ([]model.FXRate, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, v)
+	ret0, _ := ret[0].([]model.FXRate)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockFXRateInterfaceMockRecorder) GetByParam(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockFXRateInterface)(nil).GetByParam), ctx, v)
+}
+
+// LoadFile mocks base method.
+func (m *MockFXRateInterface) LoadFile(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadFile", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoadFile indicates an expected call of LoadFile.
+func (mr *MockFXRateInterfaceMockRecorder) LoadFile(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadFile", reflect.TypeOf((*MockFXRateInterface)(nil).LoadFile), ctx)
+}
+
+// Upsert mocks base method.
+func (m *MockFXRateInterface) Upsert(ctx context.Context, v model.CreateFXRate, updatedBy int64) (model.FXRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, v, updatedBy)
+	ret0, _ := ret[0].(model.FXRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockFXRateInterfaceMockRecorder) Upsert(ctx, v, updatedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockFXRateInterface)(nil).Upsert), ctx, v, updatedBy)
+}
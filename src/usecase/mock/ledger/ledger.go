@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/ledger/ledger.go
+
+// Package mock_ledger is a generated GoMock package.
+package mock_ledger
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLedgerInterface is a mock of LedgerInterface interface.
+type MockLedgerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerInterfaceMockRecorder
+}
+
+// MockLedgerInterfaceMockRecorder is the mock recorder for MockLedgerInterface.
+type MockLedgerInterfaceMockRecorder struct {
+	mock *MockLedgerInterface
+}
+
+// NewMockLedgerInterface creates a new mock instance.
+func NewMockLedgerInterface(ctrl *gomock.Controller) *MockLedgerInterface {
+	mock := &MockLedgerInterface{ctrl: ctrl}
+	mock.recorder = &MockLedgerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerInterface) EXPECT() *MockLedgerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CheckInvariant mocks base method.
+func (m *MockLedgerInterface) CheckInvariant(ctx context.Context) ([]model.LedgerInvariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckInvariant", ctx)
+	ret0, _ := ret[0].([]model.LedgerInvariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckInvariant indicates an expected call of CheckInvariant.
+func (mr *MockLedgerInterfaceMockRecorder) CheckInvariant(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInvariant", reflect.TypeOf((*MockLedgerInterface)(nil).CheckInvariant), ctx)
+}
+
+// GetBalance mocks base method.
+func (m *MockLedgerInterface) GetBalance(ctx context.Context, apikey string) ([]model.LedgerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, apikey)
+	ret0, _ := ret[0].([]model.LedgerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockLedgerInterfaceMockRecorder) GetBalance(ctx, apikey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockLedgerInterface)(nil).GetBalance), ctx, apikey)
+}
+
+// GetByID mocks base method.
+func (m *MockLedgerInterface) GetByID(ctx context.Context, id int64) (model.LedgerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(model.LedgerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockLedgerInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockLedgerInterface)(nil).GetByID), ctx, id)
+}
+
+// GetByParam mocks base method.
+func (m *MockLedgerInterface) GetByParam(ctx context.Context, v model.GetLedgerAccountsByParam) ([]model.LedgerAccount, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, v)
+	ret0, _ := ret[0].([]model.LedgerAccount)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockLedgerInterfaceMockRecorder) GetByParam(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockLedgerInterface)(nil).GetByParam), ctx, v)
+}
+
+// GetEntries mocks base method.
+func (m *MockLedgerInterface) GetEntries(ctx context.Context, v model.GetLedgerEntriesByParam) ([]model.LedgerEntry, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntries", ctx, v)
+	ret0, _ := ret[0].([]model.LedgerEntry)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEntries indicates an expected call of GetEntries.
+func (mr *MockLedgerInterfaceMockRecorder) GetEntries(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntries", reflect.TypeOf((*MockLedgerInterface)(nil).GetEntries), ctx, v)
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/reconciliation/reconciliation.go
+
+// Package mock_reconciliation is a generated GoMock package.
+package mock_reconciliation
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReconciliationInterface is a mock of ReconciliationInterface interface.
+type MockReconciliationInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationInterfaceMockRecorder
+}
+
+// MockReconciliationInterfaceMockRecorder is the mock recorder for MockReconciliationInterface.
+type MockReconciliationInterfaceMockRecorder struct {
+	mock *MockReconciliationInterface
+}
+
+// NewMockReconciliationInterface creates a new mock instance.
+func NewMockReconciliationInterface(ctrl *gomock.Controller) *MockReconciliationInterface {
+	mock := &MockReconciliationInterface{ctrl: ctrl}
+	mock.recorder = &MockReconciliationInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliationInterface) EXPECT() *MockReconciliationInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReconciliationInterface) Create(ctx context.Context, v model.CreateReconciliation, createdBy int64) (model.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, v, createdBy)
+	ret0, _ := ret[0].(model.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReconciliationInterfaceMockRecorder) Create(ctx, v, createdBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReconciliationInterface)(nil).Create), ctx, v, createdBy)
+}
+
+// ExportCSV mocks base method.
+func (m *MockReconciliationInterface) ExportCSV(ctx context.Context, reportID string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCSV", ctx, reportID, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportCSV indicates an expected call of ExportCSV.
+func (mr *MockReconciliationInterfaceMockRecorder) ExportCSV(ctx, reportID, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCSV", reflect.TypeOf((*MockReconciliationInterface)(nil).ExportCSV), ctx, reportID, w)
+}
+
+// GetByParam mocks base method.
+func (m *MockReconciliationInterface) GetByParam(ctx context.Context, v model.GetReconciliationReportsByParam) ([]model.ReconciliationReport, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, v)
+	ret0, _ := ret[0].([]model.ReconciliationReport)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockReconciliationInterfaceMockRecorder) GetByParam(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockReconciliationInterface)(nil).GetByParam), ctx, v)
+}
+
+// GetByReportID mocks base method.
+func (m *MockReconciliationInterface) GetByReportID(ctx context.Context, reportID string) (model.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReportID", ctx, reportID)
+	ret0, _ := ret[0].(model.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReportID indicates an expected call of GetByReportID.
+func (mr *MockReconciliationInterfaceMockRecorder) GetByReportID(ctx, reportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReportID", reflect.TypeOf((*MockReconciliationInterface)(nil).GetByReportID), ctx, reportID)
+}
+
+// GetDiscrepancies mocks base method.
+func (m *MockReconciliationInterface) GetDiscrepancies(ctx context.Context, reportID string, v model.GetReconciliationDiscrepanciesByParam) ([]model.ReconciliationDiscrepancy, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscrepancies", ctx, reportID, v)
+	ret0, _ := ret[0].([]model.ReconciliationDiscrepancy)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDiscrepancies indicates an expected call of GetDiscrepancies.
+func (mr *MockReconciliationInterfaceMockRecorder) GetDiscrepancies(ctx, reportID, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscrepancies", reflect.TypeOf((*MockReconciliationInterface)(nil).GetDiscrepancies), ctx, reportID, v)
+}
+
+// ReconcileDaily mocks base method.
+func (m *MockReconciliationInterface) ReconcileDaily(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileDaily", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileDaily indicates an expected call of ReconcileDaily.
+func (mr *MockReconciliationInterfaceMockRecorder) ReconcileDaily(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileDaily", reflect.TypeOf((*MockReconciliationInterface)(nil).ReconcileDaily), ctx)
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/settlement/settlement.go
+
+// Package mock_settlement is a generated GoMock package.
+package mock_settlement
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSettlementInterface is a mock of SettlementInterface interface.
+type MockSettlementInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSettlementInterfaceMockRecorder
+}
+
+// MockSettlementInterfaceMockRecorder is the mock recorder for MockSettlementInterface.
+type MockSettlementInterfaceMockRecorder struct {
+	mock *MockSettlementInterface
+}
+
+// NewMockSettlementInterface creates a new mock instance.
+func NewMockSettlementInterface(ctrl *gomock.Controller) *MockSettlementInterface {
+	mock := &MockSettlementInterface{ctrl: ctrl}
+	mock.recorder = &MockSettlementInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSettlementInterface) EXPECT() *MockSettlementInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockSettlementInterface) Export(ctx context.Context, v *model.GetSettlementReportByParam, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, v, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockSettlementInterfaceMockRecorder) Export(ctx, v, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockSettlementInterface)(nil).Export), ctx, v, w)
+}
+
+// Validate mocks base method.
+func (m *MockSettlementInterface) Validate(ctx context.Context, v *model.GetSettlementReportByParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockSettlementInterfaceMockRecorder) Validate(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockSettlementInterface)(nil).Validate), ctx, v)
+}
+
+// ValidateStatement mocks base method.
+func (m *MockSettlementInterface) ValidateStatement(ctx context.Context, apikey string, v *model.GetSettlementReportByParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateStatement", ctx, apikey, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateStatement indicates an expected call of ValidateStatement.
+func (mr *MockSettlementInterfaceMockRecorder) ValidateStatement(ctx, apikey, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateStatement", reflect.TypeOf((*MockSettlementInterface)(nil).ValidateStatement), ctx, apikey, v)
+}
+
+// WriteDaily mocks base method.
+func (m *MockSettlementInterface) WriteDaily(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteDaily", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteDaily indicates an expected call of WriteDaily.
+func (mr *MockSettlementInterfaceMockRecorder) WriteDaily(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteDaily", reflect.TypeOf((*MockSettlementInterface)(nil).WriteDaily), ctx)
+}
@@ -35,6 +35,51 @@ func (m *MockTransferInterface) EXPECT() *MockTransferInterfaceMockRecorder {
 	return m.recorder
 }
 
+// Amend mocks base method.
+func (m *MockTransferInterface) Amend(ctx context.Context, id, apikey string, v model.UpdateTransfer) (model.TransferJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Amend", ctx, id, apikey, v)
+	ret0, _ := ret[0].(model.TransferJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Amend indicates an expected call of Amend.
+func (mr *MockTransferInterfaceMockRecorder) Amend(ctx, id, apikey, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Amend", reflect.TypeOf((*MockTransferInterface)(nil).Amend), ctx, id, apikey, v)
+}
+
+// Batch mocks base method.
+func (m *MockTransferInterface) Batch(ctx context.Context, rows []model.CreateTransferBatchRow, apikey string) (model.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Batch", ctx, rows, apikey)
+	ret0, _ := ret[0].(model.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Batch indicates an expected call of Batch.
+func (mr *MockTransferInterfaceMockRecorder) Batch(ctx, rows, apikey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Batch", reflect.TypeOf((*MockTransferInterface)(nil).Batch), ctx, rows, apikey)
+}
+
+// Cancel mocks base method.
+func (m *MockTransferInterface) Cancel(ctx context.Context, id, apikey string) (model.TransferJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id, apikey)
+	ret0, _ := ret[0].(model.TransferJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockTransferInterfaceMockRecorder) Cancel(ctx, id, apikey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockTransferInterface)(nil).Cancel), ctx, id, apikey)
+}
+
 // Create mocks base method.
 func (m *MockTransferInterface) Create(ctx context.Context, key string, v model.CreateTransfer) error {
 	m.ctrl.T.Helper()
@@ -49,25 +94,55 @@ func (mr *MockTransferInterfaceMockRecorder) Create(ctx, key, v interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferInterface)(nil).Create), ctx, key, v)
 }
 
+// CreateRecurring mocks base method.
+func (m *MockTransferInterface) CreateRecurring(ctx context.Context, v model.CreateRecurringTransfer, apikey string) (model.RecurringTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecurring", ctx, v, apikey)
+	ret0, _ := ret[0].(model.RecurringTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecurring indicates an expected call of CreateRecurring.
+func (mr *MockTransferInterfaceMockRecorder) CreateRecurring(ctx, v, apikey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecurring", reflect.TypeOf((*MockTransferInterface)(nil).CreateRecurring), ctx, v, apikey)
+}
+
+// GetBatchByID mocks base method.
+func (m *MockTransferInterface) GetBatchByID(ctx context.Context, reader model.TransferReader, id string) (model.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBatchByID", ctx, reader, id)
+	ret0, _ := ret[0].(model.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBatchByID indicates an expected call of GetBatchByID.
+func (mr *MockTransferInterfaceMockRecorder) GetBatchByID(ctx, reader, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatchByID", reflect.TypeOf((*MockTransferInterface)(nil).GetBatchByID), ctx, reader, id)
+}
+
 // GetByJobID mocks base method.
-func (m *MockTransferInterface) GetByJobID(ctx context.Context, cacheControl, id string) (model.TransferJob, error) {
+func (m *MockTransferInterface) GetByJobID(ctx context.Context, cacheControl string, reader model.TransferReader, id string) (model.TransferJob, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByJobID", ctx, cacheControl, id)
+	ret := m.ctrl.Call(m, "GetByJobID", ctx, cacheControl, reader, id)
 	ret0, _ := ret[0].(model.TransferJob)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByJobID indicates an expected call of GetByJobID.
-func (mr *MockTransferInterfaceMockRecorder) GetByJobID(ctx, cacheControl, id interface{}) *gomock.Call {
+func (mr *MockTransferInterfaceMockRecorder) GetByJobID(ctx, cacheControl, reader, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByJobID", reflect.TypeOf((*MockTransferInterface)(nil).GetByJobID), ctx, cacheControl, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByJobID", reflect.TypeOf((*MockTransferInterface)(nil).GetByJobID), ctx, cacheControl, reader, id)
 }
 
 // GetByParam mocks base method.
-func (m *MockTransferInterface) GetByParam(ctx context.Context, cacheControl string, v model.GetTransferJobsByParam) ([]model.TransferJob, model.Pagination, error) {
+func (m *MockTransferInterface) GetByParam(ctx context.Context, cacheControl string, reader model.TransferReader, v model.GetTransferJobsByParam) ([]model.TransferJob, model.Pagination, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByParam", ctx, cacheControl, v)
+	ret := m.ctrl.Call(m, "GetByParam", ctx, cacheControl, reader, v)
 	ret0, _ := ret[0].([]model.TransferJob)
 	ret1, _ := ret[1].(model.Pagination)
 	ret2, _ := ret[2].(error)
@@ -75,9 +150,98 @@ func (m *MockTransferInterface) GetByParam(ctx context.Context, cacheControl str
 }
 
 // GetByParam indicates an expected call of GetByParam.
-func (mr *MockTransferInterfaceMockRecorder) GetByParam(ctx, cacheControl, v interface{}) *gomock.Call {
+func (mr *MockTransferInterfaceMockRecorder) GetByParam(ctx, cacheControl, reader, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockTransferInterface)(nil).GetByParam), ctx, cacheControl, reader, v)
+}
+
+// GetEventsByJobID mocks base method.
+func (m *MockTransferInterface) GetEventsByJobID(ctx context.Context, cacheControl string, reader model.TransferReader, id string) ([]model.TransferJobEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsByJobID", ctx, cacheControl, reader, id)
+	ret0, _ := ret[0].([]model.TransferJobEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsByJobID indicates an expected call of GetEventsByJobID.
+func (mr *MockTransferInterfaceMockRecorder) GetEventsByJobID(ctx, cacheControl, reader, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsByJobID", reflect.TypeOf((*MockTransferInterface)(nil).GetEventsByJobID), ctx, cacheControl, reader, id)
+}
+
+// GetRecurring mocks base method.
+func (m *MockTransferInterface) GetRecurring(ctx context.Context, apikey string, v model.GetRecurringTransfersByParam) ([]model.RecurringTransfer, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurring", ctx, apikey, v)
+	ret0, _ := ret[0].([]model.RecurringTransfer)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRecurring indicates an expected call of GetRecurring.
+func (mr *MockTransferInterfaceMockRecorder) GetRecurring(ctx, apikey, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurring", reflect.TypeOf((*MockTransferInterface)(nil).GetRecurring), ctx, apikey, v)
+}
+
+// GetRecurringByID mocks base method.
+func (m *MockTransferInterface) GetRecurringByID(ctx context.Context, id, apikey string) (model.RecurringTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurringByID", ctx, id, apikey)
+	ret0, _ := ret[0].(model.RecurringTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecurringByID indicates an expected call of GetRecurringByID.
+func (mr *MockTransferInterfaceMockRecorder) GetRecurringByID(ctx, id, apikey interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockTransferInterface)(nil).GetByParam), ctx, cacheControl, v)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurringByID", reflect.TypeOf((*MockTransferInterface)(nil).GetRecurringByID), ctx, id, apikey)
+}
+
+// GetScheduled mocks base method.
+func (m *MockTransferInterface) GetScheduled(ctx context.Context, apikey string, v model.GetTransferJobsByParam) ([]model.TransferJob, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduled", ctx, apikey, v)
+	ret0, _ := ret[0].([]model.TransferJob)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetScheduled indicates an expected call of GetScheduled.
+func (mr *MockTransferInterfaceMockRecorder) GetScheduled(ctx, apikey, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduled", reflect.TypeOf((*MockTransferInterface)(nil).GetScheduled), ctx, apikey, v)
+}
+
+// MaterializeRecurring mocks base method.
+func (m *MockTransferInterface) MaterializeRecurring(ctx context.Context, limit int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MaterializeRecurring", ctx, limit)
+}
+
+// MaterializeRecurring indicates an expected call of MaterializeRecurring.
+func (mr *MockTransferInterfaceMockRecorder) MaterializeRecurring(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaterializeRecurring", reflect.TypeOf((*MockTransferInterface)(nil).MaterializeRecurring), ctx, limit)
+}
+
+// PauseRecurring mocks base method.
+func (m *MockTransferInterface) PauseRecurring(ctx context.Context, id, apikey string) (model.RecurringTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseRecurring", ctx, id, apikey)
+	ret0, _ := ret[0].(model.RecurringTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseRecurring indicates an expected call of PauseRecurring.
+func (mr *MockTransferInterfaceMockRecorder) PauseRecurring(ctx, id, apikey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseRecurring", reflect.TypeOf((*MockTransferInterface)(nil).PauseRecurring), ctx, id, apikey)
 }
 
 // ProccessGetCallback mocks base method.
@@ -92,17 +256,98 @@ func (mr *MockTransferInterfaceMockRecorder) ProccessGetCallback(ctx, param inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProccessGetCallback", reflect.TypeOf((*MockTransferInterface)(nil).ProccessGetCallback), ctx, param)
 }
 
+// ProviderCallback mocks base method.
+func (m *MockTransferInterface) ProviderCallback(ctx context.Context, provider string, v model.ProviderCallback) (model.ProviderCallbackAck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProviderCallback", ctx, provider, v)
+	ret0, _ := ret[0].(model.ProviderCallbackAck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProviderCallback indicates an expected call of ProviderCallback.
+func (mr *MockTransferInterfaceMockRecorder) ProviderCallback(ctx, provider, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProviderCallback", reflect.TypeOf((*MockTransferInterface)(nil).ProviderCallback), ctx, provider, v)
+}
+
+// Quote mocks base method.
+func (m *MockTransferInterface) Quote(ctx context.Context, v model.CreateTransferQuote, apikey string) (model.TransferQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", ctx, v, apikey)
+	ret0, _ := ret[0].(model.TransferQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockTransferInterfaceMockRecorder) Quote(ctx, v, apikey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockTransferInterface)(nil).Quote), ctx, v, apikey)
+}
+
+// RelayOutbox mocks base method.
+func (m *MockTransferInterface) RelayOutbox(ctx context.Context, limit int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RelayOutbox", ctx, limit)
+}
+
+// RelayOutbox indicates an expected call of RelayOutbox.
+func (mr *MockTransferInterfaceMockRecorder) RelayOutbox(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayOutbox", reflect.TypeOf((*MockTransferInterface)(nil).RelayOutbox), ctx, limit)
+}
+
+// ReleaseScheduled mocks base method.
+func (m *MockTransferInterface) ReleaseScheduled(ctx context.Context, limit int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseScheduled", ctx, limit)
+}
+
+// ReleaseScheduled indicates an expected call of ReleaseScheduled.
+func (mr *MockTransferInterfaceMockRecorder) ReleaseScheduled(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseScheduled", reflect.TypeOf((*MockTransferInterface)(nil).ReleaseScheduled), ctx, limit)
+}
+
+// ResumeRecurring mocks base method.
+func (m *MockTransferInterface) ResumeRecurring(ctx context.Context, id, apikey string) (model.RecurringTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeRecurring", ctx, id, apikey)
+	ret0, _ := ret[0].(model.RecurringTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeRecurring indicates an expected call of ResumeRecurring.
+func (mr *MockTransferInterfaceMockRecorder) ResumeRecurring(ctx, id, apikey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeRecurring", reflect.TypeOf((*MockTransferInterface)(nil).ResumeRecurring), ctx, id, apikey)
+}
+
+// RetrySubmission mocks base method.
+func (m *MockTransferInterface) RetrySubmission(ctx context.Context, limit int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RetrySubmission", ctx, limit)
+}
+
+// RetrySubmission indicates an expected call of RetrySubmission.
+func (mr *MockTransferInterfaceMockRecorder) RetrySubmission(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrySubmission", reflect.TypeOf((*MockTransferInterface)(nil).RetrySubmission), ctx, limit)
+}
+
 // Transfer mocks base method.
-func (m *MockTransferInterface) Transfer(ctx context.Context, v model.CreateTransfer, apikey string) (model.TransferJob, error) {
+func (m *MockTransferInterface) Transfer(ctx context.Context, v model.CreateTransfer, apikey, idempotencyKey string) (model.TransferJob, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Transfer", ctx, v, apikey)
+	ret := m.ctrl.Call(m, "Transfer", ctx, v, apikey, idempotencyKey)
 	ret0, _ := ret[0].(model.TransferJob)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Transfer indicates an expected call of Transfer.
-func (mr *MockTransferInterfaceMockRecorder) Transfer(ctx, v, apikey interface{}) *gomock.Call {
+func (mr *MockTransferInterfaceMockRecorder) Transfer(ctx, v, apikey, idempotencyKey interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockTransferInterface)(nil).Transfer), ctx, v, apikey)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockTransferInterface)(nil).Transfer), ctx, v, apikey, idempotencyKey)
 }
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/transferexport/transferexport.go
+
+// Package mock_transferexport is a generated GoMock package.
+package mock_transferexport
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTransferExportInterface is a mock of TransferExportInterface interface.
+type MockTransferExportInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferExportInterfaceMockRecorder
+}
+
+// MockTransferExportInterfaceMockRecorder is the mock recorder for MockTransferExportInterface.
+type MockTransferExportInterfaceMockRecorder struct {
+	mock *MockTransferExportInterface
+}
+
+// NewMockTransferExportInterface creates a new mock instance.
+func NewMockTransferExportInterface(ctrl *gomock.Controller) *MockTransferExportInterface {
+	mock := &MockTransferExportInterface{ctrl: ctrl}
+	mock.recorder = &MockTransferExportInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferExportInterface) EXPECT() *MockTransferExportInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTransferExportInterface) Create(ctx context.Context, v model.GetTransferExportByParam, createdBy int64) (model.TransferExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, v, createdBy)
+	ret0, _ := ret[0].(model.TransferExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransferExportInterfaceMockRecorder) Create(ctx, v, createdBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferExportInterface)(nil).Create), ctx, v, createdBy)
+}
+
+// Export mocks base method.
+func (m *MockTransferExportInterface) Export(ctx context.Context, v *model.GetTransferExportByParam, w io.Writer) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, v, w)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockTransferExportInterfaceMockRecorder) Export(ctx, v, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockTransferExportInterface)(nil).Export), ctx, v, w)
+}
+
+// GetByExportID mocks base method.
+func (m *MockTransferExportInterface) GetByExportID(ctx context.Context, exportID string) (model.TransferExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByExportID", ctx, exportID)
+	ret0, _ := ret[0].(model.TransferExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByExportID indicates an expected call of GetByExportID.
+func (mr *MockTransferExportInterfaceMockRecorder) GetByExportID(ctx, exportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByExportID", reflect.TypeOf((*MockTransferExportInterface)(nil).GetByExportID), ctx, exportID)
+}
+
+// GetFile mocks base method.
+func (m *MockTransferExportInterface) GetFile(ctx context.Context, exportID string) (model.TransferExport, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFile", ctx, exportID)
+	ret0, _ := ret[0].(model.TransferExport)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFile indicates an expected call of GetFile.
+func (mr *MockTransferExportInterfaceMockRecorder) GetFile(ctx, exportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFile", reflect.TypeOf((*MockTransferExportInterface)(nil).GetFile), ctx, exportID)
+}
+
+// Validate mocks base method.
+func (m *MockTransferExportInterface) Validate(ctx context.Context, v *model.GetTransferExportByParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockTransferExportInterfaceMockRecorder) Validate(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockTransferExportInterface)(nil).Validate), ctx, v)
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/transferstream/transferstream.go
+
+// Package mock_transferstream is a generated GoMock package.
+package mock_transferstream
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTransferStreamInterface is a mock of TransferStreamInterface interface.
+type MockTransferStreamInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferStreamInterfaceMockRecorder
+}
+
+// MockTransferStreamInterfaceMockRecorder is the mock recorder for MockTransferStreamInterface.
+type MockTransferStreamInterfaceMockRecorder struct {
+	mock *MockTransferStreamInterface
+}
+
+// NewMockTransferStreamInterface creates a new mock instance.
+func NewMockTransferStreamInterface(ctrl *gomock.Controller) *MockTransferStreamInterface {
+	mock := &MockTransferStreamInterface{ctrl: ctrl}
+	mock.recorder = &MockTransferStreamInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferStreamInterface) EXPECT() *MockTransferStreamInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Open mocks base method.
+func (m *MockTransferStreamInterface) Open(ctx context.Context, apikey string, v *model.GetTransferStreamByParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, apikey, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockTransferStreamInterfaceMockRecorder) Open(ctx, apikey, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockTransferStreamInterface)(nil).Open), ctx, apikey, v)
+}
+
+// Stream mocks base method.
+func (m *MockTransferStreamInterface) Stream(ctx context.Context, v *model.GetTransferStreamByParam, fn func(*model.TransferStreamEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, v, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockTransferStreamInterfaceMockRecorder) Stream(ctx, v, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockTransferStreamInterface)(nil).Stream), ctx, v, fn)
+}
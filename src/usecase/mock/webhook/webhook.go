@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/webhook/webhook.go
+
+// Package mock_webhook is a generated GoMock package.
+package mock_webhook
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/bricksvc/src/domain/model"
+	gomock "github.com/golang/mock/gomock"
+	null "github.com/volatiletech/null/v8"
+)
+
+// MockWebhookInterface is a mock of WebhookInterface interface.
+type MockWebhookInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookInterfaceMockRecorder
+}
+
+// MockWebhookInterfaceMockRecorder is the mock recorder for MockWebhookInterface.
+type MockWebhookInterfaceMockRecorder struct {
+	mock *MockWebhookInterface
+}
+
+// NewMockWebhookInterface creates a new mock instance.
+func NewMockWebhookInterface(ctrl *gomock.Controller) *MockWebhookInterface {
+	mock := &MockWebhookInterface{ctrl: ctrl}
+	mock.recorder = &MockWebhookInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookInterface) EXPECT() *MockWebhookInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookInterface) Create(ctx context.Context, v model.CreateWebhookEndpoint) (model.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, v)
+	ret0, _ := ret[0].(model.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookInterfaceMockRecorder) Create(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookInterface)(nil).Create), ctx, v)
+}
+
+// DeleteByID mocks base method.
+func (m *MockWebhookInterface) DeleteByID(ctx context.Context, id int64, v model.GetWebhookEndpointByParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockWebhookInterfaceMockRecorder) DeleteByID(ctx, id, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockWebhookInterface)(nil).DeleteByID), ctx, id, v)
+}
+
+// Deliver mocks base method.
+func (m *MockWebhookInterface) Deliver(ctx context.Context, limit int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Deliver", ctx, limit)
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockWebhookInterfaceMockRecorder) Deliver(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockWebhookInterface)(nil).Deliver), ctx, limit)
+}
+
+// GetByParam mocks base method.
+func (m *MockWebhookInterface) GetByParam(ctx context.Context, v model.GetWebhookEndpointByParam) ([]model.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, v)
+	ret0, _ := ret[0].([]model.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockWebhookInterfaceMockRecorder) GetByParam(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockWebhookInterface)(nil).GetByParam), ctx, v)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockWebhookInterface) GetDeliveries(ctx context.Context, v model.GetWebhookEndpointByParam, limit int64) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, v, limit)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockWebhookInterfaceMockRecorder) GetDeliveries(ctx, v, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockWebhookInterface)(nil).GetDeliveries), ctx, v, limit)
+}
+
+// Redeliver mocks base method.
+func (m *MockWebhookInterface) Redeliver(ctx context.Context, id, deliveryID int64, accountID null.Int64) (model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", ctx, id, deliveryID, accountID)
+	ret0, _ := ret[0].(model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockWebhookInterfaceMockRecorder) Redeliver(ctx, id, deliveryID, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockWebhookInterface)(nil).Redeliver), ctx, id, deliveryID, accountID)
+}
@@ -0,0 +1,270 @@
+package reconciliation
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/repository/reconciliation"
+	"github.com/achwanyusuf/bricksvc/src/repository/transfer"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/null/v8"
+)
+
+type Reconciliation struct {
+	log            logger.LoggerInterface
+	conf           Conf
+	transfer       transfer.TransferInterface
+	reconciliation reconciliation.ReconciliationInterface
+}
+
+type Conf struct {
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+type ReconciliationInterface interface {
+	Create(ctx context.Context, v model.CreateReconciliation, createdBy int64) (model.ReconciliationReport, error)
+	ReconcileDaily(ctx context.Context) error
+	GetByParam(ctx context.Context, v model.GetReconciliationReportsByParam) ([]model.ReconciliationReport, model.Pagination, error)
+	GetByReportID(ctx context.Context, reportID string) (model.ReconciliationReport, error)
+	GetDiscrepancies(ctx context.Context, reportID string, v model.GetReconciliationDiscrepanciesByParam) ([]model.ReconciliationDiscrepancy, model.Pagination, error)
+	ExportCSV(ctx context.Context, reportID string, w io.Writer) error
+}
+
+func New(conf Conf, logger *logger.LoggerInterface, transfer transfer.TransferInterface, reconciliation reconciliation.ReconciliationInterface) ReconciliationInterface {
+	return &Reconciliation{
+		conf:           conf,
+		log:            *logger,
+		transfer:       transfer,
+		reconciliation: reconciliation,
+	}
+}
+
+// Create stores a running report and reconciles the period in the background, the report is polled until it completes or fails
+func (r *Reconciliation) Create(ctx context.Context, v model.CreateReconciliation, createdBy int64) (model.ReconciliationReport, error) {
+	report, err := v.ToEntity(createdBy)
+	if err != nil {
+		return model.ReconciliationReport{}, err
+	}
+
+	if err := r.reconciliation.Insert(ctx, &report); err != nil {
+		return model.ReconciliationReport{}, err
+	}
+
+	go func(report entity.ReconciliationReport) {
+		if err := r.run(context.Background(), &report); err != nil {
+			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error reconcile "+report.ReportID)))
+		}
+	}(report)
+
+	return model.TransformPSQLSingleReconciliationReport(&report), nil
+}
+
+// ReconcileDaily reconciles the previous UTC day once, a day with a running or completed report is skipped
+func (r *Reconciliation) ReconcileDaily(ctx context.Context) error {
+	v := model.ReconciliationDay(time.Now())
+	for _, status := range []entity.Reconciliationstatus{entity.ReconciliationstatusRunning, entity.ReconciliationstatusCompleted} {
+		_, err := r.reconciliation.GetSingleByParam(ctx, &model.GetReconciliationReportByParam{
+			Status:      null.StringFrom(status.String()),
+			PeriodStart: null.TimeFrom(v.PeriodStart),
+			PeriodEnd:   null.TimeFrom(v.PeriodEnd),
+		})
+		if err == nil {
+			return nil
+		}
+
+		if errormsg.GetErrorData(err).Code != svcerr.CodeNotFound {
+			return err
+		}
+	}
+
+	report, err := v.ToEntity(0)
+	if err != nil {
+		return err
+	}
+
+	if err := r.reconciliation.Insert(ctx, &report); err != nil {
+		return err
+	}
+	return r.run(ctx, &report)
+}
+
+func (r *Reconciliation) run(ctx context.Context, report *entity.ReconciliationReport) error {
+	if r.conf.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.conf.Timeout)
+		defer cancel()
+	}
+
+	discrepancies, err := r.reconcile(ctx, report)
+	report.FinishedAt = null.TimeFrom(time.Now().UTC())
+	if err != nil {
+		report.Status = entity.ReconciliationstatusFailed
+		report.Error = null.StringFrom(err.Error())
+		// the report is marked failed even when the run ran out of time
+		if errUpdate := r.reconciliation.Update(context.Background(), report); errUpdate != nil {
+			logger.Log.Error(errormsg.WriteErr(errUpdate))
+		}
+		return err
+	}
+
+	report.Status = entity.ReconciliationstatusCompleted
+	report.DiscrepancyCount = len(discrepancies)
+	return r.reconciliation.Complete(ctx, report, discrepancies)
+}
+
+// reconcile matches the provider records of the period to jobs by provider id, a job the provider did not list is asked for on its own
+func (r *Reconciliation) reconcile(ctx context.Context, report *entity.ReconciliationReport) (entity.ReconciliationDiscrepancySlice, error) {
+	records, err := r.transfer.ListTransfers(ctx, report.PeriodStart, report.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := r.reconciliation.GetSubmittedJobs(ctx, report.PeriodStart, report.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+	report.ProviderCount = len(records)
+	report.JobCount = len(jobs)
+
+	byProviderID := map[string]*entity.TransferJob{}
+	for _, v := range jobs {
+		byProviderID[model.TransferProviderID(v)] = v
+	}
+
+	var unknown []string
+	for _, v := range records {
+		if _, ok := byProviderID[v.ID]; !ok {
+			unknown = append(unknown, v.ID)
+		}
+	}
+
+	others, err := r.reconciliation.GetJobsByProviderID(ctx, unknown)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range others {
+		byProviderID[model.TransferProviderID(v)] = v
+	}
+
+	var res entity.ReconciliationDiscrepancySlice
+	listed := map[string]bool{}
+	for i := range records {
+		record := &records[i]
+		listed[record.ID] = true
+		job, ok := byProviderID[record.ID]
+		if !ok {
+			res = append(res, model.NewReconciliationDiscrepancy(entity.DiscrepancytypeMissingLocally, nil, record))
+			continue
+		}
+
+		found, err := model.CompareReconciliation(job, record)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) == 0 {
+			report.MatchedCount++
+		}
+		res = append(res, found...)
+	}
+
+	for _, job := range jobs {
+		id := model.TransferProviderID(job)
+		if listed[id] {
+			continue
+		}
+
+		record, err := r.transfer.GetTransferByID(id)
+		if err != nil {
+			if errormsg.GetErrorData(err).Code != svcerr.CodeNotFound {
+				return nil, err
+			}
+			res = append(res, model.NewReconciliationDiscrepancy(entity.DiscrepancytypeMissingOnProvider, job, nil))
+			continue
+		}
+
+		found, err := model.CompareReconciliation(job, &record)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) == 0 {
+			report.MatchedCount++
+		}
+		res = append(res, found...)
+	}
+	return res, nil
+}
+
+func (r *Reconciliation) GetByParam(ctx context.Context, v model.GetReconciliationReportsByParam) ([]model.ReconciliationReport, model.Pagination, error) {
+	res, pagination, err := r.reconciliation.GetByParam(ctx, &v)
+	if err != nil {
+		return []model.ReconciliationReport{}, model.Pagination{}, err
+	}
+	return model.TransformPSQLReconciliationReport(&res), pagination, nil
+}
+
+func (r *Reconciliation) GetByReportID(ctx context.Context, reportID string) (model.ReconciliationReport, error) {
+	res, err := r.reconciliation.GetSingleByParam(ctx, &model.GetReconciliationReportByParam{
+		ReportID: null.StringFrom(reportID),
+	})
+	if err != nil {
+		return model.ReconciliationReport{}, err
+	}
+	return model.TransformPSQLSingleReconciliationReport(&res), nil
+}
+
+func (r *Reconciliation) GetDiscrepancies(ctx context.Context, reportID string, v model.GetReconciliationDiscrepanciesByParam) ([]model.ReconciliationDiscrepancy, model.Pagination, error) {
+	report, err := r.reconciliation.GetSingleByParam(ctx, &model.GetReconciliationReportByParam{
+		ReportID: null.StringFrom(reportID),
+	})
+	if err != nil {
+		return []model.ReconciliationDiscrepancy{}, model.Pagination{}, err
+	}
+
+	v.ReconciliationReportID = int64(report.ID)
+	res, pagination, err := r.reconciliation.GetDiscrepanciesByParam(ctx, &v)
+	if err != nil {
+		return []model.ReconciliationDiscrepancy{}, model.Pagination{}, err
+	}
+	return model.TransformPSQLReconciliationDiscrepancy(&res), pagination, nil
+}
+
+// ExportCSV writes the discrepancies of a completed report, nothing is written when the report cannot be exported
+func (r *Reconciliation) ExportCSV(ctx context.Context, reportID string, w io.Writer) error {
+	report, err := r.reconciliation.GetSingleByParam(ctx, &model.GetReconciliationReportByParam{
+		ReportID: null.StringFrom(reportID),
+	})
+	if err != nil {
+		return err
+	}
+
+	if report.Status != entity.ReconciliationstatusCompleted {
+		return errormsg.WrapErr(svcerr.BrickSVCInvalidReconciliation, nil, "report is "+report.Status.String()+", only a completed report can be exported")
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(model.ReconciliationCSVHeader); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write csv")
+	}
+
+	err = r.reconciliation.EachDiscrepancy(ctx, report.ID, func(v *entity.ReconciliationDiscrepancy) error {
+		if err := writer.Write(model.ReconciliationCSVRecord(v)); err != nil {
+			return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write csv")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write csv")
+	}
+	return nil
+}
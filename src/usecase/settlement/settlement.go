@@ -0,0 +1,164 @@
+package settlement
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/repository/account"
+	"github.com/achwanyusuf/bricksvc/src/repository/settlement"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/volatiletech/null/v8"
+)
+
+type Settlement struct {
+	log        logger.LoggerInterface
+	conf       Conf
+	account    account.AccountInterface
+	settlement settlement.SettlementInterface
+}
+
+type Conf struct {
+	Dir     string   `mapstructure:"dir"`
+	Formats []string `mapstructure:"formats"`
+}
+
+type SettlementInterface interface {
+	Validate(ctx context.Context, v *model.GetSettlementReportByParam) error
+	ValidateStatement(ctx context.Context, apikey string, v *model.GetSettlementReportByParam) error
+	Export(ctx context.Context, v *model.GetSettlementReportByParam, w io.Writer) error
+	WriteDaily(ctx context.Context) error
+}
+
+func New(conf Conf, logger *logger.LoggerInterface, account account.AccountInterface, settlement settlement.SettlementInterface) SettlementInterface {
+	return &Settlement{
+		conf:       conf,
+		log:        *logger,
+		account:    account,
+		settlement: settlement,
+	}
+}
+
+// Validate fills the defaults of the report, it runs before anything is streamed so a bad parameter still gets an error response
+func (s *Settlement) Validate(ctx context.Context, v *model.GetSettlementReportByParam) error {
+	return v.Validate()
+}
+
+// ValidateStatement restricts the report to the api key, a merchant only reads its own statement
+func (s *Settlement) ValidateStatement(ctx context.Context, apikey string, v *model.GetSettlementReportByParam) error {
+	acc, err := s.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+		APIKey: null.StringFrom(apikey),
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
+	}
+
+	v.APIKey = acc.APIKey
+	return v.Validate()
+}
+
+// Export writes the report row by row in its format, the rows are never held in memory together
+func (s *Settlement) Export(ctx context.Context, v *model.GetSettlementReportByParam, w io.Writer) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	if v.Format == model.SettlementFormatNDJSON {
+		encoder := jsoniter.NewEncoder(w)
+		return s.settlement.EachRow(ctx, v, func(row *model.SettlementRow) error {
+			if err := encoder.Encode(row); err != nil {
+				return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write ndjson")
+			}
+			return nil
+		})
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(model.SettlementCSVHeader); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write csv")
+	}
+
+	err := s.settlement.EachRow(ctx, v, func(row *model.SettlementRow) error {
+		if err := writer.Write(model.SettlementCSVRecord(row)); err != nil {
+			return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write csv")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write csv")
+	}
+	return nil
+}
+
+// WriteDaily writes the report of the previous UTC day for every configured format, a report already on disk is kept
+func (s *Settlement) WriteDaily(ctx context.Context) error {
+	if s.conf.Dir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(s.conf.Dir, 0o755); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error create settlement report directory")
+	}
+
+	formats := s.conf.Formats
+	if len(formats) == 0 {
+		formats = []string{model.SettlementFormatCSV}
+	}
+
+	for _, format := range formats {
+		param := model.SettlementDay(time.Now(), format)
+		if err := param.Validate(); err != nil {
+			return err
+		}
+
+		path := filepath.Join(s.conf.Dir, param.FileName())
+		if _, err := os.Stat(path); err == nil {
+			continue
+		}
+
+		if err := s.writeFile(ctx, &param, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFile writes next to path and renames once complete, a report cut short never looks finished
+func (s *Settlement) writeFile(ctx context.Context, v *model.GetSettlementReportByParam, path string) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error create settlement report")
+	}
+
+	writer := bufio.NewWriter(f)
+	err = s.Export(ctx, v, writer)
+	if err == nil {
+		err = writer.Flush()
+	}
+	if errClose := f.Close(); err == nil {
+		err = errClose
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write settlement report")
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write settlement report")
+	}
+	return nil
+}
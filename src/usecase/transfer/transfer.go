@@ -2,6 +2,10 @@ package transfer
 
 import (
 	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/achwanyusuf/bricksvc/src/domain/clientresponse"
@@ -9,10 +13,17 @@ import (
 	"github.com/achwanyusuf/bricksvc/src/domain/model"
 	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
 	"github.com/achwanyusuf/bricksvc/src/repository/account"
+	"github.com/achwanyusuf/bricksvc/src/repository/accountlimit"
+	"github.com/achwanyusuf/bricksvc/src/repository/bank"
+	"github.com/achwanyusuf/bricksvc/src/repository/feerule"
+	"github.com/achwanyusuf/bricksvc/src/repository/fxrate"
 	"github.com/achwanyusuf/bricksvc/src/repository/transfer"
+	"github.com/achwanyusuf/bricksvc/src/repository/transferstream"
+	"github.com/achwanyusuf/bricksvc/src/repository/webhook"
 	"github.com/achwanyusuf/bricksvc/utils/errormsg"
 	"github.com/achwanyusuf/bricksvc/utils/logger"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/lucsky/cuid"
 	"github.com/volatiletech/null/v8"
 )
 
@@ -21,51 +32,115 @@ type Transfer struct {
 	conf     Conf
 	account  account.AccountInterface
 	transfer transfer.TransferInterface
+	webhook  webhook.WebhookInterface
+	fxRate   fxrate.FXRateInterface
+	feeRule  feerule.FeeRuleInterface
+	limit    accountlimit.AccountLimitInterface
+	bank     bank.BankInterface
+	stream   transferstream.TransferStreamInterface
 }
 
 type Conf struct {
-	JobActiveDuration time.Duration `mapstructure:"job_active_duration"`
+	JobActiveDuration time.Duration                         `mapstructure:"job_active_duration"`
+	BatchMaxRows      int                                   `mapstructure:"batch_max_rows"`
+	Retry             map[string]model.TransferRetryPolicy  `mapstructure:"retry"`
+	QuoteTTL          time.Duration                         `mapstructure:"quote_ttl"`
+	Providers         map[string]model.ProviderCallbackConf `mapstructure:"providers"`
+	PollWorkers       int                                   `mapstructure:"poll_workers"`
+	PollLease         time.Duration                         `mapstructure:"poll_lease"`
+	SubmitLease       time.Duration                         `mapstructure:"submit_lease"`
 }
 
 type TransferInterface interface {
 	Create(ctx context.Context, key string, v model.CreateTransfer) error
-	Transfer(ctx context.Context, v model.CreateTransfer, apikey string) (model.TransferJob, error)
-	GetByParam(ctx context.Context, cacheControl string, v model.GetTransferJobsByParam) ([]model.TransferJob, model.Pagination, error)
-	GetByJobID(ctx context.Context, cacheControl string, id string) (model.TransferJob, error)
+	Transfer(ctx context.Context, v model.CreateTransfer, apikey string, idempotencyKey string) (model.TransferJob, error)
+	Quote(ctx context.Context, v model.CreateTransferQuote, apikey string) (model.TransferQuote, error)
+	Batch(ctx context.Context, rows []model.CreateTransferBatchRow, apikey string) (model.TransferBatch, error)
+	GetBatchByID(ctx context.Context, reader model.TransferReader, id string) (model.TransferBatch, error)
+	GetByParam(ctx context.Context, cacheControl string, reader model.TransferReader, v model.GetTransferJobsByParam) ([]model.TransferJob, model.Pagination, error)
+	GetByJobID(ctx context.Context, cacheControl string, reader model.TransferReader, id string) (model.TransferJob, error)
+	GetEventsByJobID(ctx context.Context, cacheControl string, reader model.TransferReader, id string) ([]model.TransferJobEvent, error)
+	GetScheduled(ctx context.Context, apikey string, v model.GetTransferJobsByParam) ([]model.TransferJob, model.Pagination, error)
+	Amend(ctx context.Context, id string, apikey string, v model.UpdateTransfer) (model.TransferJob, error)
+	Cancel(ctx context.Context, id string, apikey string) (model.TransferJob, error)
+	ReleaseScheduled(ctx context.Context, limit int64)
 	ProccessGetCallback(ctx context.Context, param *model.GetTransferJobsByParam)
+	ProviderCallback(ctx context.Context, provider string, v model.ProviderCallback) (model.ProviderCallbackAck, error)
+	RelayOutbox(ctx context.Context, limit int64)
+	RetrySubmission(ctx context.Context, limit int64)
+	CreateRecurring(ctx context.Context, v model.CreateRecurringTransfer, apikey string) (model.RecurringTransfer, error)
+	GetRecurring(ctx context.Context, apikey string, v model.GetRecurringTransfersByParam) ([]model.RecurringTransfer, model.Pagination, error)
+	GetRecurringByID(ctx context.Context, id string, apikey string) (model.RecurringTransfer, error)
+	PauseRecurring(ctx context.Context, id string, apikey string) (model.RecurringTransfer, error)
+	ResumeRecurring(ctx context.Context, id string, apikey string) (model.RecurringTransfer, error)
+	MaterializeRecurring(ctx context.Context, limit int64)
 }
 
-func New(conf Conf, logger *logger.LoggerInterface, account account.AccountInterface, transfer transfer.TransferInterface) TransferInterface {
+func New(conf Conf, logger *logger.LoggerInterface, account account.AccountInterface, transfer transfer.TransferInterface, webhook webhook.WebhookInterface, fxRate fxrate.FXRateInterface, feeRule feerule.FeeRuleInterface, limit accountlimit.AccountLimitInterface, bank bank.BankInterface, stream transferstream.TransferStreamInterface) TransferInterface {
 	return &Transfer{
 		conf:     conf,
 		log:      *logger,
 		account:  account,
 		transfer: transfer,
+		webhook:  webhook,
+		fxRate:   fxRate,
+		feeRule:  feeRule,
+		limit:    limit,
+		bank:     bank,
+		stream:   stream,
 	}
 }
 
 func (t *Transfer) Create(ctx context.Context, key string, v model.CreateTransfer) error {
-	cParam := v.ToClientRes()
-	resClient, err := t.transfer.InsertTransfer(ctx, cParam)
-	if err != nil {
-		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
-	}
-
 	job, err := t.transfer.GetSingleByParam(ctx, model.MustRevalidate, &model.GetTransferJobByParam{
 		JobID: null.StringFrom(key),
 	})
 	if err != nil {
 		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
 	}
+
+	if job.Status == entity.TransferstatusCancelled {
+		logger.Log.Info(fmt.Sprintf("transfer job %s is cancelled, skip submission", job.JobID))
+		return nil
+	}
+
+	// outbox delivery is at-least-once, the claim skips jobs already submitted to provider or waiting for their retry and
+	// keeps the job from being cancelled while the provider call is in flight. A job held by the poller or another
+	// replica fails the claim, the message is retried until it is let go.
+	claimed, err := t.transfer.ClaimSubmission(ctx, &job, t.conf.SubmitLease)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+	defer func() {
+		if err := t.transfer.Release(ctx, &job); err != nil {
+			logger.Log.Warn(errormsg.WriteErr(err))
+		}
+	}()
+
+	cParam := v.ToClientRes()
+	if err := model.SetTransferConversion(&cParam, &job); err != nil {
+		return err
+	}
+	resClient, err := t.transfer.InsertTransfer(ctx, cParam)
+	if err != nil {
+		// provider failures are retried through next_attempt_at, the message itself is not redelivered
+		logger.Log.Warn(errormsg.WriteErr(err))
+		t.recordAttempt(ctx, &job, err)
+		return nil
+	}
+
 	payload, err := jsoniter.Marshal(resClient)
 	if err != nil {
 		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
 	}
 	job.Payload = payload
-	return t.transfer.Update(ctx, &job)
+	return t.transition(ctx, &job, entity.TransferstatusProcessing, "submitted to provider", model.TransferActorConsumer, resClient)
 }
 
-func (t *Transfer) Transfer(ctx context.Context, v model.CreateTransfer, apikey string) (model.TransferJob, error) {
+func (t *Transfer) Transfer(ctx context.Context, v model.CreateTransfer, apikey string, idempotencyKey string) (model.TransferJob, error) {
 	acc, err := t.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
 		APIKey: null.StringFrom(apikey),
 	})
@@ -73,24 +148,298 @@ func (t *Transfer) Transfer(ctx context.Context, v model.CreateTransfer, apikey
 		return model.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
 	}
 
+	// the key is reserved for the request as received, a retry of a quoted transfer replays its job before the quote,
+	// already used by then, is looked at again
+	jobID := cuid.New()
+	if idempotencyKey != "" {
+		requestHash, err := v.Hash()
+		if err != nil {
+			return model.TransferJob{}, err
+		}
+		reserved, err := t.transfer.SetIdempotency(ctx, acc.APIKey.String, idempotencyKey, model.TransferIdempotency{
+			JobID:       jobID,
+			RequestHash: requestHash,
+		})
+		if err != nil {
+			return model.TransferJob{}, err
+		}
+		if !reserved {
+			return t.replayTransfer(ctx, acc.APIKey.String, idempotencyKey, requestHash)
+		}
+	}
+
+	res, err := t.createTransfer(ctx, &acc, v, jobID)
+	if err != nil {
+		t.deleteIdempotency(ctx, acc.APIKey.String, idempotencyKey)
+		return model.TransferJob{}, err
+	}
+	return res, nil
+}
+
+// createTransfer applies the quote, prices and stores the job of a transfer whose idempotency key, if any, is reserved
+func (t *Transfer) createTransfer(ctx context.Context, acc *entity.Account, v model.CreateTransfer, jobID string) (model.TransferJob, error) {
+	var quote *entity.TransferQuote
+	if v.QuoteID != "" {
+		res, err := t.transfer.GetSingleQuoteByParam(ctx, &model.GetTransferQuoteByParam{
+			QuoteID: null.StringFrom(v.QuoteID),
+			APIKey:  null.StringFrom(acc.APIKey.String),
+		})
+		if err != nil {
+			return model.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCInvalidQuote, err)
+		}
+		if err := model.ApplyTransferQuote(&v, &res); err != nil {
+			return model.TransferJob{}, err
+		}
+		quote = &res
+	}
+
+	if err := v.Validate(); err != nil {
+		return model.TransferJob{}, err
+	}
+
 	data, err := v.ToEntity(acc.APIKey.String)
 	if err != nil {
 		return model.TransferJob{}, err
 	}
+	data.JobID = jobID
+
+	if quote != nil {
+		data.Conversion, err = model.NewTransferConversion(quote)
+		if err != nil {
+			return model.TransferJob{}, err
+		}
+	}
 
-	err = t.transfer.Insert(ctx, &data)
+	if err := t.applyFee(ctx, &data, acc.Plan); err != nil {
+		return model.TransferJob{}, err
+	}
+
+	event, err := model.NewTransferJobEvent("", data.Status, "transfer created", model.TransferActorAPI, nil)
 	if err != nil {
 		return model.TransferJob{}, err
 	}
-	res, err := model.TransformTransferJob(data)
+
+	if err := t.validateAccounts(ctx, &v, &data); err != nil {
+		return model.TransferJob{}, err
+	}
+
+	now := time.Now().UTC()
+	if err := t.reserveLimit(ctx, acc, v.Amount, v.Currency, now); err != nil {
+		return model.TransferJob{}, err
+	}
+
+	err = t.transfer.Insert(ctx, &data, &event, quote)
 	if err != nil {
+		t.releaseLimit(ctx, acc, v.Amount, v.Currency, now)
 		return model.TransferJob{}, err
 	}
+	t.publishEvent(ctx, &data, &event)
+	return model.TransformTransferJob(data)
+}
+
+func (t *Transfer) Quote(ctx context.Context, v model.CreateTransferQuote, apikey string) (model.TransferQuote, error) {
+	acc, err := t.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+		APIKey: null.StringFrom(apikey),
+	})
+	if err != nil {
+		return model.TransferQuote{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
+	}
 
+	if err := v.Validate(); err != nil {
+		return model.TransferQuote{}, err
+	}
+
+	var (
+		rate   = big.NewRat(1, 1)
+		feeBPS int64
+	)
+	if v.SourceCurrency != v.DestinationCurrency {
+		pair, err := t.fxRate.GetPair(ctx, v.SourceCurrency, v.DestinationCurrency)
+		if err != nil {
+			return model.TransferQuote{}, err
+		}
+		rate, err = model.QuoteRate(&pair, v.SourceCurrency)
+		if err != nil {
+			return model.TransferQuote{}, err
+		}
+		feeBPS = int64(pair.FeeBPS)
+	}
+
+	ttl := t.conf.QuoteTTL
+	if ttl == 0 {
+		ttl = model.DefaultTransferQuoteTTL
+	}
+	quote, err := v.ToEntity(acc.APIKey.String, rate, feeBPS, ttl)
+	if err != nil {
+		return model.TransferQuote{}, err
+	}
+
+	// the transfer fee is only a preview, it is priced again with the rules in force when the transfer is created
+	transferFee, _, err := t.transferFee(ctx, &model.MatchFeeRule{
+		SourceBankID:      v.SourceBankID,
+		DestinationBankID: v.DestinationBankID,
+		Plan:              acc.Plan,
+		Currency:          v.SourceCurrency,
+		Amount:            v.Amount,
+	})
+	if err != nil {
+		return model.TransferQuote{}, err
+	}
+
+	if err := t.transfer.InsertQuote(ctx, &quote); err != nil {
+		return model.TransferQuote{}, err
+	}
+	res := model.TransformPSQLSingleTransferQuote(&quote)
+	res.TransferFeeAmount = transferFee
+	res.TotalAmount += transferFee
 	return res, nil
 }
 
-func (t *Transfer) GetByParam(ctx context.Context, cacheControl string, v model.GetTransferJobsByParam) ([]model.TransferJob, model.Pagination, error) {
+func (t *Transfer) Batch(ctx context.Context, rows []model.CreateTransferBatchRow, apikey string) (model.TransferBatch, error) {
+	acc, err := t.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+		APIKey: null.StringFrom(apikey),
+	})
+	if err != nil {
+		return model.TransferBatch{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
+	}
+
+	maxRows := t.conf.BatchMaxRows
+	if maxRows == 0 {
+		maxRows = model.DefaultTransferBatchMaxRows
+	}
+	if len(rows) == 0 || len(rows) > maxRows {
+		return model.TransferBatch{}, errormsg.WrapErr(svcerr.BrickSVCInvalidTransferBatch, nil, fmt.Sprintf("batch must contain between 1 and %d rows", maxRows))
+	}
+
+	var (
+		jobs        entity.TransferJobSlice
+		reserved    []model.CreateTransfer
+		batchErrors = []model.TransferBatchError{}
+		now         = time.Now().UTC()
+	)
+	for _, v := range rows {
+		err := v.Err
+		if err == nil && v.Data.QuoteID != "" {
+			err = errormsg.WrapErr(svcerr.BrickSVCInvalidQuote, nil, "quote is not supported in batch")
+		}
+		if err == nil {
+			err = v.Data.Validate()
+		}
+		if err != nil {
+			batchErrors = append(batchErrors, model.NewTransferBatchError(v.Row, err))
+			continue
+		}
+
+		data, err := v.Data.ToEntity(acc.APIKey.String)
+		if err != nil {
+			batchErrors = append(batchErrors, model.NewTransferBatchError(v.Row, err))
+			continue
+		}
+
+		if err := t.applyFee(ctx, &data, acc.Plan); err != nil {
+			t.releaseLimits(ctx, &acc, reserved, now)
+			return model.TransferBatch{}, err
+		}
+
+		if err := t.validateAccounts(ctx, &v.Data, &data); err != nil {
+			batchErrors = append(batchErrors, model.NewTransferBatchError(v.Row, err))
+			continue
+		}
+
+		// rows over the account limits are rejected like invalid rows, the rest of the batch is still accepted
+		if err := t.reserveLimit(ctx, &acc, v.Data.Amount, v.Data.Currency, now); err != nil {
+			batchErrors = append(batchErrors, model.NewTransferBatchError(v.Row, err))
+			continue
+		}
+		reserved = append(reserved, v.Data)
+		jobs = append(jobs, &data)
+	}
+
+	errorsJSON, err := jsoniter.Marshal(batchErrors)
+	if err != nil {
+		t.releaseLimits(ctx, &acc, reserved, now)
+		return model.TransferBatch{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+
+	batch := entity.TransferBatch{
+		BatchID:      cuid.New(),
+		APIKey:       acc.APIKey.String,
+		TotalRows:    len(rows),
+		AcceptedRows: len(jobs),
+		RejectedRows: len(batchErrors),
+		Errors:       errorsJSON,
+	}
+
+	event, err := model.NewTransferJobEvent("", entity.TransferstatusPending, "transfer created from batch "+batch.BatchID, model.TransferActorAPI, nil)
+	if err != nil {
+		t.releaseLimits(ctx, &acc, reserved, now)
+		return model.TransferBatch{}, err
+	}
+
+	events, err := t.transfer.InsertBatch(ctx, &batch, jobs, &event)
+	if err != nil {
+		t.releaseLimits(ctx, &acc, reserved, now)
+		return model.TransferBatch{}, err
+	}
+	for i, v := range events {
+		t.publishEvent(ctx, jobs[i], v)
+	}
+	return model.TransformPSQLSingleTransferBatch(&batch, jobs)
+}
+
+func (t *Transfer) GetBatchByID(ctx context.Context, reader model.TransferReader, id string) (model.TransferBatch, error) {
+	apikey, err := t.readerAPIKey(ctx, &reader)
+	if err != nil {
+		return model.TransferBatch{}, err
+	}
+
+	batch, jobs, err := t.transfer.GetBatch(ctx, id)
+	if err != nil {
+		return model.TransferBatch{}, err
+	}
+
+	// the batch of another api key is reported missing so its id cannot be probed
+	if apikey.Valid && batch.APIKey != apikey.String {
+		return model.TransferBatch{}, errormsg.WrapErr(svcerr.BrickSVCNotFound, nil, "data not found")
+	}
+	return model.TransformPSQLSingleTransferBatch(&batch, jobs)
+}
+
+func (t *Transfer) replayTransfer(ctx context.Context, apikey string, idempotencyKey string, requestHash string) (model.TransferJob, error) {
+	idempotency, err := t.transfer.GetIdempotency(ctx, apikey, idempotencyKey)
+	if err != nil {
+		return model.TransferJob{}, err
+	}
+
+	if idempotency.RequestHash != requestHash {
+		return model.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCIdempotencyKeyMismatch, nil, "idempotency key reused with different body")
+	}
+
+	transferJob, err := t.transfer.GetSingleByParam(ctx, model.MustRevalidate, &model.GetTransferJobByParam{
+		JobID:  null.StringFrom(idempotency.JobID),
+		APIKey: null.StringFrom(apikey),
+	})
+	if err != nil {
+		return model.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCIdempotencyKeyInProgress, err, "original request not stored yet")
+	}
+
+	return model.TransformPSQLSingleTransferJob(&transferJob)
+}
+
+// GetByParam lists the jobs of the reader, the api key filter only applies to a super admin
+func (t *Transfer) GetByParam(ctx context.Context, cacheControl string, reader model.TransferReader, v model.GetTransferJobsByParam) ([]model.TransferJob, model.Pagination, error) {
+	apikey, err := t.readerAPIKey(ctx, &reader)
+	if err != nil {
+		return []model.TransferJob{}, model.Pagination{}, err
+	}
+
+	if apikey.Valid {
+		v.APIKey = apikey
+	}
+	return t.getByParam(ctx, cacheControl, v)
+}
+
+func (t *Transfer) getByParam(ctx context.Context, cacheControl string, v model.GetTransferJobsByParam) ([]model.TransferJob, model.Pagination, error) {
 	transferJobSlice, pagination, err := t.transfer.GetByParam(ctx, cacheControl, &v)
 	if err != nil {
 		return []model.TransferJob{}, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get by param")
@@ -102,9 +451,15 @@ func (t *Transfer) GetByParam(ctx context.Context, cacheControl string, v model.
 	return res, pagination, nil
 }
 
-func (t *Transfer) GetByJobID(ctx context.Context, cacheControl string, id string) (model.TransferJob, error) {
+func (t *Transfer) GetByJobID(ctx context.Context, cacheControl string, reader model.TransferReader, id string) (model.TransferJob, error) {
+	apikey, err := t.readerAPIKey(ctx, &reader)
+	if err != nil {
+		return model.TransferJob{}, err
+	}
+
 	transferJob, err := t.transfer.GetSingleByParam(ctx, cacheControl, &model.GetTransferJobByParam{
-		JobID: null.StringFrom(id),
+		JobID:  null.StringFrom(id),
+		APIKey: apikey,
 	})
 	if err != nil {
 		return model.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "data not found")
@@ -116,56 +471,766 @@ func (t *Transfer) GetByJobID(ctx context.Context, cacheControl string, id strin
 	return res, nil
 }
 
+func (t *Transfer) GetEventsByJobID(ctx context.Context, cacheControl string, reader model.TransferReader, id string) ([]model.TransferJobEvent, error) {
+	apikey, err := t.readerAPIKey(ctx, &reader)
+	if err != nil {
+		return []model.TransferJobEvent{}, err
+	}
+
+	transferJob, err := t.transfer.GetSingleByParam(ctx, cacheControl, &model.GetTransferJobByParam{
+		JobID:  null.StringFrom(id),
+		APIKey: apikey,
+	})
+	if err != nil {
+		return []model.TransferJobEvent{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "data not found")
+	}
+	events, err := t.transfer.GetEvents(ctx, transferJob.ID)
+	if err != nil {
+		return []model.TransferJobEvent{}, err
+	}
+	return model.TransformPSQLTransferJobEvent(transferJob.JobID, &events), nil
+}
+
+func (t *Transfer) GetScheduled(ctx context.Context, apikey string, v model.GetTransferJobsByParam) ([]model.TransferJob, model.Pagination, error) {
+	acc, err := t.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+		APIKey: null.StringFrom(apikey),
+	})
+	if err != nil {
+		return []model.TransferJob{}, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
+	}
+
+	v.APIKey = acc.APIKey
+	v.Status = null.StringFrom(entity.TransferstatusScheduled.String())
+	return t.getByParam(ctx, model.MustRevalidate, v)
+}
+
+func (t *Transfer) Amend(ctx context.Context, id string, apikey string, v model.UpdateTransfer) (model.TransferJob, error) {
+	transferJob, err := t.getOwnedJob(ctx, id, apikey)
+	if err != nil {
+		return model.TransferJob{}, err
+	}
+
+	if transferJob.Status != entity.TransferstatusScheduled {
+		return model.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCInvalidStatusTransition, nil, "only scheduled transfer can be amended")
+	}
+
+	var before model.CreateTransfer
+	if err := transferJob.Payload.Unmarshal(&before); err != nil {
+		return model.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+
+	if err := v.FillEntity(&transferJob); err != nil {
+		return model.TransferJob{}, err
+	}
+
+	var payload model.CreateTransfer
+	if err := transferJob.Payload.Unmarshal(&payload); err != nil {
+		return model.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+
+	acc, err := t.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+		APIKey: null.StringFrom(apikey),
+	})
+	if err != nil {
+		return model.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
+	}
+
+	if err := t.applyFee(ctx, &transferJob, acc.Plan); err != nil {
+		return model.TransferJob{}, err
+	}
+
+	if v.SourceBankAccount.Valid || v.DestinationBankAccount.Valid || v.SourceBankID.Valid || v.DestinationBankID.Valid || v.Amount.Valid || v.Currency.Valid {
+		if err := t.validateAccounts(ctx, &payload, &transferJob); err != nil {
+			return model.TransferJob{}, err
+		}
+	}
+
+	// the amended amount stays counted on the day the transfer was created, the reservation of the old amount is swapped
+	// for the new one and swapped back when the amend does not go through
+	reserved := false
+	if v.Amount.Valid || v.Currency.Valid {
+		if err := t.swapLimit(ctx, &acc, before, payload, transferJob.CreatedAt); err != nil {
+			return model.TransferJob{}, err
+		}
+		reserved = true
+	}
+
+	if err := t.transfer.Amend(ctx, &transferJob); err != nil {
+		if reserved {
+			t.unswapLimit(ctx, &acc, before, payload, transferJob.CreatedAt)
+		}
+		return model.TransferJob{}, err
+	}
+	return model.TransformPSQLSingleTransferJob(&transferJob)
+}
+
+func (t *Transfer) Cancel(ctx context.Context, id string, apikey string) (model.TransferJob, error) {
+	transferJob, err := t.getOwnedJob(ctx, id, apikey)
+	if err != nil {
+		return model.TransferJob{}, err
+	}
+
+	if err := t.transition(ctx, &transferJob, entity.TransferstatusCancelled, "cancelled by merchant", model.TransferActorMerchant, nil); err != nil {
+		return model.TransferJob{}, err
+	}
+	return model.TransformPSQLSingleTransferJob(&transferJob)
+}
+
+func (t *Transfer) ReleaseScheduled(ctx context.Context, limit int64) {
+	transferJobSlice, _, err := t.transfer.GetByParam(ctx, model.MustRevalidate, &model.GetTransferJobsByParam{
+		GetTransferJobByParam: model.GetTransferJobByParam{
+			Status:         null.StringFrom(entity.TransferstatusScheduled.String()),
+			ScheduledAtLTE: null.TimeFrom(time.Now().UTC()),
+		},
+		OrderBy: null.StringFrom("scheduled_at"),
+		Limit:   limit,
+	})
+	if err != nil {
+		logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get by param")))
+		return
+	}
+
+	for _, v := range transferJobSlice {
+		if err := t.transition(ctx, v, entity.TransferstatusPending, "scheduled transfer released", model.TransferActorScheduler, nil); err != nil {
+			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error release scheduled transfer")))
+		}
+	}
+}
+
+// applyFee stores the fee of the job payload, it is charged on top of the transferred amount
+func (t *Transfer) applyFee(ctx context.Context, data *entity.TransferJob, plan string) error {
+	var payload model.CreateTransfer
+	if err := data.Payload.Unmarshal(&payload); err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err)
+	}
+
+	fee, ruleID, err := t.transferFee(ctx, &model.MatchFeeRule{
+		SourceBankID:      payload.SourceBankID,
+		DestinationBankID: payload.DestinationBankID,
+		Plan:              plan,
+		Currency:          payload.Currency,
+		Amount:            payload.Amount,
+	})
+	if err != nil {
+		return err
+	}
+	data.FeeAmount = fee
+	data.FeeRuleID = ruleID
+	return nil
+}
+
+// transferFee prices a transfer with the most specific matching rule, a transfer no rule matches is free
+func (t *Transfer) transferFee(ctx context.Context, param *model.MatchFeeRule) (int64, null.Int, error) {
+	rule, err := t.feeRule.Match(ctx, param)
+	if err != nil {
+		if errormsg.GetErrorData(err).Code == svcerr.CodeNotFound {
+			return 0, null.Int{}, nil
+		}
+		return 0, null.Int{}, err
+	}
+	return model.CalculateFee(&rule, param.Amount), null.IntFrom(rule.ID), nil
+}
+
+// validateAccounts checks with the bank that both accounts exist and the source balance covers the amount and the fees
+// of the priced job, the balance is always read fresh
+func (t *Transfer) validateAccounts(ctx context.Context, v *model.CreateTransfer, data *entity.TransferJob) error {
+	fee, err := model.TransferTotalFee(data)
+	if err != nil {
+		return err
+	}
+
+	if v.SourceBankID == v.DestinationBankID && v.SourceBankAccount == v.DestinationBankAccount {
+		return errormsg.WrapErr(svcerr.BrickSVCSameAccountTransfer, nil, "source and destination account are the same")
+	}
+
+	source, err := t.bank.GetBankAccount(ctx, model.MustRevalidate, model.GetBankAccount{
+		AccountNumber: v.SourceBankAccount,
+		BankID:        v.SourceBankID,
+	})
+	if err != nil {
+		if errormsg.GetErrorData(err).Code == svcerr.CodeNotFound {
+			return errormsg.WrapErr(svcerr.BrickSVCSourceAccountNotFound, nil, fmt.Sprintf("account %s not found in bank %d", v.SourceBankAccount, v.SourceBankID))
+		}
+		return err
+	}
+
+	if _, err := t.bank.GetBankAccount(ctx, "", model.GetBankAccount{
+		AccountNumber: v.DestinationBankAccount,
+		BankID:        v.DestinationBankID,
+	}); err != nil {
+		if errormsg.GetErrorData(err).Code == svcerr.CodeNotFound {
+			return errormsg.WrapErr(svcerr.BrickSVCDestinationAccountNotFound, nil, fmt.Sprintf("account %s not found in bank %d", v.DestinationBankAccount, v.DestinationBankID))
+		}
+		return err
+	}
+
+	if model.MajorToMinorUnits(source.AccountAmount, v.Currency) < v.Amount+fee {
+		return errormsg.WrapErr(svcerr.BrickSVCCodeinsufficientAmount, nil, fmt.Sprintf("balance of account %s is below the amount and fee", v.SourceBankAccount))
+	}
+	return nil
+}
+
+// reserveLimit counts the transfer against the limits of the account before the job is stored
+func (t *Transfer) reserveLimit(ctx context.Context, acc *entity.Account, amount int64, currency string, at time.Time) error {
+	limit, err := t.limit.GetLimit(ctx, acc.ID, currency)
+	if err != nil {
+		return err
+	}
+
+	if err := model.CheckTransferLimit(&limit, amount); err != nil {
+		return err
+	}
+	return t.limit.Reserve(ctx, acc.APIKey.String, &limit, amount, at)
+}
+
+func (t *Transfer) releaseLimit(ctx context.Context, acc *entity.Account, amount int64, currency string, at time.Time) {
+	if err := t.limit.Release(ctx, acc.ID, currency, amount, at); err != nil {
+		logger.Log.Warn(errormsg.WriteErr(err))
+	}
+}
+
+// swapLimit moves the reservation of an amended job from its old amount to its new one, the counter of the day is
+// seeded first so releasing the old amount starts from the stored jobs that still include it
+func (t *Transfer) swapLimit(ctx context.Context, acc *entity.Account, before model.CreateTransfer, after model.CreateTransfer, at time.Time) error {
+	if _, err := t.limit.GetUsage(ctx, acc.ID, acc.APIKey.String, before.Currency, at); err != nil {
+		return err
+	}
+
+	t.releaseLimit(ctx, acc, before.Amount, before.Currency, at)
+	if err := t.reserveLimit(ctx, acc, after.Amount, after.Currency, at); err != nil {
+		t.restoreLimit(ctx, acc, before.Amount, before.Currency, at)
+		return err
+	}
+	return nil
+}
+
+// unswapLimit gives the reservation back to the old amount when the amend is not stored
+func (t *Transfer) unswapLimit(ctx context.Context, acc *entity.Account, before model.CreateTransfer, after model.CreateTransfer, at time.Time) {
+	t.releaseLimit(ctx, acc, after.Amount, after.Currency, at)
+	t.restoreLimit(ctx, acc, before.Amount, before.Currency, at)
+}
+
+// restoreLimit counts a released amount again without the daily checks, it was within the limits when first reserved
+func (t *Transfer) restoreLimit(ctx context.Context, acc *entity.Account, amount int64, currency string, at time.Time) {
+	if err := t.limit.Reserve(ctx, acc.APIKey.String, &entity.AccountLimit{AccountID: acc.ID, Currency: currency}, amount, at); err != nil {
+		logger.Log.Warn(errormsg.WriteErr(err))
+	}
+}
+
+func (t *Transfer) releaseLimits(ctx context.Context, acc *entity.Account, reserved []model.CreateTransfer, at time.Time) {
+	for _, v := range reserved {
+		t.releaseLimit(ctx, acc, v.Amount, v.Currency, at)
+	}
+}
+
+func (t *Transfer) deleteIdempotency(ctx context.Context, apikey string, idempotencyKey string) {
+	if idempotencyKey == "" {
+		return
+	}
+	if err := t.transfer.DeleteIdempotency(ctx, apikey, idempotencyKey); err != nil {
+		logger.Log.Warn(errormsg.WriteErr(err))
+	}
+}
+
+// readerAPIKey returns the api key the reads of the reader are scoped to, null for a super admin who reads every job
+func (t *Transfer) readerAPIKey(ctx context.Context, reader *model.TransferReader) (null.String, error) {
+	if reader.Scope == model.SuperAdminScope {
+		return null.String{}, nil
+	}
+
+	param := &model.GetAccountByParam{
+		APIKey: null.StringFrom(reader.APIKey),
+	}
+	if reader.AccountID > 0 {
+		param = &model.GetAccountByParam{
+			ID: null.Int64From(reader.AccountID),
+		}
+	} else if reader.APIKey == "" {
+		return null.String{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, nil, "api key required")
+	}
+
+	acc, err := t.account.GetSingleByParam(ctx, "", param)
+	if err != nil {
+		return null.String{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
+	}
+
+	// an account without an api key owns no job
+	if !acc.APIKey.Valid || acc.APIKey.String == "" {
+		return null.String{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, nil, "account has no api key")
+	}
+	return acc.APIKey, nil
+}
+
+func (t *Transfer) getOwnedJob(ctx context.Context, id string, apikey string) (entity.TransferJob, error) {
+	acc, err := t.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+		APIKey: null.StringFrom(apikey),
+	})
+	if err != nil {
+		return entity.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
+	}
+
+	transferJob, err := t.transfer.GetSingleByParam(ctx, model.MustRevalidate, &model.GetTransferJobByParam{
+		JobID:  null.StringFrom(id),
+		APIKey: acc.APIKey,
+	})
+	if err != nil {
+		return entity.TransferJob{}, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "data not found")
+	}
+	return transferJob, nil
+}
+
+func (t *Transfer) transition(ctx context.Context, transferJob *entity.TransferJob, to entity.Transferstatus, reason string, actor string, providerResponse interface{}) error {
+	if err := model.ValidateTransferTransition(transferJob.Status, to); err != nil {
+		return err
+	}
+	event, err := model.NewTransferJobEvent(transferJob.Status, to, reason, actor, providerResponse)
+	if err != nil {
+		return err
+	}
+	if err := t.transfer.Transition(ctx, transferJob, &event, t.webhookDeliveries(ctx, transferJob, &event)); err != nil {
+		return err
+	}
+	t.publishEvent(ctx, transferJob, &event)
+	return nil
+}
+
+// publishEvent is called once the event is committed and never fails the change it records, a subscriber missing the
+// message reads it back from the job events on resume
+func (t *Transfer) publishEvent(ctx context.Context, transferJob *entity.TransferJob, event *entity.TransferJobEvent) {
+	message := model.NewTransferStreamMessage(transferJob, event)
+	if err := t.stream.Publish(ctx, transferJob.APIKey, &message); err != nil {
+		logger.Log.Warn(errormsg.WriteErr(err))
+	}
+}
+
+// webhookDeliveries never blocks a transition, a failed lookup only skips the notification
+func (t *Transfer) webhookDeliveries(ctx context.Context, transferJob *entity.TransferJob, event *entity.TransferJobEvent) entity.WebhookDeliverySlice {
+	acc, err := t.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+		APIKey: null.StringFrom(transferJob.APIKey),
+	})
+	if err != nil {
+		logger.Log.Warn(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get account")))
+		return nil
+	}
+
+	endpoints, err := t.webhook.GetEndpoints(ctx, &model.GetWebhookEndpointByParam{
+		AccountID: null.Int64From(int64(acc.ID)),
+		IsActive:  null.BoolFrom(true),
+	})
+	if err != nil {
+		logger.Log.Warn(errormsg.WriteErr(err))
+		return nil
+	}
+
+	deliveries, err := model.NewWebhookDeliveries(endpoints, transferJob.JobID, event)
+	if err != nil {
+		logger.Log.Warn(errormsg.WriteErr(err))
+		return nil
+	}
+	return deliveries
+}
+
+// recordAttempt schedules the next submission of a job the provider call failed for, or fails the job once the policy of the error class is exhausted
+func (t *Transfer) recordAttempt(ctx context.Context, transferJob *entity.TransferJob, err error) {
+	class := model.ClassifyTransferError(err)
+	policy, ok := t.conf.Retry[class]
+	if !ok {
+		policy = model.DefaultTransferRetryPolicies[class]
+	}
+
+	if model.RecordTransferAttempt(transferJob, policy, class, err) {
+		if err := t.transfer.RecordAttempt(ctx, transferJob); err != nil {
+			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error record attempt")))
+		}
+		return
+	}
+
+	reason := fmt.Sprintf("provider submission failed after %d attempts", transferJob.AttemptCount)
+	if err := t.transition(ctx, transferJob, entity.TransferstatusFailed, reason, model.TransferActorConsumer, nil); err != nil {
+		logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error update data")))
+	}
+}
+
+func (t *Transfer) RetrySubmission(ctx context.Context, limit int64) {
+	transferJobSlice, _, err := t.transfer.GetByParam(ctx, model.MustRevalidate, &model.GetTransferJobsByParam{
+		GetTransferJobByParam: model.GetTransferJobByParam{
+			Status:           null.StringFrom(entity.TransferstatusPending.String()),
+			NextAttemptAtLTE: null.TimeFrom(time.Now().UTC()),
+		},
+		OrderBy: null.StringFrom("next_attempt_at"),
+		Limit:   limit,
+	})
+	if err != nil {
+		logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get by param")))
+		return
+	}
+
+	for _, v := range transferJobSlice {
+		if _, err := t.transfer.Requeue(ctx, v); err != nil {
+			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error requeue transfer")))
+		}
+	}
+}
+
+// ProviderCallback applies the status pushed by a provider, a callback for a status the job already has or one still in flight is acknowledged without a change
+func (t *Transfer) ProviderCallback(ctx context.Context, provider string, v model.ProviderCallback) (model.ProviderCallbackAck, error) {
+	conf, ok := t.conf.Providers[strings.ToLower(provider)]
+	if !ok {
+		return model.ProviderCallbackAck{}, errormsg.WrapErr(svcerr.BrickSVCNotFound, nil, "provider not found")
+	}
+
+	if err := v.Verify(conf, time.Now()); err != nil {
+		return model.ProviderCallbackAck{}, err
+	}
+
+	record, err := v.Transfer()
+	if err != nil {
+		return model.ProviderCallbackAck{}, err
+	}
+
+	to, err := model.MapProviderStatus(conf, record.Status)
+	if err != nil {
+		return model.ProviderCallbackAck{}, err
+	}
+
+	transferJob, err := t.transfer.GetSingleByParam(ctx, model.MustRevalidate, &model.GetTransferJobByParam{
+		ProviderID: null.StringFrom(record.ID),
+	})
+	if err != nil {
+		return model.ProviderCallbackAck{}, errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "data not found")
+	}
+
+	res := model.ProviderCallbackAck{
+		ID:     record.ID,
+		JobID:  transferJob.JobID,
+		Status: transferJob.Status.String(),
+	}
+	if transferJob.Status == to || to == entity.TransferstatusProcessing {
+		return res, nil
+	}
+
+	err = t.transition(ctx, &transferJob, to, "provider callback reported "+record.Status, model.TransferActorProvider, record)
+	if err != nil {
+		if errormsg.GetErrorData(err).Code != svcerr.CodeInvalidStatusTransition {
+			return model.ProviderCallbackAck{}, err
+		}
+
+		// a duplicate delivered concurrently may have applied the status first
+		current, errGet := t.transfer.GetSingleByParam(ctx, model.MustRevalidate, &model.GetTransferJobByParam{
+			ID: null.Int64From(int64(transferJob.ID)),
+		})
+		if errGet != nil || current.Status != to {
+			return model.ProviderCallbackAck{}, err
+		}
+		res.Status = current.Status.String()
+		return res, nil
+	}
+
+	res.Status = transferJob.Status.String()
+	res.Applied = true
+	return res, nil
+}
+
+// ProccessGetCallback claims the jobs of param and asks the provider about them with a bounded pool of workers, a job claimed by another replica is skipped
 func (t *Transfer) ProccessGetCallback(ctx context.Context, param *model.GetTransferJobsByParam) {
+	// jobs expire relative to their last status change, released scheduled jobs may be created long before
 	tNow := time.Now().UTC().Add(-t.conf.JobActiveDuration)
-	transferJobSlice, _, err := t.transfer.GetByParam(ctx, model.MustRevalidate, param)
+	transferJobSlice, err := t.transfer.Claim(ctx, param, t.conf.PollLease)
 	if err != nil {
-		logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get by param")))
+		logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error claim transfer")))
+		return
+	}
+
+	workers := t.conf.PollWorkers
+	if workers <= 0 {
+		workers = 1
+	}
+
+	jobs := make(chan *entity.TransferJob)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range jobs {
+				t.pollTransfer(ctx, v, tNow)
+				if err := t.transfer.Release(ctx, v); err != nil {
+					logger.Log.Warn(errormsg.WriteErr(err))
+				}
+			}
+		}()
 	}
 
 	for _, v := range transferJobSlice {
-		var payload clientresponse.Transfer
-		if err := v.Payload.Unmarshal(&payload); err != nil {
+		jobs <- v
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+func (t *Transfer) pollTransfer(ctx context.Context, v *entity.TransferJob, tNow time.Time) {
+	var payload clientresponse.Transfer
+	if err := v.Payload.Unmarshal(&payload); err != nil {
+		logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshall")))
+		return
+	}
+
+	if payload.ID == "" {
+		// a job waiting for its retry is failed by recordAttempt once its attempts are exhausted
+		if v.UpdatedAt.Before(tNow) && !v.NextAttemptAt.Valid {
+			if err := t.transition(ctx, v, entity.TransferstatusFailed, "not submitted to provider before job expired", model.TransferActorScheduler, nil); err != nil {
+				logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error update data")))
+			}
+		}
+		return
+	}
+
+	cb, err := t.transfer.GetTransferByID(payload.ID)
+	if err != nil {
+		logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get data")))
+		return
+	}
+
+	if cb.Status == entity.TransferstatusSuccess.String() {
+		if err := t.transition(ctx, v, entity.TransferstatusSuccess, "provider reported success", model.TransferActorScheduler, cb); err != nil {
+			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error update data")))
+		}
+		return
+	}
+
+	if cb.Status == entity.TransferstatusFailed.String() {
+		if err := t.transition(ctx, v, entity.TransferstatusFailed, "provider reported failure", model.TransferActorScheduler, cb); err != nil {
+			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error update data")))
+		}
+		return
+	}
+
+	if v.UpdatedAt.Before(tNow) {
+		if err := t.transition(ctx, v, entity.TransferstatusFailed, "provider did not settle before job expired", model.TransferActorScheduler, cb); err != nil {
+			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error update data")))
+		}
+	}
+}
+
+func (t *Transfer) RelayOutbox(ctx context.Context, limit int64) {
+	if _, err := t.transfer.RelayOutbox(ctx, int(limit)); err != nil {
+		logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error relay outbox")))
+	}
+}
+
+func (t *Transfer) CreateRecurring(ctx context.Context, v model.CreateRecurringTransfer, apikey string) (model.RecurringTransfer, error) {
+	acc, err := t.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+		APIKey: null.StringFrom(apikey),
+	})
+	if err != nil {
+		return model.RecurringTransfer{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
+	}
+
+	// every occurrence is counted against the daily limits when it is materialized, only the per transaction limit is
+	// known upfront
+	limit, err := t.limit.GetLimit(ctx, acc.ID, v.Currency)
+	if err != nil {
+		return model.RecurringTransfer{}, err
+	}
+	if err := model.CheckTransferLimit(&limit, v.Amount); err != nil {
+		return model.RecurringTransfer{}, err
+	}
+
+	data, err := v.ToEntity(acc.APIKey.String)
+	if err != nil {
+		return model.RecurringTransfer{}, err
+	}
+
+	// the accounts are checked with the first occurrence priced, every later one is checked again when it is materialized
+	job, err := model.NewRecurringTransferJob(&data, data.StartAt)
+	if err != nil {
+		return model.RecurringTransfer{}, err
+	}
+	if err := t.applyFee(ctx, &job, acc.Plan); err != nil {
+		return model.RecurringTransfer{}, err
+	}
+	payload := v.ToCreateTransfer(data.StartAt)
+	if err := t.validateAccounts(ctx, &payload, &job); err != nil {
+		return model.RecurringTransfer{}, err
+	}
+
+	err = t.transfer.InsertRecurring(ctx, &data)
+	if err != nil {
+		return model.RecurringTransfer{}, err
+	}
+	return model.TransformPSQLSingleRecurringTransfer(&data), nil
+}
+
+func (t *Transfer) GetRecurring(ctx context.Context, apikey string, v model.GetRecurringTransfersByParam) ([]model.RecurringTransfer, model.Pagination, error) {
+	acc, err := t.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+		APIKey: null.StringFrom(apikey),
+	})
+	if err != nil {
+		return []model.RecurringTransfer{}, model.Pagination{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
+	}
+
+	v.APIKey = acc.APIKey
+	recurringTransfers, pagination, err := t.transfer.GetRecurringByParam(ctx, &v)
+	if err != nil {
+		return []model.RecurringTransfer{}, pagination, err
+	}
+	return model.TransformPSQLRecurringTransfer(&recurringTransfers), pagination, nil
+}
+
+func (t *Transfer) GetRecurringByID(ctx context.Context, id string, apikey string) (model.RecurringTransfer, error) {
+	recurringTransfer, err := t.getOwnedRecurring(ctx, id, apikey)
+	if err != nil {
+		return model.RecurringTransfer{}, err
+	}
+
+	transferJobs, _, err := t.transfer.GetByParam(ctx, model.MustRevalidate, &model.GetTransferJobsByParam{
+		GetTransferJobByParam: model.GetTransferJobByParam{
+			RecurringTransferID: null.Int64From(int64(recurringTransfer.ID)),
+		},
+		OrderBy: null.StringFrom("id DESC"),
+	})
+	if err != nil {
+		return model.RecurringTransfer{}, err
+	}
+
+	res := model.TransformPSQLSingleRecurringTransfer(&recurringTransfer)
+	res.Jobs = model.TransformTransferJobSummary(transferJobs)
+	return res, nil
+}
+
+func (t *Transfer) PauseRecurring(ctx context.Context, id string, apikey string) (model.RecurringTransfer, error) {
+	recurringTransfer, err := t.getOwnedRecurring(ctx, id, apikey)
+	if err != nil {
+		return model.RecurringTransfer{}, err
+	}
+
+	recurringTransfer.Status = entity.RecurringstatusPaused
+	recurringTransfer.NextRunAt = null.Time{}
+	if err := t.transfer.SetRecurringStatus(ctx, &recurringTransfer, entity.RecurringstatusActive); err != nil {
+		return model.RecurringTransfer{}, err
+	}
+	return model.TransformPSQLSingleRecurringTransfer(&recurringTransfer), nil
+}
+
+func (t *Transfer) ResumeRecurring(ctx context.Context, id string, apikey string) (model.RecurringTransfer, error) {
+	recurringTransfer, err := t.getOwnedRecurring(ctx, id, apikey)
+	if err != nil {
+		return model.RecurringTransfer{}, err
+	}
+
+	// occurrences that fell due while paused are not sent
+	recurringTransfer.Status = entity.RecurringstatusActive
+	if err := model.ScheduleNextRecurringRun(&recurringTransfer, time.Now().UTC()); err != nil {
+		return model.RecurringTransfer{}, err
+	}
+	if err := t.transfer.SetRecurringStatus(ctx, &recurringTransfer, entity.RecurringstatusPaused); err != nil {
+		return model.RecurringTransfer{}, err
+	}
+	return model.TransformPSQLSingleRecurringTransfer(&recurringTransfer), nil
+}
+
+func (t *Transfer) MaterializeRecurring(ctx context.Context, limit int64) {
+	now := time.Now().UTC()
+	recurringTransfers, _, err := t.transfer.GetRecurringByParam(ctx, &model.GetRecurringTransfersByParam{
+		GetRecurringTransferByParam: model.GetRecurringTransferByParam{
+			Status:       null.StringFrom(entity.RecurringstatusActive.String()),
+			NextRunAtLTE: null.TimeFrom(now),
+		},
+		OrderBy: null.StringFrom("next_run_at"),
+		Limit:   limit,
+	})
+	if err != nil {
+		logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get recurring by param")))
+		return
+	}
+
+	for _, v := range recurringTransfers {
+		data, err := model.NewRecurringTransferJob(v, v.NextRunAt.Time)
+		if err != nil {
+			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error build recurring transfer job")))
+			continue
+		}
+
+		acc, err := t.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+			APIKey: null.StringFrom(v.APIKey),
+		})
+		if err != nil {
+			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)))
+			continue
+		}
+
+		if err := t.applyFee(ctx, &data, acc.Plan); err != nil {
+			logger.Log.Error(errormsg.WriteErr(err))
+			continue
+		}
+
+		var payload model.CreateTransfer
+		if err := data.Payload.Unmarshal(&payload); err != nil {
 			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error unmarshall")))
 			continue
 		}
 
-		if payload.ID == "" {
-			v.Status = entity.TransferstatusFailed
-			if err := t.transfer.Update(ctx, v); err != nil {
-				logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error delete data")))
-			}
+		// an occurrence whose accounts no longer pass is recorded as failed and never sent, the mandate moves on
+		if err := t.validateAccounts(ctx, &payload, &data); err != nil {
+			t.failRecurring(ctx, v, &data, err)
 			continue
 		}
 
-		cb, err := t.transfer.GetTransferByID(payload.ID)
+		event, err := model.NewTransferJobEvent("", data.Status, "transfer created from mandate "+v.MandateID, model.TransferActorScheduler, nil)
 		if err != nil {
-			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get data")))
+			logger.Log.Error(errormsg.WriteErr(err))
 			continue
 		}
 
-		if cb.Status == entity.TransferstatusSuccess.String() {
-			v.Status = entity.TransferstatusSuccess
-			if err := t.transfer.Update(ctx, v); err != nil {
-				logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error delete data")))
-			}
+		// an occurrence over the limits of the account stays due and is tried again on the next run
+		if err := t.reserveLimit(ctx, &acc, payload.Amount, payload.Currency, now); err != nil {
+			logger.Log.Warn(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error reserve limit of recurring transfer "+v.MandateID)))
 			continue
 		}
 
-		if cb.Status == entity.TransferstatusFailed.String() {
-			v.Status = entity.TransferstatusFailed
-			if err := t.transfer.Update(ctx, v); err != nil {
-				logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error delete data")))
-			}
+		materialized, err := t.transfer.MaterializeRecurring(ctx, v, &data, &event)
+		if err != nil {
+			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error materialize recurring transfer")))
+		}
+		if !materialized {
+			t.releaseLimit(ctx, &acc, payload.Amount, payload.Currency, now)
 			continue
 		}
+		t.publishEvent(ctx, &data, &event)
+	}
+}
 
-		if v.CreatedAt.Before(tNow) && v.Status == entity.TransferstatusPending {
-			v.Status = entity.TransferstatusFailed
-			if err := t.transfer.Update(ctx, v); err != nil {
-				logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error delete data")))
-			}
-		}
+// failRecurring stores an occurrence that failed its checks as a failed job, no outbox message is written for it
+func (t *Transfer) failRecurring(ctx context.Context, v *entity.RecurringTransfer, data *entity.TransferJob, err error) {
+	reason := fmt.Sprintf("transfer from mandate %s failed account check: %v", v.MandateID, errormsg.GetErrorData(err).DebugError)
+	data.Status = entity.TransferstatusFailed
+	data.ScheduledAt = null.Time{}
+	data.LastError = null.StringFrom(reason)
+	event, err := model.NewTransferJobEvent("", data.Status, reason, model.TransferActorScheduler, nil)
+	if err != nil {
+		logger.Log.Error(errormsg.WriteErr(err))
+		return
+	}
 
+	materialized, err := t.transfer.MaterializeRecurring(ctx, v, data, &event)
+	if err != nil {
+		logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error materialize recurring transfer")))
+	}
+	if materialized {
+		t.publishEvent(ctx, data, &event)
 	}
 }
+
+func (t *Transfer) getOwnedRecurring(ctx context.Context, id string, apikey string) (entity.RecurringTransfer, error) {
+	acc, err := t.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+		APIKey: null.StringFrom(apikey),
+	})
+	if err != nil {
+		return entity.RecurringTransfer{}, errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
+	}
+
+	return t.transfer.GetSingleRecurringByParam(ctx, &model.GetRecurringTransferByParam{
+		MandateID: null.StringFrom(id),
+		APIKey:    acc.APIKey,
+	})
+}
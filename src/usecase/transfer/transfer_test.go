@@ -0,0 +1,206 @@
+package transfer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/clientresponse"
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	mock_account "github.com/achwanyusuf/bricksvc/src/repository/mock/account"
+	mock_accountlimit "github.com/achwanyusuf/bricksvc/src/repository/mock/accountlimit"
+	mock_bank "github.com/achwanyusuf/bricksvc/src/repository/mock/bank"
+	mock_feerule "github.com/achwanyusuf/bricksvc/src/repository/mock/feerule"
+	mock_transfer "github.com/achwanyusuf/bricksvc/src/repository/mock/transfer"
+	mock_transferstream "github.com/achwanyusuf/bricksvc/src/repository/mock/transferstream"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/volatiletech/null/v8"
+)
+
+type testTransfer struct {
+	usecase  TransferInterface
+	account  *mock_account.MockAccountInterface
+	transfer *mock_transfer.MockTransferInterface
+	feeRule  *mock_feerule.MockFeeRuleInterface
+	limit    *mock_accountlimit.MockAccountLimitInterface
+	bank     *mock_bank.MockBankInterface
+	stream   *mock_transferstream.MockTransferStreamInterface
+}
+
+func newTestTransfer(t *testing.T) *testTransfer {
+	ctrl := gomock.NewController(t)
+	log := logger.New(&logger.Config{})
+	logger.Log = log.(*logger.Logger)
+
+	res := &testTransfer{
+		account:  mock_account.NewMockAccountInterface(ctrl),
+		transfer: mock_transfer.NewMockTransferInterface(ctrl),
+		feeRule:  mock_feerule.NewMockFeeRuleInterface(ctrl),
+		limit:    mock_accountlimit.NewMockAccountLimitInterface(ctrl),
+		bank:     mock_bank.NewMockBankInterface(ctrl),
+		stream:   mock_transferstream.NewMockTransferStreamInterface(ctrl),
+	}
+	res.usecase = New(Conf{}, &log, res.account, res.transfer, nil, nil, res.feeRule, res.limit, res.bank, res.stream)
+	return res
+}
+
+func TestTransferReplaysQuotedTransfer(t *testing.T) {
+	tt := newTestTransfer(t)
+	ctx := context.Background()
+	acc := entity.Account{ID: 1, APIKey: null.StringFrom("apikey"), Plan: "default"}
+	quote := entity.TransferQuote{
+		ID:                  7,
+		QuoteID:             "quote",
+		APIKey:              "apikey",
+		SourceCurrency:      "IDR",
+		DestinationCurrency: "USD",
+		SourceAmount:        150000,
+		DestinationAmount:   1000,
+		ExpiresAt:           time.Now().Add(time.Minute),
+	}
+	idempotency := map[string]model.TransferIdempotency{}
+	stored := map[string]entity.TransferJob{}
+
+	tt.account.EXPECT().GetSingleByParam(gomock.Any(), gomock.Any(), gomock.Any()).Return(acc, nil).Times(2)
+	tt.transfer.EXPECT().SetIdempotency(gomock.Any(), "apikey", "key", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, key string, data model.TransferIdempotency) (bool, error) {
+			if _, ok := idempotency[key]; ok {
+				return false, nil
+			}
+			idempotency[key] = data
+			return true, nil
+		}).Times(2)
+	tt.transfer.EXPECT().GetIdempotency(gomock.Any(), "apikey", "key").DoAndReturn(
+		func(_ context.Context, _ string, key string) (model.TransferIdempotency, error) {
+			return idempotency[key], nil
+		})
+	// the quote is used by the first request, looking it up again would reject the retry
+	tt.transfer.EXPECT().GetSingleQuoteByParam(gomock.Any(), gomock.Any()).Return(quote, nil).Times(1)
+	tt.feeRule.EXPECT().Match(gomock.Any(), gomock.Any()).Return(entity.FeeRule{}, errormsg.WrapErr(svcerr.BrickSVCNotFound, nil))
+	tt.bank.EXPECT().GetBankAccount(gomock.Any(), gomock.Any(), gomock.Any()).Return(clientresponse.BankAccount{AccountAmount: 10000}, nil).Times(2)
+	tt.limit.EXPECT().GetLimit(gomock.Any(), acc.ID, "IDR").Return(entity.AccountLimit{AccountID: acc.ID, Currency: "IDR"}, nil)
+	tt.limit.EXPECT().Reserve(gomock.Any(), "apikey", gomock.Any(), int64(150000), gomock.Any()).Return(nil)
+	tt.transfer.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, data *entity.TransferJob, _ *entity.TransferJobEvent, _ *entity.TransferQuote) error {
+			data.ID = 1
+			stored[data.JobID] = *data
+			return nil
+		})
+	tt.transfer.EXPECT().GetSingleByParam(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, param *model.GetTransferJobByParam) (entity.TransferJob, error) {
+			return stored[param.JobID.String], nil
+		})
+	// only the stored job is announced, the replay is not
+	tt.stream.EXPECT().Publish(gomock.Any(), "apikey", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, v *model.TransferStreamMessage) error {
+			if event := model.TransformTransferStreamEvent(v); event.Event != model.TransferStreamEventCreated {
+				t.Fatalf("published %s, want %s", event.Event, model.TransferStreamEventCreated)
+			}
+			return nil
+		})
+
+	req := model.CreateTransfer{
+		SourceBankAccount:      "111",
+		DestinationBankAccount: "222",
+		SourceBankID:           1,
+		DestinationBankID:      2,
+		QuoteID:                "quote",
+	}
+	first, err := tt.usecase.Transfer(ctx, req, "apikey", "key")
+	if err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+
+	retry, err := tt.usecase.Transfer(ctx, req, "apikey", "key")
+	if err != nil {
+		t.Fatalf("retried request: %v", err)
+	}
+
+	if retry.JobID != first.JobID {
+		t.Fatalf("retry returned job %s, want %s", retry.JobID, first.JobID)
+	}
+}
+
+func TestCreateRetriesJobClaimedByPoller(t *testing.T) {
+	tt := newTestTransfer(t)
+	ctx := context.Background()
+	job := entity.TransferJob{ID: 1, JobID: "job", APIKey: "apikey", Status: entity.TransferstatusPending}
+
+	tt.transfer.EXPECT().GetSingleByParam(gomock.Any(), gomock.Any(), gomock.Any()).Return(job, nil)
+	// the poller leased the pending job before the consumer got to it
+	tt.transfer.EXPECT().ClaimSubmission(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(false, errormsg.WrapErr(svcerr.BrickSVCTransferClaimed, nil, "transfer job is leased by poller"))
+	tt.transfer.EXPECT().InsertTransfer(gomock.Any(), gomock.Any()).Times(0)
+
+	err := tt.usecase.Create(ctx, "job", model.CreateTransfer{Amount: 1000, Currency: "IDR"})
+	if errormsg.GetErrorData(err).Code != svcerr.CodeTransferClaimed {
+		t.Fatalf("got %v, want a claimed error so the message is retried", err)
+	}
+}
+
+func TestTransferRejectsBalanceBelowAmountAndFee(t *testing.T) {
+	tt := newTestTransfer(t)
+	ctx := context.Background()
+	acc := entity.Account{ID: 1, APIKey: null.StringFrom("apikey"), Plan: "default"}
+
+	tt.account.EXPECT().GetSingleByParam(gomock.Any(), gomock.Any(), gomock.Any()).Return(acc, nil)
+	tt.feeRule.EXPECT().Match(gomock.Any(), gomock.Any()).Return(entity.FeeRule{ID: 1, FeeType: entity.FeetypeFlat, FlatAmount: 500}, nil)
+	// the balance of 1000.00 covers the amount but not the fee charged on top of it
+	tt.bank.EXPECT().GetBankAccount(gomock.Any(), gomock.Any(), gomock.Any()).Return(clientresponse.BankAccount{AccountAmount: 1000}, nil).Times(2)
+
+	_, err := tt.usecase.Transfer(ctx, model.CreateTransfer{
+		SourceBankAccount:      "111",
+		DestinationBankAccount: "222",
+		SourceBankID:           1,
+		DestinationBankID:      2,
+		Amount:                 100000,
+		Currency:               "IDR",
+	}, "apikey", "")
+	if errormsg.GetErrorData(err).Code != svcerr.CodeinsufficientAmount {
+		t.Fatalf("got %v, want insufficient amount", err)
+	}
+}
+
+func TestMaterializeRecurringFailsOccurrenceWithMissingAccount(t *testing.T) {
+	tt := newTestTransfer(t)
+	ctx := context.Background()
+	acc := entity.Account{ID: 1, APIKey: null.StringFrom("apikey"), Plan: "default"}
+	mandate := model.CreateRecurringTransfer{
+		SourceBankAccount:      "111",
+		DestinationBankAccount: "222",
+		SourceBankID:           1,
+		DestinationBankID:      2,
+		Amount:                 100000,
+		Currency:               "IDR",
+		Schedule:               "0 9 * * 1",
+		StartAt:                time.Now().Add(-time.Hour),
+	}
+	recurring, err := mandate.ToEntity("apikey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	recurring.ID = 1
+	recurring.MandateID = "mandate"
+	recurring.NextRunAt = null.TimeFrom(time.Now().Add(-time.Minute))
+
+	tt.transfer.EXPECT().GetRecurringByParam(gomock.Any(), gomock.Any()).Return(entity.RecurringTransferSlice{&recurring}, model.Pagination{}, nil)
+	tt.account.EXPECT().GetSingleByParam(gomock.Any(), gomock.Any(), gomock.Any()).Return(acc, nil)
+	tt.feeRule.EXPECT().Match(gomock.Any(), gomock.Any()).Return(entity.FeeRule{}, errormsg.WrapErr(svcerr.BrickSVCNotFound, nil))
+	tt.bank.EXPECT().GetBankAccount(gomock.Any(), gomock.Any(), gomock.Any()).Return(clientresponse.BankAccount{}, errormsg.WrapErr(svcerr.BrickSVCNotFound, nil))
+	// the failed occurrence is not counted against the limits
+	tt.limit.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	tt.transfer.EXPECT().MaterializeRecurring(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *entity.RecurringTransfer, data *entity.TransferJob, event *entity.TransferJobEvent) (bool, error) {
+			if data.Status != entity.TransferstatusFailed || event.ToStatus != entity.TransferstatusFailed {
+				t.Fatalf("occurrence stored as %s, want failed", data.Status)
+			}
+			return true, nil
+		})
+	tt.stream.EXPECT().Publish(gomock.Any(), "apikey", gomock.Any()).Return(nil)
+
+	tt.usecase.MaterializeRecurring(ctx, 10)
+}
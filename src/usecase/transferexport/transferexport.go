@@ -0,0 +1,227 @@
+package transferexport
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/repository/transferexport"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/volatiletech/null/v8"
+)
+
+type TransferExport struct {
+	log            logger.LoggerInterface
+	conf           Conf
+	transferExport transferexport.TransferExportInterface
+}
+
+type Conf struct {
+	Dir     string        `mapstructure:"dir"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+type TransferExportInterface interface {
+	Validate(ctx context.Context, v *model.GetTransferExportByParam) error
+	Export(ctx context.Context, v *model.GetTransferExportByParam, w io.Writer) (int64, error)
+	Create(ctx context.Context, v model.GetTransferExportByParam, createdBy int64) (model.TransferExport, error)
+	GetByExportID(ctx context.Context, exportID string) (model.TransferExport, error)
+	GetFile(ctx context.Context, exportID string) (model.TransferExport, string, error)
+}
+
+func New(conf Conf, logger *logger.LoggerInterface, transferExport transferexport.TransferExportInterface) TransferExportInterface {
+	return &TransferExport{
+		conf:           conf,
+		log:            *logger,
+		transferExport: transferExport,
+	}
+}
+
+// Validate fills the defaults of the export, it runs before anything is streamed so a bad parameter still gets an error response
+func (t *TransferExport) Validate(ctx context.Context, v *model.GetTransferExportByParam) error {
+	return v.Validate()
+}
+
+// Export writes the selected columns of the jobs in their format as they are read and returns the number of jobs written
+func (t *TransferExport) Export(ctx context.Context, v *model.GetTransferExportByParam, w io.Writer) (int64, error) {
+	if err := v.Validate(); err != nil {
+		return 0, err
+	}
+
+	var count int64
+	columns := v.ColumnList()
+	if v.Format == model.SettlementFormatNDJSON {
+		stream := jsoniter.NewStream(jsoniter.ConfigDefault, w, 4096)
+		err := t.transferExport.EachJob(ctx, v, func(job *entity.TransferJob) error {
+			values, err := model.TransferExportValues(job, columns)
+			if err != nil {
+				return err
+			}
+
+			// the object is written field by field to keep the selected column order
+			stream.WriteObjectStart()
+			for i, c := range columns {
+				if i > 0 {
+					stream.WriteMore()
+				}
+				stream.WriteObjectField(c)
+				stream.WriteVal(values[i])
+			}
+			stream.WriteObjectEnd()
+			stream.WriteRaw("\n")
+			if err := stream.Flush(); err != nil {
+				return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write ndjson")
+			}
+			count++
+			return nil
+		})
+		return count, err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns); err != nil {
+		return 0, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write csv")
+	}
+
+	err := t.transferExport.EachJob(ctx, v, func(job *entity.TransferJob) error {
+		values, err := model.TransferExportValues(job, columns)
+		if err != nil {
+			return err
+		}
+
+		if err := writer.Write(model.TransferExportCSVRecord(values)); err != nil {
+			return errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write csv")
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return count, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write csv")
+	}
+	return count, nil
+}
+
+// Create stores a running export job and writes its file in the background, the job is polled until it links the file or fails
+func (t *TransferExport) Create(ctx context.Context, v model.GetTransferExportByParam, createdBy int64) (model.TransferExport, error) {
+	if t.conf.Dir == "" {
+		return model.TransferExport{}, errormsg.WrapErr(svcerr.BrickSVCInvalidTransferExport, nil, "export jobs are disabled")
+	}
+
+	export, err := v.ToEntity(createdBy)
+	if err != nil {
+		return model.TransferExport{}, err
+	}
+
+	if err := t.transferExport.Insert(ctx, &export); err != nil {
+		return model.TransferExport{}, err
+	}
+
+	go func(export entity.TransferExport, v model.GetTransferExportByParam) {
+		if err := t.run(context.Background(), &export, &v); err != nil {
+			logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error export "+export.ExportID)))
+		}
+	}(export, v)
+
+	return model.TransformPSQLSingleTransferExport(&export), nil
+}
+
+func (t *TransferExport) run(ctx context.Context, export *entity.TransferExport, v *model.GetTransferExportByParam) error {
+	if t.conf.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.conf.Timeout)
+		defer cancel()
+	}
+
+	fileName := export.ExportID + "." + export.Format
+	count, err := t.writeFile(ctx, v, filepath.Join(t.conf.Dir, fileName))
+	export.FinishedAt = null.TimeFrom(time.Now().UTC())
+	if err != nil {
+		export.Status = entity.TransferexportstatusFailed
+		export.Error = null.StringFrom(err.Error())
+		// the job is marked failed even when the export ran out of time
+		if errUpdate := t.transferExport.Update(context.Background(), export); errUpdate != nil {
+			logger.Log.Error(errormsg.WriteErr(errUpdate))
+		}
+		return err
+	}
+
+	export.Status = entity.TransferexportstatusCompleted
+	export.RowCount = int(count)
+	export.FileName = fileName
+	return t.transferExport.Update(context.Background(), export)
+}
+
+// writeFile writes next to path and renames once complete, an export cut short never looks finished
+func (t *TransferExport) writeFile(ctx context.Context, v *model.GetTransferExportByParam, path string) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error create transfer export directory")
+	}
+
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return 0, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error create transfer export")
+	}
+
+	writer := bufio.NewWriter(f)
+	count, err := t.Export(ctx, v, writer)
+	if err == nil {
+		err = writer.Flush()
+	}
+	if errClose := f.Close(); err == nil {
+		err = errClose
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return count, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write transfer export")
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return count, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error write transfer export")
+	}
+	return count, nil
+}
+
+func (t *TransferExport) GetByExportID(ctx context.Context, exportID string) (model.TransferExport, error) {
+	export, err := t.transferExport.GetSingleByParam(ctx, &model.GetTransferExportJobByParam{
+		ExportID: null.StringFrom(exportID),
+	})
+	if err != nil {
+		return model.TransferExport{}, err
+	}
+	return model.TransformPSQLSingleTransferExport(&export), nil
+}
+
+// GetFile returns the path of the file of a completed export job
+func (t *TransferExport) GetFile(ctx context.Context, exportID string) (model.TransferExport, string, error) {
+	export, err := t.transferExport.GetSingleByParam(ctx, &model.GetTransferExportJobByParam{
+		ExportID: null.StringFrom(exportID),
+	})
+	if err != nil {
+		return model.TransferExport{}, "", err
+	}
+
+	if export.Status != entity.TransferexportstatusCompleted {
+		return model.TransferExport{}, "", errormsg.WrapErr(svcerr.BrickSVCInvalidTransferExport, nil, "export is "+export.Status.String())
+	}
+
+	path := filepath.Join(t.conf.Dir, export.FileName)
+	if _, err := os.Stat(path); err != nil {
+		return model.TransferExport{}, "", errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "export file not found")
+	}
+	return model.TransformPSQLSingleTransferExport(&export), path, nil
+}
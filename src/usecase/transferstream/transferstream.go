@@ -0,0 +1,139 @@
+package transferstream
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/repository/account"
+	"github.com/achwanyusuf/bricksvc/src/repository/transfer"
+	"github.com/achwanyusuf/bricksvc/src/repository/transferstream"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/volatiletech/null/v8"
+)
+
+type TransferStream struct {
+	log            logger.LoggerInterface
+	conf           Conf
+	account        account.AccountInterface
+	transfer       transfer.TransferInterface
+	transferStream transferstream.TransferStreamInterface
+}
+
+type Conf struct {
+	Heartbeat time.Duration `mapstructure:"heartbeat"`
+}
+
+type TransferStreamInterface interface {
+	Open(ctx context.Context, apikey string, v *model.GetTransferStreamByParam) error
+	Stream(ctx context.Context, v *model.GetTransferStreamByParam, fn func(v *model.TransferStreamEvent) error) error
+}
+
+func New(conf Conf, logger *logger.LoggerInterface, account account.AccountInterface, transfer transfer.TransferInterface, transferStream transferstream.TransferStreamInterface) TransferStreamInterface {
+	if conf.Heartbeat <= 0 {
+		conf.Heartbeat = model.DefaultTransferStreamHeartbeat
+	}
+	return &TransferStream{
+		conf:           conf,
+		log:            *logger,
+		account:        account,
+		transfer:       transfer,
+		transferStream: transferStream,
+	}
+}
+
+// Open checks the api key and that the job or batch filtered on belongs to it, it runs before anything is streamed so
+// a refused stream still gets an error response
+func (t *TransferStream) Open(ctx context.Context, apikey string, v *model.GetTransferStreamByParam) error {
+	acc, err := t.account.GetSingleByParam(ctx, "", &model.GetAccountByParam{
+		APIKey: null.StringFrom(apikey),
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.BrickSVCNotAuthorized, err)
+	}
+	v.APIKey = acc.APIKey.String
+
+	if v.JobID.Valid {
+		_, err := t.transfer.GetSingleByParam(ctx, model.MustRevalidate, &model.GetTransferJobByParam{
+			JobID:  v.JobID,
+			APIKey: acc.APIKey,
+		})
+		if err != nil {
+			return errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "data not found")
+		}
+	}
+
+	if v.BatchID.Valid {
+		batch, _, err := t.transfer.GetBatch(ctx, v.BatchID.String)
+		if err != nil || batch.APIKey != acc.APIKey.String {
+			return errormsg.WrapErr(svcerr.BrickSVCNotFound, err, "data not found")
+		}
+		v.TransferBatchID = null.Int64From(int64(batch.ID))
+	}
+	return nil
+}
+
+// Stream hands fn the status changes of an opened stream until ctx is done, the events after the last event id are
+// replayed first and fn gets nil on every heartbeat so a gone subscriber is noticed, an error of fn ends the stream
+func (t *TransferStream) Stream(ctx context.Context, v *model.GetTransferStreamByParam, fn func(v *model.TransferStreamEvent) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// subscribe before the replay so nothing is lost in between, what is replayed is skipped once it arrives live
+	messages, err := t.transferStream.Subscribe(ctx, v.APIKey)
+	if err != nil {
+		return err
+	}
+
+	replayed := map[int64]bool{}
+	for v.LastEventID.Valid {
+		events, err := t.transferStream.GetEventsAfter(ctx, v)
+		if err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			break
+		}
+
+		for i := range events {
+			event := model.TransformTransferStreamEvent(&events[i])
+			if err := fn(&event); err != nil {
+				return nil
+			}
+			replayed[event.ID] = true
+			v.LastEventID = null.Int64From(event.ID)
+		}
+	}
+
+	heartbeat := time.NewTicker(t.conf.Heartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case message, ok := <-messages:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return errormsg.WrapErr(svcerr.BrickSVCBadRequest, nil, "transfer stream subscription closed")
+			}
+
+			if replayed[message.EventID] || !v.Match(&message) {
+				continue
+			}
+
+			event := model.TransformTransferStreamEvent(&message)
+			if err := fn(&event); err != nil {
+				return nil
+			}
+		case <-heartbeat.C:
+			if err := fn(nil); err != nil {
+				return nil
+			}
+		}
+	}
+}
@@ -3,10 +3,20 @@ package usecase
 import (
 	"github.com/achwanyusuf/bricksvc/src/repository"
 	"github.com/achwanyusuf/bricksvc/src/usecase/account"
+	"github.com/achwanyusuf/bricksvc/src/usecase/accountlimit"
 	"github.com/achwanyusuf/bricksvc/src/usecase/accountrole"
 	"github.com/achwanyusuf/bricksvc/src/usecase/bank"
+	"github.com/achwanyusuf/bricksvc/src/usecase/deadletter"
+	"github.com/achwanyusuf/bricksvc/src/usecase/feerule"
+	"github.com/achwanyusuf/bricksvc/src/usecase/fxrate"
+	"github.com/achwanyusuf/bricksvc/src/usecase/ledger"
+	"github.com/achwanyusuf/bricksvc/src/usecase/reconciliation"
 	"github.com/achwanyusuf/bricksvc/src/usecase/role"
+	"github.com/achwanyusuf/bricksvc/src/usecase/settlement"
 	"github.com/achwanyusuf/bricksvc/src/usecase/transfer"
+	"github.com/achwanyusuf/bricksvc/src/usecase/transferexport"
+	"github.com/achwanyusuf/bricksvc/src/usecase/transferstream"
+	"github.com/achwanyusuf/bricksvc/src/usecase/webhook"
 	"github.com/achwanyusuf/bricksvc/utils/logger"
 )
 
@@ -17,19 +27,39 @@ type Usecase struct {
 }
 
 type Config struct {
-	Account     account.Conf     `mapstructure:"account"`
-	Role        role.Conf        `mapstructure:"role"`
-	AccountRole accountrole.Conf `mapstructure:"account_role"`
-	Bank        bank.Conf        `mapstructure:"bank"`
-	Transfer    transfer.Conf    `mapstructure:"transfer"`
+	Account        account.Conf        `mapstructure:"account"`
+	Role           role.Conf           `mapstructure:"role"`
+	AccountRole    accountrole.Conf    `mapstructure:"account_role"`
+	Bank           bank.Conf           `mapstructure:"bank"`
+	Transfer       transfer.Conf       `mapstructure:"transfer"`
+	Webhook        webhook.Conf        `mapstructure:"webhook"`
+	DeadLetter     deadletter.Conf     `mapstructure:"dead_letter"`
+	FXRate         fxrate.Conf         `mapstructure:"fx_rate"`
+	FeeRule        feerule.Conf        `mapstructure:"fee_rule"`
+	AccountLimit   accountlimit.Conf   `mapstructure:"account_limit"`
+	Ledger         ledger.Conf         `mapstructure:"ledger"`
+	Reconciliation reconciliation.Conf `mapstructure:"reconciliation"`
+	Settlement     settlement.Conf     `mapstructure:"settlement"`
+	TransferExport transferexport.Conf `mapstructure:"transfer_export"`
+	TransferStream transferstream.Conf `mapstructure:"transfer_stream"`
 }
 
 type UsecaseInterface struct {
-	Account     account.AccountInterface
-	Role        role.RoleInterface
-	AccountRole accountrole.AccountRoleInterface
-	Bank        bank.BankInterface
-	Transfer    transfer.TransferInterface
+	Account        account.AccountInterface
+	Role           role.RoleInterface
+	AccountRole    accountrole.AccountRoleInterface
+	Bank           bank.BankInterface
+	Transfer       transfer.TransferInterface
+	Webhook        webhook.WebhookInterface
+	DeadLetter     deadletter.DeadLetterInterface
+	FXRate         fxrate.FXRateInterface
+	FeeRule        feerule.FeeRuleInterface
+	AccountLimit   accountlimit.AccountLimitInterface
+	Ledger         ledger.LedgerInterface
+	Reconciliation reconciliation.ReconciliationInterface
+	Settlement     settlement.SettlementInterface
+	TransferExport transferexport.TransferExportInterface
+	TransferStream transferstream.TransferStreamInterface
 }
 
 func New(u *Usecase) *UsecaseInterface {
@@ -38,6 +68,16 @@ func New(u *Usecase) *UsecaseInterface {
 		role.New(u.Conf.Role, u.Log, u.Repository.Role),
 		accountrole.New(u.Conf.AccountRole, u.Log, u.Repository.AccountRole),
 		bank.New(u.Conf.Bank, u.Log, u.Repository.Bank, u.Repository.Account),
-		transfer.New(u.Conf.Transfer, u.Log, u.Repository.Account, u.Repository.Transfer),
+		transfer.New(u.Conf.Transfer, u.Log, u.Repository.Account, u.Repository.Transfer, u.Repository.Webhook, u.Repository.FXRate, u.Repository.FeeRule, u.Repository.AccountLimit, u.Repository.Bank, u.Repository.TransferStream),
+		webhook.New(u.Conf.Webhook, u.Log, u.Repository.Webhook),
+		deadletter.New(u.Conf.DeadLetter, u.Log, u.Repository.DeadLetter),
+		fxrate.New(u.Conf.FXRate, u.Log, u.Repository.FXRate),
+		feerule.New(u.Conf.FeeRule, u.Log, u.Repository.FeeRule),
+		accountlimit.New(u.Conf.AccountLimit, u.Log, u.Repository.Account, u.Repository.AccountLimit),
+		ledger.New(u.Conf.Ledger, u.Log, u.Repository.Account, u.Repository.Ledger),
+		reconciliation.New(u.Conf.Reconciliation, u.Log, u.Repository.Transfer, u.Repository.Reconciliation),
+		settlement.New(u.Conf.Settlement, u.Log, u.Repository.Account, u.Repository.Settlement),
+		transferexport.New(u.Conf.TransferExport, u.Log, u.Repository.TransferExport),
+		transferstream.New(u.Conf.TransferStream, u.Log, u.Repository.Account, u.Repository.Transfer, u.Repository.TransferStream),
 	}
 }
@@ -0,0 +1,134 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/src/domain/entity"
+	"github.com/achwanyusuf/bricksvc/src/domain/model"
+	"github.com/achwanyusuf/bricksvc/src/domain/svcerr"
+	"github.com/achwanyusuf/bricksvc/src/repository/webhook"
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+	"github.com/achwanyusuf/bricksvc/utils/hash"
+	"github.com/achwanyusuf/bricksvc/utils/logger"
+	"github.com/lucsky/cuid"
+	"github.com/volatiletech/null/v8"
+)
+
+type Webhook struct {
+	log     logger.LoggerInterface
+	conf    Conf
+	webhook webhook.WebhookInterface
+}
+
+type Conf struct{}
+
+type WebhookInterface interface {
+	Create(ctx context.Context, v model.CreateWebhookEndpoint) (model.WebhookEndpoint, error)
+	GetByParam(ctx context.Context, v model.GetWebhookEndpointByParam) ([]model.WebhookEndpoint, error)
+	DeleteByID(ctx context.Context, id int64, v model.GetWebhookEndpointByParam) error
+	GetDeliveries(ctx context.Context, v model.GetWebhookEndpointByParam, limit int64) ([]model.WebhookDelivery, error)
+	Redeliver(ctx context.Context, id int64, deliveryID int64, accountID null.Int64) (model.WebhookDelivery, error)
+	Deliver(ctx context.Context, limit int64)
+}
+
+func New(conf Conf, logger *logger.LoggerInterface, webhook webhook.WebhookInterface) WebhookInterface {
+	return &Webhook{
+		conf:    conf,
+		log:     *logger,
+		webhook: webhook,
+	}
+}
+
+func (w *Webhook) Create(ctx context.Context, v model.CreateWebhookEndpoint) (model.WebhookEndpoint, error) {
+	if err := v.Validate(); err != nil {
+		return model.WebhookEndpoint{}, err
+	}
+
+	secret := hash.SHA(cuid.New() + time.Now().Format(time.RFC3339Nano))
+	endpoint := &entity.WebhookEndpoint{
+		AccountID: int(v.AccountID),
+		URL:       v.URL,
+		Secret:    secret,
+		IsActive:  true,
+		CreatedBy: int(v.CreatedBy),
+		UpdatedBy: int(v.CreatedBy),
+	}
+
+	err := w.webhook.InsertEndpoint(ctx, endpoint)
+	if err != nil {
+		return model.WebhookEndpoint{}, err
+	}
+
+	// the secret is only shown once, on registration
+	res := model.TransformPSQLSingleWebhookEndpoint(endpoint)
+	res.Secret = secret
+	return res, nil
+}
+
+func (w *Webhook) GetByParam(ctx context.Context, v model.GetWebhookEndpointByParam) ([]model.WebhookEndpoint, error) {
+	endpoints, err := w.webhook.GetEndpoints(ctx, &v)
+	if err != nil {
+		return []model.WebhookEndpoint{}, errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error get by param")
+	}
+	return model.TransformPSQLWebhookEndpoint(&endpoints), nil
+}
+
+func (w *Webhook) DeleteByID(ctx context.Context, id int64, v model.GetWebhookEndpointByParam) error {
+	endpoint, err := w.webhook.GetEndpoint(ctx, &v)
+	if err != nil {
+		return err
+	}
+	return w.webhook.DeleteEndpoint(ctx, &endpoint, id, false)
+}
+
+func (w *Webhook) GetDeliveries(ctx context.Context, v model.GetWebhookEndpointByParam, limit int64) ([]model.WebhookDelivery, error) {
+	endpoint, err := w.webhook.GetEndpoint(ctx, &v)
+	if err != nil {
+		return []model.WebhookDelivery{}, err
+	}
+
+	deliveries, err := w.webhook.GetDeliveries(ctx, int64(endpoint.ID), limit)
+	if err != nil {
+		return []model.WebhookDelivery{}, err
+	}
+	return model.TransformPSQLWebhookDelivery(&deliveries), nil
+}
+
+func (w *Webhook) Redeliver(ctx context.Context, id int64, deliveryID int64, accountID null.Int64) (model.WebhookDelivery, error) {
+	delivery, err := w.webhook.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return model.WebhookDelivery{}, err
+	}
+
+	if delivery.R == nil || delivery.R.WebhookEndpoint == nil {
+		return model.WebhookDelivery{}, errormsg.WrapErr(svcerr.BrickSVCNotFound, nil, "webhook endpoint not found")
+	}
+
+	if accountID.Valid && int64(delivery.R.WebhookEndpoint.AccountID) != accountID.Int64 {
+		return model.WebhookDelivery{}, errormsg.WrapErr(svcerr.BrickSVCNotFound, nil, "webhook delivery not found")
+	}
+
+	// keep the original attempt log intact and queue a fresh delivery of the same payload
+	redelivery := &entity.WebhookDelivery{
+		WebhookEndpointID:  delivery.WebhookEndpointID,
+		TransferJobEventID: delivery.TransferJobEventID,
+		EventType:          delivery.EventType,
+		Payload:            delivery.Payload,
+		Status:             entity.WebhookdeliverystatusPending,
+		NextAttemptAt:      time.Now().UTC(),
+		CreatedBy:          int(id),
+		UpdatedBy:          int(id),
+	}
+	err = w.webhook.InsertDelivery(ctx, redelivery)
+	if err != nil {
+		return model.WebhookDelivery{}, err
+	}
+	return model.TransformPSQLSingleWebhookDelivery(redelivery), nil
+}
+
+func (w *Webhook) Deliver(ctx context.Context, limit int64) {
+	if _, err := w.webhook.Deliver(ctx, int(limit)); err != nil {
+		logger.Log.Error(errormsg.WriteErr(errormsg.WrapErr(svcerr.BrickSVCBadRequest, err, "error deliver webhook")))
+	}
+}
@@ -3,6 +3,7 @@ package hash
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/md5"
 	"crypto/rand"
 	"crypto/sha256"
@@ -26,6 +27,12 @@ func SHA(v string) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
+func HMAC(v string, key string) string {
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write([]byte(v))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 func Compare(v string, i string) error {
 	return bcrypt.CompareHashAndPassword([]byte(v), []byte(i))
 }
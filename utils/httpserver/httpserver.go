@@ -241,6 +241,26 @@ func Protected(secret string) fiber.Handler {
 	})
 }
 
+// ProtectedOrAPIKey protect routes that also take an api key, a request carrying the api key header and no token
+// is passed through for the handler to check the key
+func ProtectedOrAPIKey(secret string, header string) fiber.Handler {
+	return jwtware.New(jwtware.Config{
+		Filter: func(ctx *fiber.Ctx) bool {
+			return ctx.Get(fiber.HeaderAuthorization) == "" && ctx.Get(header) != ""
+		},
+		SigningKey: jwtware.SigningKey{
+			Key: []byte(secret),
+		},
+		ErrorHandler: jwtError,
+	})
+}
+
+// HasUserData reports whether the request was authenticated with a token
+func HasUserData(ctx *fiber.Ctx) bool {
+	_, ok := ctx.Locals("user").(*jwt.Token)
+	return ok
+}
+
 func jwtError(ctx *fiber.Ctx, err error) error {
 	resp := response{
 		TransactionInfo: transactionInfo{
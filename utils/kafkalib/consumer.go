@@ -1,6 +1,7 @@
 package kafkalib
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -90,6 +91,7 @@ var defaultConf = kafka.ConfigMap{
 type ConsumerInterface interface {
 	Serve() error
 	Handler(topic string, fn HandlerFunc)
+	RetryHandler(topic string, retry Retry, producer ProducerInterface, fn HandlerFunc)
 	Stop()
 }
 
@@ -99,6 +101,7 @@ type consumer struct {
 	signal     chan struct{}
 	topics     []string
 	handlers   map[string]HandlerFunc
+	retries    map[string]retryRoute
 	autoCommit bool
 }
 
@@ -113,6 +116,21 @@ func (c *consumer) Handler(topic string, fn HandlerFunc) {
 	c.handlers[topic] = fn
 }
 
+// RetryHandler serves the topic and its retry topics with the same handler, messages the handler fails are forwarded by retry tier
+func (c *consumer) RetryHandler(topic string, retry Retry, producer ProducerInterface, fn HandlerFunc) {
+	route := retryRoute{
+		topic:    topic,
+		retry:    retry,
+		producer: producer,
+	}
+	c.Handler(topic, fn)
+	c.retries[topic] = route
+	for _, v := range retry.Topics {
+		c.Handler(v.Topic, fn)
+		c.retries[v.Topic] = route
+	}
+}
+
 func NewConsumer(conf *Conf) (ConsumerInterface, error) {
 	if !conf.Enabled {
 		return &consumer{}, nil
@@ -175,6 +193,7 @@ func NewConsumer(conf *Conf) (ConsumerInterface, error) {
 		consumer:   c,
 		signal:     make(chan struct{}, 1),
 		handlers:   make(map[string]HandlerFunc),
+		retries:    make(map[string]retryRoute),
 		autoCommit: conf.EnableAutoCommit,
 	}, nil
 }
@@ -216,8 +235,20 @@ func (c *consumer) Serve() error {
 						resp := &Response{
 							commit: false,
 						}
+						route, hasRetry := c.retries[req.Topic]
+						if hasRetry {
+							route.wait(req)
+						}
 						handler.Set(req, resp)
 
+						if resp.err != nil && hasRetry {
+							// keep the offset uncommitted when the message could not be forwarded
+							if err := route.forward(context.Background(), req, resp); err != nil {
+								logger.Log.Error(errormsg.WriteErr(err))
+								resp.commit = false
+							}
+						}
+
 						if resp.commit || c.autoCommit {
 							msg.TopicPartition.Offset += 1
 							if _, err := c.consumer.CommitOffsets([]kafka.TopicPartition{msg.TopicPartition}); err != nil {
@@ -10,6 +10,7 @@ import (
 
 type ProducerInterface interface {
 	Publish(ctx context.Context, topic string, key []byte, msg []byte) (int, error)
+	PublishWithHeader(ctx context.Context, topic string, key []byte, msg []byte, header map[string]string) (int, error)
 }
 
 type producer struct {
@@ -77,10 +78,20 @@ func NewProducer(conf *Conf) (ProducerInterface, error) {
 }
 
 func (p *producer) Publish(ctx context.Context, topic string, key []byte, msg []byte) (int, error) {
+	return p.PublishWithHeader(ctx, topic, key, msg, nil)
+}
+
+func (p *producer) PublishWithHeader(ctx context.Context, topic string, key []byte, msg []byte, header map[string]string) (int, error) {
+	var headers []kafka.Header
+	for k, v := range header {
+		headers = append(headers, kafka.Header{Key: k, Value: []byte(v)})
+	}
+
 	deliveryChan := make(chan kafka.Event)
 	err := p.producer.Produce(&kafka.Message{
-		Key:   key,
-		Value: msg,
+		Key:     key,
+		Value:   msg,
+		Headers: headers,
 		TopicPartition: kafka.TopicPartition{
 			Topic:     &topic,
 			Partition: kafka.PartitionAny,
@@ -17,10 +17,10 @@ type Request struct {
 	TimestampType string
 }
 
-func (r *Request) setHeader(kh []kafka.Header) map[string]string {
+func (r *Request) setHeader(kh []kafka.Header) {
 	h := make(map[string]string)
 	for _, v := range kh {
 		h[v.Key] = string(v.Value)
 	}
-	return h
+	r.Header = h
 }
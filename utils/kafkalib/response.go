@@ -2,16 +2,31 @@ package kafkalib
 
 type ResponseInterface interface {
 	Commit()
+	Retry(err error)
+	DeadLetter(err error)
 }
 
 type Response struct {
-	commit bool
+	commit     bool
+	err        error
+	deadLetter bool
 }
 
 func (w *Response) Commit() {
 	w.commit = true
 }
 
+// Retry forwards the message to the next retry topic of the handler, or to its dead letter topic once the retry topics are used up
+func (w *Response) Retry(err error) {
+	w.err = err
+}
+
+// DeadLetter forwards the message straight to the dead letter topic of the handler
+func (w *Response) DeadLetter(err error) {
+	w.err = err
+	w.deadLetter = true
+}
+
 func NewWriter() ResponseInterface {
 	return &Response{
 		commit: false,
@@ -0,0 +1,70 @@
+package kafkalib
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/achwanyusuf/bricksvc/utils/errormsg"
+)
+
+const (
+	HeaderOriginalTopic string = "x-original-topic"
+	HeaderSourceTopic   string = "x-source-topic"
+	HeaderRetryAttempt  string = "x-retry-attempt"
+	HeaderError         string = "x-error"
+	HeaderFailedAt      string = "x-failed-at"
+	HeaderNotBefore     string = "x-not-before"
+)
+
+type Retry struct {
+	Topics          []RetryTopic `mapstructure:"topics"`
+	DeadLetterTopic string       `mapstructure:"dead_letter_topic"`
+}
+
+type RetryTopic struct {
+	Topic string        `mapstructure:"topic"`
+	Delay time.Duration `mapstructure:"delay"`
+}
+
+type retryRoute struct {
+	topic    string
+	retry    Retry
+	producer ProducerInterface
+}
+
+// wait holds a message consumed from a retry topic until its delay has passed
+func (r retryRoute) wait(req *Request) {
+	notBefore, err := time.Parse(time.RFC3339Nano, req.Header[HeaderNotBefore])
+	if err != nil {
+		return
+	}
+	time.Sleep(time.Until(notBefore))
+}
+
+// forward publishes a failed message to the next retry topic, or to the dead letter topic, with the failure carried in its headers
+func (r retryRoute) forward(ctx context.Context, req *Request, resp *Response) error {
+	attempt, _ := strconv.Atoi(req.Header[HeaderRetryAttempt])
+	header := map[string]string{
+		HeaderOriginalTopic: r.topic,
+		HeaderSourceTopic:   req.Topic,
+		HeaderRetryAttempt:  strconv.Itoa(attempt),
+		HeaderError:         errormsg.WriteErr(resp.err),
+		HeaderFailedAt:      time.Now().UTC().Format(time.RFC3339Nano),
+	}
+
+	topic := r.retry.DeadLetterTopic
+	if !resp.deadLetter && attempt < len(r.retry.Topics) {
+		next := r.retry.Topics[attempt]
+		topic = next.Topic
+		header[HeaderRetryAttempt] = strconv.Itoa(attempt + 1)
+		header[HeaderNotBefore] = time.Now().UTC().Add(next.Delay).Format(time.RFC3339Nano)
+	}
+
+	if topic == "" {
+		return errormsg.WrapErr(errormsg.Error500, nil, "no dead letter topic configured for "+r.topic)
+	}
+
+	_, err := r.producer.PublishWithHeader(ctx, topic, []byte(req.Key), []byte(req.Value), header)
+	return err
+}